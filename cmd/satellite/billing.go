@@ -14,6 +14,8 @@ import (
 	"common/uuid"
 	"private/process"
 	"storx/satellite"
+	"storx/satellite/payments"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/stripecoinpayments"
 	"storx/satellite/satellitedb"
 )
@@ -37,12 +39,59 @@ func runBillingCmd(ctx context.Context, cmdFunc func(context.Context, *stripecoi
 	return cmdFunc(ctx, payments, db)
 }
 
+func runInvoicingCmd(ctx context.Context, cmdFunc func(context.Context, payments.InvoiceGenerator) error) error {
+	// Open SatelliteDB for the Payment Service
+	logger := zap.L()
+	db, err := satellitedb.Open(ctx, logger.Named("db"), runCfg.Database, satellitedb.Options{ApplicationName: "satellite-billing"})
+	if err != nil {
+		return errs.New("error connecting to master database on satellite: %+v", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	generator, err := setupInvoiceGenerator(logger, db)
+	if err != nil {
+		return err
+	}
+
+	return cmdFunc(ctx, generator)
+}
+
+// setupInvoiceGenerator returns the invoice generator of the configured payments provider.
+func setupInvoiceGenerator(log *zap.Logger, db satellite.DB) (payments.InvoiceGenerator, error) {
+	pc := runCfg.Payments
+	if pc.Provider != "invoice-only" {
+		return setupPayments(log, db)
+	}
+
+	prices, err := pc.UsagePrice.ToModel()
+	if err != nil {
+		return nil, err
+	}
+
+	priceOverrides, err := pc.UsagePriceOverrides.ToModels()
+	if err != nil {
+		return nil, err
+	}
+
+	return invoiceonly.NewService(
+		log.Named("payments.invoiceonly:service"),
+		pc.InvoiceOnly,
+		db.InvoiceOnly(),
+		db.Console().Projects(),
+		db.Console().Users(),
+		db.ProjectAccounting(),
+		prices,
+		priceOverrides)
+}
+
 func setupPayments(log *zap.Logger, db satellite.DB) (*stripecoinpayments.Service, error) {
 	pc := runCfg.Payments
 
 	var stripeClient stripecoinpayments.StripeClient
 	switch pc.Provider {
-	case "": // just new mock, only used in testing binaries
+	case "", "invoice-only": // just new mock, used in testing binaries and when invoices are paid outside of stripe
 		stripeClient = stripecoinpayments.NewStripeMock(
 			db.StripeCoinPayments().Customers(),
 			db.Console().Users(),
@@ -41,6 +41,7 @@ import (
 	"storx/satellite/compensation"
 	"storx/satellite/metabase"
 	"storx/satellite/overlay"
+	"storx/satellite/payments"
 	"storx/satellite/payments/stripecoinpayments"
 	"storx/satellite/satellitedb"
 )
@@ -225,21 +226,21 @@ var (
 	createCustomerInvoicesCmd = &cobra.Command{
 		Use:   "create-invoices [period]",
 		Short: "Creates stripe invoices from pending invoice items",
-		Long:  "Creates stripe invoices for all stripe customers known to satellite",
+		Long:  "Creates stripe invoices for all stripe customers known to satellite. With the invoice-only payments provider, stores the documents of the draft invoices instead.",
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdCreateCustomerInvoices,
 	}
 	generateCustomerInvoicesCmd = &cobra.Command{
 		Use:   "generate-invoices [period]",
 		Short: "Performs all tasks necessary to generate Stripe invoices",
-		Long:  "Performs all tasks necessary to generate Stripe invoices. Equivalent to running apply-free-coupons, prepare-invoice-records, create-project-invoice-items, and create-invoices in order. Does not finalize invoices.",
+		Long:  "Performs all tasks necessary to generate Stripe invoices. Equivalent to running apply-free-coupons, prepare-invoice-records, create-project-invoice-items, and create-invoices in order. With the invoice-only payments provider, equivalent to running prepare-invoice-records and create-invoices. Does not finalize invoices.",
 		Args:  cobra.ExactArgs(1),
 		RunE:  cmdGenerateCustomerInvoices,
 	}
 	finalizeCustomerInvoicesCmd = &cobra.Command{
 		Use:   "finalize-invoices",
 		Short: "Finalizes all draft stripe invoices",
-		Long:  "Finalizes all draft stripe invoices known to satellite's stripe account.",
+		Long:  "Finalizes all draft stripe invoices known to satellite's stripe account. With the invoice-only payments provider, opens the draft invoices for manual payment.",
 		RunE:  cmdFinalizeCustomerInvoices,
 	}
 	payCustomerInvoicesCmd = &cobra.Command{
@@ -540,6 +541,11 @@ func cmdMigrationRun(cmd *cobra.Command, args []string) (err error) {
 		return errs.New("Error creating metabase tables: %+v", err)
 	}
 
+	err = live.MigrateToLatest(ctx, log.Named("live-accounting"), runCfg.LiveAccounting)
+	if err != nil {
+		return errs.New("Error creating live accounting tables: %+v", err)
+	}
+
 	return nil
 }
 
@@ -754,8 +760,8 @@ func cmdPrepareCustomerInvoiceRecords(cmd *cobra.Command, args []string) (err er
 		return err
 	}
 
-	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
-		return payments.PrepareInvoiceProjectRecords(ctx, periodStart)
+	return runInvoicingCmd(ctx, func(ctx context.Context, generator payments.InvoiceGenerator) error {
+		return generator.PrepareInvoiceProjectRecords(ctx, periodStart)
 	})
 }
 
@@ -780,8 +786,8 @@ func cmdCreateCustomerInvoices(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
-	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
-		return payments.CreateInvoices(ctx, periodStart)
+	return runInvoicingCmd(ctx, func(ctx context.Context, generator payments.InvoiceGenerator) error {
+		return generator.CreateInvoices(ctx, periodStart)
 	})
 }
 
@@ -793,16 +799,16 @@ func cmdGenerateCustomerInvoices(cmd *cobra.Command, args []string) (err error)
 		return err
 	}
 
-	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
-		return payments.GenerateInvoices(ctx, periodStart)
+	return runInvoicingCmd(ctx, func(ctx context.Context, generator payments.InvoiceGenerator) error {
+		return generator.GenerateInvoices(ctx, periodStart)
 	})
 }
 
 func cmdFinalizeCustomerInvoices(cmd *cobra.Command, args []string) (err error) {
 	ctx, _ := process.Ctx(cmd)
 
-	return runBillingCmd(ctx, func(ctx context.Context, payments *stripecoinpayments.Service, _ satellite.DB) error {
-		return payments.FinalizeInvoices(ctx)
+	return runInvoicingCmd(ctx, func(ctx context.Context, generator payments.InvoiceGenerator) error {
+		return generator.FinalizeInvoices(ctx)
 	})
 }
 
@@ -0,0 +1,213 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"golang.org/x/time/rate"
+
+	"common/memory"
+)
+
+// bandwidthWindow is the rate limit in bytes per second starting at a time of
+// the day. A zero rate is unlimited.
+type bandwidthWindow struct {
+	start time.Duration
+	rate  int64
+}
+
+// bandwidthSchedule is a list of windows sorted by their start. The last window
+// of a day continues until the first window of the next day.
+type bandwidthSchedule []bandwidthWindow
+
+// parseBandwidthSchedule parses either a single rate like '10MiB' or a schedule of
+// space separated 'HH:MM,RATE' entries like '08:00,512KiB 18:00,10MiB 23:00,off'.
+func parseBandwidthSchedule(value string) (bandwidthSchedule, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	if len(fields) == 1 && !strings.Contains(fields[0], ",") {
+		limit, err := parseBandwidthRate(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		return bandwidthSchedule{{rate: limit}}, nil
+	}
+
+	schedule := make(bandwidthSchedule, 0, len(fields))
+	for _, field := range fields {
+		at, limit, ok := strings.Cut(field, ",")
+		if !ok {
+			return nil, errs.New("invalid schedule entry %q: expected HH:MM,RATE", field)
+		}
+
+		start, err := time.Parse("15:04", at)
+		if err != nil {
+			return nil, errs.New("invalid schedule entry %q: %v", field, err)
+		}
+
+		window := bandwidthWindow{
+			start: time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute,
+		}
+		window.rate, err = parseBandwidthRate(limit)
+		if err != nil {
+			return nil, errs.New("invalid schedule entry %q: %v", field, err)
+		}
+
+		schedule = append(schedule, window)
+	}
+
+	sort.Slice(schedule, func(i, j int) bool { return schedule[i].start < schedule[j].start })
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].start == schedule[i-1].start {
+			return nil, errs.New("schedule contains multiple entries for the same time")
+		}
+	}
+	return schedule, nil
+}
+
+func parseBandwidthRate(value string) (int64, error) {
+	if value == "off" {
+		return 0, nil
+	}
+	limit, err := memory.ParseString(value)
+	if err != nil {
+		return 0, errs.New("invalid rate %q: %v", value, err)
+	}
+	if limit <= 0 {
+		return 0, errs.New("invalid rate %q: must be positive or 'off'", value)
+	}
+	return limit, nil
+}
+
+// rateAt returns the rate limit in effect at the time.
+func (bs bandwidthSchedule) rateAt(now time.Time) int64 {
+	if len(bs) == 0 {
+		return 0
+	}
+
+	sinceMidnight := time.Duration(now.Hour())*time.Hour +
+		time.Duration(now.Minute())*time.Minute +
+		time.Duration(now.Second())*time.Second
+
+	current := bs[len(bs)-1]
+	for _, window := range bs {
+		if window.start > sinceMidnight {
+			break
+		}
+		current = window
+	}
+	return current.rate
+}
+
+// bandwidthLimiter limits the combined rate of every transfer according to the
+// schedule.
+type bandwidthLimiter struct {
+	schedule bandwidthSchedule
+
+	mu      sync.Mutex
+	rate    int64
+	limiter *rate.Limiter
+}
+
+// newBandwidthLimiter returns a limiter for the schedule or nil if there is none.
+func newBandwidthLimiter(schedule bandwidthSchedule) *bandwidthLimiter {
+	if len(schedule) == 0 {
+		return nil
+	}
+	return &bandwidthLimiter{
+		schedule: schedule,
+		rate:     -1,
+		limiter:  rate.NewLimiter(rate.Inf, 0),
+	}
+}
+
+// reserve updates the limiter to the rate of the schedule and reserves up to
+// max bytes. The chunk is sized and reserved while holding the lock, so a
+// concurrent change of the burst can't make the reservation fail.
+func (bl *bandwidthLimiter) reserve(now time.Time, max int) (chunk int, reservation *rate.Reservation) {
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	if limit := bl.schedule.rateAt(now); limit != bl.rate {
+		bl.rate = limit
+		if limit == 0 {
+			bl.limiter.SetLimitAt(now, rate.Inf)
+		} else {
+			bl.limiter.SetLimitAt(now, rate.Limit(limit))
+			bl.limiter.SetBurstAt(now, int(limit))
+		}
+	}
+
+	chunk = max
+	if bl.limiter.Limit() != rate.Inf && chunk > bl.limiter.Burst() {
+		chunk = bl.limiter.Burst()
+	}
+	return chunk, bl.limiter.ReserveN(now, chunk)
+}
+
+// wait blocks until up to max bytes may be transferred and returns how many.
+func (bl *bandwidthLimiter) wait(ctx context.Context, max int) (int, error) {
+	now := time.Now()
+	chunk, reservation := bl.reserve(now, max)
+	if !reservation.OK() {
+		return 0, errs.New("unable to reserve %d bytes of bandwidth", chunk)
+	}
+
+	delay := reservation.DelayFrom(now)
+	if delay <= 0 {
+		return chunk, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return chunk, nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return 0, ctx.Err()
+	}
+}
+
+// Writer returns a writer that waits for the limit before every write to w.
+func (bl *bandwidthLimiter) Writer(ctx context.Context, w io.Writer) io.Writer {
+	if bl == nil {
+		return w
+	}
+	return &limitedWriter{ctx: ctx, bl: bl, w: w}
+}
+
+type limitedWriter struct {
+	ctx context.Context
+	bl  *bandwidthLimiter
+	w   io.Writer
+}
+
+func (lw *limitedWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		chunk, err := lw.bl.wait(lw.ctx, len(p))
+		if err != nil {
+			return n, err
+		}
+
+		m, err := lw.w.Write(p[:chunk])
+		n += m
+		if err != nil {
+			return n, err
+		}
+		p = p[chunk:]
+	}
+	return n, nil
+}
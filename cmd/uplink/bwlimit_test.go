@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+)
+
+func TestBandwidthSchedule(t *testing.T) {
+	at := func(clock string) time.Time {
+		tm, err := time.Parse("15:04", clock)
+		require.NoError(t, err)
+		return tm
+	}
+
+	schedule, err := parseBandwidthSchedule("")
+	require.NoError(t, err)
+	require.Nil(t, schedule)
+
+	schedule, err = parseBandwidthSchedule("10MiB")
+	require.NoError(t, err)
+	require.Equal(t, memory.MiB.Int64()*10, schedule.rateAt(at("03:00")))
+
+	schedule, err = parseBandwidthSchedule("18:00,10MiB 08:00,512KiB 23:00,off")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), schedule.rateAt(at("07:59")))
+	require.Equal(t, memory.KiB.Int64()*512, schedule.rateAt(at("08:00")))
+	require.Equal(t, memory.KiB.Int64()*512, schedule.rateAt(at("17:59")))
+	require.Equal(t, memory.MiB.Int64()*10, schedule.rateAt(at("18:00")))
+	require.Equal(t, int64(0), schedule.rateAt(at("23:30")))
+
+	for _, invalid := range []string{
+		"fast",
+		"-1MiB",
+		"08:00",
+		"25:00,1MiB",
+		"08:00,1MiB 08:00,2MiB",
+	} {
+		_, err := parseBandwidthSchedule(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestBandwidthLimiterReserve(t *testing.T) {
+	at := func(clock string) time.Time {
+		tm, err := time.Parse("15:04", clock)
+		require.NoError(t, err)
+		return tm
+	}
+
+	schedule, err := parseBandwidthSchedule("00:00,1MiB 12:00,1KiB 18:00,off")
+	require.NoError(t, err)
+	limiter := newBandwidthLimiter(schedule)
+
+	chunk, reservation := limiter.reserve(at("11:59"), memory.MiB.Int())
+	require.True(t, reservation.OK())
+	require.Equal(t, memory.MiB.Int(), chunk)
+
+	// the chunk is sized by the burst of the new window instead of failing.
+	chunk, reservation = limiter.reserve(at("12:00"), memory.MiB.Int())
+	require.True(t, reservation.OK())
+	require.Equal(t, memory.KiB.Int(), chunk)
+
+	chunk, reservation = limiter.reserve(at("18:00"), memory.MiB.Int())
+	require.True(t, reservation.OK())
+	require.Equal(t, memory.MiB.Int(), chunk)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retryPolicy{retries: 10, delay: time.Second, maxDelay: 5 * time.Second}
+	require.Equal(t, time.Second, policy.backoff(1))
+	require.Equal(t, 2*time.Second, policy.backoff(2))
+	require.Equal(t, 4*time.Second, policy.backoff(3))
+	require.Equal(t, 5*time.Second, policy.backoff(4))
+	require.Equal(t, 5*time.Second, policy.backoff(10))
+}
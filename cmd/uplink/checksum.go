@@ -0,0 +1,243 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"hash/crc32"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+const (
+	// metadataSHA256 is the custom metadata key storing the hex encoded SHA-256
+	// of the contents of an object.
+	metadataSHA256 = "storx-sha256"
+	// metadataCRC32C is the custom metadata key storing the hex encoded CRC-32C
+	// of the contents of an object.
+	metadataCRC32C = "storx-crc32c"
+)
+
+// checksumAlgorithm is a whole object checksum that is stored in the object metadata.
+type checksumAlgorithm struct {
+	name string
+	key  string
+	new  func() hash.Hash
+}
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// checksumAlgorithms are the supported checksum algorithms. The first one is used
+// when the contents are compared without a stored checksum.
+var checksumAlgorithms = []checksumAlgorithm{
+	{name: "sha256", key: metadataSHA256, new: sha256.New},
+	{name: "crc32c", key: metadataCRC32C, new: func() hash.Hash { return crc32.New(crc32cTable) }},
+}
+
+// parseChecksumAlgorithm returns the name of a supported checksum algorithm.
+func parseChecksumAlgorithm(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if _, ok := findChecksumAlgorithm(name); !ok && name != "" {
+		return "", errs.New("unknown checksum algorithm %q: must be sha256 or crc32c", name)
+	}
+	return name, nil
+}
+
+func findChecksumAlgorithm(name string) (checksumAlgorithm, bool) {
+	for _, algorithm := range checksumAlgorithms {
+		if algorithm.name == name {
+			return algorithm, true
+		}
+	}
+	return checksumAlgorithm{}, false
+}
+
+// storedChecksums returns the algorithms of the checksums stored in the metadata.
+func storedChecksums(metadata map[string]string) (algorithms []checksumAlgorithm) {
+	for _, algorithm := range checksumAlgorithms {
+		if metadata[algorithm.key] != "" {
+			algorithms = append(algorithms, algorithm)
+		}
+	}
+	return algorithms
+}
+
+// computeChecksums reads the contents of the file or object once and returns the hex
+// encoded checksums by their metadata key.
+func computeChecksums(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location, algorithms []checksumAlgorithm) (_ map[string]string, err error) {
+	mrh, err := fs.Open(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rh.Close() }()
+
+	hashes := make([]hash.Hash, len(algorithms))
+	writers := make([]io.Writer, len(algorithms))
+	for i, algorithm := range algorithms {
+		hashes[i] = algorithm.new()
+		writers[i] = hashes[i]
+	}
+
+	if _, err := io.Copy(io.MultiWriter(writers...), rh); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	sums := make(map[string]string, len(algorithms))
+	for i, algorithm := range algorithms {
+		sums[algorithm.key] = hex.EncodeToString(hashes[i].Sum(nil))
+	}
+	return sums, nil
+}
+
+// verifyChecksums compares the contents of the file or object against the checksums
+// stored in the metadata. It returns the algorithms that were compared.
+func verifyChecksums(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location, metadata map[string]string) ([]checksumAlgorithm, error) {
+	algorithms := storedChecksums(metadata)
+	if len(algorithms) == 0 {
+		return nil, nil
+	}
+
+	sums, err := computeChecksums(ctx, fs, loc, algorithms)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, algorithm := range algorithms {
+		if expected := strings.ToLower(metadata[algorithm.key]); sums[algorithm.key] != expected {
+			return nil, errs.New("%s checksum mismatch for %s: got %s, expected %s",
+				algorithm.name, loc, sums[algorithm.key], expected)
+		}
+	}
+	return algorithms, nil
+}
+
+// partHasher computes the checksum of an upload from the parts as they are copied,
+// so that the source doesn't have to be read twice. Parts are copied in parallel,
+// so the data of a part is buffered until all the parts before it are hashed.
+type partHasher struct {
+	algorithm checksumAlgorithm
+	base      map[string]string
+
+	mu    sync.Mutex
+	hash  hash.Hash
+	next  int
+	parts map[int]*hashedPart
+}
+
+// hashedPart is the data of a part that was received by the partHasher.
+type hashedPart struct {
+	received int64
+	buf      bytes.Buffer
+	done     bool
+}
+
+func newPartHasher(algorithm checksumAlgorithm, metadata map[string]string) *partHasher {
+	return &partHasher{
+		algorithm: algorithm,
+		base:      metadata,
+		hash:      algorithm.new(),
+		parts:     make(map[int]*hashedPart),
+	}
+}
+
+// writer returns the writer of an attempt to copy the part. The data that was
+// already received by an earlier attempt is only hashed once.
+func (h *partHasher) writer(part int) io.Writer {
+	return &partHashWriter{hasher: h, part: part}
+}
+
+// done marks the part as completely received.
+func (h *partHasher) done(part int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.getPart(part).done = true
+
+	for {
+		current, ok := h.parts[h.next]
+		if !ok || !current.done {
+			return
+		}
+		delete(h.parts, h.next)
+		h.next++
+
+		if following, ok := h.parts[h.next]; ok {
+			_, _ = h.hash.Write(following.buf.Bytes())
+			following.buf = bytes.Buffer{}
+		}
+	}
+}
+
+// metadata returns the metadata of the upload with the checksum added. It must
+// only be called once all the parts are done.
+func (h *partHasher) metadata() map[string]string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	withChecksum := make(map[string]string, len(h.base)+1)
+	for key, value := range h.base {
+		withChecksum[key] = value
+	}
+	withChecksum[h.algorithm.key] = hex.EncodeToString(h.hash.Sum(nil))
+	return withChecksum
+}
+
+func (h *partHasher) getPart(part int) *hashedPart {
+	hp, ok := h.parts[part]
+	if !ok {
+		hp = &hashedPart{}
+		h.parts[part] = hp
+	}
+	return hp
+}
+
+// partHashWriter passes the data of an attempt to copy a part to the partHasher.
+type partHashWriter struct {
+	hasher *partHasher
+	part   int
+	pos    int64
+}
+
+func (w *partHashWriter) Write(p []byte) (int, error) {
+	h := w.hasher
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	hp := h.getPart(w.part)
+
+	data := p
+	if seen := hp.received - w.pos; seen > 0 {
+		if seen >= int64(len(data)) {
+			data = nil
+		} else {
+			data = data[seen:]
+		}
+	}
+	w.pos += int64(len(p))
+	hp.received += int64(len(data))
+
+	if w.part == h.next {
+		_, _ = h.hash.Write(data)
+	} else {
+		_, _ = hp.buf.Write(data)
+	}
+	return len(p), nil
+}
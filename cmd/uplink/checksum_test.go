@@ -0,0 +1,47 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartHasher(t *testing.T) {
+	algorithm, ok := findChecksumAlgorithm("sha256")
+	require.True(t, ok)
+
+	parts := []string{"abc", "defg", "", "hij"}
+	sum := sha256.Sum256([]byte("abcdefghij"))
+
+	hasher := newPartHasher(algorithm, map[string]string{"foo": "bar"})
+
+	// the parts are received out of order.
+	_, err := hasher.writer(3).Write([]byte(parts[3]))
+	require.NoError(t, err)
+	hasher.done(3)
+
+	// a failed attempt to copy a part is retried from the start.
+	_, err = hasher.writer(1).Write([]byte(parts[1][:2]))
+	require.NoError(t, err)
+	retry := hasher.writer(1)
+	_, err = retry.Write([]byte(parts[1][:1]))
+	require.NoError(t, err)
+	_, err = retry.Write([]byte(parts[1][1:]))
+	require.NoError(t, err)
+	hasher.done(1)
+
+	_, err = hasher.writer(0).Write([]byte(parts[0]))
+	require.NoError(t, err)
+	hasher.done(0)
+	hasher.done(2)
+
+	require.Equal(t, map[string]string{
+		"foo":          "bar",
+		metadataSHA256: hex.EncodeToString(sum[:]),
+	}, hasher.metadata())
+}
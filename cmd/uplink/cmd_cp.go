@@ -35,9 +35,24 @@ type cmdCp struct {
 	transfers int
 	dryrun    bool
 	progress  bool
+	resume    bool
+	checksum  string
+	verify    bool
 	byteRange string
 	expires   time.Time
 	metadata  map[string]string
+	filter    filterFlags
+
+	accessDest string
+
+	bwlimit      bandwidthSchedule
+	retry        retryPolicy
+	failedOutput string
+	failedInput  string
+
+	bandwidth *bandwidthLimiter
+	failed    *failedItems
+	destFs    ulfs.Filesystem
 
 	parallelism          int
 	parallelismChunkSize memory.Size
@@ -55,6 +70,7 @@ func newCmdCp(ex ulext.External) *cmdCp {
 
 func (c *cmdCp) Setup(params clingy.Parameters) {
 	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.accessDest = params.Flag("access-dest", "Access name or value to use for remote destinations, which may be in another project. Such copies are transferred through this machine", "").(string)
 	c.recursive = params.Flag("recursive", "Peform a recursive copy", false,
 		clingy.Short('r'),
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
@@ -75,6 +91,15 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 	c.progress = params.Flag("progress", "Show a progress bar when possible", true,
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
+	c.resume = params.Flag("resume", "Continue interrupted uploads and downloads instead of starting over. Existing local files are overwritten unless their download was interrupted", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.checksum = params.Flag("checksum", "Store a checksum of the contents in the object metadata when uploading (sha256 or crc32c)", "",
+		clingy.Transform(parseChecksumAlgorithm),
+	).(string)
+	c.verify = params.Flag("verify", "Verify downloads against the checksums stored in the object metadata", true,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
 	c.byteRange = params.Flag("range", "Downloads the specified range bytes of an object. For more information about the HTTP Range header, see https://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35", "").(string)
 
 	c.parallelism = params.Flag("parallelism", "Controls how many parallel chunks to upload/download from a file", 1,
@@ -111,6 +136,29 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 		"optional metadata for the object. Please use a single level JSON object of string to string only",
 		nil, clingy.Transform(parseJSON), clingy.Type("string")).(map[string]string)
 
+	c.filter.Setup(params)
+
+	c.bwlimit = params.Flag("bwlimit", "Limit the combined bandwidth of all transfers in bytes per second (e.g. '10MiB'), or by a schedule of 'HH:MM,RATE' entries in local time (e.g. '08:00,1MiB 18:00,off')", bandwidthSchedule(nil),
+		clingy.Transform(parseBandwidthSchedule), clingy.Type("rate_or_schedule"),
+	).(bandwidthSchedule)
+	c.retry.retries = params.Flag("retries", "How many times a failed file, a failed read of one of its parts or a failed upload of one of its parts is attempted again", 0,
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n < 0 {
+				return 0, errs.New("retries cannot be below 0")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.retry.delay = params.Flag("retry-delay", "Delay before the first retry, doubled for every following retry", time.Second,
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+	c.retry.maxDelay = params.Flag("retry-max-delay", "Maximum delay between retries", time.Minute,
+		clingy.Transform(time.ParseDuration),
+	).(time.Duration)
+	c.failedOutput = params.Flag("failed-output", "Write the copies that failed to this local file as JSON lines which can be passed to --failed-input", "").(string)
+	c.failedInput = params.Flag("failed-input", "Copy the items of a file written by --failed-output again instead of the locations", "").(string)
+
 	c.locs = params.Arg("locations", "Locations to copy (at least one source and one destination). Use - for standard input/output",
 		clingy.Transform(ulloc.Parse),
 		clingy.Repeated,
@@ -118,27 +166,62 @@ func (c *cmdCp) Setup(params clingy.Parameters) {
 }
 
 func (c *cmdCp) Execute(ctx context.Context) error {
-	if len(c.locs) < 2 {
+	if c.failedInput != "" {
+		if len(c.locs) > 0 {
+			return errs.New("locations cannot be used with --failed-input")
+		}
+	} else if len(c.locs) < 2 {
 		return errs.New("must have at least one source and destination path")
 	}
+	if c.filter.Filter() != nil && !c.recursive {
+		return errs.New("filters can only be used with --recursive")
+	}
+	if c.resume && c.byteRange != "" {
+		return errs.New("unable to resume a copy with byte range")
+	}
+	if c.resume && c.accessDest != "" {
+		return errs.New("unable to resume a copy with a destination access")
+	}
 
-	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.ConnectionPoolOptions(rpcpool.Options{
+	poolOptions := ulext.ConnectionPoolOptions(rpcpool.Options{
 		Capacity:       100 * c.parallelism,
 		KeyCapacity:    5,
 		IdleExpiration: 2 * time.Minute,
-	}))
+	})
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access, poolOptions)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = fs.Close() }()
 
+	if c.accessDest != "" {
+		// objects can only be copied server-side within a project, so copies to
+		// the destination access are downloaded and uploaded again.
+		c.destFs, err = c.ex.OpenFilesystem(ctx, c.accessDest, poolOptions)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = c.destFs.Close() }()
+	}
+
 	if c.inmemoryEC {
 		ctx = fpath.WithTempData(ctx, "", true)
 	}
 
+	c.bandwidth = newBandwidthLimiter(c.bwlimit)
+	c.failed = new(failedItems)
+
 	var eg errs.Group
-	for _, source := range c.locs[:len(c.locs)-1] {
-		eg.Add(c.dispatchCopy(ctx, fs, source, c.locs[len(c.locs)-1]))
+	if c.failedInput != "" {
+		eg.Add(c.copyFailed(ctx, fs, ulloc.NewLocal(c.failedInput)))
+	} else {
+		for _, source := range c.locs[:len(c.locs)-1] {
+			eg.Add(c.dispatchCopy(ctx, fs, source, c.locs[len(c.locs)-1]))
+		}
+	}
+	if c.failedOutput != "" {
+		eg.Add(c.failed.save(ctx, fs, ulloc.NewLocal(c.failedOutput)))
 	}
 	return combineErrs(eg)
 }
@@ -183,7 +266,9 @@ func (c *cmdCp) dispatchCopy(ctx context.Context, fs ulfs.Filesystem, source, de
 		fmt.Fprintln(clingy.Stdout(ctx), copyVerb(source, dest), source, "to", dest)
 	}
 
-	return c.copyFile(ctx, fs, source, dest, c.progress)
+	return c.copyFileRetrying(ctx, fs, source, dest, c.progress, func(w io.Writer, args ...interface{}) {
+		fmt.Fprintln(w, args...)
+	})
 }
 
 func (c *cmdCp) copyRecursive(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location) error {
@@ -193,6 +278,7 @@ func (c *cmdCp) copyRecursive(ctx context.Context, fs ulfs.Filesystem, source, d
 
 	iter, err := fs.List(ctx, source, &ulfs.ListOptions{
 		Recursive: true,
+		Filter:    c.filter.Filter(),
 	})
 	if err != nil {
 		return err
@@ -233,7 +319,7 @@ func (c *cmdCp) copyRecursive(ctx context.Context, fs ulfs.Filesystem, source, d
 		ok := limiter.Go(ctx, func() {
 			fprintln(clingy.Stdout(ctx), copyVerb(item, dest), item, "to", dest)
 
-			if err := c.copyFile(ctx, fs, item, dest, false); err != nil {
+			if err := c.copyFileRetrying(ctx, fs, item, dest, false, fprintln); err != nil {
 				fprintln(clingy.Stdout(ctx), copyVerb(item, dest), "failed:", err.Error())
 				addError(err)
 			}
@@ -253,15 +339,109 @@ func (c *cmdCp) copyRecursive(ctx context.Context, fs ulfs.Filesystem, source, d
 	return nil
 }
 
+// copyFailed copies the items of a file written by --failed-output again.
+func (c *cmdCp) copyFailed(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) error {
+	items, err := loadFailedItems(ctx, fs, loc)
+	if err != nil {
+		return err
+	}
+
+	sources := make([]ulloc.Location, len(items))
+	dests := make([]ulloc.Location, len(items))
+	for i, item := range items {
+		if sources[i], err = ulloc.Parse(item.Source); err != nil {
+			return err
+		}
+		if dests[i], err = ulloc.Parse(item.Dest); err != nil {
+			return err
+		}
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintln(w, args...)
+	}
+
+	addError := func(err error) {
+		if err == nil {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	for i := range items {
+		source, dest := sources[i], dests[i]
+
+		ok := limiter.Go(ctx, func() {
+			fprintln(clingy.Stdout(ctx), copyVerb(source, dest), source, "to", dest)
+
+			if err := c.copyFileRetrying(ctx, fs, source, dest, false, fprintln); err != nil {
+				fprintln(clingy.Stdout(ctx), copyVerb(source, dest), "failed:", err.Error())
+				addError(err)
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if len(es) > 0 {
+		return es.Err()
+	}
+	return nil
+}
+
+// copyFileRetrying copies the file, attempting it again after failures as configured,
+// and records it as failed when every attempt fails.
+func (c *cmdCp) copyFileRetrying(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location, progress bool,
+	fprintln func(w io.Writer, args ...interface{})) error {
+	retry := c.retry
+	if source.Std() || dest.Std() {
+		// standard input and output can't be read or written again.
+		retry.retries = 0
+	}
+
+	err := retry.do(ctx, func() error {
+		return c.copyFile(ctx, fs, source, dest, progress)
+	}, func(attempt int, delay time.Duration, err error) {
+		fprintln(clingy.Stdout(ctx), copyVerb(source, dest), source, "failed, retry", attempt, "of", retry.retries, "in", delay.String()+":", err.Error())
+	})
+	if err != nil {
+		c.failed.add(source, dest, err)
+	}
+	return err
+}
+
 func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location, progress bool) error {
 	if c.dryrun {
 		return nil
 	}
 
-	if dest.Remote() && source.Remote() {
+	destFs := fs
+	if dest.Remote() && c.destFs != nil {
+		destFs = c.destFs
+	} else if dest.Remote() && source.Remote() {
 		return fs.Copy(ctx, source, dest)
 	}
 
+	if c.resume && !source.Std() && !dest.Std() {
+		return c.copyFileResumable(ctx, fs, source, dest, progress)
+	}
+
 	offset, length, err := parseRange(c.byteRange)
 	if err != nil {
 		return errs.Wrap(err)
@@ -273,9 +453,14 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 	}
 	defer func() { _ = mrh.Close() }()
 
-	mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
+	metadata, err := c.uploadMetadata(ctx, fs, source, dest)
+	if err != nil {
+		return err
+	}
+
+	mwh, err := destFs.Create(ctx, dest, &ulfs.CreateOptions{
 		Expires:  c.expires,
-		Metadata: c.metadata,
+		Metadata: metadata,
 	})
 	if err != nil {
 		return err
@@ -293,14 +478,72 @@ func (c *cmdCp) copyFile(ctx context.Context, fs ulfs.Filesystem, source, dest u
 		return err
 	}
 
-	return errs.Wrap(c.parallelCopy(
-		ctx,
+	err = c.parallelCopy(
+		ctx, fs,
 		source, dest,
 		mwh, mrh,
 		c.parallelism, partSize,
 		offset, length,
-		bar,
-	))
+		bar, nil, c.uploadHasher(dest, metadata),
+	)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	if offset != 0 || length >= 0 {
+		// a range of the object can't be compared with the whole object checksums.
+		return nil
+	}
+	return c.verifyDownload(ctx, fs, source, dest)
+}
+
+// uploadMetadata returns the metadata of an upload. The checksum of the source is
+// added by the hasher of the upload when it's committed.
+func (c *cmdCp) uploadMetadata(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location) (map[string]string, error) {
+	if !dest.Remote() {
+		return c.metadata, nil
+	}
+
+	metadata := c.metadata
+	if metadata == nil && source.Remote() {
+		// copies between projects keep the metadata of the source like
+		// server-side copies do.
+		info, err := fs.Stat(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		metadata = info.Metadata
+	}
+
+	return metadata, nil
+}
+
+// uploadHasher returns the hasher which adds the checksum of the source to the
+// metadata of an upload when requested, or nil.
+func (c *cmdCp) uploadHasher(dest ulloc.Location, metadata map[string]string) *partHasher {
+	if c.checksum == "" || !dest.Remote() {
+		return nil
+	}
+	algorithm, _ := findChecksumAlgorithm(c.checksum)
+	return newPartHasher(algorithm, metadata)
+}
+
+// verifyDownload compares a downloaded file against the checksums stored in the metadata
+// of the object. The file is removed when it doesn't match.
+func (c *cmdCp) verifyDownload(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location) error {
+	if !c.verify || !source.Remote() || !dest.Local() {
+		return nil
+	}
+
+	info, err := fs.Stat(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	if _, err := verifyChecksums(ctx, fs, dest, info.Metadata); err != nil {
+		return errs.Combine(err, fs.Remove(ctx, dest, nil))
+	}
+	return nil
 }
 
 // calculatePartSize returns the needed part size in order to upload the file with size of 'length'.
@@ -345,12 +588,15 @@ func joinDestWith(dest ulloc.Location, suffix string) ulloc.Location {
 
 func (c *cmdCp) parallelCopy(
 	ctx context.Context,
+	fs ulfs.Filesystem,
 	source, dest ulloc.Location,
 	dst ulfs.MultiWriteHandle,
 	src ulfs.MultiReadHandle,
 	p int, chunkSize int64,
 	offset, length int64,
-	bar *progressbar.ProgressBar) error {
+	bar *progressbar.ProgressBar,
+	tracker partTracker,
+	hasher *partHasher) error {
 
 	if offset != 0 {
 		if err := src.SetOffset(offset); err != nil {
@@ -395,6 +641,14 @@ func (c *cmdCp) parallelCopy(
 		readBufs = ulfs.NewBytesPool(int(chunkSize))
 	}
 
+	// parts are copied again after a failed write only when they can be read
+	// again from the source.
+	partRetry := c.retry
+	retryDst, ok := dst.(ulfs.RetryableMultiWriteHandle)
+	if !ok || source.Std() || offset < 0 {
+		partRetry.retries = 0
+	}
+
 	for i := 0; length != 0; i++ {
 		i := i
 
@@ -412,7 +666,32 @@ func (c *cmdCp) parallelCopy(
 			break
 		}
 
-		wh, err := dst.NextPart(ctx, chunk)
+		if tracker != nil && tracker.skip(i) {
+			if hasher != nil {
+				// the parts which were uploaded before are read again
+				// for the checksum of the whole object.
+				_, err := sync2.Copy(ctx, hasher.writer(i), rh)
+				if err != nil {
+					_ = rh.Close()
+
+					addError(errs.New("error reading part %d: %v", i, err))
+					break
+				}
+				hasher.done(i)
+			}
+			_ = rh.Close()
+			continue
+		}
+
+		if c.retry.retries > 0 && !source.Std() && offset >= 0 {
+			rh = newRetryReadHandle(ctx, c.retry, fs, source, rh, offset+int64(i)*chunkSize, chunk)
+		}
+
+		var wh ulfs.WriteHandle
+		err = c.retry.do(ctx, func() (err error) {
+			wh, err = dst.NextPart(ctx, chunk)
+			return err
+		}, nil)
 		if err != nil {
 			_ = rh.Close()
 
@@ -431,15 +710,45 @@ func (c *cmdCp) parallelCopy(
 				rh = ulfs.NewBufferedReadHandle(ctx, rh, buf)
 			}
 
-			var w io.Writer = wh
-			if bar != nil {
-				bar.SetTotal(rh.Info().ContentLength).Start()
-				w = bar.NewProxyWriter(w)
+			attempted := false
+			var n int64
+			err := partRetry.do(ctx, func() (err error) {
+				if attempted {
+					// the failed write is aborted and the whole part is copied again.
+					if bar != nil {
+						bar.Add64(-n)
+					}
+					retryRh, retryWh, retryErr := retryPart(ctx, c.retry, fs, source, retryDst, wh, offset+int64(i)*chunkSize, chunk)
+					if retryErr != nil {
+						return retryErr
+					}
+					_ = rh.Close()
+					rh, wh = retryRh, retryWh
+				}
+				attempted = true
+
+				w := c.bandwidth.Writer(ctx, wh)
+				if bar != nil {
+					bar.SetTotal(rh.Info().ContentLength).Start()
+					w = bar.NewProxyWriter(w)
+				}
+
+				var r io.Reader = rh
+				if hasher != nil {
+					r = io.TeeReader(rh, hasher.writer(i))
+				}
+
+				n, err = sync2.Copy(ctx, w, r)
+				if err == nil {
+					err = wh.Commit()
+				}
+				return err
+			}, nil)
+			if err == nil && hasher != nil {
+				hasher.done(i)
 			}
-
-			_, err := sync2.Copy(ctx, w, rh)
-			if err == nil {
-				err = wh.Commit()
+			if err == nil && tracker != nil {
+				err = tracker.done(ctx, i, n)
 			}
 
 			if err != nil {
@@ -461,6 +770,15 @@ func (c *cmdCp) parallelCopy(
 
 	limiter.Wait()
 
+	// the checksum is only known once every part is copied.
+	if len(es) == 0 && hasher != nil {
+		if mdst, ok := dst.(ulfs.MetadataMultiWriteHandle); ok {
+			es.Add(mdst.SetMetadata(hasher.metadata()))
+		} else {
+			es.Add(errs.New("unable to store a checksum for %q", dest))
+		}
+	}
+
 	// don't try to commit if any error occur
 	if len(es) == 0 {
 		es.Add(dst.Commit(ctx))
@@ -0,0 +1,119 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/zeebo/errs"
+
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+// failedItem is a copy that failed. The items are written as one JSON object per
+// line so that the file can be passed to --failed-input to copy only them again.
+type failedItem struct {
+	Source string `json:"source"`
+	Dest   string `json:"dest"`
+	Error  string `json:"error"`
+}
+
+// failedItems collects the copies that failed after every retry. Local paths are
+// stored absolute so that the file can be used from another working directory.
+type failedItems struct {
+	mu    sync.Mutex
+	items []failedItem
+}
+
+func (fi *failedItems) add(source, dest ulloc.Location, err error) {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+
+	fi.items = append(fi.items, failedItem{
+		Source: absLocation(source),
+		Dest:   absLocation(dest),
+		Error:  err.Error(),
+	})
+}
+
+// save writes the failed items to the local file, replacing it. An empty file is
+// written when nothing failed.
+func (fi *failedItems) save(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) error {
+	fi.mu.Lock()
+	defer fi.mu.Unlock()
+
+	sort.Slice(fi.items, func(i, j int) bool {
+		return fi.items[i].Source < fi.items[j].Source
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, item := range fi.items {
+		if err := enc.Encode(item); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	mwh, err := fs.Create(ctx, loc, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	wh, err := mwh.NextPart(ctx, -1)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := wh.Write(buf.Bytes()); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := wh.Commit(); err != nil {
+		return err
+	}
+	return mwh.Commit(ctx)
+}
+
+// loadFailedItems reads the failed items written by an earlier copy.
+func loadFailedItems(ctx context.Context, fs ulfs.Filesystem, loc ulloc.Location) (items []failedItem, err error) {
+	mrh, err := fs.Open(ctx, loc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rh.Close() }()
+
+	scanner := bufio.NewScanner(rh)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var item failedItem
+		if err := json.Unmarshal(scanner.Bytes(), &item); err != nil {
+			return nil, errs.New("invalid failed item on line %d: %v", line, err)
+		}
+		if item.Source == "" || item.Dest == "" {
+			return nil, errs.New("invalid failed item on line %d: missing source or dest", line)
+		}
+		items = append(items, item)
+	}
+	return items, errs.Wrap(scanner.Err())
+}
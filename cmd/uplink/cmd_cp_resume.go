@@ -0,0 +1,448 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	progressbar "github.com/cheggaaa/pb/v3"
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+// resumeState is the state of an interrupted transfer that is stored in its journal.
+type resumeState struct {
+	Source   string    `json:"source"`
+	Dest     string    `json:"dest"`
+	Size     int64     `json:"size"`
+	ModTime  time.Time `json:"modTime"`
+	PartSize int64     `json:"partSize"`
+
+	// UploadID is the id of the pending upload of an upload.
+	UploadID string `json:"uploadID,omitempty"`
+	// Completed is the number of bytes at the start of a download that are written.
+	Completed int64 `json:"completed,omitempty"`
+}
+
+// matches returns if the source didn't change since the state was saved.
+func (state *resumeState) matches(info *ulfs.ObjectInfo) bool {
+	return state.Size == info.ContentLength && state.ModTime.Equal(info.Created)
+}
+
+// resumeJournal stores the state of a transfer on the local disk so that it can be
+// continued after an interruption.
+type resumeJournal struct {
+	fs  ulfs.Filesystem
+	loc ulloc.Location
+
+	mu sync.Mutex
+}
+
+// resumeJournalLoc returns the location of the journal of the transfer from source to dest.
+func resumeJournalLoc(dir string, source, dest ulloc.Location) ulloc.Location {
+	sum := sha256.Sum256([]byte(absLocation(source) + "\x00" + absLocation(dest)))
+	return ulloc.NewLocal(filepath.Join(dir, hex.EncodeToString(sum[:16])+".json"))
+}
+
+// absLocation returns the string form of the location with local paths made absolute.
+func absLocation(loc ulloc.Location) string {
+	if path, ok := loc.LocalParts(); ok {
+		if abs, err := filepath.Abs(path); err == nil {
+			return abs
+		}
+	}
+	return loc.String()
+}
+
+func newResumeJournal(fs ulfs.Filesystem, dir string, source, dest ulloc.Location) *resumeJournal {
+	return &resumeJournal{
+		fs:  fs,
+		loc: resumeJournalLoc(dir, source, dest),
+	}
+}
+
+// load returns the saved state or nil if there is no journal.
+func (j *resumeJournal) load(ctx context.Context) (*resumeState, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, err := j.fs.Stat(ctx, j.loc); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	mrh, err := j.fs.Open(ctx, j.loc)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rh.Close() }()
+
+	data, err := io.ReadAll(rh)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	var state resumeState
+	if err := json.Unmarshal(data, &state); err != nil {
+		// a journal that was only partially written can't be used.
+		return nil, nil
+	}
+	return &state, nil
+}
+
+// save replaces the saved state.
+func (j *resumeJournal) save(ctx context.Context, state *resumeState) (err error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	// the state is written next to the journal first so that an interruption
+	// never leaves a partially written journal behind.
+	tmp := ulloc.NewLocal(j.loc.Loc() + ".tmp")
+
+	mwh, err := j.fs.Create(ctx, tmp, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	wh, err := mwh.NextPart(ctx, -1)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := wh.Write(data); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := wh.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := mwh.Commit(ctx); err != nil {
+		return errs.Wrap(err)
+	}
+
+	return errs.Wrap(j.fs.Move(ctx, tmp, j.loc))
+}
+
+// remove removes the journal once the transfer is complete.
+func (j *resumeJournal) remove(ctx context.Context) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, err := j.fs.Stat(ctx, j.loc); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return errs.Wrap(j.fs.Remove(ctx, j.loc, nil))
+}
+
+// partTracker lets parallelCopy skip the parts which were transferred before
+// an interruption and records the parts as they are transferred.
+type partTracker interface {
+	skip(part int) bool
+	done(ctx context.Context, part int, n int64) error
+}
+
+// copyFileResumable copies a file or object in a way that is continued by the next call
+// after an interruption.
+func (c *cmdCp) copyFileResumable(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location, progress bool) error {
+	info, err := fs.Stat(ctx, source)
+	if err != nil {
+		return err
+	}
+
+	journal := newResumeJournal(fs, c.ex.ResumeDir(), source, dest)
+
+	state, err := journal.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	var bar *progressbar.ProgressBar
+	if progress {
+		bar = progressbar.New64(0).SetWriter(clingy.Stdout(ctx))
+		defer bar.Finish()
+	}
+
+	if dest.Remote() {
+		err = c.resumeUpload(ctx, fs, source, dest, info, journal, state, bar)
+	} else {
+		err = c.resumeDownload(ctx, fs, source, dest, info, journal, state, bar)
+	}
+	if err != nil {
+		return errs.New("%v; run the same command again to resume", err)
+	}
+
+	// the journal is removed even when the verification fails so that the next
+	// attempt starts over.
+	return errs.Combine(c.verifyDownload(ctx, fs, source, dest), journal.remove(ctx))
+}
+
+func (c *cmdCp) resumeUpload(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location, info *ulfs.ObjectInfo,
+	journal *resumeJournal, state *resumeState, bar *progressbar.ProgressBar) error {
+
+	if state != nil && !state.matches(info) {
+		// the file changed since the upload was interrupted, so the upload is started over.
+		if mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{UploadID: state.UploadID}); err == nil {
+			_ = mwh.Abort(ctx)
+		}
+		state = nil
+	}
+
+	metadata, err := c.uploadMetadata(ctx, fs, source, dest)
+	if err != nil {
+		return err
+	}
+
+	opts := &ulfs.CreateOptions{
+		Expires:   c.expires,
+		Metadata:  metadata,
+		Resumable: true,
+	}
+
+	var handle ulfs.ResumableMultiWriteHandle
+	var committed map[uint32]int64
+
+	if state != nil {
+		opts.UploadID = state.UploadID
+		handle, committed = c.continueUpload(ctx, fs, dest, opts)
+		if handle == nil {
+			// the pending upload expired or was removed in the meantime.
+			state = nil
+		}
+	}
+
+	if state == nil {
+		partSize, err := c.calculatePartSize(info.ContentLength, c.parallelismChunkSize.Int64())
+		if err != nil {
+			return err
+		}
+
+		opts.UploadID = ""
+		mwh, err := fs.Create(ctx, dest, opts)
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+		handle, ok = mwh.(ulfs.ResumableMultiWriteHandle)
+		if !ok {
+			_ = mwh.Abort(ctx)
+			return errs.New("unable to resume uploads to %q", dest)
+		}
+
+		state = &resumeState{
+			Source:   absLocation(source),
+			Dest:     absLocation(dest),
+			Size:     info.ContentLength,
+			ModTime:  info.Created,
+			PartSize: partSize,
+			UploadID: handle.UploadID(),
+		}
+		if err := journal.save(ctx, state); err != nil {
+			return err
+		}
+	}
+
+	mrh, err := fs.Open(ctx, source)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	return c.parallelCopy(
+		ctx, fs,
+		source, dest,
+		handle, mrh,
+		c.parallelism, state.PartSize,
+		0, -1,
+		bar, &uploadTracker{
+			handle:    handle,
+			committed: committed,
+			partSize:  state.PartSize,
+			size:      state.Size,
+		},
+		c.uploadHasher(dest, metadata),
+	)
+}
+
+// continueUpload returns the handle and the committed parts of the pending upload.
+// The handle is nil if the upload can't be continued.
+func (c *cmdCp) continueUpload(ctx context.Context, fs ulfs.Filesystem, dest ulloc.Location, opts *ulfs.CreateOptions) (ulfs.ResumableMultiWriteHandle, map[uint32]int64) {
+	mwh, err := fs.Create(ctx, dest, opts)
+	if err != nil {
+		return nil, nil
+	}
+
+	handle, ok := mwh.(ulfs.ResumableMultiWriteHandle)
+	if !ok {
+		return nil, nil
+	}
+
+	committed, err := handle.CommittedParts(ctx)
+	if err != nil {
+		return nil, nil
+	}
+	return handle, committed
+}
+
+func (c *cmdCp) resumeDownload(ctx context.Context, fs ulfs.Filesystem, source, dest ulloc.Location, info *ulfs.ObjectInfo,
+	journal *resumeJournal, state *resumeState, bar *progressbar.ProgressBar) error {
+
+	existing, err := fs.Stat(ctx, dest)
+	if err != nil {
+		existing = nil
+	}
+
+	// only the files of interrupted downloads are continued. Without a journal
+	// nothing is known about the contents of an existing file, so the download
+	// is started over.
+	var offset int64
+	switch {
+	case state != nil && state.matches(info) && existing != nil && existing.ContentLength >= state.Completed:
+		offset = state.Completed
+	case existing != nil:
+		if err := fs.Remove(ctx, dest, nil); err != nil {
+			return err
+		}
+	}
+
+	if offset >= info.ContentLength {
+		return nil
+	}
+
+	partSize, err := c.calculatePartSize(info.ContentLength, c.parallelismChunkSize.Int64())
+	if err != nil {
+		return err
+	}
+
+	state = &resumeState{
+		Source:    absLocation(source),
+		Dest:      absLocation(dest),
+		Size:      info.ContentLength,
+		ModTime:   info.Created,
+		PartSize:  partSize,
+		Completed: offset,
+	}
+	if err := journal.save(ctx, state); err != nil {
+		return err
+	}
+
+	mrh, err := fs.Open(ctx, source)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	mwh, err := fs.Create(ctx, dest, &ulfs.CreateOptions{
+		Resumable: true,
+		Offset:    offset,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	return c.parallelCopy(
+		ctx, fs,
+		source, dest,
+		mwh, mrh,
+		c.parallelism, partSize,
+		offset, -1,
+		bar, &downloadTracker{
+			journal: journal,
+			state:   state,
+			written: make(map[int]int64),
+		},
+		nil,
+	)
+}
+
+// uploadTracker skips the parts of an upload which were committed before an interruption.
+type uploadTracker struct {
+	handle    ulfs.ResumableMultiWriteHandle
+	committed map[uint32]int64
+	partSize  int64
+	size      int64
+}
+
+func (t *uploadTracker) skip(part int) bool {
+	expected := t.size - int64(part)*t.partSize
+	if expected > t.partSize {
+		expected = t.partSize
+	}
+
+	size, ok := t.committed[uint32(part)+1]
+	if !ok || size != expected {
+		return false
+	}
+
+	t.handle.SkipPart()
+	return true
+}
+
+// done doesn't record anything because the committed parts are listed from the pending upload.
+func (t *uploadTracker) done(ctx context.Context, part int, n int64) error { return nil }
+
+// downloadTracker records how much of the start of a download is written. Parts are
+// written in parallel, so only the parts without a gap before them are completed.
+type downloadTracker struct {
+	journal *resumeJournal
+
+	mu      sync.Mutex
+	state   *resumeState
+	next    int
+	written map[int]int64
+}
+
+func (t *downloadTracker) skip(part int) bool { return false }
+
+func (t *downloadTracker) done(ctx context.Context, part int, n int64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.written[part] = n
+
+	advanced := false
+	for {
+		n, ok := t.written[t.next]
+		if !ok {
+			break
+		}
+		delete(t.written, t.next)
+		t.state.Completed += n
+		t.next++
+		advanced = true
+	}
+	if !advanced {
+		return nil
+	}
+
+	return t.journal.save(ctx, t.state)
+}
@@ -4,11 +4,15 @@
 package main
 
 import (
+	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"common/memory"
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
 	"storx/cmd/uplink/ultest"
 )
 
@@ -367,6 +371,40 @@ func TestCpRemoteToRemote(t *testing.T) {
 	})
 }
 
+func TestCpAccessDest(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFileOptions("sj://b1/file.txt", "data", &ulfs.CreateOptions{Metadata: map[string]string{"foo": "bar"}}),
+		ultest.WithBucket("b2"),
+	)
+
+	t.Run("Object", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://b1/file.txt", "sj://b2/file.txt", "--access-dest", "other", "--progress=false").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://b1/file.txt", Contents: "data", Metadata: map[string]string{"foo": "bar"}},
+			ultest.File{Loc: "sj://b2/file.txt", Contents: "data", Metadata: map[string]string{"foo": "bar"}},
+		)
+	})
+
+	t.Run("Metadata", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://b1/file.txt", "sj://b2/file.txt", "--access-dest", "other", "--progress=false",
+			"--metadata", `{"baz":"qux"}`,
+		).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://b1/file.txt", Contents: "data", Metadata: map[string]string{"foo": "bar"}},
+			ultest.File{Loc: "sj://b2/file.txt", Contents: "data", Metadata: map[string]string{"baz": "qux"}},
+		)
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://b1", "sj://b2/copied", "--access-dest", "other", "--recursive").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://b1/file.txt", Contents: "data", Metadata: map[string]string{"foo": "bar"}},
+			ultest.File{Loc: "sj://b2/copied/file.txt", Contents: "data", Metadata: map[string]string{"foo": "bar"}},
+		)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		state.Fail(t, "cp", "sj://b1/file.txt", "sj://b2/file.txt", "--access-dest", "other", "--resume")
+	})
+}
+
 func TestCpLocalToLocal(t *testing.T) {
 	state := ultest.Setup(commands,
 		ultest.WithFile("/home/user1/folder1/file1.txt", "data1"),
@@ -578,3 +616,227 @@ func TestCpMultipleSourcePaths(t *testing.T) {
 		)
 	})
 }
+
+func TestCpFilters(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("/home/user/dir/a.txt", "small"),
+		ultest.WithFile("/home/user/dir/b.log", "small"),
+		ultest.WithFile("/home/user/dir/sub/c.txt", "larger contents"),
+	)
+
+	state.Succeed(t, "cp", "-r", "/home/user/dir/", "sj://user/dir/", "--include", "*.txt").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/a.txt", Contents: "small"},
+		ultest.File{Loc: "sj://user/dir/sub/c.txt", Contents: "larger contents"},
+	)
+
+	state.Succeed(t, "cp", "-r", "/home/user/dir/", "sj://user/dir/", "--exclude", "sub/*", "--max-size", "10B").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/a.txt", Contents: "small"},
+		ultest.File{Loc: "sj://user/dir/b.log", Contents: "small"},
+	)
+
+	state.Fail(t, "cp", "/home/user/dir/a.txt", "sj://user/dir/a.txt", "--include", "*.txt")
+	state.Fail(t, "cp", "-r", "/home/user/dir/", "sj://user/dir/", "--min-size", "-1")
+}
+
+func TestCpChecksum(t *testing.T) {
+	const sha256ABCD = "88d4266fd4e6338d13b845fcf289579d209c897823b9217da3e161936f031589"
+
+	t.Run("Upload", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("/home/user/file.txt", "abcd"),
+		)
+
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--checksum", "sha256").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "abcd", Metadata: map[string]string{metadataSHA256: sha256ABCD}},
+		)
+
+		state.Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--checksum", "CRC32C", "--metadata", `{"foo":"bar"}`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "abcd", Metadata: map[string]string{metadataCRC32C: "92c80a31", "foo": "bar"}},
+		)
+
+		state.Fail(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--checksum", "md5")
+
+		// the checksum is computed while uploading, so it works for standard input too.
+		state.With(ultest.WithStdin("abcd")).Succeed(t, "cp", "-", "sj://user/file.txt", "--checksum", "sha256").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "abcd", Metadata: map[string]string{metadataSHA256: sha256ABCD}},
+		)
+	})
+
+	t.Run("Download", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFileOptions("sj://user/file.txt", "abcd", &ulfs.CreateOptions{Metadata: map[string]string{metadataSHA256: sha256ABCD}}),
+		).Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "abcd"},
+		)
+
+		state := ultest.Setup(commands,
+			ultest.WithFileOptions("sj://user/file.txt", "wxyz", &ulfs.CreateOptions{Metadata: map[string]string{metadataSHA256: sha256ABCD}}),
+		)
+
+		// the corrupted download is removed.
+		state.Fail(t, "cp", "sj://user/file.txt", "/home/user/file.txt").RequireLocalFiles(t)
+
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--verify=false").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "wxyz"},
+		)
+
+		// ranges of objects aren't verified.
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--range", "bytes=1-2").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "xy"},
+		)
+	})
+}
+
+func TestCpResume(t *testing.T) {
+	withJournal := func(source, dest string, state resumeState) ultest.ExecuteOption {
+		data, err := json.Marshal(state)
+		require.NoError(t, err)
+		return ultest.WithFile(journalPath(t, source, dest), string(data))
+	}
+
+	created := time.Unix(1, 0)
+
+	t.Run("DownloadExisting", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "abcdefghij"),
+			ultest.WithFile("/home/user/file.txt", "ABCDE"),
+		).Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("DownloadJournalWithoutFile", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "abcdefghij"),
+			withJournal("sj://user/file.txt", "/home/user/file.txt", resumeState{
+				Size: 10, ModTime: created, Completed: 2,
+			}),
+		).Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("DownloadJournal", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "abcdefghij"),
+			ultest.WithFile("/home/user/file.txt", "ABxxx"),
+			withJournal("sj://user/file.txt", "/home/user/file.txt", resumeState{
+				Size: 10, ModTime: created, Completed: 2,
+			}),
+		).Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "ABcdefghij"},
+		)
+	})
+
+	t.Run("DownloadChanged", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "abcdefghij"),
+			ultest.WithFile("/home/user/file.txt", "ABxxx"),
+			withJournal("sj://user/file.txt", "/home/user/file.txt", resumeState{
+				Size: 10, ModTime: created.Add(time.Hour), Completed: 2,
+			}),
+		).Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "abcdefghij"},
+		)
+	})
+
+	t.Run("UploadPending", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("/home/user/file.txt", "abcdefghij"),
+			ultest.WithPendingFile("sj://user/file.txt"),
+			withJournal("/home/user/file.txt", "sj://user/file.txt", resumeState{
+				Size: 10, PartSize: memory.MiB.Int64(), UploadID: "1",
+			}),
+		).Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--resume").RequireFiles(t,
+			ultest.File{Loc: "/home/user/file.txt", Contents: "abcdefghij"},
+			ultest.File{Loc: "sj://user/file.txt", Contents: "abcdefghij"},
+		).RequirePending(t)
+	})
+
+	t.Run("UploadMissing", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("/home/user/file.txt", "abcdefghij"),
+			ultest.WithPendingFile("sj://user/file.txt"),
+			withJournal("/home/user/file.txt", "sj://user/file.txt", resumeState{
+				Size: 10, PartSize: memory.MiB.Int64(), UploadID: "7",
+			}),
+		).Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--resume").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "abcdefghij"},
+		).RequirePending(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: ""},
+		)
+	})
+
+	t.Run("UploadChanged", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("/home/user/file.txt", "abcdefghij"),
+			ultest.WithPendingFile("sj://user/file.txt"),
+			withJournal("/home/user/file.txt", "sj://user/file.txt", resumeState{
+				Size: 5, PartSize: memory.MiB.Int64(), UploadID: "1",
+			}),
+		).Succeed(t, "cp", "/home/user/file.txt", "sj://user/file.txt", "--resume").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "abcdefghij"},
+		).RequirePending(t)
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		ultest.Setup(commands,
+			ultest.WithFile("sj://user/file.txt", "abcdefghij"),
+		).Fail(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--resume", "--range", "bytes=0-2")
+	})
+}
+
+func journalPath(t *testing.T, source, dest string) string {
+	sourceLoc, err := ulloc.Parse(source)
+	require.NoError(t, err)
+	destLoc, err := ulloc.Parse(dest)
+	require.NoError(t, err)
+
+	path, _ := resumeJournalLoc("/uplink/resume", sourceLoc, destLoc).LocalParts()
+	return path
+}
+
+func TestCpRetries(t *testing.T) {
+	failed := `{"source":"sj://user/file.txt","dest":"/home/user/file.txt","error":"timeout"}` + "\n" +
+		`{"source":"sj://user/missing.txt","dest":"/home/user/missing.txt","error":"timeout"}` + "\n"
+
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/file.txt", "remote"),
+		ultest.WithFile("/home/user/failed.json", failed),
+	)
+
+	t.Run("FailedItems", func(t *testing.T) {
+		state.Fail(t, "cp", "--failed-input", "/home/user/failed.json", "--failed-output", "/home/user/failed.json",
+			"--retries", "2", "--retry-delay", "1ms",
+		).RequireStdout(t, `
+			download sj://user/file.txt to /home/user/file.txt
+			download sj://user/missing.txt to /home/user/missing.txt
+			download sj://user/missing.txt failed, retry 1 of 2 in 1ms: file does not exist "sj://user/missing.txt"
+			download sj://user/missing.txt failed, retry 2 of 2 in 2ms: file does not exist "sj://user/missing.txt"
+			download failed: file does not exist "sj://user/missing.txt"
+		`).RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/failed.json", Contents: `{"source":"sj://user/missing.txt","dest":"/home/user/missing.txt","error":"file does not exist \"sj://user/missing.txt\""}` + "\n"},
+			ultest.File{Loc: "/home/user/file.txt", Contents: "remote"},
+		)
+	})
+
+	t.Run("NoFailures", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--failed-output", "/home/user/failed.json", "--progress=false").RequireStdout(t, `
+			download sj://user/file.txt to /home/user/file.txt
+		`)
+	})
+
+	t.Run("InvalidArguments", func(t *testing.T) {
+		state.Fail(t, "cp", "--failed-input", "/home/user/failed.json", "sj://user/file.txt", "/home/user/file.txt")
+		state.Fail(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--retries", "-1")
+	})
+
+	t.Run("BandwidthLimit", func(t *testing.T) {
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--bwlimit", "1KiB").RequireLocalFiles(t,
+			ultest.File{Loc: "/home/user/failed.json", Contents: failed},
+			ultest.File{Loc: "/home/user/file.txt", Contents: "remote"},
+		)
+		state.Succeed(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--bwlimit", "00:00,1KiB 12:00,off")
+		state.Fail(t, "cp", "sj://user/file.txt", "/home/user/file.txt", "--bwlimit", "fast")
+	})
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"common/sync2"
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+type cmdDu struct {
+	ex ulext.External
+
+	access      string
+	depth       int
+	parallelism int
+	output      string
+
+	prefix ulloc.Location
+}
+
+func newCmdDu(ex ulext.External) *cmdDu {
+	return &cmdDu{ex: ex}
+}
+
+func (c *cmdDu) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.depth = params.Flag("depth", "Report the usage of sub-prefixes up to this many levels deep, 0 reports only the total", 1,
+		clingy.Short('d'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n < 0 {
+				return 0, errs.New("depth cannot be below 0")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.parallelism = params.Flag("parallelism", "Controls how many sub-prefixes are listed in parallel", 1,
+		clingy.Short('p'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("parallelism must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.output = params.Flag("output", "Output Format (tabbed, json)", "tabbed",
+		clingy.Short('o'),
+		clingy.Transform(func(format string) (string, error) {
+			switch format {
+			case "tabbed", "json":
+				return format, nil
+			default:
+				return "", errs.New("unknown output format, got %s", format)
+			}
+		}),
+	).(string)
+
+	c.prefix = params.Arg("prefix", "Bucket or prefix to report the usage of (sj://BUCKET[/KEY])",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+// duUsage is the usage of a prefix including all of its sub-prefixes. The size
+// of a pending upload is the size of its already uploaded parts.
+type duUsage struct {
+	Prefix       string `json:"prefix"`
+	Objects      int64  `json:"objects"`
+	Bytes        int64  `json:"bytes"`
+	Pending      int64  `json:"pending"`
+	PendingBytes int64  `json:"pendingBytes"`
+}
+
+// duCounter sums the usage of the listed objects by prefix.
+type duCounter struct {
+	prefix ulloc.Location
+	depth  int
+
+	mu     sync.Mutex
+	usages map[string]*duUsage
+}
+
+// add counts the object to the total and to every prefix above it up to the depth.
+func (dc *duCounter) add(info ulfs.ObjectInfo, pending bool) error {
+	rel, err := dc.prefix.RelativeTo(info.Loc)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{""}
+	dirs := strings.Split(rel, "/")
+	for i := 1; i < len(dirs) && i <= dc.depth; i++ {
+		keys = append(keys, strings.Join(dirs[:i], "/")+"/")
+	}
+
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+
+	for _, key := range keys {
+		usage, ok := dc.usages[key]
+		if !ok {
+			usage = &duUsage{Prefix: dc.prefix.AppendKey(key).String()}
+			dc.usages[key] = usage
+		}
+
+		if pending {
+			usage.Pending++
+			usage.PendingBytes += info.ContentLength
+		} else {
+			usage.Objects++
+			usage.Bytes += info.ContentLength
+		}
+	}
+	return nil
+}
+
+func (c *cmdDu) Execute(ctx context.Context) error {
+	if !c.prefix.Remote() {
+		return errs.New("prefix must be a remote sj:// location")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	prefix := c.prefix
+	if _, key, _ := prefix.RemoteParts(); key != "" {
+		prefix = prefix.AsDirectoryish()
+	}
+
+	counter := &duCounter{
+		prefix: prefix,
+		depth:  c.depth,
+		usages: map[string]*duUsage{"": {Prefix: prefix.String()}},
+	}
+
+	// the first level is listed to find the sub-prefixes which are then listed
+	// recursively in parallel.
+	subprefixes := make(map[string]struct{})
+	for _, pending := range []bool{false, true} {
+		iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
+			Pending:   pending,
+			PartSizes: pending,
+		})
+		if err != nil {
+			return err
+		}
+		for iter.Next() {
+			item := iter.Item()
+			if item.IsPrefix {
+				subprefixes[item.Loc.Loc()] = struct{}{}
+				continue
+			}
+
+			item.Loc = prefix.AppendKey(item.Loc.Loc())
+			if err := counter.add(item, pending); err != nil {
+				return err
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.parallelism)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	for _, subprefix := range sortedKeys(subprefixes) {
+		loc := prefix.AppendKey(subprefix)
+
+		ok := limiter.Go(ctx, func() {
+			for _, pending := range []bool{false, true} {
+				if err := c.count(ctx, fs, counter, loc, pending); err != nil {
+					addError(err)
+					return
+				}
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if len(es) > 0 {
+		return combineErrs(es)
+	}
+
+	// the sub-prefixes are reported in order with the total of everything last.
+	usages := make([]*duUsage, 0, len(counter.usages))
+	for _, key := range sortedKeys(counter.usages) {
+		if key != "" {
+			usages = append(usages, counter.usages[key])
+		}
+	}
+	usages = append(usages, counter.usages[""])
+
+	if c.output == "json" {
+		jw := json.NewEncoder(clingy.Stdout(ctx))
+		for _, usage := range usages {
+			if err := jw.Encode(usage); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	tw := newTabbedWriter(clingy.Stdout(ctx), "OBJECTS", "SIZE", "PENDING", "PENDING SIZE", "PREFIX")
+	defer tw.Done()
+
+	for _, usage := range usages {
+		tw.WriteLine(usage.Objects, usage.Bytes, usage.Pending, usage.PendingBytes, usage.Prefix)
+	}
+	return nil
+}
+
+// count adds the objects or pending uploads under the location to the counter.
+func (c *cmdDu) count(ctx context.Context, fs ulfs.Filesystem, counter *duCounter, loc ulloc.Location, pending bool) error {
+	iter, err := fs.List(ctx, loc, &ulfs.ListOptions{
+		Recursive: true,
+		Pending:   pending,
+		PartSizes: pending,
+	})
+	if err != nil {
+		return err
+	}
+	for iter.Next() {
+		if err := counter.add(iter.Item(), pending); err != nil {
+			return err
+		}
+	}
+	return errs.Wrap(iter.Err())
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storx/cmd/uplink/ultest"
+)
+
+func TestDu(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/a.txt", "12345"),
+		ultest.WithFile("sj://user/logs/x/1", "123"),
+		ultest.WithFile("sj://user/logs/x/2", "1234"),
+		ultest.WithFile("sj://user/logs/x/deep/3", "1"),
+		ultest.WithFile("sj://user/logs/y/1", "12"),
+		ultest.WithFile("sj://user/media/m", "1"),
+		ultest.WithPendingFile("sj://user/logs/z/pending", "1234567"),
+	)
+
+	t.Run("Bucket", func(t *testing.T) {
+		state.Succeed(t, "du", "sj://user", "-p", "2").RequireStdout(t, `
+			OBJECTS    SIZE    PENDING    PENDING SIZE    PREFIX
+			4          10      1          7               sj://user/logs/
+			1          1       0          0               sj://user/media/
+			6          16      1          7               sj://user
+		`)
+	})
+
+	t.Run("Depth", func(t *testing.T) {
+		state.Succeed(t, "du", "sj://user/logs", "--depth", "2", "--output", "json").RequireStdout(t, `
+			{"prefix":"sj://user/logs/x/","objects":3,"bytes":8,"pending":0,"pendingBytes":0}
+			{"prefix":"sj://user/logs/x/deep/","objects":1,"bytes":1,"pending":0,"pendingBytes":0}
+			{"prefix":"sj://user/logs/y/","objects":1,"bytes":2,"pending":0,"pendingBytes":0}
+			{"prefix":"sj://user/logs/z/","objects":0,"bytes":0,"pending":1,"pendingBytes":7}
+			{"prefix":"sj://user/logs/","objects":4,"bytes":10,"pending":1,"pendingBytes":7}
+		`)
+
+		state.Succeed(t, "du", "sj://user/logs/x/", "--depth", "0", "--output", "json").RequireStdout(t, `
+			{"prefix":"sj://user/logs/x/","objects":3,"bytes":8,"pending":0,"pendingBytes":0}
+		`)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		state.Fail(t, "du", "/home/user")
+		state.Fail(t, "du", "sj://user", "--depth", "-1")
+		state.Fail(t, "du", "sj://user", "--output", "xml")
+	})
+}
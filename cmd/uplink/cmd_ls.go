@@ -5,7 +5,9 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"sort"
 	"strconv"
 	"time"
 
@@ -18,6 +20,18 @@ import (
 	"uplink"
 )
 
+// cmdLs lists buckets and objects.
+//
+// Objects can be sorted and printed as csv or ndjson. Besides the key, they
+// show the fields of the uplink object listing: the content length, the
+// creation and expiration time and the custom metadata, including the
+// checksum stored by cp --checksum.
+//
+// Listing the segment count, ETag, redundancy scheme and placement isn't
+// supported. The satellite already reads the segment count with
+// metabase.ListObjects, but uplink.Object doesn't expose it. The other fields
+// aren't part of the metainfo listing response at all. Both need changes to
+// the uplink library and the protocol, which live outside of this repository.
 type cmdLs struct {
 	ex ulext.External
 
@@ -28,6 +42,9 @@ type cmdLs struct {
 	pending   bool
 	utc       bool
 	output    string
+	sort      string
+	reverse   bool
+	filter    filterFlags
 
 	prefix *ulloc.Location
 }
@@ -55,9 +72,23 @@ func (c *cmdLs) Setup(params clingy.Parameters) {
 	c.utc = params.Flag("utc", "Show all timestamps in UTC instead of local time", false,
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
-	c.output = params.Flag("output", "Output Format (tabbed, json)", "tabbed",
+	c.output = params.Flag("output", "Output Format (tabbed, json, ndjson, csv)", "tabbed",
 		clingy.Short('o'),
 	).(string)
+	c.sort = params.Flag("sort", "Sort objects by name, size or time. Sorting by anything but name lists everything before printing", "name",
+		clingy.Transform(func(by string) (string, error) {
+			switch by {
+			case "name", "size", "time":
+				return by, nil
+			default:
+				return "", errs.New("unknown sort order, got %s", by)
+			}
+		}),
+	).(string)
+	c.reverse = params.Flag("reverse", "Reverse the sort order", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.filter.Setup(params)
 
 	c.prefix = params.Arg("prefix", "Prefix to list (sj://BUCKET[/KEY])", clingy.Optional,
 		clingy.Transform(ulloc.Parse),
@@ -83,8 +114,10 @@ func (c *cmdLs) listBuckets(ctx context.Context) error {
 	switch c.output {
 	case "tabbed":
 		return c.printTabbedBucket(ctx, iter)
-	case "json":
+	case "json", "ndjson":
 		return c.printJSONBucket(ctx, iter)
+	case "csv":
+		return c.printCSVBucket(ctx, iter)
 	default:
 		return errs.New("unknown output format, got %s", c.output)
 	}
@@ -106,16 +139,26 @@ func (c *cmdLs) listLocation(ctx context.Context, prefix ulloc.Location) error {
 		Recursive: c.recursive,
 		Pending:   c.pending,
 		Expanded:  c.expanded,
+		Filter:    c.filter.Filter(),
 	})
 	if err != nil {
 		return err
 	}
 
+	if c.sort != "name" || c.reverse {
+		iter, err = sortObjects(iter, c.sort, c.reverse)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch c.output {
 	case "tabbed":
 		return c.printTabbedLocation(ctx, iter)
-	case "json":
+	case "json", "ndjson":
 		return c.printJSONLocation(ctx, iter)
+	case "csv":
+		return c.printCSVLocation(ctx, iter)
 	default:
 		return errs.New("unknown output format, got %s", c.output)
 	}
@@ -146,10 +189,26 @@ func (c *cmdLs) printJSONBucket(ctx context.Context, iter *uplink.BucketIterator
 	return iter.Err()
 }
 
+func (c *cmdLs) printCSVBucket(ctx context.Context, iter *uplink.BucketIterator) (err error) {
+	cw := csv.NewWriter(clingy.Stdout(ctx))
+	defer cw.Flush()
+
+	if err := cw.Write([]string{"created", "name"}); err != nil {
+		return err
+	}
+	for iter.Next() {
+		item := iter.Item()
+		if err := cw.Write([]string{formatTime(c.utc, item.Created), item.Name}); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
 func (c *cmdLs) printTabbedLocation(ctx context.Context, iter ulfs.ObjectIterator) (err error) {
 	headers := []string{"KIND", "CREATED", "SIZE", "KEY"}
 	if c.expanded {
-		headers = append(headers, "EXPIRES", "META")
+		headers = append(headers, "EXPIRES", "META", "CHECKSUM")
 	}
 
 	tw := newTabbedWriter(clingy.Stdout(ctx), headers...)
@@ -163,12 +222,12 @@ func (c *cmdLs) printTabbedLocation(ctx context.Context, iter ulfs.ObjectIterato
 		if obj.IsPrefix {
 			parts = append(parts, "PRE", "", "", obj.Loc.Loc())
 			if c.expanded {
-				parts = append(parts, "", "")
+				parts = append(parts, "", "", "")
 			}
 		} else {
 			parts = append(parts, "OBJ", formatTime(c.utc, obj.Created), obj.ContentLength, obj.Loc.Loc())
 			if c.expanded {
-				parts = append(parts, formatTime(c.utc, obj.Expires), sumMetadataSize(obj.Metadata))
+				parts = append(parts, formatTime(c.utc, obj.Expires), sumMetadataSize(obj.Metadata), formatChecksum(obj.Metadata))
 			}
 		}
 
@@ -196,7 +255,8 @@ func (c *cmdLs) printJSONLocation(ctx context.Context, iter ulfs.ObjectIterator)
 				Key      string `json:"key"`
 				Expires  string `json:"expires,omitempty"`
 				Metadata int    `json:"meta,omitempty"`
-			}{"OBJ", formatTime(c.utc, obj.Created), obj.ContentLength, obj.Loc.Loc(), formatTime(c.utc, obj.Expires), sumMetadataSize(obj.Metadata)})
+				Checksum string `json:"checksum,omitempty"`
+			}{"OBJ", formatTime(c.utc, obj.Created), obj.ContentLength, obj.Loc.Loc(), formatTime(c.utc, obj.Expires), sumMetadataSize(obj.Metadata), formatChecksum(obj.Metadata)})
 		}
 		if err != nil {
 			return err
@@ -205,6 +265,84 @@ func (c *cmdLs) printJSONLocation(ctx context.Context, iter ulfs.ObjectIterator)
 	return iter.Err()
 }
 
+func (c *cmdLs) printCSVLocation(ctx context.Context, iter ulfs.ObjectIterator) (err error) {
+	cw := csv.NewWriter(clingy.Stdout(ctx))
+	defer cw.Flush()
+
+	headers := []string{"kind", "created", "size", "key"}
+	if c.expanded {
+		headers = append(headers, "expires", "meta", "checksum")
+	}
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+
+	for iter.Next() {
+		obj := iter.Item()
+
+		var record []string
+		if obj.IsPrefix {
+			record = append(record, "PRE", "", "", obj.Loc.Loc())
+			if c.expanded {
+				record = append(record, "", "", "")
+			}
+		} else {
+			record = append(record, "OBJ", formatTime(c.utc, obj.Created), strconv.FormatInt(obj.ContentLength, 10), obj.Loc.Loc())
+			if c.expanded {
+				record = append(record, formatTime(c.utc, obj.Expires), strconv.Itoa(sumMetadataSize(obj.Metadata)), formatChecksum(obj.Metadata))
+			}
+		}
+
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
+
+// sortObjects lists every object of the iterator and returns them sorted by name,
+// size or time.
+func sortObjects(iter ulfs.ObjectIterator, by string, reverse bool) (ulfs.ObjectIterator, error) {
+	var infos []ulfs.ObjectInfo
+	for iter.Next() {
+		infos = append(infos, iter.Item())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+
+	switch by {
+	case "size":
+		sort.SliceStable(infos, func(i, j int) bool { return infos[i].ContentLength < infos[j].ContentLength })
+	case "time":
+		sort.SliceStable(infos, func(i, j int) bool { return infos[i].Created.Before(infos[j].Created) })
+	}
+
+	if reverse {
+		for i, j := 0, len(infos)-1; i < j; i, j = i+1, j-1 {
+			infos[i], infos[j] = infos[j], infos[i]
+		}
+	}
+	return &sortedObjectIterator{infos: infos}, nil
+}
+
+// sortedObjectIterator iterates over the objects listed by sortObjects.
+type sortedObjectIterator struct {
+	infos   []ulfs.ObjectInfo
+	current ulfs.ObjectInfo
+}
+
+func (it *sortedObjectIterator) Next() bool {
+	if len(it.infos) == 0 {
+		return false
+	}
+	it.current, it.infos = it.infos[0], it.infos[1:]
+	return true
+}
+
+func (it *sortedObjectIterator) Err() error            { return nil }
+func (it *sortedObjectIterator) Item() ulfs.ObjectInfo { return it.current }
+
 func formatTime(utc bool, x time.Time) string {
 	if x.IsZero() {
 		return ""
@@ -218,6 +356,15 @@ func formatTime(utc bool, x time.Time) string {
 	return x.Format("2006-01-02 15:04:05")
 }
 
+// formatChecksum returns the first checksum stored in the metadata as ALGORITHM:HEX.
+func formatChecksum(md uplink.CustomMetadata) string {
+	algorithms := storedChecksums(md)
+	if len(algorithms) == 0 {
+		return ""
+	}
+	return algorithms[0].name + ":" + md[algorithms[0].key]
+}
+
 func sumMetadataSize(md uplink.CustomMetadata) int {
 	size := 0
 	for k, v := range md {
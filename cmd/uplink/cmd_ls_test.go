@@ -6,6 +6,7 @@ package main
 import (
 	"testing"
 
+	"storx/cmd/uplink/ulfs"
 	"storx/cmd/uplink/ultest"
 )
 
@@ -465,3 +466,41 @@ func TestLsRelative(t *testing.T) {
 	})
 
 }
+
+func TestLsSortAndFormats(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/a", "ccc"),
+		ultest.WithFile("sj://user/b", "a"),
+		ultest.WithFileOptions("sj://user/c", "bb", &ulfs.CreateOptions{Metadata: map[string]string{metadataSHA256: "abcd"}}),
+	)
+
+	t.Run("TimeReversed", func(t *testing.T) {
+		state.Succeed(t, "ls", "sj://user", "--utc", "--sort", "time", "--reverse").RequireStdout(t, `
+			KIND    CREATED                SIZE    KEY
+			OBJ     1970-01-01 00:00:03    0       c
+			OBJ     1970-01-01 00:00:02    0       b
+			OBJ     1970-01-01 00:00:01    0       a
+		`)
+	})
+
+	t.Run("SizeCSV", func(t *testing.T) {
+		state.Succeed(t, "ls", "sj://user", "--utc", "--expanded", "--sort", "size", "--output", "csv").RequireStdout(t, `
+			kind,created,size,key,expires,meta,checksum
+			OBJ,1970-01-01 00:00:02,1,b,,0,
+			OBJ,1970-01-01 00:00:03,2,c,,16,sha256:abcd
+			OBJ,1970-01-01 00:00:01,3,a,,0,
+		`)
+	})
+
+	t.Run("SizeReversedNDJSON", func(t *testing.T) {
+		state.Succeed(t, "ls", "sj://user", "--utc", "--expanded", "--sort", "size", "--reverse", "--output", "ndjson").RequireStdout(t, `
+			{"kind":"OBJ","created":"1970-01-01 00:00:01","size":3,"key":"a"}
+			{"kind":"OBJ","created":"1970-01-01 00:00:03","size":2,"key":"c","meta":16,"checksum":"sha256:abcd"}
+			{"kind":"OBJ","created":"1970-01-01 00:00:02","size":1,"key":"b"}
+		`)
+	})
+
+	t.Run("InvalidSort", func(t *testing.T) {
+		state.Fail(t, "ls", "sj://user", "--sort", "color")
+	})
+}
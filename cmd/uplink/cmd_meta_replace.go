@@ -0,0 +1,43 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/zeebo/clingy"
+
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulloc"
+)
+
+type cmdMetaReplace struct {
+	ex ulext.External
+
+	updater metaUpdater
+
+	location ulloc.Location
+	metadata map[string]string
+}
+
+func newCmdMetaReplace(ex ulext.External) *cmdMetaReplace {
+	return &cmdMetaReplace{ex: ex}
+}
+
+func (c *cmdMetaReplace) Setup(params clingy.Parameters) {
+	c.updater.Setup(params)
+
+	c.location = params.Arg("location", "Location of object or prefix with --recursive (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.metadata = params.Arg("metadata", "JSON string of the metadata to replace all existing entries with, '{}' removes every entry",
+		clingy.Transform(parseJSON),
+	).(map[string]string)
+}
+
+func (c *cmdMetaReplace) Execute(ctx context.Context) error {
+	return c.updater.Execute(ctx, c.ex, c.location, func(map[string]string) map[string]string {
+		return copyMetadata(c.metadata)
+	})
+}
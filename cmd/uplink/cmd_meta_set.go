@@ -0,0 +1,63 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulloc"
+)
+
+type cmdMetaSet struct {
+	ex ulext.External
+
+	updater metaUpdater
+
+	location ulloc.Location
+	entries  []metaEntry
+}
+
+// metaEntry is a custom metadata entry given as key=value.
+type metaEntry struct {
+	key   string
+	value string
+}
+
+func newCmdMetaSet(ex ulext.External) *cmdMetaSet {
+	return &cmdMetaSet{ex: ex}
+}
+
+func (c *cmdMetaSet) Setup(params clingy.Parameters) {
+	c.updater.Setup(params)
+
+	c.location = params.Arg("location", "Location of object or prefix with --recursive (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.entries = params.Arg("entries", "Metadata entries to set (KEY=VALUE)",
+		clingy.Transform(parseMetaEntry),
+		clingy.Repeated,
+	).([]metaEntry)
+}
+
+func (c *cmdMetaSet) Execute(ctx context.Context) error {
+	return c.updater.Execute(ctx, c.ex, c.location, func(metadata map[string]string) map[string]string {
+		for _, entry := range c.entries {
+			metadata[entry.key] = entry.value
+		}
+		return metadata
+	})
+}
+
+func parseMetaEntry(value string) (metaEntry, error) {
+	key, val, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return metaEntry{}, errs.New("invalid metadata entry %q: expected KEY=VALUE", value)
+	}
+	return metaEntry{key: key, value: val}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ultest"
+)
+
+func TestMetaUpdate(t *testing.T) {
+	withMetadata := func(location string, metadata map[string]string) ultest.ExecuteOption {
+		return ultest.WithFileOptions(location, "data", &ulfs.CreateOptions{Metadata: metadata})
+	}
+
+	t.Run("Set", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			withMetadata("sj://user/file.txt", map[string]string{"foo": "bar", "keep": "me"}),
+		)
+
+		state.Succeed(t, "meta", "set", "sj://user/file.txt", "foo=baz", "new=value=with=equals").RequireStdout(t, `
+			updated sj://user/file.txt
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "data", Metadata: map[string]string{
+				"foo": "baz", "keep": "me", "new": "value=with=equals",
+			}},
+		)
+
+		state.Fail(t, "meta", "set", "sj://user/file.txt", "novalue")
+		state.Fail(t, "meta", "set", "sj://user/missing.txt", "foo=bar")
+		state.Fail(t, "meta", "set", "/home/user/file.txt", "foo=bar")
+		state.Fail(t, "meta", "set", "sj://user/file.txt", "foo=bar", "--encrypted")
+	})
+
+	t.Run("Unset", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			withMetadata("sj://user/file.txt", map[string]string{"foo": "bar", "keep": "me"}),
+		)
+
+		state.Succeed(t, "meta", "unset", "sj://user/file.txt", "foo", "missing").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "data", Metadata: map[string]string{"keep": "me"}},
+		)
+
+		state.Succeed(t, "meta", "unset", "sj://user/file.txt", "foo", "keep").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "data"},
+		)
+
+		state.Fail(t, "meta", "unset", "sj://user/file.txt", "foo", "--encrypted")
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			withMetadata("sj://user/file.txt", map[string]string{"foo": "bar"}),
+		)
+
+		state.Succeed(t, "meta", "replace", "sj://user/file.txt", `{"schema":"v2"}`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "data", Metadata: map[string]string{"schema": "v2"}},
+		)
+
+		state.Succeed(t, "meta", "replace", "sj://user/file.txt", `{}`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/file.txt", Contents: "data"},
+		)
+
+		state.Fail(t, "meta", "replace", "sj://user/file.txt", `not json`)
+		state.Fail(t, "meta", "replace", "sj://user/file.txt", `{}`, "--encrypted")
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			withMetadata("sj://user/docs/a.txt", map[string]string{"schema": "v1"}),
+			withMetadata("sj://user/docs/sub/b.txt", map[string]string{"schema": "v1", "owner": "bob"}),
+			withMetadata("sj://user/docs/c.log", map[string]string{"schema": "v1"}),
+			withMetadata("sj://user/other.txt", map[string]string{"schema": "v1"}),
+		)
+
+		state.Succeed(t, "meta", "set", "-r", "sj://user/docs/", "schema=v2", "--include", "*.txt").RequireStdout(t, `
+			updated sj://user/docs/a.txt
+			updated sj://user/docs/sub/b.txt
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/docs/a.txt", Contents: "data", Metadata: map[string]string{"schema": "v2"}},
+			ultest.File{Loc: "sj://user/docs/c.log", Contents: "data", Metadata: map[string]string{"schema": "v1"}},
+			ultest.File{Loc: "sj://user/docs/sub/b.txt", Contents: "data", Metadata: map[string]string{"schema": "v2", "owner": "bob"}},
+			ultest.File{Loc: "sj://user/other.txt", Contents: "data", Metadata: map[string]string{"schema": "v1"}},
+		)
+
+		state.Fail(t, "meta", "set", "sj://user/docs/a.txt", "schema=v3", "--include", "*.txt")
+	})
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+
+	"github.com/zeebo/clingy"
+
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulloc"
+)
+
+type cmdMetaUnset struct {
+	ex ulext.External
+
+	updater metaUpdater
+
+	location ulloc.Location
+	keys     []string
+}
+
+func newCmdMetaUnset(ex ulext.External) *cmdMetaUnset {
+	return &cmdMetaUnset{ex: ex}
+}
+
+func (c *cmdMetaUnset) Setup(params clingy.Parameters) {
+	c.updater.Setup(params)
+
+	c.location = params.Arg("location", "Location of object or prefix with --recursive (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.keys = params.Arg("keys", "Metadata entries to remove",
+		clingy.Repeated,
+	).([]string)
+}
+
+func (c *cmdMetaUnset) Execute(ctx context.Context) error {
+	return c.updater.Execute(ctx, c.ex, c.location, func(metadata map[string]string) map[string]string {
+		for _, key := range c.keys {
+			delete(metadata, key)
+		}
+		return metadata
+	})
+}
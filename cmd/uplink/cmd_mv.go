@@ -27,6 +27,7 @@ type cmdMv struct {
 	parallelism int
 	dryrun      bool
 	progress    bool
+	filter      filterFlags
 
 	source ulloc.Location
 	dest   ulloc.Location
@@ -58,6 +59,7 @@ func (c *cmdMv) Setup(params clingy.Parameters) {
 	c.progress = params.Flag("progress", "Show a progress bar when possible", true,
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
+	c.filter.Setup(params)
 
 	c.source = params.Arg("source", "Source to move", clingy.Transform(ulloc.Parse)).(ulloc.Location)
 	c.dest = params.Arg("dest", "Destination to move", clingy.Transform(ulloc.Parse)).(ulloc.Location)
@@ -81,6 +83,8 @@ func (c *cmdMv) Execute(ctx context.Context) error {
 		return errs.New("source and dest cannot be equal")
 	case c.recursive && (!c.source.Directoryish() || !c.dest.Directoryish()):
 		return errs.New("with --recursive flag source and destination must end with '/'")
+	case !c.recursive && c.filter.Filter() != nil:
+		return errs.New("filters can only be used with --recursive")
 	}
 
 	// we ensure the source and destination are lexically directoryish
@@ -117,6 +121,7 @@ func (c *cmdMv) Execute(ctx context.Context) error {
 func (c *cmdMv) moveRecursive(ctx context.Context, fs ulfs.Filesystem) error {
 	iter, err := fs.List(ctx, c.source, &ulfs.ListOptions{
 		Recursive: true,
+		Filter:    c.filter.Filter(),
 	})
 	if err != nil {
 		return errs.Wrap(err)
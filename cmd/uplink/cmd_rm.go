@@ -27,6 +27,7 @@ type cmdRm struct {
 	parallelism int
 	encrypted   bool
 	pending     bool
+	filter      filterFlags
 
 	location ulloc.Location
 }
@@ -57,6 +58,7 @@ func (c *cmdRm) Setup(params clingy.Parameters) {
 	c.pending = params.Flag("pending", "Remove pending object uploads instead", false,
 		clingy.Transform(strconv.ParseBool), clingy.Boolean,
 	).(bool)
+	c.filter.Setup(params)
 
 	c.location = params.Arg("location", "Location to remove (sj://BUCKET[/KEY])",
 		clingy.Transform(ulloc.Parse),
@@ -75,6 +77,10 @@ func (c *cmdRm) Execute(ctx context.Context) error {
 	defer func() { _ = fs.Close() }()
 
 	if !c.recursive {
+		if c.filter.Filter() != nil {
+			return errs.New("filters can only be used with --recursive")
+		}
+
 		err := fs.Remove(ctx, c.location, &ulfs.RemoveOptions{
 			Pending: c.pending,
 		})
@@ -89,6 +95,7 @@ func (c *cmdRm) Execute(ctx context.Context) error {
 	iter, err := fs.List(ctx, c.location, &ulfs.ListOptions{
 		Recursive: true,
 		Pending:   c.pending,
+		Filter:    c.filter.Filter(),
 	})
 	if err != nil {
 		return err
@@ -41,6 +41,29 @@ func TestRmRemote(t *testing.T) {
 		)
 	})
 
+	t.Run("Filtered", func(t *testing.T) {
+		state := ultest.Setup(commands,
+			ultest.WithFile("sj://user/logs/old.log", "old"),
+			ultest.WithFile("sj://user/logs/old.txt", "old"),
+			ultest.WithFile("sj://user/logs/new.log", "new"),
+			ultest.WithFile("sj://user/logs/big.log", "big contents"),
+		)
+
+		state.Succeed(t, "rm", "-r", "sj://user/logs/", "--include", "*.log", "--older-than", "1970-01-01T00:00:03Z").RequireFiles(t,
+			ultest.File{Loc: "sj://user/logs/big.log", Contents: "big contents"},
+			ultest.File{Loc: "sj://user/logs/new.log", Contents: "new"},
+			ultest.File{Loc: "sj://user/logs/old.txt", Contents: "old"},
+		)
+
+		state.Succeed(t, "rm", "-r", "sj://user/logs/", "--min-size", "5B", "--older-than", "30d").RequireFiles(t,
+			ultest.File{Loc: "sj://user/logs/new.log", Contents: "new"},
+			ultest.File{Loc: "sj://user/logs/old.log", Contents: "old"},
+			ultest.File{Loc: "sj://user/logs/old.txt", Contents: "old"},
+		)
+
+		state.Fail(t, "rm", "sj://user/logs/old.log", "--older-than", "30d")
+	})
+
 	t.Run("Pending", func(t *testing.T) {
 		state := ultest.Setup(commands,
 			ultest.WithPendingFile("sj://user/files/file1.txt"),
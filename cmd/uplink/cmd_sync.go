@@ -0,0 +1,380 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"common/rpc/rpcpool"
+	"common/sync2"
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+// metadataModTime is the custom metadata key storing the modification time
+// of the local file an object was uploaded from.
+const metadataModTime = "storx-mtime"
+
+type cmdSync struct {
+	ex ulext.External
+
+	access         string
+	delete         bool
+	dryrun         bool
+	transfers      int
+	checksum       bool
+	overwriteNewer bool
+	filter         filterFlags
+
+	source ulloc.Location
+	dest   ulloc.Location
+}
+
+func newCmdSync(ex ulext.External) *cmdSync {
+	return &cmdSync{ex: ex}
+}
+
+func (c *cmdSync) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.delete = params.Flag("delete", "Remove files or objects from the destination that don't exist in the source", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.dryrun = params.Flag("dry-run", "Print what operations would happen but don't execute them", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.transfers = params.Flag("transfers", "Controls how many uploads/downloads to perform in parallel", 1,
+		clingy.Short('t'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("transfers must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	c.checksum = params.Flag("checksum", "Compare the SHA-256 of the contents in addition to the size and modification time", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.overwriteNewer = params.Flag("overwrite-newer", "Overwrite destination files or objects that were modified after the source", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.filter.Setup(params)
+
+	c.source = params.Arg("source", "Directory or prefix to synchronize from",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.dest = params.Arg("dest", "Directory or prefix to synchronize to",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+}
+
+// syncItem is a file or object that exists at the destination.
+type syncItem struct {
+	info ulfs.ObjectInfo
+	seen bool
+}
+
+func (c *cmdSync) Execute(ctx context.Context) error {
+	if c.source.Std() || c.dest.Std() {
+		return errs.New("cannot synchronize stdin/stdout")
+	}
+	if !c.source.Remote() && !c.dest.Remote() {
+		return errs.New("at least one location must be a remote sj:// location")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access, ulext.ConnectionPoolOptions(rpcpool.Options{
+		Capacity:       100 * c.transfers,
+		KeyCapacity:    5,
+		IdleExpiration: 2 * time.Minute,
+	}))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	source, dest := c.source.AsDirectoryish(), c.dest.AsDirectoryish()
+
+	// both listings are only filtered by the paths. the size and the age are
+	// compared with the source below so that the destinations of the skipped
+	// sources are still seen and not removed.
+	filter := c.filter.Filter()
+	var pathFilter *ulfs.Filter
+	if filter != nil {
+		pathFilter = &ulfs.Filter{Include: filter.Include, Exclude: filter.Exclude}
+	}
+
+	// the destination is read fully so that the source can be streamed while
+	// looking up what already exists at the destination.
+	existing := make(map[string]*syncItem)
+	{
+		iter, err := fs.List(ctx, dest, &ulfs.ListOptions{Recursive: true, Expanded: true, Filter: pathFilter})
+		if err != nil {
+			return err
+		}
+		for iter.Next() {
+			item := iter.Item()
+			if item.IsPrefix {
+				continue
+			}
+			existing[item.Loc.String()] = &syncItem{info: item}
+		}
+		if err := iter.Err(); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+
+	iter, err := fs.List(ctx, source, &ulfs.ListOptions{Recursive: true, Expanded: true, Filter: pathFilter})
+	if err != nil {
+		return err
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintln(w, args...)
+	}
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	for iter.Next() {
+		item := iter.Item()
+		if item.IsPrefix {
+			continue
+		}
+
+		rel, err := source.RelativeTo(item.Loc)
+		if err != nil {
+			addError(err)
+			break
+		}
+
+		target := joinDestWith(dest, rel)
+		current := existing[target.String()]
+		if current != nil {
+			current.seen = true
+		}
+		if !filter.Match(rel, item) {
+			continue
+		}
+
+		if current != nil && !c.overwriteNewer && isNewer(current.info, item) {
+			fprintln(clingy.Stdout(ctx), "skip", item.Loc, "because", target, "is newer")
+			continue
+		}
+
+		ok := limiter.Go(ctx, func() {
+			transfer, sum, err := c.needsTransfer(ctx, fs, item, current)
+			if err != nil {
+				fprintln(clingy.Stderr(ctx), "compare", item.Loc, "failed:", err.Error())
+				addError(err)
+				return
+			}
+			if !transfer {
+				return
+			}
+
+			fprintln(clingy.Stdout(ctx), copyVerb(item.Loc, target), item.Loc, "to", target)
+			if c.dryrun {
+				return
+			}
+
+			if err := c.transfer(ctx, fs, item, target, sum); err != nil {
+				fprintln(clingy.Stderr(ctx), copyVerb(item.Loc, target), item.Loc, "failed:", err.Error())
+				addError(err)
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	} else if len(es) > 0 {
+		// nothing is removed when the source could not be fully synchronized.
+		return combineErrs(es)
+	}
+
+	if c.delete {
+		return c.removeUnseen(ctx, fs, existing)
+	}
+	return nil
+}
+
+// needsTransfer compares the source with the current destination and returns if it needs to
+// be transferred. When checksums are compared, it also returns the SHA-256 of the source.
+func (c *cmdSync) needsTransfer(ctx context.Context, fs ulfs.Filesystem, source ulfs.ObjectInfo, current *syncItem) (_ bool, sum string, err error) {
+	// the SHA-256 of a local source is stored with the uploaded object.
+	if c.checksum && source.Loc.Local() {
+		sum, err = contentSHA256(ctx, fs, source)
+		if err != nil {
+			return false, "", err
+		}
+	}
+
+	if current == nil {
+		return true, sum, nil
+	}
+	dest := current.info
+
+	sourceTime, destTime := syncModTime(source), syncModTime(dest)
+	if source.ContentLength != dest.ContentLength {
+		return true, sum, nil
+	}
+	if !sourceTime.IsZero() && !destTime.IsZero() && !sourceTime.Equal(destTime) {
+		return true, sum, nil
+	}
+
+	if c.checksum {
+		if sum == "" {
+			sum, err = contentSHA256(ctx, fs, source)
+			if err != nil {
+				return false, "", err
+			}
+		}
+		destSum, err := contentSHA256(ctx, fs, dest)
+		if err != nil {
+			return false, "", err
+		}
+		return destSum != sum, sum, nil
+	}
+
+	return false, sum, nil
+}
+
+// transfer copies the source to the destination, keeping its modification time.
+func (c *cmdSync) transfer(ctx context.Context, fs ulfs.Filesystem, source ulfs.ObjectInfo, dest ulloc.Location, sum string) error {
+	if source.Loc.Remote() && dest.Remote() {
+		// server side copies keep the metadata of the object.
+		return fs.Copy(ctx, source.Loc, dest)
+	}
+
+	opts := &ulfs.CreateOptions{ModTime: syncModTime(source)}
+	if dest.Remote() {
+		opts.Metadata = make(map[string]string)
+		if !opts.ModTime.IsZero() {
+			opts.Metadata[metadataModTime] = opts.ModTime.UTC().Format(time.RFC3339Nano)
+		}
+		if sum != "" {
+			opts.Metadata[metadataSHA256] = sum
+		}
+		if len(opts.Metadata) == 0 {
+			opts.Metadata = nil
+		}
+	}
+
+	mrh, err := fs.Open(ctx, source.Loc)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mrh.Close() }()
+
+	mwh, err := fs.Create(ctx, dest, opts)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = mwh.Abort(ctx) }()
+
+	rh, err := mrh.NextPart(ctx, -1)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rh.Close() }()
+
+	wh, err := mwh.NextPart(ctx, -1)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = wh.Abort() }()
+
+	if _, err := sync2.Copy(ctx, wh, rh); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := wh.Commit(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(mwh.Commit(ctx))
+}
+
+// removeUnseen removes everything from the destination that doesn't exist in the source.
+func (c *cmdSync) removeUnseen(ctx context.Context, fs ulfs.Filesystem, existing map[string]*syncItem) error {
+	var unseen []ulfs.ObjectInfo
+	for _, item := range existing {
+		if !item.seen {
+			unseen = append(unseen, item.info)
+		}
+	}
+	sort.Slice(unseen, func(i, j int) bool { return unseen[i].Loc.Less(unseen[j].Loc) })
+
+	var es errs.Group
+	for _, info := range unseen {
+		fmt.Fprintln(clingy.Stdout(ctx), "remove", info.Loc)
+		if c.dryrun {
+			continue
+		}
+
+		if err := fs.Remove(ctx, info.Loc, nil); err != nil {
+			fmt.Fprintln(clingy.Stderr(ctx), "remove", info.Loc, "failed:", err.Error())
+			es.Add(err)
+		}
+	}
+	return combineErrs(es)
+}
+
+// isNewer returns if a was modified after b. It is false when either modification time is unknown.
+func isNewer(a, b ulfs.ObjectInfo) bool {
+	aTime, bTime := syncModTime(a), syncModTime(b)
+	return !aTime.IsZero() && !bTime.IsZero() && aTime.After(bTime)
+}
+
+// syncModTime returns the modification time of a local file or the modification time
+// stored in the metadata of an object. It is zero if the time is unknown.
+func syncModTime(info ulfs.ObjectInfo) time.Time {
+	if info.Loc.Local() {
+		return info.Created
+	}
+	modTime, err := time.Parse(time.RFC3339Nano, info.Metadata[metadataModTime])
+	if err != nil {
+		return time.Time{}
+	}
+	return modTime
+}
+
+// contentSHA256 returns the SHA-256 stored in the metadata of an object. Otherwise, it
+// reads the file or object and returns its hex encoded SHA-256.
+func contentSHA256(ctx context.Context, fs ulfs.Filesystem, info ulfs.ObjectInfo) (_ string, err error) {
+	if sum := info.Metadata[metadataSHA256]; info.Loc.Remote() && sum != "" {
+		return sum, nil
+	}
+
+	sums, err := computeChecksums(ctx, fs, info.Loc, []checksumAlgorithm{checksumAlgorithms[0]})
+	if err != nil {
+		return "", err
+	}
+	return sums[metadataSHA256], nil
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ultest"
+)
+
+func TestSyncUpload(t *testing.T) {
+	older := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+
+	mtime := func(t time.Time) map[string]string {
+		return map[string]string{metadataModTime: t.Format(time.RFC3339Nano)}
+	}
+
+	state := ultest.Setup(commands,
+		ultest.WithFileOptions("/home/user/dir/same.txt", "same", &ulfs.CreateOptions{ModTime: older}),
+		ultest.WithFileOptions("/home/user/dir/changed.txt", "data", &ulfs.CreateOptions{ModTime: newer}),
+		ultest.WithFileOptions("/home/user/dir/sub/new.txt", "new", &ulfs.CreateOptions{ModTime: older}),
+		ultest.WithFileOptions("/home/user/dir/stale.txt", "local", &ulfs.CreateOptions{ModTime: older}),
+
+		ultest.WithFileOptions("sj://user/dir/same.txt", "same", &ulfs.CreateOptions{Metadata: mtime(older)}),
+		ultest.WithFileOptions("sj://user/dir/changed.txt", "data", &ulfs.CreateOptions{Metadata: mtime(older)}),
+		ultest.WithFileOptions("sj://user/dir/stale.txt", "remote", &ulfs.CreateOptions{Metadata: mtime(newer)}),
+		ultest.WithFile("sj://user/dir/extra.txt", "extra"),
+	)
+
+	t.Run("Basic", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir").RequireStdout(t, `
+			upload /home/user/dir/changed.txt to sj://user/dir/changed.txt
+			skip /home/user/dir/stale.txt because sj://user/dir/stale.txt is newer
+			upload /home/user/dir/sub/new.txt to sj://user/dir/sub/new.txt
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/changed.txt", Contents: "data", Metadata: mtime(newer)},
+			ultest.File{Loc: "sj://user/dir/extra.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dir/same.txt", Contents: "same", Metadata: mtime(older)},
+			ultest.File{Loc: "sj://user/dir/stale.txt", Contents: "remote", Metadata: mtime(newer)},
+			ultest.File{Loc: "sj://user/dir/sub/new.txt", Contents: "new", Metadata: mtime(older)},
+		)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir", "--delete", "--overwrite-newer").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/changed.txt", Contents: "data", Metadata: mtime(newer)},
+			ultest.File{Loc: "sj://user/dir/same.txt", Contents: "same", Metadata: mtime(older)},
+			ultest.File{Loc: "sj://user/dir/stale.txt", Contents: "local", Metadata: mtime(older)},
+			ultest.File{Loc: "sj://user/dir/sub/new.txt", Contents: "new", Metadata: mtime(older)},
+		)
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir", "--delete", "--dry-run").RequireStdout(t, `
+			upload /home/user/dir/changed.txt to sj://user/dir/changed.txt
+			skip /home/user/dir/stale.txt because sj://user/dir/stale.txt is newer
+			upload /home/user/dir/sub/new.txt to sj://user/dir/sub/new.txt
+			remove sj://user/dir/extra.txt
+		`).RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/changed.txt", Contents: "data", Metadata: mtime(older)},
+			ultest.File{Loc: "sj://user/dir/extra.txt", Contents: "extra"},
+			ultest.File{Loc: "sj://user/dir/same.txt", Contents: "same", Metadata: mtime(older)},
+			ultest.File{Loc: "sj://user/dir/stale.txt", Contents: "remote", Metadata: mtime(newer)},
+		)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir", "--delete", "--include", "*.txt", "--exclude", "sub/*").RequireRemoteFiles(t,
+			ultest.File{Loc: "sj://user/dir/changed.txt", Contents: "data", Metadata: mtime(newer)},
+			ultest.File{Loc: "sj://user/dir/same.txt", Contents: "same", Metadata: mtime(older)},
+			ultest.File{Loc: "sj://user/dir/stale.txt", Contents: "remote", Metadata: mtime(newer)},
+		)
+
+		state.Fail(t, "sync", "/home/user/dir", "sj://user/dir", "--include", "[")
+	})
+}
+
+func TestSyncChecksum(t *testing.T) {
+	modTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	metadata := map[string]string{metadataModTime: modTime.Format(time.RFC3339Nano)}
+
+	state := ultest.Setup(commands,
+		ultest.WithFileOptions("/home/user/dir/file.txt", "abcd", &ulfs.CreateOptions{ModTime: modTime}),
+		ultest.WithFileOptions("sj://user/dir/file.txt", "wxyz", &ulfs.CreateOptions{Metadata: metadata}),
+	)
+
+	// the size and the modification time are the same.
+	state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/file.txt", Contents: "wxyz", Metadata: metadata},
+	)
+
+	state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir", "--checksum").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/file.txt", Contents: "abcd", Metadata: map[string]string{
+			metadataModTime: modTime.Format(time.RFC3339Nano),
+			metadataSHA256:  "88d4266fd4e6338d13b845fcf289579d209c897823b9217da3e161936f031589",
+		}},
+	)
+}
+
+func TestSyncChecksumUnknown(t *testing.T) {
+	modTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	metadata := map[string]string{metadataModTime: modTime.Format(time.RFC3339Nano)}
+
+	state := ultest.Setup(commands,
+		ultest.WithFileOptions("/home/user/dir/file.txt", "abcd", &ulfs.CreateOptions{ModTime: modTime}),
+		ultest.WithFileOptions("sj://user/dir/file.txt", "abcd", &ulfs.CreateOptions{Metadata: metadata}),
+	)
+
+	// objects without a stored SHA-256 are compared by their contents.
+	state.Succeed(t, "sync", "sj://user/dir", "/home/user/dir", "--checksum").RequireStdout(t, "").RequireLocalFiles(t,
+		ultest.File{Loc: "/home/user/dir/file.txt", Contents: "abcd"},
+	)
+
+	state.Succeed(t, "sync", "/home/user/dir", "sj://user/dir", "--checksum").RequireStdout(t, "").RequireRemoteFiles(t,
+		ultest.File{Loc: "sj://user/dir/file.txt", Contents: "abcd", Metadata: metadata},
+	)
+}
+
+func TestSyncDownload(t *testing.T) {
+	state := ultest.Setup(commands,
+		ultest.WithFile("sj://user/dir/file1.txt", "remote1"),
+		ultest.WithFile("sj://user/dir/sub/file2.txt", "remote2"),
+		ultest.WithFile("/home/user/dir/file1.txt", "local1"),
+		ultest.WithFile("/home/user/dir/extra.txt", "extra"),
+	)
+
+	state.Succeed(t, "sync", "sj://user/dir", "/home/user/dir", "--delete").RequireLocalFiles(t,
+		ultest.File{Loc: "/home/user/dir/file1.txt", Contents: "remote1"},
+		ultest.File{Loc: "/home/user/dir/sub/file2.txt", Contents: "remote2"},
+	)
+
+	state.Fail(t, "sync", "/home/user/dir", "/home/user/other")
+}
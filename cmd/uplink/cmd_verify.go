@@ -0,0 +1,213 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"common/sync2"
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+type cmdVerify struct {
+	ex ulext.External
+
+	access          string
+	recursive       bool
+	transfers       int
+	requireChecksum bool
+
+	location ulloc.Location
+	local    *ulloc.Location
+}
+
+func newCmdVerify(ex ulext.External) *cmdVerify {
+	return &cmdVerify{ex: ex}
+}
+
+func (c *cmdVerify) Setup(params clingy.Parameters) {
+	c.access = params.Flag("access", "Access name or value to use", "").(string)
+	c.recursive = params.Flag("recursive", "Verify every object under the prefix", false,
+		clingy.Short('r'),
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.requireChecksum = params.Flag("require-checksum", "Fail for objects without a stored checksum instead of reporting them as unverified", false,
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	c.transfers = params.Flag("transfers", "Controls how many objects to verify in parallel", 1,
+		clingy.Short('t'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("transfers must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+
+	c.location = params.Arg("location", "Object or prefix to verify (sj://BUCKET/KEY)",
+		clingy.Transform(ulloc.Parse),
+	).(ulloc.Location)
+	c.local = params.Arg("local", "Local file or directory to compare the objects against instead of their stored checksums",
+		clingy.Optional,
+		clingy.Transform(ulloc.Parse),
+	).(*ulloc.Location)
+}
+
+func (c *cmdVerify) Execute(ctx context.Context) error {
+	if !c.location.Remote() {
+		return errs.New("location must be a remote sj:// location")
+	}
+	if c.local != nil && !c.local.Local() {
+		return errs.New("compared location must be a local path")
+	}
+
+	fs, err := c.ex.OpenFilesystem(ctx, c.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	if !c.recursive {
+		info, err := fs.Stat(ctx, c.location)
+		if err != nil {
+			return err
+		}
+
+		result, err := c.verifyObject(ctx, fs, *info, c.local)
+		if err != nil {
+			fmt.Fprintln(clingy.Stderr(ctx), "verify", info.Loc, "failed:", err.Error())
+			return err
+		}
+		fmt.Fprintln(clingy.Stdout(ctx), result)
+		return nil
+	}
+
+	prefix := c.location.AsDirectoryish()
+
+	iter, err := fs.List(ctx, prefix, &ulfs.ListOptions{
+		Recursive: true,
+		Expanded:  true,
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		limiter = sync2.NewLimiter(c.transfers)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintln(w, args...)
+	}
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	for iter.Next() {
+		info := iter.Item()
+		if info.IsPrefix {
+			continue
+		}
+
+		var local *ulloc.Location
+		if c.local != nil {
+			rel, err := prefix.RelativeTo(info.Loc)
+			if err != nil {
+				return err
+			}
+			dest := joinDestWith(c.local.AsDirectoryish(), rel)
+			local = &dest
+		}
+
+		ok := limiter.Go(ctx, func() {
+			result, err := c.verifyObject(ctx, fs, info, local)
+			if err != nil {
+				fprintln(clingy.Stderr(ctx), "verify", info.Loc, "failed:", err.Error())
+				addError(err)
+			} else {
+				fprintln(clingy.Stdout(ctx), result)
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	} else if len(es) > 0 {
+		return errs.New("%d objects failed verification", len(es))
+	}
+	return nil
+}
+
+// verifyObject downloads the object and compares it against its stored checksums, or
+// compares the local file against them when local is set. It returns the line that is
+// printed for the object. Objects without a stored checksum fail when requireChecksum
+// is set.
+func (c *cmdVerify) verifyObject(ctx context.Context, fs ulfs.Filesystem, info ulfs.ObjectInfo, local *ulloc.Location) (string, error) {
+	if c.requireChecksum && len(storedChecksums(info.Metadata)) == 0 {
+		return "", errs.New("no stored checksum for %s", info.Loc)
+	}
+
+	if local == nil {
+		algorithms, err := verifyChecksums(ctx, fs, info.Loc, info.Metadata)
+		if err != nil {
+			return "", err
+		}
+		if len(algorithms) == 0 {
+			return fmt.Sprintf("unverified %s: no stored checksum", info.Loc), nil
+		}
+		return fmt.Sprintf("verified %s (%s)", info.Loc, checksumNames(algorithms)), nil
+	}
+
+	// the local file is compared with the stored checksums or, for objects without
+	// one, with the SHA-256 of the downloaded contents.
+	algorithms, err := verifyChecksums(ctx, fs, *local, info.Metadata)
+	if err != nil {
+		return "", err
+	}
+	if len(algorithms) == 0 {
+		algorithms = []checksumAlgorithm{checksumAlgorithms[0]}
+
+		remote, err := computeChecksums(ctx, fs, info.Loc, algorithms)
+		if err != nil {
+			return "", err
+		}
+		if _, err := verifyChecksums(ctx, fs, *local, remote); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("verified %s against %s (%s)", info.Loc, local, checksumNames(algorithms)), nil
+}
+
+func checksumNames(algorithms []checksumAlgorithm) string {
+	names := make([]string, 0, len(algorithms))
+	for _, algorithm := range algorithms {
+		names = append(names, algorithm.name)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"testing"
+
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ultest"
+)
+
+func TestVerify(t *testing.T) {
+	const (
+		sha256ABCD = "88d4266fd4e6338d13b845fcf289579d209c897823b9217da3e161936f031589"
+		crc32cABCD = "92c80a31"
+	)
+
+	state := ultest.Setup(commands,
+		ultest.WithFileOptions("sj://user/dir/both.txt", "abcd", &ulfs.CreateOptions{Metadata: map[string]string{
+			metadataSHA256: sha256ABCD,
+			metadataCRC32C: crc32cABCD,
+		}}),
+		ultest.WithFileOptions("sj://user/dir/crc.txt", "abcd", &ulfs.CreateOptions{Metadata: map[string]string{
+			metadataCRC32C: crc32cABCD,
+		}}),
+		ultest.WithFile("sj://user/dir/none.txt", "abcd"),
+	)
+
+	t.Run("Object", func(t *testing.T) {
+		state.Succeed(t, "verify", "sj://user/dir/both.txt").RequireStdout(t, `
+			verified sj://user/dir/both.txt (sha256,crc32c)
+		`)
+	})
+
+	t.Run("Recursive", func(t *testing.T) {
+		state.Succeed(t, "verify", "-r", "sj://user/dir").RequireStdout(t, `
+			verified sj://user/dir/both.txt (sha256,crc32c)
+			verified sj://user/dir/crc.txt (crc32c)
+			unverified sj://user/dir/none.txt: no stored checksum
+		`)
+	})
+
+	t.Run("Mismatch", func(t *testing.T) {
+		state.With(
+			ultest.WithFileOptions("sj://user/dir/bad.txt", "abcd", &ulfs.CreateOptions{Metadata: map[string]string{
+				metadataSHA256: sha256ABCD[1:] + "0",
+			}}),
+		).Fail(t, "verify", "-r", "sj://user/dir").RequireStdout(t, `
+			verified sj://user/dir/both.txt (sha256,crc32c)
+			verified sj://user/dir/crc.txt (crc32c)
+			unverified sj://user/dir/none.txt: no stored checksum
+		`)
+	})
+
+	t.Run("RequireChecksum", func(t *testing.T) {
+		state.Succeed(t, "verify", "sj://user/dir/crc.txt", "--require-checksum").RequireStdout(t, `
+			verified sj://user/dir/crc.txt (crc32c)
+		`)
+
+		state.Fail(t, "verify", "sj://user/dir/none.txt", "--require-checksum")
+
+		state.Fail(t, "verify", "-r", "sj://user/dir", "--require-checksum").RequireStdout(t, `
+			verified sj://user/dir/both.txt (sha256,crc32c)
+			verified sj://user/dir/crc.txt (crc32c)
+		`)
+
+		state.With(
+			ultest.WithFile("/home/user/dir/none.txt", "abcd"),
+		).Fail(t, "verify", "sj://user/dir/none.txt", "/home/user/dir/none.txt", "--require-checksum")
+	})
+
+	t.Run("Local", func(t *testing.T) {
+		state.With(
+			ultest.WithFile("/home/user/dir/both.txt", "abcd"),
+			ultest.WithFile("/home/user/dir/crc.txt", "abcd"),
+			ultest.WithFile("/home/user/dir/none.txt", "abcd"),
+		).Succeed(t, "verify", "-r", "sj://user/dir", "/home/user/dir").RequireStdout(t, `
+			verified sj://user/dir/both.txt against /home/user/dir/both.txt (sha256,crc32c)
+			verified sj://user/dir/crc.txt against /home/user/dir/crc.txt (crc32c)
+			verified sj://user/dir/none.txt against /home/user/dir/none.txt (sha256)
+		`)
+
+		state.With(
+			ultest.WithFile("/home/user/dir/none.txt", "other"),
+		).Fail(t, "verify", "sj://user/dir/none.txt", "/home/user/dir/none.txt")
+
+		state.Fail(t, "verify", "sj://user/dir/none.txt", "/home/user/dir/missing.txt")
+		state.Fail(t, "verify", "/home/user/dir/none.txt")
+	})
+}
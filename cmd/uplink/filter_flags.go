@@ -0,0 +1,97 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"common/memory"
+	"storx/cmd/uplink/ulfs"
+)
+
+// filterFlags are the flags that select which files or objects recursive commands
+// operate on.
+type filterFlags struct {
+	include   []string
+	exclude   []string
+	minSize   memory.Size
+	maxSize   memory.Size
+	newerThan time.Time
+	olderThan time.Time
+}
+
+func (ff *filterFlags) Setup(params clingy.Parameters) {
+	ff.include = params.Flag("include", "Only select paths matching the glob pattern. Patterns without a slash match the base name", []string{},
+		clingy.Transform(ulfs.ValidateGlob),
+		clingy.Repeated,
+	).([]string)
+	ff.exclude = params.Flag("exclude", "Skip paths matching the glob pattern. Patterns without a slash match the base name", []string{},
+		clingy.Transform(ulfs.ValidateGlob),
+		clingy.Repeated,
+	).([]string)
+	ff.minSize = params.Flag("min-size", "Only select files or objects of at least this size (e.g. '10MiB')", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(validateFilterSize),
+	).(memory.Size)
+	ff.maxSize = params.Flag("max-size", "Only select files or objects of at most this size (e.g. '1GiB')", memory.Size(0),
+		clingy.Transform(memory.ParseString),
+		clingy.Transform(validateFilterSize),
+	).(memory.Size)
+	ff.newerThan = params.Flag("newer-than", "Only select files or objects created after this age or time (e.g. '30d', '12h', '2020-01-02T15:04:05Z')", time.Time{},
+		clingy.Transform(parseFilterTime), clingy.Type("age_or_date"),
+	).(time.Time)
+	ff.olderThan = params.Flag("older-than", "Only select files or objects created before this age or time (e.g. '30d', '12h', '2020-01-02T15:04:05Z')", time.Time{},
+		clingy.Transform(parseFilterTime), clingy.Type("age_or_date"),
+	).(time.Time)
+}
+
+// Filter returns the filter of the flags or nil if no flag is set.
+func (ff *filterFlags) Filter() *ulfs.Filter {
+	if len(ff.include) == 0 && len(ff.exclude) == 0 &&
+		ff.minSize == 0 && ff.maxSize == 0 &&
+		ff.newerThan.IsZero() && ff.olderThan.IsZero() {
+		return nil
+	}
+
+	return &ulfs.Filter{
+		Include:   ff.include,
+		Exclude:   ff.exclude,
+		MinSize:   ff.minSize.Int64(),
+		MaxSize:   ff.maxSize.Int64(),
+		NewerThan: ff.newerThan,
+		OlderThan: ff.olderThan,
+	}
+}
+
+func validateFilterSize(n int64) (memory.Size, error) {
+	if n < 0 {
+		return 0, errs.New("size cannot be below 0")
+	}
+	return memory.Size(n), nil
+}
+
+var filterAgeDays = regexp.MustCompile(`^(\d+)d$`)
+
+// parseFilterTime parses an age like '30d' or '12h' into the time that long ago, or
+// any date accepted by parseHumanDate.
+func parseFilterTime(value string) (time.Time, error) {
+	if match := filterAgeDays.FindStringSubmatch(value); match != nil {
+		days, err := strconv.Atoi(match[1])
+		if err != nil {
+			return time.Time{}, errs.Wrap(err)
+		}
+		return time.Now().AddDate(0, 0, -days), nil
+	}
+
+	if age, err := time.ParseDuration(value); err == nil && value[0] != '+' && value[0] != '-' {
+		return time.Now().Add(-age), nil
+	}
+
+	return parseHumanDate(value)
+}
@@ -82,8 +82,14 @@ func commands(cmds clingy.Commands, ex ulext.External) {
 	cmds.New("mv", "Moves files or objects", newCmdMv(ex))
 	cmds.New("ls", "Lists buckets, prefixes, or objects", newCmdLs(ex))
 	cmds.New("rm", "Remove an object", newCmdRm(ex))
+	cmds.New("du", "Reports the number and size of objects under a prefix", newCmdDu(ex))
+	cmds.New("sync", "Synchronizes a directory or prefix, transferring only the differences", newCmdSync(ex))
+	cmds.New("verify", "Verifies objects against their stored checksums or local files", newCmdVerify(ex))
 	cmds.Group("meta", "Object metadata related commands", func() {
 		cmds.New("get", "Get an object's metadata", newCmdMetaGet(ex))
+		cmds.New("set", "Set entries of an object's metadata", newCmdMetaSet(ex))
+		cmds.New("unset", "Remove entries from an object's metadata", newCmdMetaUnset(ex))
+		cmds.New("replace", "Replace all of an object's metadata", newCmdMetaReplace(ex))
 	})
 	cmds.New("share", "Shares restricted accesses to objects", newCmdShare(ex))
 	cmds.New("version", "Prints version information", newCmdVersion())
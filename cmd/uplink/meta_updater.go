@@ -0,0 +1,151 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/zeebo/clingy"
+	"github.com/zeebo/errs"
+
+	"common/sync2"
+	"storx/cmd/uplink/ulext"
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+// metaUpdater holds the flags shared by the commands that change the custom metadata
+// of objects in place and applies the change to one object or recursively to many.
+//
+// Unlike meta get it has no --encrypted flag, the metadata can only be updated with
+// the encryption keys of the access.
+type metaUpdater struct {
+	access      string
+	recursive   bool
+	parallelism int
+	filter      filterFlags
+}
+
+func (u *metaUpdater) Setup(params clingy.Parameters) {
+	u.access = params.Flag("access", "Access name or value to use", "").(string)
+	u.recursive = params.Flag("recursive", "Update the metadata of every object under the prefix", false,
+		clingy.Short('r'),
+		clingy.Transform(strconv.ParseBool), clingy.Boolean,
+	).(bool)
+	u.parallelism = params.Flag("parallelism", "Controls how many objects are updated in parallel", 1,
+		clingy.Short('p'),
+		clingy.Transform(strconv.Atoi),
+		clingy.Transform(func(n int) (int, error) {
+			if n <= 0 {
+				return 0, errs.New("parallelism must be at least 1")
+			}
+			return n, nil
+		}),
+	).(int)
+	u.filter.Setup(params)
+}
+
+// Execute replaces the custom metadata of the objects at the location with the result
+// of calling update with a copy of their current metadata.
+func (u *metaUpdater) Execute(ctx context.Context, ex ulext.External, location ulloc.Location,
+	update func(metadata map[string]string) map[string]string) error {
+	if !location.Remote() {
+		return errs.New("location must be remote")
+	}
+
+	fs, err := ex.OpenFilesystem(ctx, u.access)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fs.Close() }()
+
+	if !u.recursive {
+		if u.filter.Filter() != nil {
+			return errs.New("filters can only be used with --recursive")
+		}
+
+		info, err := fs.Stat(ctx, location)
+		if err != nil {
+			return err
+		}
+
+		if err := fs.UpdateMetadata(ctx, location, update(copyMetadata(info.Metadata))); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(clingy.Stdout(ctx), "updated", location)
+		return nil
+	}
+
+	iter, err := fs.List(ctx, location, &ulfs.ListOptions{
+		Recursive: true,
+		Expanded:  true,
+		Filter:    u.filter.Filter(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		limiter = sync2.NewLimiter(u.parallelism)
+		es      errs.Group
+		mu      sync.Mutex
+	)
+
+	fprintln := func(w io.Writer, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintln(w, args...)
+	}
+
+	addError := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		es.Add(err)
+	}
+
+	for iter.Next() {
+		item := iter.Item()
+		if item.IsPrefix {
+			continue
+		}
+
+		ok := limiter.Go(ctx, func() {
+			err := fs.UpdateMetadata(ctx, item.Loc, update(copyMetadata(item.Metadata)))
+			if err != nil {
+				fprintln(clingy.Stderr(ctx), "update", item.Loc, "failed:", err.Error())
+				addError(err)
+			} else {
+				fprintln(clingy.Stdout(ctx), "updated", item.Loc)
+			}
+		})
+		if !ok {
+			break
+		}
+	}
+
+	limiter.Wait()
+
+	if err := iter.Err(); err != nil {
+		return errs.Wrap(err)
+	} else if len(es) > 0 {
+		return es.Err()
+	}
+	return nil
+}
+
+// copyMetadata returns a copy of the metadata that can be modified.
+func copyMetadata(metadata map[string]string) map[string]string {
+	copied := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/sync2"
+	"storx/cmd/uplink/ulfs"
+	"storx/cmd/uplink/ulloc"
+)
+
+// retryPolicy is how often and how long apart failed operations are attempted again.
+type retryPolicy struct {
+	retries  int
+	delay    time.Duration
+	maxDelay time.Duration
+}
+
+// backoff returns the delay before the attempt, doubling the delay for every
+// earlier retry up to the maximum.
+func (rp retryPolicy) backoff(attempt int) time.Duration {
+	delay := rp.delay
+	for i := 1; i < attempt && delay < rp.maxDelay; i++ {
+		delay *= 2
+	}
+	if rp.maxDelay > 0 && delay > rp.maxDelay {
+		delay = rp.maxDelay
+	}
+	return delay
+}
+
+// do calls fn until it succeeds, the retries are used up or the context is canceled.
+// The onRetry callback is called with the error before every retry.
+func (rp retryPolicy) do(ctx context.Context, fn func() error, onRetry func(attempt int, delay time.Duration, err error)) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt > rp.retries || ctx.Err() != nil {
+			return err
+		}
+
+		delay := rp.backoff(attempt)
+		if onRetry != nil {
+			onRetry(attempt, delay, err)
+		}
+		if !sync2.Sleep(ctx, delay) {
+			return err
+		}
+	}
+}
+
+// retryReadHandle is a ReadHandle of a part that opens the source again at the
+// current position when reading fails, so that a transient error doesn't fail
+// the whole part.
+type retryReadHandle struct {
+	ctx    context.Context
+	policy retryPolicy
+	fs     ulfs.Filesystem
+	source ulloc.Location
+
+	ulfs.ReadHandle
+	info ulfs.ObjectInfo
+	off  int64
+	len  int64
+}
+
+func newRetryReadHandle(ctx context.Context, policy retryPolicy, fs ulfs.Filesystem, source ulloc.Location, rh ulfs.ReadHandle, offset, length int64) *retryReadHandle {
+	return &retryReadHandle{
+		ctx:        ctx,
+		policy:     policy,
+		fs:         fs,
+		source:     source,
+		ReadHandle: rh,
+		info:       rh.Info(),
+		off:        offset,
+		len:        length,
+	}
+}
+
+// reopen opens the rest of the part.
+func (r *retryReadHandle) reopen() error {
+	rh, err := openPart(r.ctx, r.fs, r.source, r.off, r.len)
+	if err != nil {
+		return err
+	}
+	r.ReadHandle = rh
+	return nil
+}
+
+func (r *retryReadHandle) Read(p []byte) (n int, err error) {
+	if r.len == 0 {
+		return 0, io.EOF
+	}
+
+	var eof bool
+	err = r.policy.do(r.ctx, func() error {
+		if r.ReadHandle == nil {
+			if err := r.reopen(); err != nil {
+				return err
+			}
+		}
+
+		var readErr error
+		n, readErr = r.ReadHandle.Read(p)
+		r.off += int64(n)
+		if r.len > 0 {
+			r.len -= int64(n)
+		}
+
+		switch {
+		case readErr == nil:
+			return nil
+		case errors.Is(readErr, io.EOF):
+			eof = true
+			return nil
+		}
+
+		// the part is opened again at the current position by the next attempt.
+		_ = r.ReadHandle.Close()
+		r.ReadHandle = nil
+		if n > 0 {
+			// the data read before the error is returned first.
+			return nil
+		}
+		return readErr
+	}, nil)
+	if err == nil && eof {
+		err = io.EOF
+	}
+	return n, err
+}
+
+func (r *retryReadHandle) Info() ulfs.ObjectInfo { return r.info }
+
+func (r *retryReadHandle) Close() error {
+	if r.ReadHandle == nil {
+		return nil
+	}
+	return r.ReadHandle.Close()
+}
+
+// partReadHandle is a ReadHandle that also closes the MultiReadHandle it is from.
+type partReadHandle struct {
+	ulfs.ReadHandle
+	mrh ulfs.MultiReadHandle
+}
+
+func (p *partReadHandle) Close() error {
+	return errs.Combine(p.ReadHandle.Close(), p.mrh.Close())
+}
+
+// openPart opens the part of the source at the offset.
+func openPart(ctx context.Context, fs ulfs.Filesystem, source ulloc.Location, offset, length int64) (ulfs.ReadHandle, error) {
+	mrh, err := fs.Open(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if err := mrh.SetOffset(offset); err != nil {
+		return nil, errs.Combine(err, mrh.Close())
+	}
+	rh, err := mrh.NextPart(ctx, length)
+	if err != nil {
+		return nil, errs.Combine(err, mrh.Close())
+	}
+	return &partReadHandle{ReadHandle: rh, mrh: mrh}, nil
+}
+
+// retryPart opens the part of the source again and aborts the failed write of
+// the part, returning the handles that copy the whole part again.
+func retryPart(ctx context.Context, policy retryPolicy, fs ulfs.Filesystem, source ulloc.Location, dst ulfs.RetryableMultiWriteHandle,
+	wh ulfs.WriteHandle, offset, length int64) (ulfs.ReadHandle, ulfs.WriteHandle, error) {
+	rh, err := openPart(ctx, fs, source, offset, length)
+	if err != nil {
+		return nil, nil, err
+	}
+	wh, err = dst.RetryPart(ctx, wh)
+	if err != nil {
+		return nil, nil, errs.Combine(err, rh.Close())
+	}
+	return newRetryReadHandle(ctx, policy, fs, source, rh, offset, length), wh, nil
+}
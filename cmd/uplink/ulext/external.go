@@ -36,6 +36,8 @@ type External interface {
 	ConfigFile() string
 	SaveConfig(values map[string]string) error
 
+	ResumeDir() string
+
 	PromptInput(ctx context.Context, prompt string) (input string, err error)
 	PromptSecret(ctx context.Context, prompt string) (secret string, err error)
 }
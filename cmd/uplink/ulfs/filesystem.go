@@ -16,6 +16,17 @@ import (
 type CreateOptions struct {
 	Expires  time.Time
 	Metadata map[string]string
+
+	// ModTime is the modification time set on local files when they are committed.
+	ModTime time.Time
+
+	// Resumable keeps partially written local files and pending uploads when the
+	// write is aborted so that it can be continued later.
+	Resumable bool
+	// Offset is where writes to an existing resumable local file continue.
+	Offset int64
+	// UploadID continues the pending upload with the id instead of beginning a new one.
+	UploadID string
 }
 
 // ListOptions describes options to the List command.
@@ -23,10 +34,24 @@ type ListOptions struct {
 	Recursive bool
 	Pending   bool
 	Expanded  bool
+
+	// PartSizes sums the sizes of the uploaded parts into the content length of
+	// pending uploads, which requires listing the parts of every upload.
+	PartSizes bool
+
+	// Filter selects which files or objects are listed.
+	Filter *Filter
 }
 
 func (lo *ListOptions) isRecursive() bool { return lo != nil && lo.Recursive }
 func (lo *ListOptions) isPending() bool   { return lo != nil && lo.Pending }
+func (lo *ListOptions) partSizes() bool   { return lo != nil && lo.PartSizes }
+func (lo *ListOptions) filter() *Filter {
+	if lo == nil {
+		return nil
+	}
+	return lo.Filter
+}
 
 // RemoveOptions describes options to the Remove command.
 type RemoveOptions struct {
@@ -42,6 +67,7 @@ type Filesystem interface {
 	Create(ctx context.Context, loc ulloc.Location, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, source, dest ulloc.Location) error
 	Copy(ctx context.Context, source, dest ulloc.Location) error
+	UpdateMetadata(ctx context.Context, loc ulloc.Location, metadata map[string]string) error
 	Remove(ctx context.Context, loc ulloc.Location, opts *RemoveOptions) error
 	List(ctx context.Context, prefix ulloc.Location, opts *ListOptions) (ObjectIterator, error)
 	IsLocalDir(ctx context.Context, loc ulloc.Location) bool
@@ -52,7 +78,7 @@ type Filesystem interface {
 type FilesystemLocal interface {
 	IsLocalDir(ctx context.Context, path string) bool
 	Open(ctx context.Context, path string) (MultiReadHandle, error)
-	Create(ctx context.Context, path string) (MultiWriteHandle, error)
+	Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, oldpath string, newpath string) error
 	Copy(ctx context.Context, oldpath string, newpath string) error
 	Remove(ctx context.Context, path string, opts *RemoveOptions) error
@@ -67,6 +93,7 @@ type FilesystemRemote interface {
 	Create(ctx context.Context, bucket, key string, opts *CreateOptions) (MultiWriteHandle, error)
 	Move(ctx context.Context, oldbucket, oldkey string, newbucket, newkey string) error
 	Copy(ctx context.Context, oldbucket, oldkey string, newbucket, newkey string) error
+	UpdateMetadata(ctx context.Context, bucket, key string, metadata map[string]string) error
 	Remove(ctx context.Context, bucket, key string, opts *RemoveOptions) error
 	List(ctx context.Context, bucket, key string, opts *ListOptions) ObjectIterator
 	Stat(ctx context.Context, bucket, key string) (*ObjectInfo, error)
@@ -154,6 +181,38 @@ type MultiWriteHandle interface {
 	Abort(ctx context.Context) error
 }
 
+// ResumableMultiWriteHandle is a MultiWriteHandle of a pending upload that can be
+// continued by a later process after an interruption.
+type ResumableMultiWriteHandle interface {
+	MultiWriteHandle
+
+	// UploadID returns the id that continues the upload.
+	UploadID() string
+	// CommittedParts returns the sizes of the already committed parts by their number.
+	CommittedParts(ctx context.Context) (map[uint32]int64, error)
+	// SkipPart skips the next part because it was already committed.
+	SkipPart()
+}
+
+// MetadataMultiWriteHandle is a MultiWriteHandle of an object whose custom metadata
+// can be replaced until it's committed.
+type MetadataMultiWriteHandle interface {
+	MultiWriteHandle
+
+	// SetMetadata replaces the custom metadata that is stored when committing.
+	SetMetadata(metadata map[string]string) error
+}
+
+// RetryableMultiWriteHandle is a MultiWriteHandle whose parts can be written
+// again after writing or committing them failed.
+type RetryableMultiWriteHandle interface {
+	MultiWriteHandle
+
+	// RetryPart aborts the part and returns a WriteHandle that writes the part
+	// again from its start.
+	RetryPart(ctx context.Context, wh WriteHandle) (WriteHandle, error)
+}
+
 // WriteHandle is anything that can be written to with commit/abort semantics.
 type WriteHandle interface {
 	io.Writer
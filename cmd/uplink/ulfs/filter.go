@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs
+
+import (
+	"path"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storx/cmd/uplink/ulloc"
+)
+
+// Filter selects which files or objects of a listing are returned. Prefixes are
+// always returned. The zero value selects everything.
+type Filter struct {
+	// Include and Exclude are glob patterns matched against the path relative to
+	// the listed prefix. Patterns without a slash are matched against the base name.
+	Include []string
+	Exclude []string
+
+	// MinSize and MaxSize bound the content length. A zero MaxSize has no limit.
+	MinSize int64
+	MaxSize int64
+
+	// NewerThan and OlderThan bound the creation or modification time.
+	NewerThan time.Time
+	OlderThan time.Time
+}
+
+// Match returns if the file or object at the relative path is selected.
+func (f *Filter) Match(rel string, info ObjectInfo) bool {
+	if f == nil || info.IsPrefix {
+		return true
+	}
+
+	if len(f.Include) > 0 && !matchGlobs(f.Include, rel) {
+		return false
+	}
+	if matchGlobs(f.Exclude, rel) {
+		return false
+	}
+
+	if info.ContentLength < f.MinSize {
+		return false
+	}
+	if f.MaxSize > 0 && info.ContentLength > f.MaxSize {
+		return false
+	}
+
+	if !f.NewerThan.IsZero() && !info.Created.After(f.NewerThan) {
+		return false
+	}
+	if !f.OlderThan.IsZero() && !info.Created.Before(f.OlderThan) {
+		return false
+	}
+	return true
+}
+
+// ValidateGlob returns an error if the pattern is not a valid glob pattern.
+func ValidateGlob(pattern string) (string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", errs.New("invalid pattern %q: %v", pattern, err)
+	}
+	return pattern, nil
+}
+
+// matchGlobs returns if the relative path matches any of the patterns.
+func matchGlobs(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		name := rel
+		if !strings.Contains(pattern, "/") {
+			name = path.Base(rel)
+		}
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// filterObjectIterator removes the entries that are not selected by the filter.
+type filterObjectIterator struct {
+	prefix ulloc.Location
+	filter *Filter
+	iter   ObjectIterator
+}
+
+func (f *filterObjectIterator) Next() bool {
+	for f.iter.Next() {
+		item := f.iter.Item()
+
+		rel, err := f.prefix.RelativeTo(item.Loc)
+		if err != nil {
+			// entries of listings that aren't recursive are already relative.
+			rel = item.Loc.Loc()
+		}
+
+		if f.filter.Match(rel, item) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filterObjectIterator) Err() error       { return f.iter.Err() }
+func (f *filterObjectIterator) Item() ObjectInfo { return f.iter.Item() }
@@ -0,0 +1,45 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package ulfs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Now()
+	info := func(size int64, age time.Duration) ObjectInfo {
+		return ObjectInfo{ContentLength: size, Created: now.Add(-age)}
+	}
+
+	var nilFilter *Filter
+	require.True(t, nilFilter.Match("a.txt", info(0, 0)))
+	require.True(t, (&Filter{}).Match("a.txt", info(0, 0)))
+
+	globs := &Filter{Include: []string{"*.txt", "logs/*"}, Exclude: []string{"skip*"}}
+	require.True(t, globs.Match("dir/a.txt", info(0, 0)))
+	require.True(t, globs.Match("logs/a.log", info(0, 0)))
+	require.False(t, globs.Match("dir/a.log", info(0, 0)))
+	require.False(t, globs.Match("dir/skip.txt", info(0, 0)))
+
+	sizes := &Filter{MinSize: 10, MaxSize: 20}
+	require.False(t, sizes.Match("a", info(9, 0)))
+	require.True(t, sizes.Match("a", info(10, 0)))
+	require.True(t, sizes.Match("a", info(20, 0)))
+	require.False(t, sizes.Match("a", info(21, 0)))
+
+	ages := &Filter{NewerThan: now.Add(-48 * time.Hour), OlderThan: now.Add(-24 * time.Hour)}
+	require.False(t, ages.Match("a", info(0, time.Hour)))
+	require.True(t, ages.Match("a", info(0, 36*time.Hour)))
+	require.False(t, ages.Match("a", info(0, 72*time.Hour)))
+
+	// prefixes are always listed.
+	require.True(t, sizes.Match("dir/", ObjectInfo{IsPrefix: true}))
+
+	_, err := ValidateGlob("[")
+	require.Error(t, err)
+}
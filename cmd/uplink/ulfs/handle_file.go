@@ -4,6 +4,8 @@
 package ulfs
 
 import (
+	"time"
+
 	"github.com/zeebo/errs"
 
 	"storx/cmd/uplink/ulloc"
@@ -32,22 +34,48 @@ func newOSMultiReadHandle(fh LocalBackendFile) (MultiReadHandle, error) {
 //
 
 type fileGenericWriter struct {
-	fs  LocalBackend
-	raw LocalBackendFile
+	fs      LocalBackend
+	raw     LocalBackendFile
+	modTime time.Time
+	keep    bool
 }
 
 func (f *fileGenericWriter) WriteAt(b []byte, off int64) (int, error) { return f.raw.WriteAt(b, off) }
-func (f *fileGenericWriter) Commit() error                            { return f.raw.Close() }
+func (f *fileGenericWriter) Commit() error {
+	if err := f.raw.Close(); err != nil {
+		return err
+	}
+	if f.modTime.IsZero() {
+		return nil
+	}
+	return f.fs.Chtimes(f.raw.Name(), f.modTime, f.modTime)
+}
 func (f *fileGenericWriter) Abort() error {
+	// partially written resumable files are kept so that they can be continued.
+	if f.keep {
+		return f.raw.Close()
+	}
 	return errs.Combine(
 		f.raw.Close(),
 		f.fs.Remove(f.raw.Name()),
 	)
 }
 
-func newOSMultiWriteHandle(fs LocalBackend, fh LocalBackendFile) MultiWriteHandle {
-	return NewGenericMultiWriteHandle(&fileGenericWriter{
+func newOSMultiWriteHandle(fs LocalBackend, fh LocalBackendFile, opts *CreateOptions) MultiWriteHandle {
+	w := &fileGenericWriter{
 		fs:  fs,
 		raw: fh,
-	})
+	}
+	if opts == nil {
+		return NewGenericMultiWriteHandle(w)
+	}
+
+	w.modTime = opts.ModTime
+	w.keep = opts.Resumable
+
+	mwh := NewGenericMultiWriteHandle(w)
+	if opts.Resumable {
+		mwh.off = opts.Offset
+	}
+	return mwh
 }
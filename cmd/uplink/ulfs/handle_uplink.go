@@ -167,10 +167,11 @@ func (u *uplinkReadHandle) Info() ObjectInfo           { return *u.info }
 //
 
 type uplinkMultiWriteHandle struct {
-	project  *uplink.Project
-	bucket   string
-	info     uplink.UploadInfo
-	metadata uplink.CustomMetadata
+	project   *uplink.Project
+	bucket    string
+	info      uplink.UploadInfo
+	metadata  uplink.CustomMetadata
+	resumable bool
 
 	mu        sync.Mutex
 	tail      bool
@@ -179,15 +180,42 @@ type uplinkMultiWriteHandle struct {
 	abortErr  *error
 }
 
-func newUplinkMultiWriteHandle(project *uplink.Project, bucket string, info uplink.UploadInfo, metadata uplink.CustomMetadata) *uplinkMultiWriteHandle {
+func newUplinkMultiWriteHandle(project *uplink.Project, bucket string, info uplink.UploadInfo, metadata uplink.CustomMetadata, resumable bool) *uplinkMultiWriteHandle {
 	return &uplinkMultiWriteHandle{
-		project:  project,
-		bucket:   bucket,
-		info:     info,
-		metadata: metadata,
+		project:   project,
+		bucket:    bucket,
+		info:      info,
+		metadata:  metadata,
+		resumable: resumable,
 	}
 }
 
+// UploadID returns the id that continues the upload.
+func (u *uplinkMultiWriteHandle) UploadID() string { return u.info.UploadID }
+
+// CommittedParts returns the sizes of the already committed parts by their number.
+func (u *uplinkMultiWriteHandle) CommittedParts(ctx context.Context) (map[uint32]int64, error) {
+	parts := make(map[uint32]int64)
+
+	iter := u.project.ListUploadParts(ctx, u.bucket, u.info.Key, u.info.UploadID, nil)
+	for iter.Next() {
+		part := iter.Item()
+		parts[part.PartNumber] = part.Size
+	}
+	if err := iter.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return parts, nil
+}
+
+// SkipPart skips the next part because it was already committed.
+func (u *uplinkMultiWriteHandle) SkipPart() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.part++
+}
+
 func (u *uplinkMultiWriteHandle) NextPart(ctx context.Context, length int64) (WriteHandle, error) {
 	part, err := func() (uint32, error) {
 		u.mu.Lock()
@@ -212,18 +240,78 @@ func (u *uplinkMultiWriteHandle) NextPart(ctx context.Context, length int64) (Wr
 		return nil, err
 	}
 
+	wh, err := u.uploadPart(ctx, part, length)
+	if err != nil {
+		u.mu.Lock()
+		defer u.mu.Unlock()
+
+		// the part number is used again when getting the part is retried.
+		if u.part == part {
+			u.part--
+			u.tail = false
+		}
+		return nil, err
+	}
+	return wh, nil
+}
+
+// RetryPart aborts the part and returns a WriteHandle that uploads it again.
+// The part which is committed last replaces the earlier uploads of the part.
+func (u *uplinkMultiWriteHandle) RetryPart(ctx context.Context, wh WriteHandle) (WriteHandle, error) {
+	failed, ok := wh.(*uplinkWriteHandle)
+	if !ok {
+		return nil, errs.New("unable to retry a part of another upload")
+	}
+	_ = failed.Abort()
+
+	err := func() error {
+		u.mu.Lock()
+		defer u.mu.Unlock()
+
+		switch {
+		case u.abortErr != nil:
+			return errs.New("cannot retry part after multipart write has been aborted")
+		case u.commitErr != nil:
+			return errs.New("cannot retry part after multipart write has been committed")
+		}
+		return nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	return u.uploadPart(ctx, failed.part, failed.length)
+}
+
+func (u *uplinkMultiWriteHandle) uploadPart(ctx context.Context, part uint32, length int64) (*uplinkWriteHandle, error) {
 	ul, err := u.project.UploadPart(ctx, u.bucket, u.info.Key, u.info.UploadID, part)
 	if err != nil {
 		return nil, err
 	}
 
 	return &uplinkWriteHandle{
-		ul:   ul,
-		tail: length < 0,
-		len:  length,
+		ul:     ul,
+		part:   part,
+		length: length,
+		tail:   length < 0,
+		len:    length,
 	}, nil
 }
 
+// SetMetadata replaces the custom metadata that is stored when committing.
+func (u *uplinkMultiWriteHandle) SetMetadata(metadata map[string]string) error {
+	customMetadata := uplink.CustomMetadata(metadata)
+	if err := customMetadata.Verify(); err != nil {
+		return err
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.metadata = customMetadata
+	return nil
+}
+
 func (u *uplinkMultiWriteHandle) Commit(ctx context.Context) error {
 	u.mu.Lock()
 	defer u.mu.Unlock()
@@ -253,6 +341,12 @@ func (u *uplinkMultiWriteHandle) Abort(ctx context.Context) error {
 		return errs.New("cannot abort a committed multipart write")
 	}
 
+	// resumable uploads are kept pending so that they can be continued.
+	if u.resumable {
+		u.abortErr = new(error)
+		return nil
+	}
+
 	err := u.project.AbortUpload(ctx, u.bucket, u.info.Key, u.info.UploadID)
 	u.abortErr = &err
 	return err
@@ -260,9 +354,11 @@ func (u *uplinkMultiWriteHandle) Abort(ctx context.Context) error {
 
 // uplinkWriteHandle implements writeHandle for *uplink.Uploads.
 type uplinkWriteHandle struct {
-	ul   *uplink.PartUpload
-	tail bool
-	len  int64
+	ul     *uplink.PartUpload
+	part   uint32
+	length int64
+	tail   bool
+	len    int64
 }
 
 func (u *uplinkWriteHandle) Write(p []byte) (int, error) {
@@ -31,9 +31,11 @@ type LocalBackend interface {
 	Create(name string) (LocalBackendFile, error)
 	MkdirAll(path string, perm os.FileMode) error
 	Open(name string) (LocalBackendFile, error)
+	OpenFile(name string, flag int, perm os.FileMode) (LocalBackendFile, error)
 	Remove(name string) error
 	Rename(oldname, newname string) error
 	Stat(name string) (os.FileInfo, error)
+	Chtimes(name string, atime, mtime time.Time) error
 }
 
 // Local implements something close to a filesystem but backed by the local disk.
@@ -58,7 +60,7 @@ func (l *Local) Open(ctx context.Context, path string) (MultiReadHandle, error)
 }
 
 // Create makes any directories necessary to create a file at path and returns a WriteHandle.
-func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, error) {
+func (l *Local) Create(ctx context.Context, path string, opts *CreateOptions) (MultiWriteHandle, error) {
 	fi, err := l.fs.Stat(path)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, errs.Wrap(err)
@@ -70,12 +72,20 @@ func (l *Local) Create(ctx context.Context, path string) (MultiWriteHandle, erro
 		return nil, errs.Wrap(err)
 	}
 
+	if opts != nil && opts.Resumable {
+		fh, err := l.fs.OpenFile(path, os.O_WRONLY|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		return newOSMultiWriteHandle(l.fs, fh, opts), nil
+	}
+
 	// TODO: atomic rename
 	fh, err := l.fs.Create(path)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return newOSMultiWriteHandle(l.fs, fh), nil
+	return newOSMultiWriteHandle(l.fs, fh, opts), nil
 }
 
 // Move moves file to provided path.
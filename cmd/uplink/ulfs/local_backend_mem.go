@@ -4,6 +4,7 @@
 package ulfs
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -117,6 +118,21 @@ func (l *LocalBackendMem) Open(name string) (LocalBackendFile, error) {
 	return root, nil
 }
 
+// OpenFile opens the file with the given name, creating it if it doesn't exist
+// and os.O_CREATE is set.
+func (l *LocalBackendMem) OpenFile(name string, flag int, perm os.FileMode) (LocalBackendFile, error) {
+	fh, err := l.Open(name)
+	if errors.Is(err, os.ErrNotExist) && flag&os.O_CREATE != 0 {
+		return l.Create(name)
+	} else if err != nil {
+		return nil, err
+	}
+	if _, ok := fh.(*memFile); !ok {
+		return nil, errs.New("not a regular file: %q", name)
+	}
+	return fh, nil
+}
+
 // Remove deletes the file with the given name.
 func (l *LocalBackendMem) Remove(name string) error {
 	name = filepath.Clean(name)
@@ -173,13 +189,28 @@ func (l *LocalBackendMem) Stat(name string) (os.FileInfo, error) {
 	return fh.Stat()
 }
 
+// Chtimes changes the modification time of the file with the given name.
+func (l *LocalBackendMem) Chtimes(name string, atime, mtime time.Time) error {
+	fh, err := l.Open(name)
+	if err != nil {
+		return err
+	}
+	mf, ok := fh.(*memFile)
+	if !ok {
+		return errs.New("not a regular file: %q", name)
+	}
+	mf.mtime = mtime
+	return nil
+}
+
 //
 // memFile
 //
 
 type memFile struct {
-	name string
-	buf  []byte
+	name  string
+	buf   []byte
+	mtime time.Time
 }
 
 func newMemFile(name string) *memFile {
@@ -225,7 +256,7 @@ func (mfi *memFileInfo) Name() string {
 
 func (mfi *memFileInfo) Size() int64        { return int64(len((*memFile)(mfi).buf)) }
 func (mfi *memFileInfo) Mode() fs.FileMode  { return 0777 }
-func (mfi *memFileInfo) ModTime() time.Time { return time.Time{} }
+func (mfi *memFileInfo) ModTime() time.Time { return (*memFile)(mfi).mtime }
 func (mfi *memFileInfo) IsDir() bool        { return false }
 func (mfi *memFileInfo) Sys() interface{}   { return nil }
 
@@ -3,7 +3,10 @@
 
 package ulfs
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 // LocalBackendOS implements LocalBackend by using the os package.
 type LocalBackendOS struct{}
@@ -28,6 +31,11 @@ func (l *LocalBackendOS) Open(name string) (LocalBackendFile, error) {
 	return os.Open(name)
 }
 
+// OpenFile calls os.OpenFile.
+func (l *LocalBackendOS) OpenFile(name string, flag int, perm os.FileMode) (LocalBackendFile, error) {
+	return os.OpenFile(name, flag, perm)
+}
+
 // Remove calls os.Remove.
 func (l *LocalBackendOS) Remove(name string) error {
 	return os.Remove(name)
@@ -42,3 +50,8 @@ func (l *LocalBackendOS) Rename(oldname, newname string) error {
 func (l *LocalBackendOS) Stat(name string) (os.FileInfo, error) {
 	return os.Stat(name)
 }
+
+// Chtimes calls os.Chtimes.
+func (l *LocalBackendOS) Chtimes(name string, atime, mtime time.Time) error {
+	return os.Chtimes(name, atime, mtime)
+}
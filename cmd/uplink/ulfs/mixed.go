@@ -46,7 +46,7 @@ func (m *Mixed) Create(ctx context.Context, loc ulloc.Location, opts *CreateOpti
 	if bucket, key, ok := loc.RemoteParts(); ok {
 		return m.remote.Create(ctx, bucket, key, opts)
 	} else if path, ok := loc.LocalParts(); ok {
-		return m.local.Create(ctx, path)
+		return m.local.Create(ctx, path, opts)
 	}
 	return newStdMultiWriteHandle(clingy.Stdout(ctx)), nil
 }
@@ -79,6 +79,14 @@ func (m *Mixed) Copy(ctx context.Context, source, dest ulloc.Location) error {
 	return errs.New("copying objects between local and remote is not supported")
 }
 
+// UpdateMetadata replaces the custom metadata of a remote object.
+func (m *Mixed) UpdateMetadata(ctx context.Context, loc ulloc.Location, metadata map[string]string) error {
+	if bucket, key, ok := loc.RemoteParts(); ok {
+		return m.remote.UpdateMetadata(ctx, bucket, key, metadata)
+	}
+	return errs.New("updating metadata of local files is not supported")
+}
+
 // Remove deletes either a local file or remote object.
 func (m *Mixed) Remove(ctx context.Context, loc ulloc.Location, opts *RemoveOptions) error {
 	if bucket, key, ok := loc.RemoteParts(); ok {
@@ -92,12 +100,28 @@ func (m *Mixed) Remove(ctx context.Context, loc ulloc.Location, opts *RemoveOpti
 // List lists either files and directories with some local path prefix or remote objects
 // with a given bucket and key.
 func (m *Mixed) List(ctx context.Context, prefix ulloc.Location, opts *ListOptions) (ObjectIterator, error) {
+	var iter ObjectIterator
 	if bucket, key, ok := prefix.RemoteParts(); ok {
-		return m.remote.List(ctx, bucket, key, opts), nil
+		iter = m.remote.List(ctx, bucket, key, opts)
 	} else if path, ok := prefix.LocalParts(); ok {
-		return m.local.List(ctx, path, opts)
+		var err error
+		iter, err = m.local.List(ctx, path, opts)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, errs.New("unable to list objects for prefix %q", prefix)
+	}
+
+	// the filter is applied here so that every listing is filtered the same way.
+	if filter := opts.filter(); filter != nil {
+		iter = &filterObjectIterator{
+			prefix: prefix,
+			filter: filter,
+			iter:   iter,
+		}
 	}
-	return nil, errs.New("unable to list objects for prefix %q", prefix)
+	return iter, nil
 }
 
 // IsLocalDir returns true if the location is a directory that is local.
@@ -56,13 +56,18 @@ func (r *Remote) Create(ctx context.Context, bucket, key string, opts *CreateOpt
 		}
 	}
 
+	if opts.UploadID != "" {
+		info := uplink.UploadInfo{UploadID: opts.UploadID, Key: key}
+		return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata, opts.Resumable), nil
+	}
+
 	info, err := r.project.BeginUpload(ctx, bucket, key, &uplink.UploadOptions{
 		Expires: opts.Expires,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata), nil
+	return newUplinkMultiWriteHandle(r.project, bucket, info, customMetadata, opts.Resumable), nil
 }
 
 // Move moves object to provided key and bucket.
@@ -76,6 +81,15 @@ func (r *Remote) Copy(ctx context.Context, oldbucket, oldkey, newbucket, newkey
 	return errs.Wrap(err)
 }
 
+// UpdateMetadata replaces the custom metadata of the object without uploading it again.
+func (r *Remote) UpdateMetadata(ctx context.Context, bucket, key string, metadata map[string]string) error {
+	customMetadata := uplink.CustomMetadata(metadata)
+	if err := customMetadata.Verify(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(r.project.UpdateObjectMetadata(ctx, bucket, key, customMetadata, nil))
+}
+
 // Remove deletes the object at the provided key and bucket.
 func (r *Remote) Remove(ctx context.Context, bucket, key string, opts *RemoveOptions) error {
 	if !opts.isPending() {
@@ -116,7 +130,7 @@ func (r *Remote) List(ctx context.Context, bucket, prefix string, opts *ListOpti
 
 	var iter ObjectIterator
 	if opts.isPending() {
-		iter = newUplinkUploadIterator(
+		uploads := newUplinkUploadIterator(
 			bucket,
 			r.project.ListUploads(ctx, bucket, &uplink.ListUploadsOptions{
 				Prefix:    parentPrefix,
@@ -125,6 +139,10 @@ func (r *Remote) List(ctx context.Context, bucket, prefix string, opts *ListOpti
 				Custom:    opts.Expanded,
 			}),
 		)
+		if opts.partSizes() {
+			uploads.ctx, uploads.project = ctx, r.project
+		}
+		iter = uploads
 	} else {
 		iter = newUplinkObjectIterator(
 			bucket,
@@ -165,9 +183,16 @@ func (u *uplinkObjectIterator) Item() ObjectInfo {
 }
 
 // uplinkUploadIterator implements objectIterator for *multipart.UploadIterators.
+// When the project is set, the content length of every upload is the sum of its
+// uploaded parts.
 type uplinkUploadIterator struct {
 	bucket string
 	iter   *uplink.UploadIterator
+
+	ctx     context.Context
+	project *uplink.Project
+	length  int64
+	err     error
 }
 
 // newUplinkUploadIterator constructs a *uplinkUploadIterator from a *uplink.UploadIterator.
@@ -178,8 +203,38 @@ func newUplinkUploadIterator(bucket string, iter *uplink.UploadIterator) *uplink
 	}
 }
 
-func (u *uplinkUploadIterator) Next() bool { return u.iter.Next() }
-func (u *uplinkUploadIterator) Err() error { return u.iter.Err() }
+func (u *uplinkUploadIterator) Next() bool {
+	if u.err != nil || !u.iter.Next() {
+		return false
+	}
+	item := u.iter.Item()
+	u.length = 0
+	if u.project == nil || item.IsPrefix {
+		return true
+	}
+
+	parts := u.project.ListUploadParts(u.ctx, u.bucket, item.Key, item.UploadID, nil)
+	for parts.Next() {
+		u.length += parts.Item().Size
+	}
+	if err := parts.Err(); err != nil {
+		u.err = errs.Wrap(err)
+		return false
+	}
+	return true
+}
+
+func (u *uplinkUploadIterator) Err() error {
+	if u.err != nil {
+		return u.err
+	}
+	return u.iter.Err()
+}
+
 func (u *uplinkUploadIterator) Item() ObjectInfo {
-	return uplinkUploadInfoToObjectInfo(u.bucket, u.iter.Item())
+	info := uplinkUploadInfoToObjectInfo(u.bucket, u.iter.Item())
+	if u.project != nil {
+		info.ContentLength = u.length
+	}
+	return info
 }
@@ -59,6 +59,10 @@ func (ex *external) OpenAccess(accessName string) (access *uplink.Access, err er
 	return access, nil
 }
 
+func (ex *external) ResumeDir() string {
+	return "/uplink/resume"
+}
+
 func (ex *external) GetAccessInfo(required bool) (string, map[string]string, error) {
 	return accesses["TestAccessA"], accesses, nil
 }
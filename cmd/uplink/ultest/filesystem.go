@@ -8,6 +8,7 @@ import (
 	"context"
 	"io"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -123,24 +124,39 @@ func (rfs *remoteFilesystem) Create(ctx context.Context, bucket, key string, opt
 	}
 
 	var metadata map[string]string
+	var resumable bool
 	expires := time.Time{}
 	if opts != nil {
 		expires = opts.Expires
 		metadata = opts.Metadata
+		resumable = opts.Resumable
+
+		if opts.UploadID != "" {
+			for _, wh := range rfs.pending[loc] {
+				if wh.id == opts.UploadID {
+					wh.metadata = metadata
+					wh.resumable = resumable
+					return newMemMultiWriteHandle(wh), nil
+				}
+			}
+			return nil, errs.New("upload %q does not exist", opts.UploadID)
+		}
 	}
 
 	rfs.created++
 	wh := &memWriteHandle{
-		loc:      loc,
-		rfs:      rfs,
-		cre:      rfs.created,
-		expires:  expires,
-		metadata: metadata,
+		id:        strconv.FormatInt(rfs.created, 10),
+		loc:       loc,
+		rfs:       rfs,
+		cre:       rfs.created,
+		expires:   expires,
+		metadata:  metadata,
+		resumable: resumable,
 	}
 
 	rfs.pending[loc] = append(rfs.pending[loc], wh)
 
-	return ulfs.NewGenericMultiWriteHandle(wh), nil
+	return newMemMultiWriteHandle(wh), nil
 }
 
 func (rfs *remoteFilesystem) Move(ctx context.Context, oldbucket, oldkey string, newbucket, newkey string) error {
@@ -174,6 +190,24 @@ func (rfs *remoteFilesystem) Copy(ctx context.Context, oldbucket, oldkey string,
 	return nil
 }
 
+func (rfs *remoteFilesystem) UpdateMetadata(ctx context.Context, bucket, key string, metadata map[string]string) error {
+	rfs.mu.Lock()
+	defer rfs.mu.Unlock()
+
+	loc := ulloc.NewRemote(bucket, key)
+
+	mf, ok := rfs.files[loc]
+	if !ok || mf.expired() {
+		return errs.New("file does not exist %q", loc)
+	}
+	if len(metadata) == 0 {
+		metadata = nil
+	}
+	mf.metadata = metadata
+	rfs.files[loc] = mf
+	return nil
+}
+
 func (rfs *remoteFilesystem) Remove(ctx context.Context, bucket, key string, opts *ulfs.RemoveOptions) error {
 	rfs.mu.Lock()
 	defer rfs.mu.Unlock()
@@ -204,11 +238,18 @@ func (rfs *remoteFilesystem) List(ctx context.Context, bucket, key string, opts
 	var infos []ulfs.ObjectInfo
 	for loc, mf := range rfs.files {
 		if (loc.HasPrefix(prefixDir) || loc == prefix) && !mf.expired() {
-			infos = append(infos, ulfs.ObjectInfo{
+			info := ulfs.ObjectInfo{
 				Loc:     loc,
 				Created: time.Unix(mf.created, 0),
 				Expires: mf.expires,
-			})
+			}
+			// only expanded and filtered listings include the content length and
+			// the custom metadata so that the output of the other listings stays stable.
+			if opts != nil && (opts.Expanded || opts.Filter != nil) {
+				info.ContentLength = int64(len(mf.contents))
+				info.Metadata = mf.metadata
+			}
+			infos = append(infos, info)
 		}
 	}
 
@@ -228,10 +269,14 @@ func (rfs *remoteFilesystem) listPending(ctx context.Context, prefix ulloc.Locat
 	for loc, whs := range rfs.pending {
 		if loc.HasPrefix(prefixDir) || loc == prefix {
 			for _, wh := range whs {
-				infos = append(infos, ulfs.ObjectInfo{
+				info := ulfs.ObjectInfo{
 					Loc:     loc,
 					Created: time.Unix(wh.cre, 0),
-				})
+				}
+				if opts != nil && opts.PartSizes {
+					info.ContentLength = int64(len(wh.buf))
+				}
+				infos = append(infos, info)
 			}
 		}
 	}
@@ -265,6 +310,7 @@ func (rfs *remoteFilesystem) Stat(ctx context.Context, bucket, key string) (*ulf
 		Created:       time.Unix(mf.created, 0),
 		Expires:       mf.expires,
 		ContentLength: int64(len(mf.contents)),
+		Metadata:      mf.metadata,
 	}, nil
 }
 
@@ -272,14 +318,46 @@ func (rfs *remoteFilesystem) Stat(ctx context.Context, bucket, key string) (*ulf
 // ulfs.WriteHandle
 //
 
+// memMultiWriteHandle is a ulfs.ResumableMultiWriteHandle of a pending upload.
+// It doesn't keep track of parts, so resumed uploads write every part again.
+type memMultiWriteHandle struct {
+	*ulfs.GenericMultiWriteHandle
+	wh *memWriteHandle
+}
+
+func newMemMultiWriteHandle(wh *memWriteHandle) *memMultiWriteHandle {
+	return &memMultiWriteHandle{
+		GenericMultiWriteHandle: ulfs.NewGenericMultiWriteHandle(wh),
+		wh:                      wh,
+	}
+}
+
+func (m *memMultiWriteHandle) UploadID() string { return m.wh.id }
+
+func (m *memMultiWriteHandle) CommittedParts(ctx context.Context) (map[uint32]int64, error) {
+	return map[uint32]int64{}, nil
+}
+
+func (m *memMultiWriteHandle) SkipPart() {}
+
+func (m *memMultiWriteHandle) SetMetadata(metadata map[string]string) error {
+	m.wh.rfs.mu.Lock()
+	defer m.wh.rfs.mu.Unlock()
+
+	m.wh.metadata = metadata
+	return nil
+}
+
 type memWriteHandle struct {
-	buf      []byte
-	loc      ulloc.Location
-	rfs      *remoteFilesystem
-	cre      int64
-	expires  time.Time
-	metadata map[string]string
-	done     bool
+	id        string
+	buf       []byte
+	loc       ulloc.Location
+	rfs       *remoteFilesystem
+	cre       int64
+	expires   time.Time
+	metadata  map[string]string
+	resumable bool
+	done      bool
 }
 
 func (b *memWriteHandle) WriteAt(p []byte, off int64) (int, error) {
@@ -315,6 +393,11 @@ func (b *memWriteHandle) Abort() error {
 	b.rfs.mu.Lock()
 	defer b.rfs.mu.Unlock()
 
+	// resumable uploads are kept pending so that they can be continued.
+	if b.resumable {
+		return nil
+	}
+
 	if err := b.close(); err != nil {
 		return err
 	}
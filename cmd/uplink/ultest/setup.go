@@ -192,38 +192,51 @@ func WithStdin(stdin string) ExecuteOption {
 func WithFile(location string, contents ...string) ExecuteOption {
 	contents = append([]string(nil), contents...)
 	return ExecuteOption{func(t *testing.T, ctx context.Context, cs *callbackState) {
-		loc, err := ulloc.Parse(location)
-		require.NoError(t, err)
+		createFile(t, ctx, cs, location, nil, contents...)
+	}}
+}
 
-		if bucket, _, ok := loc.RemoteParts(); ok {
-			cs.rfs.ensureBucket(bucket)
-		}
+// WithFileOptions sets the command to execute with a file created at the given
+// location with the provided options, like the metadata of remote objects or the
+// modification time of local files.
+func WithFileOptions(location, contents string, opts *ulfs.CreateOptions) ExecuteOption {
+	return ExecuteOption{func(t *testing.T, ctx context.Context, cs *callbackState) {
+		createFile(t, ctx, cs, location, opts, contents)
+	}}
+}
 
-		mwh, err := cs.fs.Create(ctx, loc, nil)
-		require.NoError(t, err)
-		defer func() { _ = mwh.Abort(ctx) }()
+func createFile(t *testing.T, ctx context.Context, cs *callbackState, location string, opts *ulfs.CreateOptions, contents ...string) {
+	loc, err := ulloc.Parse(location)
+	require.NoError(t, err)
 
-		wh, err := mwh.NextPart(ctx, -1)
-		require.NoError(t, err)
-		defer func() { _ = wh.Abort() }()
+	if bucket, _, ok := loc.RemoteParts(); ok {
+		cs.rfs.ensureBucket(bucket)
+	}
 
-		for _, content := range contents {
-			_, err := wh.Write([]byte(content))
-			require.NoError(t, err)
-		}
-		if len(contents) == 0 {
-			_, err := wh.Write([]byte(location))
-			require.NoError(t, err)
-		}
+	mwh, err := cs.fs.Create(ctx, loc, opts)
+	require.NoError(t, err)
+	defer func() { _ = mwh.Abort(ctx) }()
 
-		require.NoError(t, wh.Commit())
-		require.NoError(t, mwh.Commit(ctx))
-	}}
+	wh, err := mwh.NextPart(ctx, -1)
+	require.NoError(t, err)
+	defer func() { _ = wh.Abort() }()
+
+	for _, content := range contents {
+		_, err := wh.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	if len(contents) == 0 {
+		_, err := wh.Write([]byte(location))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, wh.Commit())
+	require.NoError(t, mwh.Commit(ctx))
 }
 
 // WithPendingFile sets the command to execute with a pending upload happening to
-// the provided location.
-func WithPendingFile(location string) ExecuteOption {
+// the provided location. The contents are uploaded as a single part.
+func WithPendingFile(location string, contents ...string) ExecuteOption {
 	return ExecuteOption{func(t *testing.T, ctx context.Context, cs *callbackState) {
 		loc, err := ulloc.Parse(location)
 		require.NoError(t, err)
@@ -234,7 +247,17 @@ func WithPendingFile(location string) ExecuteOption {
 			t.Fatalf("Invalid pending local file: %s", loc)
 		}
 
-		_, err = cs.fs.Create(ctx, loc, nil)
+		mwh, err := cs.fs.Create(ctx, loc, nil)
 		require.NoError(t, err)
+
+		if len(contents) > 0 {
+			wh, err := mwh.NextPart(ctx, -1)
+			require.NoError(t, err)
+			for _, content := range contents {
+				_, err := wh.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, wh.Commit())
+		}
 	}}
 }
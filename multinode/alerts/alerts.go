@@ -0,0 +1,106 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+// Package alerts implements monitoring of connected nodes and notifying
+// the operator when some of them needs attention.
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/storx"
+)
+
+// DB exposes needed by MND alerts storage functionality.
+//
+// architecture: Database
+type DB interface {
+	// List returns all active alerts, most recent first.
+	List(ctx context.Context) ([]Alert, error)
+	// ListByNode returns all active alerts of the node.
+	ListByNode(ctx context.Context, nodeID storx.NodeID) ([]Alert, error)
+	// Get returns alert of specified kind raised for the node.
+	Get(ctx context.Context, nodeID storx.NodeID, kind Kind) (Alert, error)
+	// Add stores new active alert.
+	Add(ctx context.Context, alert Alert) error
+	// UpdateMessage updates description of the active alert.
+	UpdateMessage(ctx context.Context, nodeID storx.NodeID, kind Kind, message string) error
+	// Acknowledge marks alert as acknowledged by the operator.
+	Acknowledge(ctx context.Context, nodeID storx.NodeID, kind Kind, acknowledgedAt time.Time) error
+	// Silence mutes notifications of the alert until specified time.
+	Silence(ctx context.Context, nodeID storx.NodeID, kind Kind, until time.Time) error
+	// MarkNotified saves time of the last notification sent for the alert via all channels.
+	MarkNotified(ctx context.Context, nodeID storx.NodeID, kind Kind, notifiedAt time.Time) error
+	// MarkChannelsNotified saves channels the pending notification of the alert was delivered to.
+	MarkChannelsNotified(ctx context.Context, nodeID storx.NodeID, kind Kind, channels []string) error
+	// Remove removes alert, e.g. when condition is resolved.
+	Remove(ctx context.Context, nodeID storx.NodeID, kind Kind) error
+	// RemoveByNode removes all alerts of the node.
+	RemoveByNode(ctx context.Context, nodeID storx.NodeID) error
+}
+
+var (
+	// ErrNoAlert is a special error type that indicates about absence of alert in AlertsDB.
+	ErrNoAlert = errs.Class("no such alert")
+)
+
+// Kind defines which condition alert was raised for.
+type Kind string
+
+const (
+	// KindNodeOffline indicates that node is not reachable or has not contacted satellites for a long time.
+	KindNodeOffline Kind = "node_offline"
+	// KindLowAuditScore indicates that node audit score is below configured threshold on some satellites.
+	KindLowAuditScore Kind = "low_audit_score"
+	// KindLowSuspensionScore indicates that node suspension score is below configured threshold on some satellites.
+	KindLowSuspensionScore Kind = "low_suspension_score"
+	// KindDiskNearlyFull indicates that node has used almost all allocated disk space.
+	KindDiskNearlyFull Kind = "disk_nearly_full"
+	// KindVersionOutdated indicates that node runs outdated software version.
+	KindVersionOutdated Kind = "version_outdated"
+)
+
+// Kinds contains all supported alert kinds.
+var Kinds = []Kind{
+	KindNodeOffline,
+	KindLowAuditScore,
+	KindLowSuspensionScore,
+	KindDiskNearlyFull,
+	KindVersionOutdated,
+}
+
+// ParseKind validates and returns kind from string.
+func ParseKind(s string) (Kind, error) {
+	for _, kind := range Kinds {
+		if string(kind) == s {
+			return kind, nil
+		}
+	}
+	return "", Error.New("unknown alert kind %q", s)
+}
+
+// Alert is an active condition of a node that requires operator attention.
+type Alert struct {
+	NodeID         storx.NodeID `json:"nodeId"`
+	Kind           Kind         `json:"kind"`
+	Message        string       `json:"message"`
+	TriggeredAt    time.Time    `json:"triggeredAt"`
+	AcknowledgedAt *time.Time   `json:"acknowledgedAt"`
+	SilencedUntil  *time.Time   `json:"silencedUntil"`
+	NotifiedAt     *time.Time   `json:"notifiedAt"`
+
+	// NotifiedChannels contains channels the pending notification was
+	// already delivered to, when some of the channels failed.
+	NotifiedChannels []string `json:"notifiedChannels"`
+}
+
+// Muted returns true if no notifications should be sent for the alert.
+func (alert Alert) Muted(now time.Time) bool {
+	if alert.AcknowledgedAt != nil {
+		return true
+	}
+	return alert.SilencedUntil != nil && now.Before(*alert.SilencedUntil)
+}
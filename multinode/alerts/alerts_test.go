@@ -0,0 +1,290 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"common/rpc"
+	"common/testcontext"
+	"common/testrand"
+	"private/version"
+	"storx/multinode"
+	"storx/multinode/alerts"
+	"storx/multinode/multinodedb/multinodedbtest"
+)
+
+func TestAlertsDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		alertsDB := db.Alerts()
+
+		nodeID := testrand.NodeID()
+		now := time.Now().UTC().Truncate(time.Second)
+
+		_, err := alertsDB.Get(ctx, nodeID, alerts.KindNodeOffline)
+		require.Error(t, err)
+		require.True(t, alerts.ErrNoAlert.Has(err))
+
+		err = alertsDB.Add(ctx, alerts.Alert{
+			NodeID:      nodeID,
+			Kind:        alerts.KindNodeOffline,
+			Message:     "offline",
+			TriggeredAt: now,
+		})
+		require.NoError(t, err)
+
+		err = alertsDB.Add(ctx, alerts.Alert{
+			NodeID:      nodeID,
+			Kind:        alerts.KindDiskNearlyFull,
+			Message:     "full",
+			TriggeredAt: now.Add(time.Minute),
+		})
+		require.NoError(t, err)
+
+		list, err := alertsDB.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, alerts.KindDiskNearlyFull, list[0].Kind)
+		assert.Equal(t, alerts.KindNodeOffline, list[1].Kind)
+
+		require.NoError(t, alertsDB.UpdateMessage(ctx, nodeID, alerts.KindNodeOffline, "still offline"))
+		require.NoError(t, alertsDB.Acknowledge(ctx, nodeID, alerts.KindNodeOffline, now))
+		require.NoError(t, alertsDB.Silence(ctx, nodeID, alerts.KindNodeOffline, now.Add(time.Hour)))
+		require.NoError(t, alertsDB.MarkChannelsNotified(ctx, nodeID, alerts.KindNodeOffline, []string{"email", "webhook"}))
+
+		alert, err := alertsDB.Get(ctx, nodeID, alerts.KindNodeOffline)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"email", "webhook"}, alert.NotifiedChannels)
+
+		require.NoError(t, alertsDB.MarkNotified(ctx, nodeID, alerts.KindNodeOffline, now))
+
+		alert, err = alertsDB.Get(ctx, nodeID, alerts.KindNodeOffline)
+		require.NoError(t, err)
+		assert.Empty(t, alert.NotifiedChannels)
+		assert.Equal(t, "still offline", alert.Message)
+		require.NotNil(t, alert.AcknowledgedAt)
+		require.NotNil(t, alert.SilencedUntil)
+		require.NotNil(t, alert.NotifiedAt)
+		assert.True(t, alert.SilencedUntil.Equal(now.Add(time.Hour)))
+		assert.True(t, alert.Muted(now))
+
+		require.NoError(t, alertsDB.Remove(ctx, nodeID, alerts.KindNodeOffline))
+
+		list, err = alertsDB.ListByNode(ctx, nodeID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, alerts.KindDiskNearlyFull, list[0].Kind)
+
+		require.NoError(t, alertsDB.RemoveByNode(ctx, nodeID))
+
+		list, err = alertsDB.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 0)
+	})
+}
+
+func TestRules(t *testing.T) {
+	rules, err := alerts.NewRules(alerts.Config{
+		OfflineThreshold:   3 * time.Hour,
+		MinAuditScore:      0.98,
+		MinSuspensionScore: 0.98,
+		DiskUsageThreshold: 0.9,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	latest, err := version.NewSemVer("v1.60.3")
+	require.NoError(t, err)
+
+	healthy := alerts.NodeState{
+		NodeID:        testrand.NodeID(),
+		Version:       "v1.60.3",
+		LastContact:   now.Add(-time.Hour),
+		DiskAllocated: 1000,
+		DiskUsed:      100,
+		Satellites: []alerts.SatelliteState{
+			{ID: testrand.NodeID(), AuditScore: 1, SuspensionScore: 1},
+		},
+	}
+	require.Empty(t, rules.Evaluate(healthy, latest, now))
+
+	kinds := func(conditions []alerts.Condition) (kinds []alerts.Kind) {
+		for _, condition := range conditions {
+			kinds = append(kinds, condition.Kind)
+		}
+		return kinds
+	}
+
+	unreachable := healthy
+	unreachable.Err = errors.New("dial timeout")
+	require.Equal(t, []alerts.Kind{alerts.KindNodeOffline}, kinds(rules.Evaluate(unreachable, latest, now)))
+
+	broken := healthy
+	broken.LastContact = now.Add(-4 * time.Hour)
+	broken.DiskUsed = 950
+	broken.Version = "v1.59.0"
+	broken.Satellites = []alerts.SatelliteState{
+		{ID: testrand.NodeID(), AuditScore: 0.9, SuspensionScore: 0.5},
+	}
+	require.Equal(t, []alerts.Kind{
+		alerts.KindNodeOffline,
+		alerts.KindLowAuditScore,
+		alerts.KindLowSuspensionScore,
+		alerts.KindDiskNearlyFull,
+		alerts.KindVersionOutdated,
+	}, kinds(rules.Evaluate(broken, latest, now)))
+
+	require.Equal(t, latest, alerts.LatestVersion([]alerts.NodeState{broken, healthy, unreachable}))
+}
+
+type notifierMock struct {
+	notifications []alerts.Notification
+	fail          bool
+}
+
+func (mock *notifierMock) Notify(ctx context.Context, notification alerts.Notification) error {
+	if mock.fail {
+		return errors.New("delivery failed")
+	}
+	mock.notifications = append(mock.notifications, notification)
+	return nil
+}
+
+func TestProcess(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		notifier := &notifierMock{}
+		service, err := alerts.NewService(zaptest.NewLogger(t), rpc.Dialer{}, db.Nodes(), db.Alerts(), alerts.Notifiers{{Name: "mock", Notifier: notifier}}, alerts.Config{
+			Interval:         time.Hour,
+			RenotifyInterval: 24 * time.Hour,
+		})
+		require.NoError(t, err)
+
+		now := time.Now()
+		state := alerts.NodeState{NodeID: testrand.NodeID(), Name: "node"}
+		offline := []alerts.Condition{{Kind: alerts.KindNodeOffline, Message: "offline"}}
+
+		// new alert is stored and notified.
+		require.NoError(t, service.Process(ctx, state, offline, now))
+		require.Len(t, notifier.notifications, 1)
+		assert.False(t, notifier.notifications[0].Resolved)
+
+		// repeated condition is not notified again before renotify interval.
+		require.NoError(t, service.Process(ctx, state, offline, now.Add(time.Hour)))
+		require.Len(t, notifier.notifications, 1)
+
+		require.NoError(t, service.Process(ctx, state, offline, now.Add(25*time.Hour)))
+		require.Len(t, notifier.notifications, 2)
+
+		// acknowledged alert is not notified.
+		service.SetNow(func() time.Time { return now.Add(25 * time.Hour) })
+		require.NoError(t, service.Acknowledge(ctx, state.NodeID, alerts.KindNodeOffline))
+		require.NoError(t, service.Process(ctx, state, offline, now.Add(50*time.Hour)))
+		require.Len(t, notifier.notifications, 2)
+
+		// resolved alert is removed.
+		require.NoError(t, service.Process(ctx, state, nil, now.Add(51*time.Hour)))
+		list, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Empty(t, list)
+
+		// silenced alert is notified only after silence expires.
+		require.NoError(t, service.Process(ctx, state, offline, now))
+		require.Len(t, notifier.notifications, 3)
+		require.NoError(t, service.Silence(ctx, state.NodeID, alerts.KindNodeOffline, 48*time.Hour))
+		require.NoError(t, service.Process(ctx, state, offline, now.Add(30*time.Hour)))
+		require.Len(t, notifier.notifications, 3)
+		require.NoError(t, service.Process(ctx, state, offline, now.Add(80*time.Hour)))
+		require.Len(t, notifier.notifications, 4)
+
+		// resolution of notified alert is notified.
+		require.NoError(t, service.Process(ctx, state, nil, now.Add(81*time.Hour)))
+		require.Len(t, notifier.notifications, 5)
+		assert.True(t, notifier.notifications[4].Resolved)
+
+		err = service.Acknowledge(ctx, state.NodeID, alerts.KindDiskNearlyFull)
+		require.Error(t, err)
+		require.True(t, alerts.ErrNoAlert.Has(err))
+	})
+}
+
+func TestProcessUnreachable(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		notifier := &notifierMock{}
+		service, err := alerts.NewService(zaptest.NewLogger(t), rpc.Dialer{}, db.Nodes(), db.Alerts(), alerts.Notifiers{{Name: "mock", Notifier: notifier}}, alerts.Config{
+			Interval:         time.Hour,
+			RenotifyInterval: 24 * time.Hour,
+		})
+		require.NoError(t, err)
+
+		now := time.Now()
+		state := alerts.NodeState{NodeID: testrand.NodeID(), Name: "node"}
+		full := []alerts.Condition{{Kind: alerts.KindDiskNearlyFull, Message: "full"}}
+		require.NoError(t, service.Process(ctx, state, full, now))
+		require.Len(t, notifier.notifications, 1)
+
+		// conditions which can't be checked are not resolved while node is not reachable.
+		unreachable := state
+		unreachable.Err = errors.New("dial timeout")
+		offline := []alerts.Condition{{Kind: alerts.KindNodeOffline, Message: "offline"}}
+		require.NoError(t, service.Process(ctx, unreachable, offline, now.Add(time.Hour)))
+
+		list, err := service.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		require.Len(t, notifier.notifications, 2)
+		for _, notification := range notifier.notifications {
+			assert.False(t, notification.Resolved)
+		}
+
+		// they are resolved once node is reachable again.
+		require.NoError(t, service.Process(ctx, state, nil, now.Add(2*time.Hour)))
+		list, err = service.List(ctx)
+		require.NoError(t, err)
+		require.Empty(t, list)
+	})
+}
+
+func TestProcessChannels(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		working, failing := &notifierMock{}, &notifierMock{fail: true}
+		service, err := alerts.NewService(zaptest.NewLogger(t), rpc.Dialer{}, db.Nodes(), db.Alerts(), alerts.Notifiers{
+			{Name: "working", Notifier: working},
+			{Name: "failing", Notifier: failing},
+		}, alerts.Config{
+			Interval:         time.Hour,
+			RenotifyInterval: 24 * time.Hour,
+		})
+		require.NoError(t, err)
+
+		now := time.Now()
+		state := alerts.NodeState{NodeID: testrand.NodeID(), Name: "node"}
+		offline := []alerts.Condition{{Kind: alerts.KindNodeOffline, Message: "offline"}}
+
+		require.NoError(t, service.Process(ctx, state, offline, now))
+		require.Len(t, working.notifications, 1)
+
+		alert, err := db.Alerts().Get(ctx, state.NodeID, alerts.KindNodeOffline)
+		require.NoError(t, err)
+		require.Nil(t, alert.NotifiedAt)
+		require.Equal(t, []string{"working"}, alert.NotifiedChannels)
+
+		// only failed channel is retried.
+		failing.fail = false
+		require.NoError(t, service.Process(ctx, state, offline, now.Add(time.Minute)))
+		require.Len(t, working.notifications, 1)
+		require.Len(t, failing.notifications, 1)
+
+		alert, err = db.Alerts().Get(ctx, state.NodeID, alerts.KindNodeOffline)
+		require.NoError(t, err)
+		require.NotNil(t, alert.NotifiedAt)
+		require.Empty(t, alert.NotifiedChannels)
+	})
+}
@@ -0,0 +1,277 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/storx"
+	"storx/private/post"
+)
+
+// ErrNotify is an error class for alert delivery errors.
+var ErrNotify = errs.Class("alerts notify")
+
+// NotifyConfig contains configuration of alert delivery channels.
+type NotifyConfig struct {
+	SMTP SMTPConfig
+
+	WebhookURL     string        `help:"url alerts are sent to as JSON via POST request" default:""`
+	WebhookTimeout time.Duration `help:"timeout of a single webhook request" default:"30s"`
+
+	Command string `help:"path to local executable run for every alert, alert is passed as JSON via stdin" default:""`
+}
+
+// SMTPConfig contains configuration of alert delivery via email.
+type SMTPConfig struct {
+	ServerAddress string `help:"smtp server address, alerts are not emailed when empty" default:""`
+	From          string `help:"sender email address" default:""`
+	To            string `help:"comma separated list of recipient email addresses" default:""`
+	AuthType      string `help:"smtp authentication type (plain or login)" default:"login"`
+	Login         string `help:"smtp login" default:""`
+	Password      string `help:"smtp password" default:""`
+}
+
+// Notification describes alert state change delivered to the operator.
+type Notification struct {
+	NodeID      storx.NodeID `json:"nodeId"`
+	NodeName    string       `json:"nodeName"`
+	Kind        Kind         `json:"kind"`
+	Message     string       `json:"message"`
+	TriggeredAt time.Time    `json:"triggeredAt"`
+	Resolved    bool         `json:"resolved"`
+}
+
+// Subject returns short human readable description of the notification.
+func (notification Notification) Subject() string {
+	name := notification.NodeName
+	if name == "" {
+		name = notification.NodeID.String()
+	}
+	if notification.Resolved {
+		return fmt.Sprintf("[resolved] %s: %s", name, notification.Kind)
+	}
+	return fmt.Sprintf("[alert] %s: %s", name, notification.Kind)
+}
+
+// Notifier delivers alert notifications to the operator.
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+// NewNotifier creates notifier for every configured delivery channel.
+func NewNotifier(log *zap.Logger, config NotifyConfig) (Notifiers, error) {
+	var notifiers Notifiers
+
+	if config.SMTP.ServerAddress != "" {
+		notifier, err := NewSMTPNotifier(config.SMTP)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, Channel{Name: "email", Notifier: notifier})
+	}
+	if config.WebhookURL != "" {
+		notifiers = append(notifiers, Channel{Name: "webhook", Notifier: &WebhookNotifier{
+			URL:    config.WebhookURL,
+			Client: &http.Client{Timeout: config.WebhookTimeout},
+		}})
+	}
+	if config.Command != "" {
+		notifiers = append(notifiers, Channel{Name: "command", Notifier: &CommandNotifier{Command: config.Command}})
+	}
+
+	if len(notifiers) == 0 {
+		log.Info("no alert delivery channels configured, alerts are only shown in the dashboard")
+	}
+
+	return notifiers, nil
+}
+
+// Channel is a named alert delivery channel.
+type Channel struct {
+	Name string
+	Notifier
+}
+
+// Notifiers delivers notification via all contained channels.
+type Notifiers []Channel
+
+// Notify sends notification via every channel.
+func (notifiers Notifiers) Notify(ctx context.Context, notification Notification) (err error) {
+	_, err = notifiers.NotifyChannels(ctx, notification, nil)
+	return err
+}
+
+// NotifyChannels sends notification via every channel except the skipped ones.
+// It returns names of the channels the notification was delivered to.
+func (notifiers Notifiers) NotifyChannels(ctx context.Context, notification Notification, skip []string) (delivered []string, err error) {
+	var group errs.Group
+	for _, channel := range notifiers {
+		if containsString(skip, channel.Name) {
+			continue
+		}
+		if err := channel.Notify(ctx, notification); err != nil {
+			group.Add(ErrNotify.New("%s: %v", channel.Name, err))
+			continue
+		}
+		delivered = append(delivered, channel.Name)
+	}
+	return delivered, group.Err()
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// SMTPNotifier delivers notifications via email.
+type SMTPNotifier struct {
+	Sender *post.SMTPSender
+	To     []post.Address
+}
+
+// NewSMTPNotifier creates email notifier from config.
+func NewSMTPNotifier(config SMTPConfig) (*SMTPNotifier, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, ErrNotify.Wrap(err)
+	}
+
+	to, err := mail.ParseAddressList(config.To)
+	if err != nil {
+		return nil, ErrNotify.Wrap(err)
+	}
+
+	var auth smtp.Auth
+	switch config.AuthType {
+	case "plain":
+		host := config.ServerAddress
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", config.Login, config.Password, host)
+	case "login":
+		auth = post.LoginAuth{
+			Username: config.Login,
+			Password: config.Password,
+		}
+	default:
+		return nil, ErrNotify.New("unsupported smtp auth type %q", config.AuthType)
+	}
+
+	notifier := &SMTPNotifier{
+		Sender: &post.SMTPSender{
+			ServerAddress: config.ServerAddress,
+			From:          *from,
+			Auth:          auth,
+		},
+	}
+	for _, address := range to {
+		notifier.To = append(notifier.To, *address)
+	}
+
+	return notifier, nil
+}
+
+// Notify sends notification email.
+func (notifier *SMTPNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var body strings.Builder
+	fmt.Fprintf(&body, "Node: %s\n", notification.NodeID)
+	if notification.NodeName != "" {
+		fmt.Fprintf(&body, "Name: %s\n", notification.NodeName)
+	}
+	fmt.Fprintf(&body, "Alert: %s\n", notification.Kind)
+	fmt.Fprintf(&body, "Triggered at: %s\n", notification.TriggeredAt.UTC().Format(time.RFC3339))
+	if notification.Resolved {
+		fmt.Fprintf(&body, "\nCondition is resolved.\n")
+	} else {
+		fmt.Fprintf(&body, "\n%s\n", notification.Message)
+	}
+
+	err = notifier.Sender.SendEmail(ctx, &post.Message{
+		From:      notifier.Sender.FromAddress(),
+		To:        notifier.To,
+		Subject:   notification.Subject(),
+		PlainText: body.String(),
+	})
+	return ErrNotify.Wrap(err)
+}
+
+// WebhookNotifier delivers notifications as JSON POST requests.
+type WebhookNotifier struct {
+	URL    string
+	Client *http.Client
+}
+
+// Notify posts notification to the webhook.
+func (notifier *WebhookNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.URL, bytes.NewReader(data))
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := notifier.Client.Do(req)
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return ErrNotify.New("webhook responded with status %q", resp.Status)
+	}
+
+	return nil
+}
+
+// CommandNotifier delivers notifications by running a local executable.
+// Notification is passed as JSON via stdin.
+type CommandNotifier struct {
+	Command string
+}
+
+// Notify runs the command.
+func (notifier *CommandNotifier) Notify(ctx context.Context, notification Notification) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return ErrNotify.Wrap(err)
+	}
+
+	cmd := exec.CommandContext(ctx, notifier.Command)
+	cmd.Stdin = bytes.NewReader(data)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return ErrNotify.New("command failed: %v: %s", err, bytes.TrimSpace(output))
+	}
+
+	return nil
+}
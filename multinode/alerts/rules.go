@@ -0,0 +1,177 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"common/memory"
+	"common/storx"
+	"private/version"
+)
+
+// Config contains configurable values for alerts.
+type Config struct {
+	Interval           time.Duration `help:"how frequently connected nodes are checked" default:"5m0s"`
+	PollTimeout        time.Duration `help:"how long a single node is checked before it is considered not reachable" default:"1m0s"`
+	PollConcurrency    int           `help:"how many nodes are checked at the same time" default:"10"`
+	RenotifyInterval   time.Duration `help:"how frequently notification is repeated for not acknowledged alerts" default:"24h0m0s"`
+	OfflineThreshold   time.Duration `help:"how long node could not contact satellites before it is considered offline" default:"3h0m0s"`
+	MinAuditScore      float64       `help:"audit score below which alert is raised" default:"0.98"`
+	MinSuspensionScore float64       `help:"suspension score below which alert is raised" default:"0.98"`
+	DiskUsageThreshold float64       `help:"fraction of allocated disk space used after which alert is raised" default:"0.95"`
+	MinimumVersion     string        `help:"nodes running older version are reported as outdated, if empty the newest version among connected nodes is used" default:""`
+
+	Notify NotifyConfig
+}
+
+// NodeState contains node state observed during a single check.
+type NodeState struct {
+	NodeID storx.NodeID
+	Name   string
+
+	// Err is set when node could not be reached or queried.
+	Err error
+
+	Version     string
+	LastContact time.Time
+
+	DiskAllocated int64
+	DiskUsed      int64
+
+	Satellites []SatelliteState
+}
+
+// SatelliteState contains node reputation on a single satellite.
+type SatelliteState struct {
+	ID              storx.NodeID
+	URL             string
+	AuditScore      float64
+	SuspensionScore float64
+}
+
+// Condition is a rule violation detected by Evaluate.
+type Condition struct {
+	Kind    Kind
+	Message string
+}
+
+// Rules evaluates node state against configured thresholds.
+type Rules struct {
+	config  Config
+	minimum version.SemVer
+}
+
+// NewRules creates rules from config.
+func NewRules(config Config) (*Rules, error) {
+	rules := &Rules{config: config}
+	if config.MinimumVersion != "" {
+		minimum, err := version.NewSemVer(config.MinimumVersion)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		rules.minimum = minimum
+	}
+	return rules, nil
+}
+
+// Evaluate returns all conditions violated by node state.
+// latest is the newest version observed among connected nodes, it is used
+// when minimum version is not configured.
+//
+// When node is not reachable only offline condition is returned, other
+// conditions can't be checked and stay as they were.
+func (rules *Rules) Evaluate(state NodeState, latest version.SemVer, now time.Time) []Condition {
+	if state.Err != nil {
+		return []Condition{{
+			Kind:    KindNodeOffline,
+			Message: fmt.Sprintf("node is not reachable: %v", state.Err),
+		}}
+	}
+
+	var conditions []Condition
+
+	if offline := now.Sub(state.LastContact); offline > rules.config.OfflineThreshold {
+		conditions = append(conditions, Condition{
+			Kind:    KindNodeOffline,
+			Message: fmt.Sprintf("node has not contacted satellites since %s", state.LastContact.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	var lowAudit, lowSuspension []string
+	for _, satellite := range state.Satellites {
+		name := satellite.URL
+		if name == "" {
+			name = satellite.ID.String()
+		}
+		if satellite.AuditScore < rules.config.MinAuditScore {
+			lowAudit = append(lowAudit, fmt.Sprintf("%s (%.4f)", name, satellite.AuditScore))
+		}
+		if satellite.SuspensionScore < rules.config.MinSuspensionScore {
+			lowSuspension = append(lowSuspension, fmt.Sprintf("%s (%.4f)", name, satellite.SuspensionScore))
+		}
+	}
+	if len(lowAudit) > 0 {
+		sort.Strings(lowAudit)
+		conditions = append(conditions, Condition{
+			Kind:    KindLowAuditScore,
+			Message: fmt.Sprintf("audit score is below %.4f on %s", rules.config.MinAuditScore, strings.Join(lowAudit, ", ")),
+		})
+	}
+	if len(lowSuspension) > 0 {
+		sort.Strings(lowSuspension)
+		conditions = append(conditions, Condition{
+			Kind:    KindLowSuspensionScore,
+			Message: fmt.Sprintf("suspension score is below %.4f on %s", rules.config.MinSuspensionScore, strings.Join(lowSuspension, ", ")),
+		})
+	}
+
+	if state.DiskAllocated > 0 {
+		usage := float64(state.DiskUsed) / float64(state.DiskAllocated)
+		if usage >= rules.config.DiskUsageThreshold {
+			conditions = append(conditions, Condition{
+				Kind: KindDiskNearlyFull,
+				Message: fmt.Sprintf("%.1f%% of allocated disk space is used (%s of %s)",
+					usage*100, memory.Size(state.DiskUsed).Base10String(), memory.Size(state.DiskAllocated).Base10String()),
+			})
+		}
+	}
+
+	expected := rules.minimum
+	if expected.IsZero() {
+		expected = latest
+	}
+	if !expected.IsZero() {
+		current, err := version.NewSemVer(state.Version)
+		if err == nil && current.Compare(expected) < 0 {
+			conditions = append(conditions, Condition{
+				Kind:    KindVersionOutdated,
+				Message: fmt.Sprintf("node runs %s, expected at least %s", current.String(), expected.String()),
+			})
+		}
+	}
+
+	return conditions
+}
+
+// LatestVersion returns the newest version reported by nodes.
+func LatestVersion(states []NodeState) version.SemVer {
+	var latest version.SemVer
+	for _, state := range states {
+		if state.Err != nil {
+			continue
+		}
+		current, err := version.NewSemVer(state.Version)
+		if err != nil {
+			continue
+		}
+		if current.Compare(latest) > 0 {
+			latest = current
+		}
+	}
+	return latest
+}
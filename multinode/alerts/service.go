@@ -0,0 +1,366 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package alerts
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/rpc"
+	"common/storx"
+	"common/sync2"
+	"storx/multinode/nodes"
+	"storx/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for alerts service error.
+	Error = errs.Class("alerts")
+)
+
+// Service periodically checks connected nodes, keeps track of active alerts
+// and notifies the operator about them.
+//
+// architecture: Chore
+type Service struct {
+	log      *zap.Logger
+	dialer   rpc.Dialer
+	nodes    nodes.DB
+	alerts   DB
+	notifier Notifiers
+	rules    *Rules
+	config   Config
+
+	nowFn func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, alerts DB, notifier Notifiers, config Config) (*Service, error) {
+	rules, err := NewRules(config)
+	if err != nil {
+		return nil, err
+	}
+	if config.PollConcurrency <= 0 {
+		config.PollConcurrency = 1
+	}
+	if config.PollTimeout <= 0 {
+		config.PollTimeout = time.Minute
+	}
+
+	return &Service{
+		log:      log,
+		dialer:   dialer,
+		nodes:    nodes,
+		alerts:   alerts,
+		notifier: notifier,
+		rules:    rules,
+		config:   config,
+		nowFn:    time.Now,
+		Loop:     sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// Run runs alerts service.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Check(ctx); err != nil {
+			service.log.Error("failed to check nodes", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops alerts service.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Check polls all connected nodes, updates active alerts and sends notifications.
+func (service *Service) Check(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := service.nodes.List(ctx)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	states := make([]NodeState, len(list))
+	limiter := sync2.NewLimiter(service.config.PollConcurrency)
+	for i, node := range list {
+		i, node := i, node
+		limiter.Go(ctx, func() {
+			pollCtx, cancel := context.WithTimeout(ctx, service.config.PollTimeout)
+			defer cancel()
+
+			states[i] = service.poll(pollCtx, node)
+		})
+	}
+	limiter.Wait()
+
+	// nodes which were not polled because ctx was canceled.
+	if err := ctx.Err(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := service.nowFn()
+	latest := LatestVersion(states)
+
+	var group errs.Group
+	for _, state := range states {
+		group.Add(service.Process(ctx, state, service.rules.Evaluate(state, latest, now), now))
+	}
+
+	// alerts of nodes removed from the dashboard are not relevant anymore.
+	all, err := service.alerts.List(ctx)
+	if err != nil {
+		group.Add(err)
+		return Error.Wrap(group.Err())
+	}
+
+	connected := make(map[storx.NodeID]struct{}, len(list))
+	for _, node := range list {
+		connected[node.ID] = struct{}{}
+	}
+	removed := make(map[storx.NodeID]struct{})
+	for _, alert := range all {
+		if _, ok := connected[alert.NodeID]; ok {
+			continue
+		}
+		if _, ok := removed[alert.NodeID]; ok {
+			continue
+		}
+		removed[alert.NodeID] = struct{}{}
+		group.Add(service.alerts.RemoveByNode(ctx, alert.NodeID))
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// Process reconciles stored alerts of the node with currently violated conditions
+// and delivers notifications where needed.
+func (service *Service) Process(ctx context.Context, state NodeState, conditions []Condition, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	existing, err := service.alerts.ListByNode(ctx, state.NodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	active := make(map[Kind]Alert, len(existing))
+	for _, alert := range existing {
+		active[alert.Kind] = alert
+	}
+
+	var group errs.Group
+	for _, condition := range conditions {
+		alert, ok := active[condition.Kind]
+		delete(active, condition.Kind)
+
+		if !ok {
+			alert = Alert{
+				NodeID:      state.NodeID,
+				Kind:        condition.Kind,
+				Message:     condition.Message,
+				TriggeredAt: now,
+			}
+			if err := service.alerts.Add(ctx, alert); err != nil {
+				group.Add(err)
+				continue
+			}
+		} else if alert.Message != condition.Message {
+			alert.Message = condition.Message
+			if err := service.alerts.UpdateMessage(ctx, state.NodeID, condition.Kind, condition.Message); err != nil {
+				group.Add(err)
+				continue
+			}
+		}
+
+		if alert.Muted(now) {
+			continue
+		}
+		if alert.NotifiedAt != nil && now.Sub(*alert.NotifiedAt) < service.config.RenotifyInterval && len(alert.NotifiedChannels) == 0 {
+			continue
+		}
+
+		// channels which already received this notification are skipped, so
+		// that a failing channel doesn't cause duplicates on the others.
+		delivered, err := service.notifier.NotifyChannels(ctx, Notification{
+			NodeID:      state.NodeID,
+			NodeName:    state.Name,
+			Kind:        alert.Kind,
+			Message:     alert.Message,
+			TriggeredAt: alert.TriggeredAt,
+		}, alert.NotifiedChannels)
+		if err != nil {
+			service.log.Warn("failed to deliver alert notification",
+				zap.Stringer("Node ID", state.NodeID), zap.String("Kind", string(alert.Kind)), zap.Error(err))
+			if len(delivered) > 0 {
+				group.Add(service.alerts.MarkChannelsNotified(ctx, state.NodeID, alert.Kind, append(alert.NotifiedChannels, delivered...)))
+			}
+			continue
+		}
+
+		group.Add(service.alerts.MarkNotified(ctx, state.NodeID, alert.Kind, now))
+	}
+
+	// conditions which are no longer violated are resolved. Only offline
+	// condition can be checked when node is not reachable, the others are kept.
+	for kind, alert := range active {
+		if state.Err != nil && kind != KindNodeOffline {
+			continue
+		}
+
+		if err := service.alerts.Remove(ctx, state.NodeID, kind); err != nil {
+			group.Add(err)
+			continue
+		}
+
+		if alert.NotifiedAt == nil || alert.Muted(now) {
+			continue
+		}
+
+		err := service.notifier.Notify(ctx, Notification{
+			NodeID:      state.NodeID,
+			NodeName:    state.Name,
+			Kind:        alert.Kind,
+			Message:     alert.Message,
+			TriggeredAt: alert.TriggeredAt,
+			Resolved:    true,
+		})
+		if err != nil {
+			service.log.Warn("failed to deliver alert notification",
+				zap.Stringer("Node ID", state.NodeID), zap.String("Kind", string(alert.Kind)), zap.Error(err))
+		}
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// List returns all active alerts.
+func (service *Service) List(ctx context.Context) (_ []Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	alerts, err := service.alerts.List(ctx)
+	return alerts, Error.Wrap(err)
+}
+
+// Acknowledge marks alert as acknowledged, no more notifications are sent for it.
+func (service *Service) Acknowledge(ctx context.Context, nodeID storx.NodeID, kind Kind) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err = service.alerts.Get(ctx, nodeID, kind); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.alerts.Acknowledge(ctx, nodeID, kind, service.nowFn()))
+}
+
+// Silence mutes notifications of the alert for specified duration.
+func (service *Service) Silence(ctx context.Context, nodeID storx.NodeID, kind Kind, duration time.Duration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if duration <= 0 {
+		return Error.New("silence duration should be positive")
+	}
+
+	if _, err = service.alerts.Get(ctx, nodeID, kind); err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(service.alerts.Silence(ctx, nodeID, kind, service.nowFn().Add(duration)))
+}
+
+// poll queries node state via multinode api.
+func (service *Service) poll(ctx context.Context, node nodes.Node) (state NodeState) {
+	defer mon.Task()(&ctx)(nil)
+
+	state = NodeState{
+		NodeID: node.ID,
+		Name:   node.Name,
+	}
+
+	conn, err := service.dialer.DialNodeURL(ctx, storx.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		state.Err = nodes.ErrNodeNotReachable.Wrap(err)
+		return state
+	}
+	defer func() { _ = conn.Close() }()
+
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	}
+
+	nodeVersion, err := nodeClient.Version(ctx, &multinodepb.VersionRequest{Header: header})
+	if err != nil {
+		state.Err = err
+		return state
+	}
+	state.Version = nodeVersion.Version
+
+	lastContact, err := nodeClient.LastContact(ctx, &multinodepb.LastContactRequest{Header: header})
+	if err != nil {
+		state.Err = err
+		return state
+	}
+	state.LastContact = lastContact.LastContact
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		state.Err = err
+		return state
+	}
+	state.DiskAllocated = diskSpace.GetAllocated()
+	state.DiskUsed = diskSpace.GetUsedPieces() + diskSpace.GetUsedTrash()
+
+	trusted, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		state.Err = err
+		return state
+	}
+
+	for _, satellite := range trusted.TrustedSatellites {
+		reputation, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			state.Err = err
+			return state
+		}
+
+		state.Satellites = append(state.Satellites, SatelliteState{
+			ID:              satellite.NodeId,
+			URL:             satellite.Address,
+			AuditScore:      reputation.Audit.GetScore(),
+			SuspensionScore: reputation.Audit.GetSuspensionScore(),
+		})
+	}
+
+	return state
+}
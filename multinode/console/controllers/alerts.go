@@ -0,0 +1,158 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/storx"
+	"storx/multinode/alerts"
+)
+
+var (
+	// ErrAlerts is an internal error type for alerts web api controller.
+	ErrAlerts = errs.Class("alerts web api controller")
+)
+
+// Alerts is a web api controller.
+type Alerts struct {
+	log     *zap.Logger
+	service *alerts.Service
+}
+
+// NewAlerts is a constructor for Alerts.
+func NewAlerts(log *zap.Logger, service *alerts.Service) *Alerts {
+	return &Alerts{
+		log:     log,
+		service: service,
+	}
+}
+
+// List handles retrieving all active alerts.
+func (controller *Alerts) List(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	list, err := controller.service.List(ctx)
+	if err != nil {
+		controller.log.Error("list alerts internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(list); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Acknowledge handles alert acknowledgement, no more notifications are sent for acknowledged alert.
+func (controller *Alerts) Acknowledge(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, kind, err := parseAlertKey(mux.Vars(r))
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+
+	if err = controller.service.Acknowledge(ctx, nodeID, kind); err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+}
+
+// Silence handles muting alert notifications for a period of time.
+func (controller *Alerts) Silence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, kind, err := parseAlertKey(mux.Vars(r))
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Duration string `json:"duration"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+
+	duration, err := time.ParseDuration(payload.Duration)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.Wrap(err))
+		return
+	}
+	if duration <= 0 {
+		controller.serveError(w, http.StatusBadRequest, ErrAlerts.New("duration should be positive"))
+		return
+	}
+
+	if err = controller.service.Silence(ctx, nodeID, kind, duration); err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+}
+
+// handleServiceError maps alerts service error to http status.
+func (controller *Alerts) handleServiceError(w http.ResponseWriter, err error) {
+	if alerts.ErrNoAlert.Has(err) {
+		controller.serveError(w, http.StatusNotFound, ErrAlerts.Wrap(err))
+		return
+	}
+
+	controller.log.Error("alerts internal error", zap.Error(err))
+	controller.serveError(w, http.StatusInternalServerError, ErrAlerts.Wrap(err))
+}
+
+// parseAlertKey parses node id and alert kind from segment parameters.
+func parseAlertKey(vars map[string]string) (storx.NodeID, alerts.Kind, error) {
+	nodeID, err := storx.NodeIDFromString(vars["nodeID"])
+	if err != nil {
+		return storx.NodeID{}, "", err
+	}
+
+	kind, err := alerts.ParseKind(vars["kind"])
+	if err != nil {
+		return storx.NodeID{}, "", err
+	}
+
+	return nodeID, kind, nil
+}
+
+// serveError set http statuses and send json error.
+func (controller *Alerts) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/storx"
+	"storx/multinode/history"
+)
+
+var (
+	// ErrHistory is an internal error type for history web api controller.
+	ErrHistory = errs.Class("history web api controller")
+)
+
+// defaultHistoryRange is a range of history returned when it is not specified in the request.
+const defaultHistoryRange = 30 * 24 * time.Hour
+
+// History is a web api controller.
+type History struct {
+	log     *zap.Logger
+	service *history.Service
+}
+
+// NewHistory is a constructor for History.
+func NewHistory(log *zap.Logger, service *history.Service) *History {
+	return &History{
+		log:     log,
+		service: service,
+	}
+}
+
+// NodeSnapshots handles retrieving state snapshots of all nodes.
+func (controller *History) NodeSnapshots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	from, to, err := parseRange(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	snapshots, err := controller.service.NodeSnapshots(ctx, from, to)
+	if err != nil {
+		controller.log.Error("node snapshots internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrHistory.Wrap(err))
+		return
+	}
+
+	controller.serveNodeSnapshots(w, r, snapshots)
+}
+
+// NodeSnapshotsByNode handles retrieving state snapshots of the node.
+func (controller *History) NodeSnapshotsByNode(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	from, to, err := parseRange(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	snapshots, err := controller.service.NodeSnapshotsByNode(ctx, nodeID, from, to)
+	if err != nil {
+		controller.log.Error("node snapshots internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrHistory.Wrap(err))
+		return
+	}
+
+	controller.serveNodeSnapshots(w, r, snapshots)
+}
+
+// ReputationSnapshots handles retrieving reputation snapshots of the node.
+func (controller *History) ReputationSnapshots(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	from, to, err := parseRange(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	snapshots, err := controller.service.ReputationSnapshots(ctx, nodeID, from, to)
+	if err != nil {
+		controller.log.Error("reputation snapshots internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrHistory.Wrap(err))
+		return
+	}
+
+	if isCSV(r) {
+		serveCSVHeaders(w, "reputation.csv")
+		if err = history.WriteReputationSnapshotsCSV(w, snapshots); err != nil {
+			controller.log.Error("failed to write csv response", zap.Error(err))
+		}
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(snapshots); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// Monthly handles retrieving month over month totals of all nodes.
+func (controller *History) Monthly(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	from, to, err := parseRange(r)
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrHistory.Wrap(err))
+		return
+	}
+
+	summaries, err := controller.service.Monthly(ctx, from, to)
+	if err != nil {
+		controller.log.Error("monthly history internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrHistory.Wrap(err))
+		return
+	}
+
+	if isCSV(r) {
+		serveCSVHeaders(w, "monthly.csv")
+		if err = history.WriteMonthlyCSV(w, summaries); err != nil {
+			controller.log.Error("failed to write csv response", zap.Error(err))
+		}
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(summaries); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// serveNodeSnapshots writes node snapshots in requested format.
+func (controller *History) serveNodeSnapshots(w http.ResponseWriter, r *http.Request, snapshots []history.NodeSnapshot) {
+	if isCSV(r) {
+		serveCSVHeaders(w, "nodes.csv")
+		if err := history.WriteNodeSnapshotsCSV(w, snapshots); err != nil {
+			controller.log.Error("failed to write csv response", zap.Error(err))
+		}
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(snapshots); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// parseRange parses from and to query parameters in RFC3339 format,
+// by default range covers last 30 days.
+func parseRange(r *http.Request) (from, to time.Time, err error) {
+	query := r.URL.Query()
+
+	to = time.Now().UTC()
+	if param := query.Get("to"); param != "" {
+		to, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	from = to.Add(-defaultHistoryRange)
+	if param := query.Get("from"); param != "" {
+		from, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errs.New("from should be before to")
+	}
+
+	return from, to, nil
+}
+
+// isCSV returns true when csv response format is requested.
+func isCSV(r *http.Request) bool {
+	return r.URL.Query().Get("format") == "csv"
+}
+
+// serveCSVHeaders sets headers for csv file download.
+func serveCSVHeaders(w http.ResponseWriter, filename string) {
+	w.Header().Add("Content-Type", "text/csv")
+	w.Header().Add("Content-Disposition", `attachment; filename="`+filename+`"`)
+}
+
+// serveError set http statuses and send json error.
+func (controller *History) serveError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
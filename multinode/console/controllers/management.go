@@ -0,0 +1,324 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/storx"
+	"storx/multinode/management"
+	"storx/multinode/nodes"
+)
+
+var (
+	// ErrManagement is an internal error type for management web api controller.
+	ErrManagement = errs.Class("management web api controller")
+)
+
+// Management is a web api controller.
+type Management struct {
+	log     *zap.Logger
+	service *management.Service
+}
+
+// NewManagement is a constructor for Management.
+func NewManagement(log *zap.Logger, service *management.Service) *Management {
+	return &Management{
+		log:     log,
+		service: service,
+	}
+}
+
+// InitiateGracefulExit handles starting graceful exit of the node from specific satellite.
+func (controller *Management) InitiateGracefulExit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+	segments := mux.Vars(r)
+
+	nodeID, err := storx.NodeIDFromString(segments["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	satelliteID, err := storx.NodeIDFromString(segments["satelliteID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	progress, err := controller.service.InitiateGracefulExit(ctx, nodeID, satelliteID)
+	if err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+
+	if err = json.NewEncoder(w).Encode(progress); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// SetAllocatedDiskSpace handles changing disk space allocated by the node.
+func (controller *Management) SetAllocatedDiskSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Allocated int64 `json:"allocated"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+	if payload.Allocated <= 0 {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("allocated disk space should be positive"))
+		return
+	}
+
+	allocated, err := controller.service.SetAllocatedDiskSpace(ctx, nodeID, payload.Allocated)
+	if err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+
+	var response struct {
+		Allocated int64 `json:"allocated"`
+	}
+	response.Allocated = allocated
+
+	if err = json.NewEncoder(w).Encode(response); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// TrustExclusions handles retrieving trust exclusions added to the node remotely.
+func (controller *Management) TrustExclusions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	exclusions, err := controller.service.TrustExclusions(ctx, nodeID)
+	if err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+
+	if exclusions == nil {
+		exclusions = []string{}
+	}
+
+	if err = json.NewEncoder(w).Encode(exclusions); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// AddTrustExclusion handles excluding satellites from the ones trusted by the node.
+func (controller *Management) AddTrustExclusion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Exclusion string `json:"exclusion"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+	if payload.Exclusion == "" {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("exclusion is missing"))
+		return
+	}
+
+	if err = controller.service.AddTrustExclusion(ctx, nodeID, payload.Exclusion); err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+}
+
+// RemoveTrustExclusion handles removing trust exclusion added to the node remotely.
+func (controller *Management) RemoveTrustExclusion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	exclusion := r.URL.Query().Get("exclusion")
+	if exclusion == "" {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("exclusion is missing"))
+		return
+	}
+
+	if err = controller.service.RemoveTrustExclusion(ctx, nodeID, exclusion); err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+}
+
+// RescanUsedSpace handles scheduling used space recalculation on the node.
+func (controller *Management) RescanUsedSpace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = controller.service.RescanUsedSpace(ctx, nodeID); err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// AnnounceMaintenance handles announcing planned downtime of the node to its satellites.
+func (controller *Management) AnnounceMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	var payload struct {
+		Start time.Time `json:"start"`
+		End   time.Time `json:"end"`
+	}
+
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+	if !payload.Start.Before(payload.End) {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.New("start should be before end"))
+		return
+	}
+
+	satellites, err := controller.service.AnnounceMaintenance(ctx, nodeID, payload.Start, payload.End)
+	if err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+
+	if satellites == nil {
+		satellites = []management.SatelliteMaintenance{}
+	}
+
+	if err = json.NewEncoder(w).Encode(satellites); err != nil {
+		controller.log.Error("failed to write json response", zap.Error(err))
+		return
+	}
+}
+
+// CancelMaintenance handles cancelling planned downtime announced by the node.
+func (controller *Management) CancelMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Add("Content-Type", "application/json")
+
+	nodeID, err := storx.NodeIDFromString(mux.Vars(r)["nodeID"])
+	if err != nil {
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+		return
+	}
+
+	if err = controller.service.CancelMaintenance(ctx, nodeID); err != nil {
+		controller.handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleServiceError maps management service error to http status.
+func (controller *Management) handleServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case nodes.ErrNoNode.Has(err), management.ErrNotFound.Has(err):
+		controller.serveError(w, http.StatusNotFound, ErrManagement.Wrap(err))
+	case management.ErrInvalidArgument.Has(err):
+		controller.serveError(w, http.StatusBadRequest, ErrManagement.Wrap(err))
+	case nodes.ErrNodeAPIKeyInvalid.Has(err):
+		controller.serveError(w, http.StatusUnauthorized, ErrManagement.Wrap(err))
+	case nodes.ErrNodeNotReachable.Has(err):
+		controller.serveError(w, http.StatusBadGateway, ErrManagement.Wrap(err))
+	default:
+		controller.log.Error("management internal error", zap.Error(err))
+		controller.serveError(w, http.StatusInternalServerError, ErrManagement.Wrap(err))
+	}
+}
+
+// serveError set http statuses and send json error.
+func (controller *Management) serveError(w http.ResponseWriter, status int, err error) {
+	w.WriteHeader(status)
+
+	var response struct {
+		Error string `json:"error"`
+	}
+
+	response.Error = err.Error()
+
+	err = json.NewEncoder(w).Encode(response)
+	if err != nil {
+		controller.log.Error("failed to write json error response", zap.Error(err))
+	}
+}
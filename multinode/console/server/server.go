@@ -17,8 +17,11 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"common/errs2"
+	"storx/multinode/alerts"
 	"storx/multinode/bandwidth"
 	"storx/multinode/console/controllers"
+	"storx/multinode/history"
+	"storx/multinode/management"
 	"storx/multinode/nodes"
 	"storx/multinode/operators"
 	"storx/multinode/payouts"
@@ -46,6 +49,9 @@ type Services struct {
 	Storage    *storage.Service
 	Bandwidth  *bandwidth.Service
 	Reputation *reputation.Service
+	Alerts     *alerts.Service
+	Management *management.Service
+	History    *history.Service
 }
 
 // Server represents Multinode Dashboard http server.
@@ -63,6 +69,9 @@ type Server struct {
 	bandwidth  *bandwidth.Service
 	storage    *storage.Service
 	reputation *reputation.Service
+	alerts     *alerts.Service
+	management *management.Service
+	history    *history.Service
 }
 
 // NewServer returns new instance of Multinode Dashboard http server.
@@ -77,6 +86,9 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 		storage:    services.Storage,
 		bandwidth:  services.Bandwidth,
 		reputation: services.Reputation,
+		alerts:     services.Alerts,
+		management: services.Management,
+		history:    services.History,
 	}
 
 	router := mux.NewRouter()
@@ -133,6 +145,30 @@ func NewServer(log *zap.Logger, listener net.Listener, assets fs.FS, services Se
 	reputationRouter := apiRouter.PathPrefix("/reputation").Subrouter()
 	reputationRouter.HandleFunc("/satellites/{satelliteID}", reputationController.Stats)
 
+	alertsController := controllers.NewAlerts(server.log, server.alerts)
+	alertsRouter := apiRouter.PathPrefix("/alerts").Subrouter()
+	alertsRouter.HandleFunc("", alertsController.List).Methods(http.MethodGet)
+	alertsRouter.HandleFunc("/{nodeID}/{kind}/acknowledge", alertsController.Acknowledge).Methods(http.MethodPost)
+	alertsRouter.HandleFunc("/{nodeID}/{kind}/silence", alertsController.Silence).Methods(http.MethodPost)
+
+	managementController := controllers.NewManagement(server.log, server.management)
+	managementRouter := apiRouter.PathPrefix("/management/{nodeID}").Subrouter()
+	managementRouter.HandleFunc("/graceful-exit/{satelliteID}", managementController.InitiateGracefulExit).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/allocated-disk-space", managementController.SetAllocatedDiskSpace).Methods(http.MethodPut)
+	managementRouter.HandleFunc("/trust-exclusions", managementController.TrustExclusions).Methods(http.MethodGet)
+	managementRouter.HandleFunc("/trust-exclusions", managementController.AddTrustExclusion).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/trust-exclusions", managementController.RemoveTrustExclusion).Methods(http.MethodDelete)
+	managementRouter.HandleFunc("/rescan-used-space", managementController.RescanUsedSpace).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/maintenance", managementController.AnnounceMaintenance).Methods(http.MethodPost)
+	managementRouter.HandleFunc("/maintenance", managementController.CancelMaintenance).Methods(http.MethodDelete)
+
+	historyController := controllers.NewHistory(server.log, server.history)
+	historyRouter := apiRouter.PathPrefix("/history").Subrouter()
+	historyRouter.HandleFunc("/nodes", historyController.NodeSnapshots).Methods(http.MethodGet)
+	historyRouter.HandleFunc("/nodes/{nodeID}", historyController.NodeSnapshotsByNode).Methods(http.MethodGet)
+	historyRouter.HandleFunc("/reputation/{nodeID}", historyController.ReputationSnapshots).Methods(http.MethodGet)
+	historyRouter.HandleFunc("/monthly", historyController.Monthly).Methods(http.MethodGet)
+
 	staticServer := http.FileServer(http.FS(server.assets))
 	router.PathPrefix("/static").Handler(web.CacheHandler(staticServer))
 	router.PathPrefix("/").HandlerFunc(server.appHandler)
@@ -0,0 +1,109 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package history
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// WriteNodeSnapshotsCSV writes node snapshots as csv with header.
+func WriteNodeSnapshotsCSV(w io.Writer, snapshots []NodeSnapshot) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{
+		"nodeId", "createdAt", "diskAllocated", "diskUsed", "diskTrash", "diskFree", "bandwidthUsed", "estimatedPayout",
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, snapshot := range snapshots {
+		err := writer.Write([]string{
+			snapshot.NodeID.String(),
+			snapshot.CreatedAt.UTC().Format(time.RFC3339),
+			strconv.FormatInt(snapshot.DiskAllocated, 10),
+			strconv.FormatInt(snapshot.DiskUsed, 10),
+			strconv.FormatInt(snapshot.DiskTrash, 10),
+			strconv.FormatInt(snapshot.DiskFree, 10),
+			strconv.FormatInt(snapshot.BandwidthUsed, 10),
+			strconv.FormatInt(snapshot.EstimatedPayout, 10),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	writer.Flush()
+	return Error.Wrap(writer.Error())
+}
+
+// WriteReputationSnapshotsCSV writes reputation snapshots as csv with header.
+func WriteReputationSnapshotsCSV(w io.Writer, snapshots []ReputationSnapshot) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{
+		"nodeId", "satelliteId", "createdAt", "auditScore", "suspensionScore", "onlineScore",
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, snapshot := range snapshots {
+		err := writer.Write([]string{
+			snapshot.NodeID.String(),
+			snapshot.SatelliteID.String(),
+			snapshot.CreatedAt.UTC().Format(time.RFC3339),
+			formatFloat(snapshot.AuditScore),
+			formatFloat(snapshot.SuspensionScore),
+			formatFloat(snapshot.OnlineScore),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	writer.Flush()
+	return Error.Wrap(writer.Error())
+}
+
+// WriteMonthlyCSV writes monthly summaries as csv with header.
+func WriteMonthlyCSV(w io.Writer, summaries []MonthlySummary) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write([]string{
+		"month", "nodes", "diskAllocated", "diskUsed", "bandwidthUsed", "estimatedPayout",
+		"diskUsedGrowth", "bandwidthUsedGrowth", "estimatedPayoutGrowth",
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, summary := range summaries {
+		err := writer.Write([]string{
+			summary.Month,
+			strconv.Itoa(summary.Nodes),
+			strconv.FormatInt(summary.DiskAllocated, 10),
+			strconv.FormatInt(summary.DiskUsed, 10),
+			strconv.FormatInt(summary.BandwidthUsed, 10),
+			strconv.FormatInt(summary.EstimatedPayout, 10),
+			formatFloat(summary.DiskUsedGrowth),
+			formatFloat(summary.BandwidthUsedGrowth),
+			formatFloat(summary.EstimatedPayoutGrowth),
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	writer.Flush()
+	return Error.Wrap(writer.Error())
+}
+
+// formatFloat formats float with the minimal precision needed to represent it.
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
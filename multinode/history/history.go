@@ -0,0 +1,127 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+// Package history implements periodic collection of connected nodes state
+// so that trends are available even when nodes are offline.
+package history
+
+import (
+	"context"
+	"time"
+
+	"common/storx"
+)
+
+// DB exposes needed by MND history storage functionality.
+//
+// architecture: Database
+type DB interface {
+	// AddNodeSnapshot stores node state snapshot.
+	AddNodeSnapshot(ctx context.Context, snapshot NodeSnapshot) error
+	// AddReputationSnapshot stores node reputation snapshot for a single satellite.
+	AddReputationSnapshot(ctx context.Context, snapshot ReputationSnapshot) error
+	// NodeSnapshots returns snapshots of all nodes taken in [from, to) range, oldest first.
+	NodeSnapshots(ctx context.Context, from, to time.Time) ([]NodeSnapshot, error)
+	// NodeSnapshotsByNode returns snapshots of the node taken in [from, to) range, oldest first.
+	NodeSnapshotsByNode(ctx context.Context, nodeID storx.NodeID, from, to time.Time) ([]NodeSnapshot, error)
+	// ReputationSnapshots returns reputation snapshots of the node taken in [from, to) range, oldest first.
+	ReputationSnapshots(ctx context.Context, nodeID storx.NodeID, from, to time.Time) ([]ReputationSnapshot, error)
+	// DeleteBefore removes all snapshots taken before specified time.
+	DeleteBefore(ctx context.Context, before time.Time) error
+}
+
+// NodeSnapshot contains node disk usage, bandwidth and payout state at specific point in time.
+type NodeSnapshot struct {
+	NodeID        storx.NodeID `json:"nodeId"`
+	CreatedAt     time.Time    `json:"createdAt"`
+	DiskAllocated int64        `json:"diskAllocated"`
+	DiskUsed      int64        `json:"diskUsed"`
+	DiskTrash     int64        `json:"diskTrash"`
+	DiskFree      int64        `json:"diskFree"`
+	// BandwidthUsed is bandwidth used since the beginning of the month.
+	BandwidthUsed int64 `json:"bandwidthUsed"`
+	// EstimatedPayout is payout estimation for the current month in cents.
+	EstimatedPayout int64 `json:"estimatedPayout"`
+}
+
+// ReputationSnapshot contains node reputation scores on specific satellite at specific point in time.
+type ReputationSnapshot struct {
+	NodeID          storx.NodeID `json:"nodeId"`
+	SatelliteID     storx.NodeID `json:"satelliteId"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	AuditScore      float64      `json:"auditScore"`
+	SuspensionScore float64      `json:"suspensionScore"`
+	OnlineScore     float64      `json:"onlineScore"`
+}
+
+// MonthlySummary contains totals of all nodes by the end of the month
+// and growth compared to previous month.
+type MonthlySummary struct {
+	Month           string `json:"month"`
+	Nodes           int    `json:"nodes"`
+	DiskAllocated   int64  `json:"diskAllocated"`
+	DiskUsed        int64  `json:"diskUsed"`
+	BandwidthUsed   int64  `json:"bandwidthUsed"`
+	EstimatedPayout int64  `json:"estimatedPayout"`
+	// growth is a relative change compared to previous month, e.g. 0.1 means 10% increase.
+	DiskUsedGrowth        float64 `json:"diskUsedGrowth"`
+	BandwidthUsedGrowth   float64 `json:"bandwidthUsedGrowth"`
+	EstimatedPayoutGrowth float64 `json:"estimatedPayoutGrowth"`
+}
+
+// Monthly aggregates snapshots by month using the latest snapshot of every node in the month.
+// Snapshots are expected to be sorted by creation time.
+func Monthly(snapshots []NodeSnapshot) []MonthlySummary {
+	type monthKey struct {
+		year  int
+		month time.Month
+	}
+
+	var months []monthKey
+	latest := make(map[monthKey]map[storx.NodeID]NodeSnapshot)
+	for _, snapshot := range snapshots {
+		createdAt := snapshot.CreatedAt.UTC()
+		key := monthKey{year: createdAt.Year(), month: createdAt.Month()}
+
+		byNode, ok := latest[key]
+		if !ok {
+			byNode = make(map[storx.NodeID]NodeSnapshot)
+			latest[key] = byNode
+			months = append(months, key)
+		}
+		byNode[snapshot.NodeID] = snapshot
+	}
+
+	summaries := make([]MonthlySummary, 0, len(months))
+	for i, key := range months {
+		summary := MonthlySummary{
+			Month: time.Date(key.year, key.month, 1, 0, 0, 0, 0, time.UTC).Format("2006-01"),
+			Nodes: len(latest[key]),
+		}
+		for _, snapshot := range latest[key] {
+			summary.DiskAllocated += snapshot.DiskAllocated
+			summary.DiskUsed += snapshot.DiskUsed
+			summary.BandwidthUsed += snapshot.BandwidthUsed
+			summary.EstimatedPayout += snapshot.EstimatedPayout
+		}
+
+		if i > 0 {
+			previous := summaries[i-1]
+			summary.DiskUsedGrowth = growth(previous.DiskUsed, summary.DiskUsed)
+			summary.BandwidthUsedGrowth = growth(previous.BandwidthUsed, summary.BandwidthUsed)
+			summary.EstimatedPayoutGrowth = growth(previous.EstimatedPayout, summary.EstimatedPayout)
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries
+}
+
+// growth returns relative change between two values, zero if previous value is unknown.
+func growth(previous, current int64) float64 {
+	if previous == 0 {
+		return 0
+	}
+	return float64(current-previous) / float64(previous)
+}
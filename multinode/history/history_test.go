@@ -0,0 +1,126 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package history_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common/testcontext"
+	"common/testrand"
+	"storx/multinode"
+	"storx/multinode/history"
+	"storx/multinode/multinodedb/multinodedbtest"
+)
+
+func TestHistoryDB(t *testing.T) {
+	multinodedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db multinode.DB) {
+		historyDB := db.History()
+
+		nodeID, otherID, satelliteID := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+		now := time.Now().UTC().Truncate(time.Second)
+
+		for i := 0; i < 3; i++ {
+			createdAt := now.Add(time.Duration(i-2) * time.Hour)
+
+			require.NoError(t, historyDB.AddNodeSnapshot(ctx, history.NodeSnapshot{
+				NodeID:          nodeID,
+				CreatedAt:       createdAt,
+				DiskAllocated:   1000,
+				DiskUsed:        int64(100 * (i + 1)),
+				BandwidthUsed:   int64(10 * (i + 1)),
+				EstimatedPayout: int64(i + 1),
+			}))
+			require.NoError(t, historyDB.AddNodeSnapshot(ctx, history.NodeSnapshot{
+				NodeID:    otherID,
+				CreatedAt: createdAt,
+			}))
+			require.NoError(t, historyDB.AddReputationSnapshot(ctx, history.ReputationSnapshot{
+				NodeID:          nodeID,
+				SatelliteID:     satelliteID,
+				CreatedAt:       createdAt,
+				AuditScore:      1,
+				SuspensionScore: 0.5,
+				OnlineScore:     0.25,
+			}))
+		}
+
+		all, err := historyDB.NodeSnapshots(ctx, now.Add(-time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, all, 4)
+
+		snapshots, err := historyDB.NodeSnapshotsByNode(ctx, nodeID, now.Add(-3*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, snapshots, 3)
+		for i, snapshot := range snapshots {
+			assert.Equal(t, nodeID, snapshot.NodeID)
+			assert.Equal(t, int64(100*(i+1)), snapshot.DiskUsed)
+			assert.True(t, snapshot.CreatedAt.Equal(now.Add(time.Duration(i-2)*time.Hour)))
+		}
+
+		reputation, err := historyDB.ReputationSnapshots(ctx, nodeID, now.Add(-3*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, reputation, 3)
+		assert.Equal(t, satelliteID, reputation[0].SatelliteID)
+		assert.Equal(t, 0.5, reputation[0].SuspensionScore)
+		assert.Equal(t, 0.25, reputation[0].OnlineScore)
+
+		require.NoError(t, historyDB.DeleteBefore(ctx, now.Add(-time.Hour)))
+
+		snapshots, err = historyDB.NodeSnapshotsByNode(ctx, nodeID, now.Add(-3*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, snapshots, 2)
+
+		reputation, err = historyDB.ReputationSnapshots(ctx, nodeID, now.Add(-3*time.Hour), now.Add(time.Hour))
+		require.NoError(t, err)
+		require.Len(t, reputation, 2)
+	})
+}
+
+func TestMonthly(t *testing.T) {
+	nodeID, otherID := testrand.NodeID(), testrand.NodeID()
+
+	june := time.Date(2022, time.June, 10, 0, 0, 0, 0, time.UTC)
+	july := time.Date(2022, time.July, 10, 0, 0, 0, 0, time.UTC)
+
+	summaries := history.Monthly([]history.NodeSnapshot{
+		{NodeID: nodeID, CreatedAt: june, DiskUsed: 50, BandwidthUsed: 5, EstimatedPayout: 1},
+		// only the latest snapshot of the node in the month is accounted.
+		{NodeID: nodeID, CreatedAt: june.Add(time.Hour), DiskUsed: 100, BandwidthUsed: 10, EstimatedPayout: 2},
+		{NodeID: nodeID, CreatedAt: july, DiskUsed: 150, BandwidthUsed: 10, EstimatedPayout: 2},
+		{NodeID: otherID, CreatedAt: july, DiskUsed: 50, BandwidthUsed: 10, EstimatedPayout: 1},
+	})
+	require.Len(t, summaries, 2)
+
+	assert.Equal(t, history.MonthlySummary{
+		Month:           "2022-06",
+		Nodes:           1,
+		DiskUsed:        100,
+		BandwidthUsed:   10,
+		EstimatedPayout: 2,
+	}, summaries[0])
+
+	assert.Equal(t, history.MonthlySummary{
+		Month:                 "2022-07",
+		Nodes:                 2,
+		DiskUsed:              200,
+		BandwidthUsed:         20,
+		EstimatedPayout:       3,
+		DiskUsedGrowth:        1,
+		BandwidthUsedGrowth:   1,
+		EstimatedPayoutGrowth: 0.5,
+	}, summaries[1])
+
+	var buf bytes.Buffer
+	require.NoError(t, history.WriteMonthlyCSV(&buf, summaries))
+	assert.Equal(t, ""+
+		"month,nodes,diskAllocated,diskUsed,bandwidthUsed,estimatedPayout,diskUsedGrowth,bandwidthUsedGrowth,estimatedPayoutGrowth\n"+
+		"2022-06,1,0,100,10,2,0,0,0\n"+
+		"2022-07,2,0,200,20,3,1,1,0.5\n",
+		buf.String())
+}
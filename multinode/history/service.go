@@ -0,0 +1,268 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package history
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/rpc"
+	"common/storx"
+	"common/sync2"
+	"storx/multinode/nodes"
+	"storx/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for history service error.
+	Error = errs.Class("history")
+)
+
+// Config defines parameters for node state history collection.
+type Config struct {
+	Interval        time.Duration `help:"how often nodes state snapshots are collected" default:"1h0m0s"`
+	Retention       time.Duration `help:"how long collected snapshots are kept, zero keeps them forever" default:"0s"`
+	PollTimeout     time.Duration `help:"how long collecting the snapshot of a single node may take" default:"1m0s"`
+	PollConcurrency int           `help:"how many nodes are polled at the same time" default:"10"`
+}
+
+// Service periodically collects state of connected nodes and serves collected history.
+//
+// architecture: Chore
+type Service struct {
+	log     *zap.Logger
+	dialer  rpc.Dialer
+	nodes   nodes.DB
+	history DB
+	config  Config
+
+	nowFn func() time.Time
+
+	Loop *sync2.Cycle
+}
+
+// NewService creates new instance of Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB, history DB, config Config) *Service {
+	if config.PollConcurrency <= 0 {
+		config.PollConcurrency = 1
+	}
+	if config.PollTimeout <= 0 {
+		config.PollTimeout = time.Minute
+	}
+
+	return &Service{
+		log:     log,
+		dialer:  dialer,
+		nodes:   nodes,
+		history: history,
+		config:  config,
+		nowFn:   time.Now,
+		Loop:    sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs history collection.
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := service.Collect(ctx); err != nil {
+			service.log.Error("failed to collect nodes history", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Close stops history collection.
+func (service *Service) Close() error {
+	service.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) SetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// Collect takes snapshots of all reachable nodes and removes snapshots which are out of retention.
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := service.nodes.List(ctx)
+	if err != nil {
+		if nodes.ErrNoNode.Has(err) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	now := service.nowFn().UTC()
+
+	type result struct {
+		snapshot   NodeSnapshot
+		reputation []ReputationSnapshot
+		err        error
+	}
+
+	results := make([]result, len(list))
+	limiter := sync2.NewLimiter(service.config.PollConcurrency)
+	for i, node := range list {
+		i, node := i, node
+		limiter.Go(ctx, func() {
+			pollCtx, cancel := context.WithTimeout(ctx, service.config.PollTimeout)
+			defer cancel()
+
+			snapshot, reputation, err := service.poll(pollCtx, node, now)
+			results[i] = result{snapshot: snapshot, reputation: reputation, err: err}
+		})
+	}
+	limiter.Wait()
+
+	// nodes which were not polled because ctx was canceled.
+	if err := ctx.Err(); err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for i, result := range results {
+		if result.err != nil {
+			// unreachable node keeps its history, it just has a gap.
+			service.log.Warn("failed to collect node snapshot", zap.Stringer("Node ID", list[i].ID), zap.Error(result.err))
+			continue
+		}
+
+		if err := service.history.AddNodeSnapshot(ctx, result.snapshot); err != nil {
+			group.Add(err)
+			continue
+		}
+		for _, snapshot := range result.reputation {
+			group.Add(service.history.AddReputationSnapshot(ctx, snapshot))
+		}
+	}
+
+	if service.config.Retention > 0 {
+		group.Add(service.history.DeleteBefore(ctx, now.Add(-service.config.Retention)))
+	}
+
+	return Error.Wrap(group.Err())
+}
+
+// NodeSnapshots returns snapshots of all nodes taken in [from, to) range.
+func (service *Service) NodeSnapshots(ctx context.Context, from, to time.Time) (_ []NodeSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	snapshots, err := service.history.NodeSnapshots(ctx, from, to)
+	return snapshots, Error.Wrap(err)
+}
+
+// NodeSnapshotsByNode returns snapshots of the node taken in [from, to) range.
+func (service *Service) NodeSnapshotsByNode(ctx context.Context, nodeID storx.NodeID, from, to time.Time) (_ []NodeSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	snapshots, err := service.history.NodeSnapshotsByNode(ctx, nodeID, from, to)
+	return snapshots, Error.Wrap(err)
+}
+
+// ReputationSnapshots returns reputation snapshots of the node taken in [from, to) range.
+func (service *Service) ReputationSnapshots(ctx context.Context, nodeID storx.NodeID, from, to time.Time) (_ []ReputationSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	snapshots, err := service.history.ReputationSnapshots(ctx, nodeID, from, to)
+	return snapshots, Error.Wrap(err)
+}
+
+// Monthly returns month over month totals of all nodes in [from, to) range.
+func (service *Service) Monthly(ctx context.Context, from, to time.Time) (_ []MonthlySummary, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	snapshots, err := service.history.NodeSnapshots(ctx, from, to)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return Monthly(snapshots), nil
+}
+
+// poll queries node state via multinode api.
+func (service *Service) poll(ctx context.Context, node nodes.Node, now time.Time) (snapshot NodeSnapshot, reputation []ReputationSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dialer.DialNodeURL(ctx, storx.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return NodeSnapshot{}, nil, nodes.ErrNodeNotReachable.Wrap(err)
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	storageClient := multinodepb.NewDRPCStorageClient(conn)
+	bandwidthClient := multinodepb.NewDRPCBandwidthClient(conn)
+	payoutsClient := multinodepb.NewDRPCPayoutsClient(conn)
+	nodeClient := multinodepb.NewDRPCNodeClient(conn)
+
+	header := &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	}
+
+	diskSpace, err := storageClient.DiskSpace(ctx, &multinodepb.DiskSpaceRequest{Header: header})
+	if err != nil {
+		return NodeSnapshot{}, nil, err
+	}
+
+	bandwidth, err := bandwidthClient.MonthSummary(ctx, &multinodepb.BandwidthMonthSummaryRequest{Header: header})
+	if err != nil {
+		return NodeSnapshot{}, nil, err
+	}
+
+	estimated, err := payoutsClient.EstimatedPayout(ctx, &multinodepb.EstimatedPayoutRequest{Header: header})
+	if err != nil {
+		return NodeSnapshot{}, nil, err
+	}
+
+	snapshot = NodeSnapshot{
+		NodeID:          node.ID,
+		CreatedAt:       now,
+		DiskAllocated:   diskSpace.GetAllocated(),
+		DiskUsed:        diskSpace.GetUsedPieces(),
+		DiskTrash:       diskSpace.GetUsedTrash(),
+		DiskFree:        diskSpace.GetFree(),
+		BandwidthUsed:   bandwidth.GetUsed(),
+		EstimatedPayout: estimated.GetEstimatedEarnings(),
+	}
+
+	trusted, err := nodeClient.TrustedSatellites(ctx, &multinodepb.TrustedSatellitesRequest{Header: header})
+	if err != nil {
+		return NodeSnapshot{}, nil, err
+	}
+
+	for _, satellite := range trusted.TrustedSatellites {
+		resp, err := nodeClient.Reputation(ctx, &multinodepb.ReputationRequest{
+			Header:      header,
+			SatelliteId: satellite.NodeId,
+		})
+		if err != nil {
+			return NodeSnapshot{}, nil, err
+		}
+
+		reputation = append(reputation, ReputationSnapshot{
+			NodeID:          node.ID,
+			SatelliteID:     satellite.NodeId,
+			CreatedAt:       now,
+			AuditScore:      resp.Audit.GetScore(),
+			SuspensionScore: resp.Audit.GetSuspensionScore(),
+			OnlineScore:     resp.Online.GetScore(),
+		})
+	}
+
+	return snapshot, reputation, nil
+}
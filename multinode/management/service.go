@@ -0,0 +1,265 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package management
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/rpc"
+	"common/rpc/rpcstatus"
+	"common/storx"
+	"storx/multinode/nodes"
+	"storx/private/multinodepb"
+)
+
+var (
+	mon = monkit.Package()
+
+	// Error is an error class for management service error.
+	Error = errs.Class("management")
+	// ErrInvalidArgument is an error class that indicates that node rejected the action arguments.
+	ErrInvalidArgument = errs.Class("invalid argument")
+	// ErrNotFound is an error class that indicates that node has no entity the action refers to.
+	ErrNotFound = errs.Class("not found")
+)
+
+// ExitProgress contains initial graceful exit state returned by the node.
+type ExitProgress struct {
+	SatelliteID     storx.NodeID `json:"satelliteId"`
+	DomainName      string       `json:"domainName"`
+	PercentComplete float32      `json:"percentComplete"`
+	Successful      bool         `json:"successful"`
+}
+
+// SatelliteMaintenance is a planned downtime window as accepted by a single satellite.
+type SatelliteMaintenance struct {
+	SatelliteID storx.NodeID `json:"satelliteId"`
+	Start       time.Time    `json:"start"`
+	End         time.Time    `json:"end"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// Service performs management actions on connected nodes remotely.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	nodes  nodes.DB
+}
+
+// NewService creates new instance of management Service.
+func NewService(log *zap.Logger, dialer rpc.Dialer, nodes nodes.DB) *Service {
+	return &Service{
+		log:    log,
+		dialer: dialer,
+		nodes:  nodes,
+	}
+}
+
+// InitiateGracefulExit starts graceful exit of the node from specific satellite.
+func (service *Service) InitiateGracefulExit(ctx context.Context, nodeID, satelliteID storx.NodeID) (progress ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		resp, err := client.InitiateGracefulExit(ctx, &multinodepb.InitiateGracefulExitRequest{
+			Header:      header,
+			SatelliteId: satelliteID,
+		})
+		if err != nil {
+			return err
+		}
+
+		progress = ExitProgress{
+			SatelliteID:     resp.NodeId,
+			DomainName:      resp.DomainName,
+			PercentComplete: resp.PercentComplete,
+			Successful:      resp.Successful,
+		}
+		return nil
+	})
+	if err != nil {
+		return ExitProgress{}, err
+	}
+
+	service.log.Info("graceful exit initiated",
+		zap.Stringer("Node ID", nodeID), zap.Stringer("Satellite ID", satelliteID))
+
+	return progress, nil
+}
+
+// SetAllocatedDiskSpace changes disk space allocated by the node, returns applied allocation.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, nodeID storx.NodeID, allocated int64) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		resp, err := client.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+			Header:    header,
+			Allocated: allocated,
+		})
+		if err != nil {
+			return err
+		}
+
+		allocated = resp.Allocated
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return allocated, nil
+}
+
+// TrustExclusions returns trust exclusions added to the node remotely.
+func (service *Service) TrustExclusions(ctx context.Context, nodeID storx.NodeID) (exclusions []string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		resp, err := client.TrustExclusions(ctx, &multinodepb.TrustExclusionsRequest{
+			Header: header,
+		})
+		if err != nil {
+			return err
+		}
+
+		exclusions = resp.Exclusions
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return exclusions, nil
+}
+
+// AddTrustExclusion excludes satellites matching the rule from the ones trusted by the node.
+// Accepted forms are a satellite id followed by '@', a hostname or a full satellite url.
+func (service *Service) AddTrustExclusion(ctx context.Context, nodeID storx.NodeID, exclusion string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		_, err := client.AddTrustExclusion(ctx, &multinodepb.AddTrustExclusionRequest{
+			Header:    header,
+			Exclusion: exclusion,
+		})
+		return err
+	})
+}
+
+// RemoveTrustExclusion removes trust exclusion previously added to the node.
+func (service *Service) RemoveTrustExclusion(ctx context.Context, nodeID storx.NodeID, exclusion string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		_, err := client.RemoveTrustExclusion(ctx, &multinodepb.RemoveTrustExclusionRequest{
+			Header:    header,
+			Exclusion: exclusion,
+		})
+		return err
+	})
+}
+
+// RescanUsedSpace schedules recalculation of used space on the node.
+func (service *Service) RescanUsedSpace(ctx context.Context, nodeID storx.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		_, err := client.RescanUsedSpace(ctx, &multinodepb.RescanUsedSpaceRequest{
+			Header: header,
+		})
+		return err
+	})
+}
+
+// AnnounceMaintenance announces planned downtime of the node in [start, end) range to its trusted satellites.
+func (service *Service) AnnounceMaintenance(ctx context.Context, nodeID storx.NodeID, start, end time.Time) (satellites []SatelliteMaintenance, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		resp, err := client.AnnounceMaintenance(ctx, &multinodepb.AnnounceMaintenanceRequest{
+			Header: header,
+			Start:  start,
+			End:    end,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, satellite := range resp.Satellites {
+			satellites = append(satellites, SatelliteMaintenance{
+				SatelliteID: satellite.SatelliteId,
+				Start:       satellite.Start,
+				End:         satellite.End,
+				Error:       satellite.Error,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	service.log.Info("maintenance announced",
+		zap.Stringer("Node ID", nodeID), zap.Time("Start", start), zap.Time("End", end))
+
+	return satellites, nil
+}
+
+// CancelMaintenance cancels planned downtime previously announced by the node.
+func (service *Service) CancelMaintenance(ctx context.Context, nodeID storx.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return service.dial(ctx, nodeID, func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error {
+		_, err := client.CancelMaintenance(ctx, &multinodepb.CancelMaintenanceRequest{
+			Header: header,
+		})
+		return err
+	})
+}
+
+// dial connects to the node and calls fn with management client,
+// errors returned by the node are mapped to the service error classes.
+func (service *Service) dial(ctx context.Context, nodeID storx.NodeID, fn func(client multinodepb.DRPCManagementClient, header *multinodepb.RequestHeader) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.nodes.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.dialer.DialNodeURL(ctx, storx.NodeURL{
+		ID:      node.ID,
+		Address: node.PublicAddress,
+	})
+	if err != nil {
+		return Error.Wrap(nodes.ErrNodeNotReachable.Wrap(err))
+	}
+	defer func() {
+		err = errs.Combine(err, conn.Close())
+	}()
+
+	err = fn(multinodepb.NewDRPCManagementClient(conn), &multinodepb.RequestHeader{
+		ApiKey: node.APISecret[:],
+	})
+	if err == nil {
+		return nil
+	}
+
+	switch rpcstatus.Code(err) {
+	case rpcstatus.Unauthenticated:
+		return Error.Wrap(nodes.ErrNodeAPIKeyInvalid.Wrap(err))
+	case rpcstatus.InvalidArgument:
+		return Error.Wrap(ErrInvalidArgument.Wrap(err))
+	case rpcstatus.NotFound:
+		return Error.Wrap(ErrNotFound.Wrap(err))
+	default:
+		return Error.Wrap(err)
+	}
+}
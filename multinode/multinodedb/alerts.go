@@ -0,0 +1,213 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/storx"
+	"storx/multinode/alerts"
+	"storx/multinode/multinodedb/dbx"
+)
+
+// ErrAlertsDB indicates about internal AlertsDB error.
+var ErrAlertsDB = errs.Class("AlertsDB")
+
+// ensures that alertsdb implements alerts.DB.
+var _ alerts.DB = (*alertsdb)(nil)
+
+// alertsdb exposes needed by MND AlertsDB functionality.
+//
+// architecture: Database
+type alertsdb struct {
+	methods dbx.Methods
+}
+
+// List returns all active alerts, most recent first.
+func (a *alertsdb) List(ctx context.Context) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlerts, err := a.methods.All_Alert_OrderBy_Desc_TriggeredAt(ctx)
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	return fromDBXAlerts(dbxAlerts)
+}
+
+// ListByNode returns all active alerts of the node.
+func (a *alertsdb) ListByNode(ctx context.Context, nodeID storx.NodeID) (_ []alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlerts, err := a.methods.All_Alert_By_NodeId(ctx, dbx.Alert_NodeId(nodeID.Bytes()))
+	if err != nil {
+		return nil, ErrAlertsDB.Wrap(err)
+	}
+
+	return fromDBXAlerts(dbxAlerts)
+}
+
+// Get returns alert of specified kind raised for the node.
+func (a *alertsdb) Get(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind) (_ alerts.Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlert, err := a.methods.Get_Alert_By_NodeId_And_Kind(ctx,
+		dbx.Alert_NodeId(nodeID.Bytes()),
+		dbx.Alert_Kind(string(kind)),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return alerts.Alert{}, alerts.ErrNoAlert.Wrap(err)
+		}
+		return alerts.Alert{}, ErrAlertsDB.Wrap(err)
+	}
+
+	alert, err := fromDBXAlert(dbxAlert)
+	return alert, ErrAlertsDB.Wrap(err)
+}
+
+// Add stores new active alert.
+func (a *alertsdb) Add(ctx context.Context, alert alerts.Alert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.methods.Create_Alert(ctx,
+		dbx.Alert_NodeId(alert.NodeID.Bytes()),
+		dbx.Alert_Kind(string(alert.Kind)),
+		dbx.Alert_Message(alert.Message),
+		dbx.Alert_TriggeredAt(alert.TriggeredAt.UTC()),
+		dbx.Alert_Create_Fields{
+			AcknowledgedAt: dbx.Alert_AcknowledgedAt_Raw(alert.AcknowledgedAt),
+			SilencedUntil:  dbx.Alert_SilencedUntil_Raw(alert.SilencedUntil),
+			NotifiedAt:     dbx.Alert_NotifiedAt_Raw(alert.NotifiedAt),
+		},
+	)
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// UpdateMessage updates description of the active alert.
+func (a *alertsdb) UpdateMessage(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind, message string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return a.update(ctx, nodeID, kind, dbx.Alert_Update_Fields{
+		Message: dbx.Alert_Message(message),
+	})
+}
+
+// Acknowledge marks alert as acknowledged by the operator.
+func (a *alertsdb) Acknowledge(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind, acknowledgedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return a.update(ctx, nodeID, kind, dbx.Alert_Update_Fields{
+		AcknowledgedAt: dbx.Alert_AcknowledgedAt(acknowledgedAt.UTC()),
+	})
+}
+
+// Silence mutes notifications of the alert until specified time.
+func (a *alertsdb) Silence(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind, until time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return a.update(ctx, nodeID, kind, dbx.Alert_Update_Fields{
+		SilencedUntil: dbx.Alert_SilencedUntil(until.UTC()),
+	})
+}
+
+// MarkNotified saves time of the last notification sent for the alert via all channels.
+func (a *alertsdb) MarkNotified(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind, notifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return a.update(ctx, nodeID, kind, dbx.Alert_Update_Fields{
+		NotifiedAt:       dbx.Alert_NotifiedAt(notifiedAt.UTC()),
+		NotifiedChannels: dbx.Alert_NotifiedChannels_Null(),
+	})
+}
+
+// MarkChannelsNotified saves channels the pending notification of the alert was delivered to.
+func (a *alertsdb) MarkChannelsNotified(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind, channels []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	notifiedChannels := dbx.Alert_NotifiedChannels_Null()
+	if len(channels) > 0 {
+		notifiedChannels = dbx.Alert_NotifiedChannels(strings.Join(channels, ","))
+	}
+
+	return a.update(ctx, nodeID, kind, dbx.Alert_Update_Fields{
+		NotifiedChannels: notifiedChannels,
+	})
+}
+
+// Remove removes alert, e.g. when condition is resolved.
+func (a *alertsdb) Remove(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.methods.Delete_Alert_By_NodeId_And_Kind(ctx,
+		dbx.Alert_NodeId(nodeID.Bytes()),
+		dbx.Alert_Kind(string(kind)),
+	)
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// RemoveByNode removes all alerts of the node.
+func (a *alertsdb) RemoveByNode(ctx context.Context, nodeID storx.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = a.methods.Delete_Alert_By_NodeId(ctx, dbx.Alert_NodeId(nodeID.Bytes()))
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// update updates alert fields.
+func (a *alertsdb) update(ctx context.Context, nodeID storx.NodeID, kind alerts.Kind, fields dbx.Alert_Update_Fields) error {
+	err := a.methods.UpdateNoReturn_Alert_By_NodeId_And_Kind(ctx,
+		dbx.Alert_NodeId(nodeID.Bytes()),
+		dbx.Alert_Kind(string(kind)),
+		fields,
+	)
+
+	return ErrAlertsDB.Wrap(err)
+}
+
+// fromDBXAlerts converts slice of dbx.Alert to alerts.Alert.
+func fromDBXAlerts(dbxAlerts []*dbx.Alert) ([]alerts.Alert, error) {
+	list := make([]alerts.Alert, 0, len(dbxAlerts))
+	for _, dbxAlert := range dbxAlerts {
+		alert, err := fromDBXAlert(dbxAlert)
+		if err != nil {
+			return nil, ErrAlertsDB.Wrap(err)
+		}
+		list = append(list, alert)
+	}
+	return list, nil
+}
+
+// fromDBXAlert converts dbx.Alert to alerts.Alert.
+func fromDBXAlert(dbxAlert *dbx.Alert) (alerts.Alert, error) {
+	nodeID, err := storx.NodeIDFromBytes(dbxAlert.NodeId)
+	if err != nil {
+		return alerts.Alert{}, err
+	}
+
+	alert := alerts.Alert{
+		NodeID:         nodeID,
+		Kind:           alerts.Kind(dbxAlert.Kind),
+		Message:        dbxAlert.Message,
+		TriggeredAt:    dbxAlert.TriggeredAt,
+		AcknowledgedAt: dbxAlert.AcknowledgedAt,
+		SilencedUntil:  dbxAlert.SilencedUntil,
+		NotifiedAt:     dbxAlert.NotifiedAt,
+	}
+
+	if dbxAlert.NotifiedChannels != nil && *dbxAlert.NotifiedChannels != "" {
+		alert.NotifiedChannels = strings.Split(*dbxAlert.NotifiedChannels, ",")
+	}
+
+	return alert, nil
+}
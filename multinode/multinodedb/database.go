@@ -15,6 +15,8 @@ import (
 	"private/dbutil/pgutil"
 	"private/tagsql"
 	"storx/multinode"
+	"storx/multinode/alerts"
+	"storx/multinode/history"
 	"storx/multinode/multinodedb/dbx"
 	"storx/multinode/nodes"
 	"storx/private/migrate"
@@ -94,6 +96,20 @@ func (db *DB) Nodes() nodes.DB {
 	}
 }
 
+// Alerts returns alerts database.
+func (db *DB) Alerts() alerts.DB {
+	return &alertsdb{
+		methods: db,
+	}
+}
+
+// History returns node state history database.
+func (db *DB) History() history.DB {
+	return &historydb{
+		methods: db,
+	}
+}
+
 // MigrateToLatest migrates db to the latest version.
 func (db DB) MigrateToLatest(ctx context.Context) error {
 	var migration *migrate.Migration
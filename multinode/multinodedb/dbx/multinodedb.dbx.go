@@ -275,13 +275,46 @@ func newpgx(db *DB) *pgxDB {
 }
 
 func (obj *pgxDB) Schema() string {
-	return `CREATE TABLE nodes (
+	return `CREATE TABLE alerts (
+	node_id bytea NOT NULL,
+	kind text NOT NULL,
+	message text NOT NULL,
+	triggered_at timestamp with time zone NOT NULL,
+	acknowledged_at timestamp with time zone,
+	silenced_until timestamp with time zone,
+	notified_at timestamp with time zone,
+	notified_channels text,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE node_snapshots (
+	node_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	disk_allocated bigint NOT NULL,
+	disk_used bigint NOT NULL,
+	disk_trash bigint NOT NULL,
+	disk_free bigint NOT NULL,
+	bandwidth_used bigint NOT NULL,
+	estimated_payout bigint NOT NULL,
+	PRIMARY KEY ( node_id, created_at )
+);
+CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
 	public_address text NOT NULL,
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
-);`
+);
+CREATE TABLE reputation_snapshots (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, created_at )
+);
+CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at ) ;
+CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at ) ;`
 }
 
 func (obj *pgxDB) wrapTx(tx tagsql.Tx) txMethods {
@@ -345,13 +378,46 @@ func newsqlite3(db *DB) *sqlite3DB {
 }
 
 func (obj *sqlite3DB) Schema() string {
-	return `CREATE TABLE nodes (
+	return `CREATE TABLE alerts (
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	message TEXT NOT NULL,
+	triggered_at TIMESTAMP NOT NULL,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	notified_channels TEXT,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE node_snapshots (
+	node_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	disk_allocated INTEGER NOT NULL,
+	disk_used INTEGER NOT NULL,
+	disk_trash INTEGER NOT NULL,
+	disk_free INTEGER NOT NULL,
+	bandwidth_used INTEGER NOT NULL,
+	estimated_payout INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, created_at )
+);
+CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
 	public_address TEXT NOT NULL,
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
-);`
+);
+CREATE TABLE reputation_snapshots (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	online_score REAL NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, created_at )
+);
+CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at ) ;
+CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at ) ;`
 }
 
 func (obj *sqlite3DB) wrapTx(tx tagsql.Tx) txMethods {
@@ -414,6 +480,238 @@ nextval:
 	fmt.Fprint(f, "]")
 }
 
+type Alert struct {
+	NodeId           []byte
+	Kind             string
+	Message          string
+	TriggeredAt      time.Time
+	AcknowledgedAt   *time.Time
+	SilencedUntil    *time.Time
+	NotifiedAt       *time.Time
+	NotifiedChannels *string
+}
+
+func (Alert) _Table() string { return "alerts" }
+
+type Alert_Create_Fields struct {
+	AcknowledgedAt   Alert_AcknowledgedAt_Field
+	SilencedUntil    Alert_SilencedUntil_Field
+	NotifiedAt       Alert_NotifiedAt_Field
+	NotifiedChannels Alert_NotifiedChannels_Field
+}
+
+type Alert_Update_Fields struct {
+	Message          Alert_Message_Field
+	AcknowledgedAt   Alert_AcknowledgedAt_Field
+	SilencedUntil    Alert_SilencedUntil_Field
+	NotifiedAt       Alert_NotifiedAt_Field
+	NotifiedChannels Alert_NotifiedChannels_Field
+}
+
+type Alert_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Alert_NodeId(v []byte) Alert_NodeId_Field {
+	return Alert_NodeId_Field{_set: true, _value: v}
+}
+
+func (f Alert_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_NodeId_Field) _Column() string { return "node_id" }
+
+type Alert_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Kind(v string) Alert_Kind_Field {
+	return Alert_Kind_Field{_set: true, _value: v}
+}
+
+func (f Alert_Kind_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Kind_Field) _Column() string { return "kind" }
+
+type Alert_Message_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func Alert_Message(v string) Alert_Message_Field {
+	return Alert_Message_Field{_set: true, _value: v}
+}
+
+func (f Alert_Message_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_Message_Field) _Column() string { return "message" }
+
+type Alert_TriggeredAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func Alert_TriggeredAt(v time.Time) Alert_TriggeredAt_Field {
+	return Alert_TriggeredAt_Field{_set: true, _value: v}
+}
+
+func (f Alert_TriggeredAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_TriggeredAt_Field) _Column() string { return "triggered_at" }
+
+type Alert_AcknowledgedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_AcknowledgedAt(v time.Time) Alert_AcknowledgedAt_Field {
+	return Alert_AcknowledgedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_AcknowledgedAt_Raw(v *time.Time) Alert_AcknowledgedAt_Field {
+	if v == nil {
+		return Alert_AcknowledgedAt_Null()
+	}
+	return Alert_AcknowledgedAt(*v)
+}
+
+func Alert_AcknowledgedAt_Null() Alert_AcknowledgedAt_Field {
+	return Alert_AcknowledgedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_AcknowledgedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_AcknowledgedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_AcknowledgedAt_Field) _Column() string { return "acknowledged_at" }
+
+type Alert_SilencedUntil_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_SilencedUntil(v time.Time) Alert_SilencedUntil_Field {
+	return Alert_SilencedUntil_Field{_set: true, _value: &v}
+}
+
+func Alert_SilencedUntil_Raw(v *time.Time) Alert_SilencedUntil_Field {
+	if v == nil {
+		return Alert_SilencedUntil_Null()
+	}
+	return Alert_SilencedUntil(*v)
+}
+
+func Alert_SilencedUntil_Null() Alert_SilencedUntil_Field {
+	return Alert_SilencedUntil_Field{_set: true, _null: true}
+}
+
+func (f Alert_SilencedUntil_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_SilencedUntil_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_SilencedUntil_Field) _Column() string { return "silenced_until" }
+
+type Alert_NotifiedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Alert_NotifiedAt(v time.Time) Alert_NotifiedAt_Field {
+	return Alert_NotifiedAt_Field{_set: true, _value: &v}
+}
+
+func Alert_NotifiedAt_Raw(v *time.Time) Alert_NotifiedAt_Field {
+	if v == nil {
+		return Alert_NotifiedAt_Null()
+	}
+	return Alert_NotifiedAt(*v)
+}
+
+func Alert_NotifiedAt_Null() Alert_NotifiedAt_Field {
+	return Alert_NotifiedAt_Field{_set: true, _null: true}
+}
+
+func (f Alert_NotifiedAt_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_NotifiedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_NotifiedAt_Field) _Column() string { return "notified_at" }
+
+type Alert_NotifiedChannels_Field struct {
+	_set   bool
+	_null  bool
+	_value *string
+}
+
+func Alert_NotifiedChannels(v string) Alert_NotifiedChannels_Field {
+	return Alert_NotifiedChannels_Field{_set: true, _value: &v}
+}
+
+func Alert_NotifiedChannels_Raw(v *string) Alert_NotifiedChannels_Field {
+	if v == nil {
+		return Alert_NotifiedChannels_Null()
+	}
+	return Alert_NotifiedChannels(*v)
+}
+
+func Alert_NotifiedChannels_Null() Alert_NotifiedChannels_Field {
+	return Alert_NotifiedChannels_Field{_set: true, _null: true}
+}
+
+func (f Alert_NotifiedChannels_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Alert_NotifiedChannels_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Alert_NotifiedChannels_Field) _Column() string { return "notified_channels" }
+
 type Node struct {
 	Id            []byte
 	Name          string
@@ -503,116 +801,412 @@ func (f Node_ApiSecret_Field) value() interface{} {
 
 func (Node_ApiSecret_Field) _Column() string { return "api_secret" }
 
-func toUTC(t time.Time) time.Time {
-	return t.UTC()
+type NodeSnapshot struct {
+	NodeId          []byte
+	CreatedAt       time.Time
+	DiskAllocated   int64
+	DiskUsed        int64
+	DiskTrash       int64
+	DiskFree        int64
+	BandwidthUsed   int64
+	EstimatedPayout int64
 }
 
-func toDate(t time.Time) time.Time {
-	// keep up the minute portion so that translations between timezones will
-	// continue to reflect properly.
-	return t.Truncate(time.Minute)
-}
+func (NodeSnapshot) _Table() string { return "node_snapshots" }
 
-//
-// runtime support for building sql statements
-//
+type NodeSnapshot_Update_Fields struct {
+}
 
-type __sqlbundle_SQL interface {
-	Render() string
+type NodeSnapshot_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
 
-	private()
+func NodeSnapshot_NodeId(v []byte) NodeSnapshot_NodeId_Field {
+	return NodeSnapshot_NodeId_Field{_set: true, _value: v}
 }
 
-type __sqlbundle_Dialect interface {
-	Rebind(sql string) string
+func (f NodeSnapshot_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type __sqlbundle_RenderOp int
+func (NodeSnapshot_NodeId_Field) _Column() string { return "node_id" }
 
-const (
-	__sqlbundle_NoFlatten __sqlbundle_RenderOp = iota
-	__sqlbundle_NoTerminate
-)
+type NodeSnapshot_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
 
-func __sqlbundle_Render(dialect __sqlbundle_Dialect, sql __sqlbundle_SQL, ops ...__sqlbundle_RenderOp) string {
-	out := sql.Render()
+func NodeSnapshot_CreatedAt(v time.Time) NodeSnapshot_CreatedAt_Field {
+	return NodeSnapshot_CreatedAt_Field{_set: true, _value: v}
+}
 
-	flatten := true
-	terminate := true
-	for _, op := range ops {
-		switch op {
-		case __sqlbundle_NoFlatten:
-			flatten = false
-		case __sqlbundle_NoTerminate:
-			terminate = false
-		}
+func (f NodeSnapshot_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
+	return f._value
+}
 
-	if flatten {
-		out = __sqlbundle_flattenSQL(out)
-	}
-	if terminate {
-		out += ";"
-	}
+func (NodeSnapshot_CreatedAt_Field) _Column() string { return "created_at" }
 
-	return dialect.Rebind(out)
+type NodeSnapshot_DiskAllocated_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
 }
 
-func __sqlbundle_flattenSQL(x string) string {
-	// trim whitespace from beginning and end
-	s, e := 0, len(x)-1
-	for s < len(x) && (x[s] == ' ' || x[s] == '\t' || x[s] == '\n') {
-		s++
-	}
-	for s <= e && (x[e] == ' ' || x[e] == '\t' || x[e] == '\n') {
-		e--
-	}
-	if s > e {
-		return ""
+func NodeSnapshot_DiskAllocated(v int64) NodeSnapshot_DiskAllocated_Field {
+	return NodeSnapshot_DiskAllocated_Field{_set: true, _value: v}
+}
+
+func (f NodeSnapshot_DiskAllocated_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
 	}
-	x = x[s : e+1]
+	return f._value
+}
 
-	// check for whitespace that needs fixing
-	wasSpace := false
-	for i := 0; i < len(x); i++ {
-		r := x[i]
-		justSpace := r == ' '
-		if (wasSpace && justSpace) || r == '\t' || r == '\n' {
-			// whitespace detected, start writing a new string
-			var result strings.Builder
-			result.Grow(len(x))
-			if wasSpace {
-				result.WriteString(x[:i-1])
-			} else {
-				result.WriteString(x[:i])
-			}
-			for p := i; p < len(x); p++ {
-				for p < len(x) && (x[p] == ' ' || x[p] == '\t' || x[p] == '\n') {
-					p++
-				}
-				result.WriteByte(' ')
+func (NodeSnapshot_DiskAllocated_Field) _Column() string { return "disk_allocated" }
 
-				start := p
-				for p < len(x) && !(x[p] == ' ' || x[p] == '\t' || x[p] == '\n') {
-					p++
-				}
-				result.WriteString(x[start:p])
-			}
+type NodeSnapshot_DiskUsed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
 
-			return result.String()
-		}
-		wasSpace = justSpace
-	}
+func NodeSnapshot_DiskUsed(v int64) NodeSnapshot_DiskUsed_Field {
+	return NodeSnapshot_DiskUsed_Field{_set: true, _value: v}
+}
 
-	// no problematic whitespace found
-	return x
+func (f NodeSnapshot_DiskUsed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-// this type is specially named to match up with the name returned by the
-// dialect impl in the sql package.
-type __sqlbundle_postgres struct{}
+func (NodeSnapshot_DiskUsed_Field) _Column() string { return "disk_used" }
 
-func (p __sqlbundle_postgres) Rebind(sql string) string {
+type NodeSnapshot_DiskTrash_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSnapshot_DiskTrash(v int64) NodeSnapshot_DiskTrash_Field {
+	return NodeSnapshot_DiskTrash_Field{_set: true, _value: v}
+}
+
+func (f NodeSnapshot_DiskTrash_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSnapshot_DiskTrash_Field) _Column() string { return "disk_trash" }
+
+type NodeSnapshot_DiskFree_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSnapshot_DiskFree(v int64) NodeSnapshot_DiskFree_Field {
+	return NodeSnapshot_DiskFree_Field{_set: true, _value: v}
+}
+
+func (f NodeSnapshot_DiskFree_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSnapshot_DiskFree_Field) _Column() string { return "disk_free" }
+
+type NodeSnapshot_BandwidthUsed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSnapshot_BandwidthUsed(v int64) NodeSnapshot_BandwidthUsed_Field {
+	return NodeSnapshot_BandwidthUsed_Field{_set: true, _value: v}
+}
+
+func (f NodeSnapshot_BandwidthUsed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSnapshot_BandwidthUsed_Field) _Column() string { return "bandwidth_used" }
+
+type NodeSnapshot_EstimatedPayout_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeSnapshot_EstimatedPayout(v int64) NodeSnapshot_EstimatedPayout_Field {
+	return NodeSnapshot_EstimatedPayout_Field{_set: true, _value: v}
+}
+
+func (f NodeSnapshot_EstimatedPayout_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (NodeSnapshot_EstimatedPayout_Field) _Column() string { return "estimated_payout" }
+
+type ReputationSnapshot struct {
+	NodeId          []byte
+	SatelliteId     []byte
+	CreatedAt       time.Time
+	AuditScore      float64
+	SuspensionScore float64
+	OnlineScore     float64
+}
+
+func (ReputationSnapshot) _Table() string { return "reputation_snapshots" }
+
+type ReputationSnapshot_Update_Fields struct {
+}
+
+type ReputationSnapshot_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReputationSnapshot_NodeId(v []byte) ReputationSnapshot_NodeId_Field {
+	return ReputationSnapshot_NodeId_Field{_set: true, _value: v}
+}
+
+func (f ReputationSnapshot_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ReputationSnapshot_NodeId_Field) _Column() string { return "node_id" }
+
+type ReputationSnapshot_SatelliteId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ReputationSnapshot_SatelliteId(v []byte) ReputationSnapshot_SatelliteId_Field {
+	return ReputationSnapshot_SatelliteId_Field{_set: true, _value: v}
+}
+
+func (f ReputationSnapshot_SatelliteId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ReputationSnapshot_SatelliteId_Field) _Column() string { return "satellite_id" }
+
+type ReputationSnapshot_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ReputationSnapshot_CreatedAt(v time.Time) ReputationSnapshot_CreatedAt_Field {
+	return ReputationSnapshot_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ReputationSnapshot_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ReputationSnapshot_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ReputationSnapshot_AuditScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func ReputationSnapshot_AuditScore(v float64) ReputationSnapshot_AuditScore_Field {
+	return ReputationSnapshot_AuditScore_Field{_set: true, _value: v}
+}
+
+func (f ReputationSnapshot_AuditScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ReputationSnapshot_AuditScore_Field) _Column() string { return "audit_score" }
+
+type ReputationSnapshot_SuspensionScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func ReputationSnapshot_SuspensionScore(v float64) ReputationSnapshot_SuspensionScore_Field {
+	return ReputationSnapshot_SuspensionScore_Field{_set: true, _value: v}
+}
+
+func (f ReputationSnapshot_SuspensionScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ReputationSnapshot_SuspensionScore_Field) _Column() string { return "suspension_score" }
+
+type ReputationSnapshot_OnlineScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func ReputationSnapshot_OnlineScore(v float64) ReputationSnapshot_OnlineScore_Field {
+	return ReputationSnapshot_OnlineScore_Field{_set: true, _value: v}
+}
+
+func (f ReputationSnapshot_OnlineScore_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ReputationSnapshot_OnlineScore_Field) _Column() string { return "online_score" }
+
+func toUTC(t time.Time) time.Time {
+	return t.UTC()
+}
+
+func toDate(t time.Time) time.Time {
+	// keep up the minute portion so that translations between timezones will
+	// continue to reflect properly.
+	return t.Truncate(time.Minute)
+}
+
+//
+// runtime support for building sql statements
+//
+
+type __sqlbundle_SQL interface {
+	Render() string
+
+	private()
+}
+
+type __sqlbundle_Dialect interface {
+	Rebind(sql string) string
+}
+
+type __sqlbundle_RenderOp int
+
+const (
+	__sqlbundle_NoFlatten __sqlbundle_RenderOp = iota
+	__sqlbundle_NoTerminate
+)
+
+func __sqlbundle_Render(dialect __sqlbundle_Dialect, sql __sqlbundle_SQL, ops ...__sqlbundle_RenderOp) string {
+	out := sql.Render()
+
+	flatten := true
+	terminate := true
+	for _, op := range ops {
+		switch op {
+		case __sqlbundle_NoFlatten:
+			flatten = false
+		case __sqlbundle_NoTerminate:
+			terminate = false
+		}
+	}
+
+	if flatten {
+		out = __sqlbundle_flattenSQL(out)
+	}
+	if terminate {
+		out += ";"
+	}
+
+	return dialect.Rebind(out)
+}
+
+func __sqlbundle_flattenSQL(x string) string {
+	// trim whitespace from beginning and end
+	s, e := 0, len(x)-1
+	for s < len(x) && (x[s] == ' ' || x[s] == '\t' || x[s] == '\n') {
+		s++
+	}
+	for s <= e && (x[e] == ' ' || x[e] == '\t' || x[e] == '\n') {
+		e--
+	}
+	if s > e {
+		return ""
+	}
+	x = x[s : e+1]
+
+	// check for whitespace that needs fixing
+	wasSpace := false
+	for i := 0; i < len(x); i++ {
+		r := x[i]
+		justSpace := r == ' '
+		if (wasSpace && justSpace) || r == '\t' || r == '\n' {
+			// whitespace detected, start writing a new string
+			var result strings.Builder
+			result.Grow(len(x))
+			if wasSpace {
+				result.WriteString(x[:i-1])
+			} else {
+				result.WriteString(x[:i])
+			}
+			for p := i; p < len(x); p++ {
+				for p < len(x) && (x[p] == ' ' || x[p] == '\t' || x[p] == '\n') {
+					p++
+				}
+				result.WriteByte(' ')
+
+				start := p
+				for p < len(x) && !(x[p] == ' ' || x[p] == '\t' || x[p] == '\n') {
+					p++
+				}
+				result.WriteString(x[start:p])
+			}
+
+			return result.String()
+		}
+		wasSpace = justSpace
+	}
+
+	// no problematic whitespace found
+	return x
+}
+
+// this type is specially named to match up with the name returned by the
+// dialect impl in the sql package.
+type __sqlbundle_postgres struct{}
+
+func (p __sqlbundle_postgres) Rebind(sql string) string {
 	type sqlParseState int
 	const (
 		sqlParseStart sqlParseState = iota
@@ -919,11 +1513,778 @@ func (h *__sqlbundle_Hole) Render() string {
 	return h.SQL.Render()
 }
 
-//
-// end runtime support for building sql statements
-//
+//
+// end runtime support for building sql statements
+//
+
+func (obj *pgxImpl) Create_Node(ctx context.Context,
+	node_id Node_Id_Field,
+	node_name Node_Name_Field,
+	node_public_address Node_PublicAddress_Field,
+	node_api_secret Node_ApiSecret_Field) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	__id_val := node_id.value()
+	__name_val := node_name.value()
+	__public_address_val := node_public_address.value()
+	__api_secret_val := node_api_secret.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, name, public_address, api_secret ) VALUES ( ?, ?, ?, ? ) RETURNING nodes.id, nodes.name, nodes.public_address, nodes.api_secret")
+
+	var __values []interface{}
+	__values = append(__values, __id_val, __name_val, __public_address_val, __api_secret_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	if err != nil {
+		return (*Node)(nil), obj.makeErr(err)
+	}
+	return node, nil
+
+}
+
+func (obj *pgxImpl) Count_Node(ctx context.Context) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM nodes")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&count)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) All_Node(ctx context.Context) (
+	rows []*Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node := &Node{}
+		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Limited_Node(ctx context.Context,
+	limit int, offset int64) (
+	rows []*Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes LIMIT ? OFFSET ?")
+
+	var __values []interface{}
+
+	__values = append(__values, limit, offset)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node := &Node{}
+		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	node *Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.name, nodes.public_address, nodes.api_secret")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	node = &Node{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return node, nil
+}
+
+func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field,
+	update Node_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Name._set {
+		__values = append(__values, update.Name.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxImpl) Delete_Node_By_Id(ctx context.Context,
+	node_id Node_Id_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+
+	var __values []interface{}
+	__values = append(__values, node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Create_Alert(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	alert_message Alert_Message_Field,
+	alert_triggered_at Alert_TriggeredAt_Field,
+	optional Alert_Create_Fields) (
+	alert *Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := alert_node_id.value()
+	__kind_val := alert_kind.value()
+	__message_val := alert_message.value()
+	__triggered_at_val := alert_triggered_at.value()
+	__acknowledged_at_val := optional.AcknowledgedAt.value()
+	__silenced_until_val := optional.SilencedUntil.value()
+	__notified_at_val := optional.NotifiedAt.value()
+	__notified_channels_val := optional.NotifiedChannels.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO alerts ( node_id, kind, message, triggered_at, acknowledged_at, silenced_until, notified_at, notified_channels ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __kind_val, __message_val, __triggered_at_val, __acknowledged_at_val, __silenced_until_val, __notified_at_val, __notified_channels_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return alert, nil
+
+}
+
+func (obj *pgxImpl) Delete_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field) (
+	deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM alerts WHERE alerts.node_id = ? AND alerts.kind = ?")
+
+	var __values []interface{}
+	__values = append(__values, alert_node_id.value(), alert_kind.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
+	}
+
+	return __count > 0, nil
+
+}
+
+func (obj *pgxImpl) Delete_Alert_By_NodeId(ctx context.Context,
+	alert_node_id Alert_NodeId_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM alerts WHERE alerts.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, alert_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) Get_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field) (
+	alert *Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts WHERE alerts.node_id = ? AND alerts.kind = ?")
+
+	var __values []interface{}
+	__values = append(__values, alert_node_id.value(), alert_kind.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+	if err != nil {
+		return (*Alert)(nil), obj.makeErr(err)
+	}
+	return alert, nil
+
+}
+
+func (obj *pgxImpl) All_Alert_OrderBy_Desc_TriggeredAt(ctx context.Context) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts ORDER BY alerts.triggered_at DESC")
+
+	var __values []interface{}
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) All_Alert_By_NodeId(ctx context.Context,
+	alert_node_id Alert_NodeId_Field) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts WHERE alerts.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, alert_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) UpdateNoReturn_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	update Alert_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE alerts SET "), __sets, __sqlbundle_Literal(" WHERE alerts.node_id = ? AND alerts.kind = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Message._set {
+		__values = append(__values, update.Message.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("message = ?"))
+	}
+
+	if update.AcknowledgedAt._set {
+		__values = append(__values, update.AcknowledgedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("acknowledged_at = ?"))
+	}
+
+	if update.SilencedUntil._set {
+		__values = append(__values, update.SilencedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("silenced_until = ?"))
+	}
+
+	if update.NotifiedAt._set {
+		__values = append(__values, update.NotifiedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notified_at = ?"))
+	}
+
+	if update.NotifiedChannels._set {
+		__values = append(__values, update.NotifiedChannels.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notified_channels = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, alert_node_id.value(), alert_kind.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *pgxImpl) CreateNoReturn_NodeSnapshot(ctx context.Context,
+	node_snapshot_node_id NodeSnapshot_NodeId_Field,
+	node_snapshot_created_at NodeSnapshot_CreatedAt_Field,
+	node_snapshot_disk_allocated NodeSnapshot_DiskAllocated_Field,
+	node_snapshot_disk_used NodeSnapshot_DiskUsed_Field,
+	node_snapshot_disk_trash NodeSnapshot_DiskTrash_Field,
+	node_snapshot_disk_free NodeSnapshot_DiskFree_Field,
+	node_snapshot_bandwidth_used NodeSnapshot_BandwidthUsed_Field,
+	node_snapshot_estimated_payout NodeSnapshot_EstimatedPayout_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := node_snapshot_node_id.value()
+	__created_at_val := node_snapshot_created_at.value()
+	__disk_allocated_val := node_snapshot_disk_allocated.value()
+	__disk_used_val := node_snapshot_disk_used.value()
+	__disk_trash_val := node_snapshot_disk_trash.value()
+	__disk_free_val := node_snapshot_disk_free.value()
+	__bandwidth_used_val := node_snapshot_bandwidth_used.value()
+	__estimated_payout_val := node_snapshot_estimated_payout.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_snapshots ( node_id, created_at, disk_allocated, disk_used, disk_trash, disk_free, bandwidth_used, estimated_payout ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __created_at_val, __disk_allocated_val, __disk_used_val, __disk_trash_val, __disk_free_val, __bandwidth_used_val, __estimated_payout_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) Delete_NodeSnapshot_By_CreatedAt_Less(ctx context.Context,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_snapshots WHERE node_snapshots.created_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, node_snapshot_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) All_NodeSnapshot_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	rows []*NodeSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_snapshots.node_id, node_snapshots.created_at, node_snapshots.disk_allocated, node_snapshots.disk_used, node_snapshots.disk_trash, node_snapshots.disk_free, node_snapshots.bandwidth_used, node_snapshots.estimated_payout FROM node_snapshots WHERE node_snapshots.created_at >= ? AND node_snapshots.created_at < ? ORDER BY node_snapshots.created_at")
+
+	var __values []interface{}
+	__values = append(__values, node_snapshot_created_at_greater_or_equal.value(), node_snapshot_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_snapshot := &NodeSnapshot{}
+		err = __rows.Scan(&node_snapshot.NodeId, &node_snapshot.CreatedAt, &node_snapshot.DiskAllocated, &node_snapshot.DiskUsed, &node_snapshot.DiskTrash, &node_snapshot.DiskFree, &node_snapshot.BandwidthUsed, &node_snapshot.EstimatedPayout)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_snapshot)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) All_NodeSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	node_snapshot_node_id NodeSnapshot_NodeId_Field,
+	node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	rows []*NodeSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_snapshots.node_id, node_snapshots.created_at, node_snapshots.disk_allocated, node_snapshots.disk_used, node_snapshots.disk_trash, node_snapshots.disk_free, node_snapshots.bandwidth_used, node_snapshots.estimated_payout FROM node_snapshots WHERE node_snapshots.node_id = ? AND node_snapshots.created_at >= ? AND node_snapshots.created_at < ? ORDER BY node_snapshots.created_at")
+
+	var __values []interface{}
+	__values = append(__values, node_snapshot_node_id.value(), node_snapshot_created_at_greater_or_equal.value(), node_snapshot_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		node_snapshot := &NodeSnapshot{}
+		err = __rows.Scan(&node_snapshot.NodeId, &node_snapshot.CreatedAt, &node_snapshot.DiskAllocated, &node_snapshot.DiskUsed, &node_snapshot.DiskTrash, &node_snapshot.DiskFree, &node_snapshot.BandwidthUsed, &node_snapshot.EstimatedPayout)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_snapshot)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *pgxImpl) CreateNoReturn_ReputationSnapshot(ctx context.Context,
+	reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+	reputation_snapshot_satellite_id ReputationSnapshot_SatelliteId_Field,
+	reputation_snapshot_created_at ReputationSnapshot_CreatedAt_Field,
+	reputation_snapshot_audit_score ReputationSnapshot_AuditScore_Field,
+	reputation_snapshot_suspension_score ReputationSnapshot_SuspensionScore_Field,
+	reputation_snapshot_online_score ReputationSnapshot_OnlineScore_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := reputation_snapshot_node_id.value()
+	__satellite_id_val := reputation_snapshot_satellite_id.value()
+	__created_at_val := reputation_snapshot_created_at.value()
+	__audit_score_val := reputation_snapshot_audit_score.value()
+	__suspension_score_val := reputation_snapshot_suspension_score.value()
+	__online_score_val := reputation_snapshot_online_score.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reputation_snapshots ( node_id, satellite_id, created_at, audit_score, suspension_score, online_score ) VALUES ( ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __satellite_id_val, __created_at_val, __audit_score_val, __suspension_score_val, __online_score_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *pgxImpl) Delete_ReputationSnapshot_By_CreatedAt_Less(ctx context.Context,
+	reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reputation_snapshots WHERE reputation_snapshots.created_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, reputation_snapshot_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *pgxImpl) All_ReputationSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+	reputation_snapshot_created_at_greater_or_equal ReputationSnapshot_CreatedAt_Field,
+	reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+	rows []*ReputationSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT reputation_snapshots.node_id, reputation_snapshots.satellite_id, reputation_snapshots.created_at, reputation_snapshots.audit_score, reputation_snapshots.suspension_score, reputation_snapshots.online_score FROM reputation_snapshots WHERE reputation_snapshots.node_id = ? AND reputation_snapshots.created_at >= ? AND reputation_snapshots.created_at < ? ORDER BY reputation_snapshots.created_at")
+
+	var __values []interface{}
+	__values = append(__values, reputation_snapshot_node_id.value(), reputation_snapshot_created_at_greater_or_equal.value(), reputation_snapshot_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		reputation_snapshot := &ReputationSnapshot{}
+		err = __rows.Scan(&reputation_snapshot.NodeId, &reputation_snapshot.SatelliteId, &reputation_snapshot.CreatedAt, &reputation_snapshot.AuditScore, &reputation_snapshot.SuspensionScore, &reputation_snapshot.OnlineScore)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, reputation_snapshot)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (impl pgxImpl) isConstraintError(err error) (
+	constraint string, ok bool) {
+	if e, ok := err.(*pgconn.PgError); ok {
+		if e.Code[:2] == "23" {
+			return e.ConstraintName, true
+		}
+	}
+	return "", false
+}
+
+func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __res sql.Result
+	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputation_snapshots;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_snapshots;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+
+	return count, nil
 
-func (obj *pgxImpl) Create_Node(ctx context.Context,
+}
+
+func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	node_id Node_Id_Field,
 	node_name Node_Name_Field,
 	node_public_address Node_PublicAddress_Field,
@@ -935,7 +2296,7 @@ func (obj *pgxImpl) Create_Node(ctx context.Context,
 	__public_address_val := node_public_address.value()
 	__api_secret_val := node_api_secret.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, name, public_address, api_secret ) VALUES ( ?, ?, ?, ? ) RETURNING nodes.id, nodes.name, nodes.public_address, nodes.api_secret")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, name, public_address, api_secret ) VALUES ( ?, ?, ?, ? )")
 
 	var __values []interface{}
 	__values = append(__values, __id_val, __name_val, __public_address_val, __api_secret_val)
@@ -943,16 +2304,19 @@ func (obj *pgxImpl) Create_Node(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastNode(ctx, __pk)
 
 }
 
-func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
+func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -974,7 +2338,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 
 }
 
-func (obj *pgxImpl) Count_Node(ctx context.Context) (
+func (obj *sqlite3Impl) Count_Node(ctx context.Context) (
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -994,7 +2358,7 @@ func (obj *pgxImpl) Count_Node(ctx context.Context) (
 
 }
 
-func (obj *pgxImpl) All_Node(ctx context.Context) (
+func (obj *sqlite3Impl) All_Node(ctx context.Context) (
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -1026,7 +2390,7 @@ func (obj *pgxImpl) All_Node(ctx context.Context) (
 
 }
 
-func (obj *pgxImpl) Limited_Node(ctx context.Context,
+func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
 	limit int, offset int64) (
 	rows []*Node, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1061,14 +2425,14 @@ func (obj *pgxImpl) Limited_Node(ctx context.Context,
 
 }
 
-func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
+func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
 	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.name, nodes.public_address, nodes.api_secret")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -1092,7 +2456,17 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE nodes.id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRowContext(ctx, __stmt_get, __args...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -1102,7 +2476,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	return node, nil
 }
 
-func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
+func (obj *sqlite3Impl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
 	err error) {
@@ -1139,7 +2513,7 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	return nil
 }
 
-func (obj *pgxImpl) Delete_Node_By_Id(ctx context.Context,
+func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -1166,51 +2540,46 @@ func (obj *pgxImpl) Delete_Node_By_Id(ctx context.Context,
 
 }
 
-func (impl pgxImpl) isConstraintError(err error) (
-	constraint string, ok bool) {
-	if e, ok := err.(*pgconn.PgError); ok {
-		if e.Code[:2] == "23" {
-			return e.ConstraintName, true
-		}
-	}
-	return "", false
-}
-
-func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
+func (obj *sqlite3Impl) getLastNode(ctx context.Context,
+	pk int64) (
+	node *Node, err error) {
 	defer mon.Task()(&ctx)(&err)
-	var __res sql.Result
-	var __count int64
-	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
-	if err != nil {
-		return 0, obj.makeErr(err)
-	}
 
-	__count, err = __res.RowsAffected()
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	node = &Node{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, pk).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
 	if err != nil {
-		return 0, obj.makeErr(err)
+		return (*Node)(nil), obj.makeErr(err)
 	}
-	count += __count
-
-	return count, nil
+	return node, nil
 
 }
 
-func (obj *sqlite3Impl) Create_Node(ctx context.Context,
-	node_id Node_Id_Field,
-	node_name Node_Name_Field,
-	node_public_address Node_PublicAddress_Field,
-	node_api_secret Node_ApiSecret_Field) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) Create_Alert(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	alert_message Alert_Message_Field,
+	alert_triggered_at Alert_TriggeredAt_Field,
+	optional Alert_Create_Fields) (
+	alert *Alert, err error) {
 	defer mon.Task()(&ctx)(&err)
-	__id_val := node_id.value()
-	__name_val := node_name.value()
-	__public_address_val := node_public_address.value()
-	__api_secret_val := node_api_secret.value()
+	__node_id_val := alert_node_id.value()
+	__kind_val := alert_kind.value()
+	__message_val := alert_message.value()
+	__triggered_at_val := alert_triggered_at.value()
+	__acknowledged_at_val := optional.AcknowledgedAt.value()
+	__silenced_until_val := optional.SilencedUntil.value()
+	__notified_at_val := optional.NotifiedAt.value()
+	__notified_channels_val := optional.NotifiedChannels.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, name, public_address, api_secret ) VALUES ( ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO alerts ( node_id, kind, message, triggered_at, acknowledged_at, silenced_until, notified_at, notified_channels ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __values []interface{}
-	__values = append(__values, __id_val, __name_val, __public_address_val, __api_secret_val)
+	__values = append(__values, __node_id_val, __kind_val, __message_val, __triggered_at_val, __acknowledged_at_val, __silenced_until_val, __notified_at_val, __notified_channels_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -1223,44 +2592,57 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastNode(ctx, __pk)
+	return obj.getLastAlert(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) Delete_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field) (
+	deleted bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM alerts WHERE alerts.node_id = ? AND alerts.kind = ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, alert_node_id.value(), alert_kind.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return (*Node)(nil), obj.makeErr(err)
+		return false, obj.makeErr(err)
+	}
+
+	__count, err := __res.RowsAffected()
+	if err != nil {
+		return false, obj.makeErr(err)
 	}
-	return node, nil
+
+	return __count > 0, nil
 
 }
 
-func (obj *sqlite3Impl) Count_Node(ctx context.Context) (
+func (obj *sqlite3Impl) Delete_Alert_By_NodeId(ctx context.Context,
+	alert_node_id Alert_NodeId_Field) (
 	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT COUNT(*) FROM nodes")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM alerts WHERE alerts.node_id = ?")
 
 	var __values []interface{}
+	__values = append(__values, alert_node_id.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&count)
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
@@ -1269,11 +2651,34 @@ func (obj *sqlite3Impl) Count_Node(ctx context.Context) (
 
 }
 
-func (obj *sqlite3Impl) All_Node(ctx context.Context) (
-	rows []*Node, err error) {
+func (obj *sqlite3Impl) Get_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field) (
+	alert *Alert, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes")
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts WHERE alerts.node_id = ? AND alerts.kind = ?")
+
+	var __values []interface{}
+	__values = append(__values, alert_node_id.value(), alert_kind.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+	if err != nil {
+		return (*Alert)(nil), obj.makeErr(err)
+	}
+	return alert, nil
+
+}
+
+func (obj *sqlite3Impl) All_Alert_OrderBy_Desc_TriggeredAt(ctx context.Context) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts ORDER BY alerts.triggered_at DESC")
 
 	var __values []interface{}
 
@@ -1287,12 +2692,12 @@ func (obj *sqlite3Impl) All_Node(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		alert := &Alert{}
+		err = __rows.Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, node)
+		rows = append(rows, alert)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -1301,16 +2706,190 @@ func (obj *sqlite3Impl) All_Node(ctx context.Context) (
 
 }
 
-func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
-	limit int, offset int64) (
-	rows []*Node, err error) {
+func (obj *sqlite3Impl) All_Alert_By_NodeId(ctx context.Context,
+	alert_node_id Alert_NodeId_Field) (
+	rows []*Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts WHERE alerts.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, alert_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		alert := &Alert{}
+		err = __rows.Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, alert)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) UpdateNoReturn_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	update Alert_Update_Fields) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE alerts SET "), __sets, __sqlbundle_Literal(" WHERE alerts.node_id = ? AND alerts.kind = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.Message._set {
+		__values = append(__values, update.Message.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("message = ?"))
+	}
+
+	if update.AcknowledgedAt._set {
+		__values = append(__values, update.AcknowledgedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("acknowledged_at = ?"))
+	}
+
+	if update.SilencedUntil._set {
+		__values = append(__values, update.SilencedUntil.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("silenced_until = ?"))
+	}
+
+	if update.NotifiedAt._set {
+		__values = append(__values, update.NotifiedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notified_at = ?"))
+	}
+
+	if update.NotifiedChannels._set {
+		__values = append(__values, update.NotifiedChannels.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("notified_channels = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return emptyUpdate()
+	}
+
+	__args = append(__args, alert_node_id.value(), alert_kind.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+}
+
+func (obj *sqlite3Impl) getLastAlert(ctx context.Context,
+	pk int64) (
+	alert *Alert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT alerts.node_id, alerts.kind, alerts.message, alerts.triggered_at, alerts.acknowledged_at, alerts.silenced_until, alerts.notified_at, alerts.notified_channels FROM alerts WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	alert = &Alert{}
+	err = obj.driver.QueryRowContext(ctx, __stmt, pk).Scan(&alert.NodeId, &alert.Kind, &alert.Message, &alert.TriggeredAt, &alert.AcknowledgedAt, &alert.SilencedUntil, &alert.NotifiedAt, &alert.NotifiedChannels)
+	if err != nil {
+		return (*Alert)(nil), obj.makeErr(err)
+	}
+	return alert, nil
+
+}
+
+func (obj *sqlite3Impl) CreateNoReturn_NodeSnapshot(ctx context.Context,
+	node_snapshot_node_id NodeSnapshot_NodeId_Field,
+	node_snapshot_created_at NodeSnapshot_CreatedAt_Field,
+	node_snapshot_disk_allocated NodeSnapshot_DiskAllocated_Field,
+	node_snapshot_disk_used NodeSnapshot_DiskUsed_Field,
+	node_snapshot_disk_trash NodeSnapshot_DiskTrash_Field,
+	node_snapshot_disk_free NodeSnapshot_DiskFree_Field,
+	node_snapshot_bandwidth_used NodeSnapshot_BandwidthUsed_Field,
+	node_snapshot_estimated_payout NodeSnapshot_EstimatedPayout_Field) (
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+	__node_id_val := node_snapshot_node_id.value()
+	__created_at_val := node_snapshot_created_at.value()
+	__disk_allocated_val := node_snapshot_disk_allocated.value()
+	__disk_used_val := node_snapshot_disk_used.value()
+	__disk_trash_val := node_snapshot_disk_trash.value()
+	__disk_free_val := node_snapshot_disk_free.value()
+	__bandwidth_used_val := node_snapshot_bandwidth_used.value()
+	__estimated_payout_val := node_snapshot_estimated_payout.value()
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO node_snapshots ( node_id, created_at, disk_allocated, disk_used, disk_trash, disk_free, bandwidth_used, estimated_payout ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __values []interface{}
+	__values = append(__values, __node_id_val, __created_at_val, __disk_allocated_val, __disk_used_val, __disk_trash_val, __disk_free_val, __bandwidth_used_val, __estimated_payout_val)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return obj.makeErr(err)
+	}
+	return nil
+
+}
+
+func (obj *sqlite3Impl) Delete_NodeSnapshot_By_CreatedAt_Less(ctx context.Context,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM node_snapshots WHERE node_snapshots.created_at < ?")
+
+	var __values []interface{}
+	__values = append(__values, node_snapshot_created_at_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	return count, nil
+
+}
+
+func (obj *sqlite3Impl) All_NodeSnapshot_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	rows []*NodeSnapshot, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_snapshots.node_id, node_snapshots.created_at, node_snapshots.disk_allocated, node_snapshots.disk_used, node_snapshots.disk_trash, node_snapshots.disk_free, node_snapshots.bandwidth_used, node_snapshots.estimated_payout FROM node_snapshots WHERE node_snapshots.created_at >= ? AND node_snapshots.created_at < ? ORDER BY node_snapshots.created_at")
 
 	var __values []interface{}
-
-	__values = append(__values, limit, offset)
+	__values = append(__values, node_snapshot_created_at_greater_or_equal.value(), node_snapshot_created_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -1322,12 +2901,12 @@ func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
 	defer __rows.Close()
 
 	for __rows.Next() {
-		node := &Node{}
-		err = __rows.Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+		node_snapshot := &NodeSnapshot{}
+		err = __rows.Scan(&node_snapshot.NodeId, &node_snapshot.CreatedAt, &node_snapshot.DiskAllocated, &node_snapshot.DiskUsed, &node_snapshot.DiskTrash, &node_snapshot.DiskFree, &node_snapshot.BandwidthUsed, &node_snapshot.EstimatedPayout)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, node)
+		rows = append(rows, node_snapshot)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -1336,83 +2915,62 @@ func (obj *sqlite3Impl) Limited_Node(ctx context.Context,
 
 }
 
-func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) All_NodeSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	node_snapshot_node_id NodeSnapshot_NodeId_Field,
+	node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	rows []*NodeSnapshot, err error) {
 	defer mon.Task()(&ctx)(&err)
-	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("SELECT node_snapshots.node_id, node_snapshots.created_at, node_snapshots.disk_allocated, node_snapshots.disk_used, node_snapshots.disk_trash, node_snapshots.disk_free, node_snapshots.bandwidth_used, node_snapshots.estimated_payout FROM node_snapshots WHERE node_snapshots.node_id = ? AND node_snapshots.created_at >= ? AND node_snapshots.created_at < ? ORDER BY node_snapshots.created_at")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	var __args []interface{}
-
-	if update.Name._set {
-		__values = append(__values, update.Name.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
-	}
-
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
-	}
-
-	__args = append(__args, node_id.value())
-
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	__values = append(__values, node_snapshot_node_id.value(), node_snapshot_created_at_greater_or_equal.value(), node_snapshot_created_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	_, err = obj.driver.ExecContext(ctx, __stmt, __values...)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
+	defer __rows.Close()
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE nodes.id = ?")
-
-	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
-	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
-
-	err = obj.driver.QueryRowContext(ctx, __stmt_get, __args...).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
-	if err == sql.ErrNoRows {
-		return nil, nil
+	for __rows.Next() {
+		node_snapshot := &NodeSnapshot{}
+		err = __rows.Scan(&node_snapshot.NodeId, &node_snapshot.CreatedAt, &node_snapshot.DiskAllocated, &node_snapshot.DiskUsed, &node_snapshot.DiskTrash, &node_snapshot.DiskFree, &node_snapshot.BandwidthUsed, &node_snapshot.EstimatedPayout)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, node_snapshot)
 	}
-	if err != nil {
+	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	return rows, nil
+
 }
 
-func (obj *sqlite3Impl) UpdateNoReturn_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field,
-	update Node_Update_Fields) (
+func (obj *sqlite3Impl) CreateNoReturn_ReputationSnapshot(ctx context.Context,
+	reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+	reputation_snapshot_satellite_id ReputationSnapshot_SatelliteId_Field,
+	reputation_snapshot_created_at ReputationSnapshot_CreatedAt_Field,
+	reputation_snapshot_audit_score ReputationSnapshot_AuditScore_Field,
+	reputation_snapshot_suspension_score ReputationSnapshot_SuspensionScore_Field,
+	reputation_snapshot_online_score ReputationSnapshot_OnlineScore_Field) (
 	err error) {
 	defer mon.Task()(&ctx)(&err)
-	var __sets = &__sqlbundle_Hole{}
+	__node_id_val := reputation_snapshot_node_id.value()
+	__satellite_id_val := reputation_snapshot_satellite_id.value()
+	__created_at_val := reputation_snapshot_created_at.value()
+	__audit_score_val := reputation_snapshot_audit_score.value()
+	__suspension_score_val := reputation_snapshot_suspension_score.value()
+	__online_score_val := reputation_snapshot_online_score.value()
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ?")}}
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO reputation_snapshots ( node_id, satellite_id, created_at, audit_score, suspension_score, online_score ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
-	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
-	var __args []interface{}
-
-	if update.Name._set {
-		__values = append(__values, update.Name.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("name = ?"))
-	}
-
-	if len(__sets_sql.SQLs) == 0 {
-		return emptyUpdate()
-	}
-
-	__args = append(__args, node_id.value())
-
-	__values = append(__values, __args...)
-	__sets.SQL = __sets_sql
+	__values = append(__values, __node_id_val, __satellite_id_val, __created_at_val, __audit_score_val, __suspension_score_val, __online_score_val)
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -1422,51 +2980,69 @@ func (obj *sqlite3Impl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		return obj.makeErr(err)
 	}
 	return nil
+
 }
 
-func (obj *sqlite3Impl) Delete_Node_By_Id(ctx context.Context,
-	node_id Node_Id_Field) (
-	deleted bool, err error) {
+func (obj *sqlite3Impl) Delete_ReputationSnapshot_By_CreatedAt_Less(ctx context.Context,
+	reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+	count int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("DELETE FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("DELETE FROM reputation_snapshots WHERE reputation_snapshots.created_at < ?")
 
 	var __values []interface{}
-	__values = append(__values, node_id.value())
+	__values = append(__values, reputation_snapshot_created_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
 	__res, err := obj.driver.ExecContext(ctx, __stmt, __values...)
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	__count, err := __res.RowsAffected()
+	count, err = __res.RowsAffected()
 	if err != nil {
-		return false, obj.makeErr(err)
+		return 0, obj.makeErr(err)
 	}
 
-	return __count > 0, nil
+	return count, nil
 
 }
 
-func (obj *sqlite3Impl) getLastNode(ctx context.Context,
-	pk int64) (
-	node *Node, err error) {
+func (obj *sqlite3Impl) All_ReputationSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+	reputation_snapshot_created_at_greater_or_equal ReputationSnapshot_CreatedAt_Field,
+	reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+	rows []*ReputationSnapshot, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.name, nodes.public_address, nodes.api_secret FROM nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT reputation_snapshots.node_id, reputation_snapshots.satellite_id, reputation_snapshots.created_at, reputation_snapshots.audit_score, reputation_snapshots.suspension_score, reputation_snapshots.online_score FROM reputation_snapshots WHERE reputation_snapshots.node_id = ? AND reputation_snapshots.created_at >= ? AND reputation_snapshots.created_at < ? ORDER BY reputation_snapshots.created_at")
+
+	var __values []interface{}
+	__values = append(__values, reputation_snapshot_node_id.value(), reputation_snapshot_created_at_greater_or_equal.value(), reputation_snapshot_created_at_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, pk)
+	obj.logStmt(__stmt, __values...)
 
-	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, pk).Scan(&node.Id, &node.Name, &node.PublicAddress, &node.ApiSecret)
+	__rows, err := obj.driver.QueryContext(ctx, __stmt, __values...)
 	if err != nil {
-		return (*Node)(nil), obj.makeErr(err)
+		return nil, obj.makeErr(err)
 	}
-	return node, nil
+	defer __rows.Close()
+
+	for __rows.Next() {
+		reputation_snapshot := &ReputationSnapshot{}
+		err = __rows.Scan(&reputation_snapshot.NodeId, &reputation_snapshot.SatelliteId, &reputation_snapshot.CreatedAt, &reputation_snapshot.AuditScore, &reputation_snapshot.SuspensionScore, &reputation_snapshot.OnlineScore)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, reputation_snapshot)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
@@ -1489,11 +3065,41 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 	defer mon.Task()(&ctx)(&err)
 	var __res sql.Result
 	var __count int64
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM reputation_snapshots;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
 	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM nodes;")
 	if err != nil {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_snapshots;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM alerts;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -1538,6 +3144,164 @@ func (rx *Rx) Commit() (err error) {
 	return err
 }
 
+func (rx *Rx) All_Alert_By_NodeId(ctx context.Context,
+	alert_node_id Alert_NodeId_Field) (
+	rows []*Alert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Alert_By_NodeId(ctx, alert_node_id)
+}
+
+func (rx *Rx) All_Alert_OrderBy_Desc_TriggeredAt(ctx context.Context) (
+	rows []*Alert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_Alert_OrderBy_Desc_TriggeredAt(ctx)
+}
+
+func (rx *Rx) Create_Alert(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	alert_message Alert_Message_Field,
+	alert_triggered_at Alert_TriggeredAt_Field,
+	optional Alert_Create_Fields) (
+	alert *Alert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_Alert(ctx, alert_node_id, alert_kind, alert_message, alert_triggered_at, optional)
+
+}
+
+func (rx *Rx) Delete_Alert_By_NodeId(ctx context.Context,
+	alert_node_id Alert_NodeId_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_Alert_By_NodeId(ctx, alert_node_id)
+
+}
+
+func (rx *Rx) Delete_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field) (
+	deleted bool, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_Alert_By_NodeId_And_Kind(ctx, alert_node_id, alert_kind)
+}
+
+func (rx *Rx) Get_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field) (
+	alert *Alert, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_Alert_By_NodeId_And_Kind(ctx, alert_node_id, alert_kind)
+}
+
+func (rx *Rx) All_NodeSnapshot_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	rows []*NodeSnapshot, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_NodeSnapshot_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx, node_snapshot_created_at_greater_or_equal, node_snapshot_created_at_less)
+}
+
+func (rx *Rx) All_NodeSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	node_snapshot_node_id NodeSnapshot_NodeId_Field,
+	node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	rows []*NodeSnapshot, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_NodeSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx, node_snapshot_node_id, node_snapshot_created_at_greater_or_equal, node_snapshot_created_at_less)
+}
+
+func (rx *Rx) All_ReputationSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+	reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+	reputation_snapshot_created_at_greater_or_equal ReputationSnapshot_CreatedAt_Field,
+	reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+	rows []*ReputationSnapshot, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_ReputationSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx, reputation_snapshot_node_id, reputation_snapshot_created_at_greater_or_equal, reputation_snapshot_created_at_less)
+}
+
+func (rx *Rx) CreateNoReturn_NodeSnapshot(ctx context.Context,
+	node_snapshot_node_id NodeSnapshot_NodeId_Field,
+	node_snapshot_created_at NodeSnapshot_CreatedAt_Field,
+	node_snapshot_disk_allocated NodeSnapshot_DiskAllocated_Field,
+	node_snapshot_disk_used NodeSnapshot_DiskUsed_Field,
+	node_snapshot_disk_trash NodeSnapshot_DiskTrash_Field,
+	node_snapshot_disk_free NodeSnapshot_DiskFree_Field,
+	node_snapshot_bandwidth_used NodeSnapshot_BandwidthUsed_Field,
+	node_snapshot_estimated_payout NodeSnapshot_EstimatedPayout_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_NodeSnapshot(ctx, node_snapshot_node_id, node_snapshot_created_at, node_snapshot_disk_allocated, node_snapshot_disk_used, node_snapshot_disk_trash, node_snapshot_disk_free, node_snapshot_bandwidth_used, node_snapshot_estimated_payout)
+
+}
+
+func (rx *Rx) CreateNoReturn_ReputationSnapshot(ctx context.Context,
+	reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+	reputation_snapshot_satellite_id ReputationSnapshot_SatelliteId_Field,
+	reputation_snapshot_created_at ReputationSnapshot_CreatedAt_Field,
+	reputation_snapshot_audit_score ReputationSnapshot_AuditScore_Field,
+	reputation_snapshot_suspension_score ReputationSnapshot_SuspensionScore_Field,
+	reputation_snapshot_online_score ReputationSnapshot_OnlineScore_Field) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.CreateNoReturn_ReputationSnapshot(ctx, reputation_snapshot_node_id, reputation_snapshot_satellite_id, reputation_snapshot_created_at, reputation_snapshot_audit_score, reputation_snapshot_suspension_score, reputation_snapshot_online_score)
+
+}
+
+func (rx *Rx) Delete_NodeSnapshot_By_CreatedAt_Less(ctx context.Context,
+	node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_NodeSnapshot_By_CreatedAt_Less(ctx, node_snapshot_created_at_less)
+
+}
+
+func (rx *Rx) Delete_ReputationSnapshot_By_CreatedAt_Less(ctx context.Context,
+	reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+	count int64, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Delete_ReputationSnapshot_By_CreatedAt_Less(ctx, reputation_snapshot_created_at_less)
+
+}
+
 func (rx *Rx) Rollback() (err error) {
 	if rx.tx != nil {
 		err = rx.tx.Rollback()
@@ -1608,6 +3372,18 @@ func (rx *Rx) Limited_Node(ctx context.Context,
 	return tx.Limited_Node(ctx, limit, offset)
 }
 
+func (rx *Rx) UpdateNoReturn_Alert_By_NodeId_And_Kind(ctx context.Context,
+	alert_node_id Alert_NodeId_Field,
+	alert_kind Alert_Kind_Field,
+	update Alert_Update_Fields) (
+	err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.UpdateNoReturn_Alert_By_NodeId_And_Kind(ctx, alert_node_id, alert_kind, update)
+}
+
 func (rx *Rx) UpdateNoReturn_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field,
 	update Node_Update_Fields) (
@@ -1631,12 +3407,64 @@ func (rx *Rx) Update_Node_By_Id(ctx context.Context,
 }
 
 type Methods interface {
+	All_Alert_By_NodeId(ctx context.Context,
+		alert_node_id Alert_NodeId_Field) (
+		rows []*Alert, err error)
+
+	All_Alert_OrderBy_Desc_TriggeredAt(ctx context.Context) (
+		rows []*Alert, err error)
+
 	All_Node(ctx context.Context) (
 		rows []*Node, err error)
 
+	All_NodeSnapshot_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+		node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+		node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+		rows []*NodeSnapshot, err error)
+
+	All_NodeSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+		node_snapshot_node_id NodeSnapshot_NodeId_Field,
+		node_snapshot_created_at_greater_or_equal NodeSnapshot_CreatedAt_Field,
+		node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+		rows []*NodeSnapshot, err error)
+
+	All_ReputationSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx context.Context,
+		reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+		reputation_snapshot_created_at_greater_or_equal ReputationSnapshot_CreatedAt_Field,
+		reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+		rows []*ReputationSnapshot, err error)
+
 	Count_Node(ctx context.Context) (
 		count int64, err error)
 
+	CreateNoReturn_NodeSnapshot(ctx context.Context,
+		node_snapshot_node_id NodeSnapshot_NodeId_Field,
+		node_snapshot_created_at NodeSnapshot_CreatedAt_Field,
+		node_snapshot_disk_allocated NodeSnapshot_DiskAllocated_Field,
+		node_snapshot_disk_used NodeSnapshot_DiskUsed_Field,
+		node_snapshot_disk_trash NodeSnapshot_DiskTrash_Field,
+		node_snapshot_disk_free NodeSnapshot_DiskFree_Field,
+		node_snapshot_bandwidth_used NodeSnapshot_BandwidthUsed_Field,
+		node_snapshot_estimated_payout NodeSnapshot_EstimatedPayout_Field) (
+		err error)
+
+	CreateNoReturn_ReputationSnapshot(ctx context.Context,
+		reputation_snapshot_node_id ReputationSnapshot_NodeId_Field,
+		reputation_snapshot_satellite_id ReputationSnapshot_SatelliteId_Field,
+		reputation_snapshot_created_at ReputationSnapshot_CreatedAt_Field,
+		reputation_snapshot_audit_score ReputationSnapshot_AuditScore_Field,
+		reputation_snapshot_suspension_score ReputationSnapshot_SuspensionScore_Field,
+		reputation_snapshot_online_score ReputationSnapshot_OnlineScore_Field) (
+		err error)
+
+	Create_Alert(ctx context.Context,
+		alert_node_id Alert_NodeId_Field,
+		alert_kind Alert_Kind_Field,
+		alert_message Alert_Message_Field,
+		alert_triggered_at Alert_TriggeredAt_Field,
+		optional Alert_Create_Fields) (
+		alert *Alert, err error)
+
 	Create_Node(ctx context.Context,
 		node_id Node_Id_Field,
 		node_name Node_Name_Field,
@@ -1644,10 +3472,32 @@ type Methods interface {
 		node_api_secret Node_ApiSecret_Field) (
 		node *Node, err error)
 
+	Delete_Alert_By_NodeId(ctx context.Context,
+		alert_node_id Alert_NodeId_Field) (
+		count int64, err error)
+
+	Delete_Alert_By_NodeId_And_Kind(ctx context.Context,
+		alert_node_id Alert_NodeId_Field,
+		alert_kind Alert_Kind_Field) (
+		deleted bool, err error)
+
+	Delete_NodeSnapshot_By_CreatedAt_Less(ctx context.Context,
+		node_snapshot_created_at_less NodeSnapshot_CreatedAt_Field) (
+		count int64, err error)
+
 	Delete_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		deleted bool, err error)
 
+	Delete_ReputationSnapshot_By_CreatedAt_Less(ctx context.Context,
+		reputation_snapshot_created_at_less ReputationSnapshot_CreatedAt_Field) (
+		count int64, err error)
+
+	Get_Alert_By_NodeId_And_Kind(ctx context.Context,
+		alert_node_id Alert_NodeId_Field,
+		alert_kind Alert_Kind_Field) (
+		alert *Alert, err error)
+
 	Get_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field) (
 		node *Node, err error)
@@ -1656,6 +3506,12 @@ type Methods interface {
 		limit int, offset int64) (
 		rows []*Node, err error)
 
+	UpdateNoReturn_Alert_By_NodeId_And_Kind(ctx context.Context,
+		alert_node_id Alert_NodeId_Field,
+		alert_kind Alert_Kind_Field,
+		update Alert_Update_Fields) (
+		err error)
+
 	UpdateNoReturn_Node_By_Id(ctx context.Context,
 		node_id Node_Id_Field,
 		update Node_Update_Fields) (
@@ -1,5 +1,27 @@
 -- AUTOGENERATED BY storx/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	node_id bytea NOT NULL,
+	kind text NOT NULL,
+	message text NOT NULL,
+	triggered_at timestamp with time zone NOT NULL,
+	acknowledged_at timestamp with time zone,
+	silenced_until timestamp with time zone,
+	notified_at timestamp with time zone,
+	notified_channels text,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE node_snapshots (
+	node_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	disk_allocated bigint NOT NULL,
+	disk_used bigint NOT NULL,
+	disk_trash bigint NOT NULL,
+	disk_free bigint NOT NULL,
+	bandwidth_used bigint NOT NULL,
+	estimated_payout bigint NOT NULL,
+	PRIMARY KEY ( node_id, created_at )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	name text NOT NULL,
@@ -7,3 +29,14 @@ CREATE TABLE nodes (
 	api_secret bytea NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE reputation_snapshots (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, created_at )
+);
+CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at ) ;
+CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at ) ;
@@ -1,5 +1,27 @@
 -- AUTOGENERATED BY storx/dbx
 -- DO NOT EDIT
+CREATE TABLE alerts (
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	message TEXT NOT NULL,
+	triggered_at TIMESTAMP NOT NULL,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	notified_channels TEXT,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE node_snapshots (
+	node_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	disk_allocated INTEGER NOT NULL,
+	disk_used INTEGER NOT NULL,
+	disk_trash INTEGER NOT NULL,
+	disk_free INTEGER NOT NULL,
+	bandwidth_used INTEGER NOT NULL,
+	estimated_payout INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, created_at )
+);
 CREATE TABLE nodes (
 	id BLOB NOT NULL,
 	name TEXT NOT NULL,
@@ -7,3 +29,14 @@ CREATE TABLE nodes (
 	api_secret BLOB NOT NULL,
 	PRIMARY KEY ( id )
 );
+CREATE TABLE reputation_snapshots (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	online_score REAL NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, created_at )
+);
+CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at ) ;
+CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at ) ;
@@ -0,0 +1,166 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package multinodedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/storx"
+	"storx/multinode/history"
+	"storx/multinode/multinodedb/dbx"
+)
+
+// ErrHistoryDB indicates about internal HistoryDB error.
+var ErrHistoryDB = errs.Class("HistoryDB")
+
+// ensures that historydb implements history.DB.
+var _ history.DB = (*historydb)(nil)
+
+// historydb exposes needed by MND HistoryDB functionality.
+//
+// architecture: Database
+type historydb struct {
+	methods dbx.Methods
+}
+
+// AddNodeSnapshot stores node state snapshot.
+func (h *historydb) AddNodeSnapshot(ctx context.Context, snapshot history.NodeSnapshot) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = h.methods.CreateNoReturn_NodeSnapshot(ctx,
+		dbx.NodeSnapshot_NodeId(snapshot.NodeID.Bytes()),
+		dbx.NodeSnapshot_CreatedAt(snapshot.CreatedAt.UTC()),
+		dbx.NodeSnapshot_DiskAllocated(snapshot.DiskAllocated),
+		dbx.NodeSnapshot_DiskUsed(snapshot.DiskUsed),
+		dbx.NodeSnapshot_DiskTrash(snapshot.DiskTrash),
+		dbx.NodeSnapshot_DiskFree(snapshot.DiskFree),
+		dbx.NodeSnapshot_BandwidthUsed(snapshot.BandwidthUsed),
+		dbx.NodeSnapshot_EstimatedPayout(snapshot.EstimatedPayout),
+	)
+
+	return ErrHistoryDB.Wrap(err)
+}
+
+// AddReputationSnapshot stores node reputation snapshot for a single satellite.
+func (h *historydb) AddReputationSnapshot(ctx context.Context, snapshot history.ReputationSnapshot) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = h.methods.CreateNoReturn_ReputationSnapshot(ctx,
+		dbx.ReputationSnapshot_NodeId(snapshot.NodeID.Bytes()),
+		dbx.ReputationSnapshot_SatelliteId(snapshot.SatelliteID.Bytes()),
+		dbx.ReputationSnapshot_CreatedAt(snapshot.CreatedAt.UTC()),
+		dbx.ReputationSnapshot_AuditScore(snapshot.AuditScore),
+		dbx.ReputationSnapshot_SuspensionScore(snapshot.SuspensionScore),
+		dbx.ReputationSnapshot_OnlineScore(snapshot.OnlineScore),
+	)
+
+	return ErrHistoryDB.Wrap(err)
+}
+
+// NodeSnapshots returns snapshots of all nodes taken in [from, to) range, oldest first.
+func (h *historydb) NodeSnapshots(ctx context.Context, from, to time.Time) (_ []history.NodeSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxSnapshots, err := h.methods.All_NodeSnapshot_By_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx,
+		dbx.NodeSnapshot_CreatedAt(from.UTC()),
+		dbx.NodeSnapshot_CreatedAt(to.UTC()),
+	)
+	if err != nil {
+		return nil, ErrHistoryDB.Wrap(err)
+	}
+
+	return fromDBXNodeSnapshots(dbxSnapshots)
+}
+
+// NodeSnapshotsByNode returns snapshots of the node taken in [from, to) range, oldest first.
+func (h *historydb) NodeSnapshotsByNode(ctx context.Context, nodeID storx.NodeID, from, to time.Time) (_ []history.NodeSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxSnapshots, err := h.methods.All_NodeSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx,
+		dbx.NodeSnapshot_NodeId(nodeID.Bytes()),
+		dbx.NodeSnapshot_CreatedAt(from.UTC()),
+		dbx.NodeSnapshot_CreatedAt(to.UTC()),
+	)
+	if err != nil {
+		return nil, ErrHistoryDB.Wrap(err)
+	}
+
+	return fromDBXNodeSnapshots(dbxSnapshots)
+}
+
+// ReputationSnapshots returns reputation snapshots of the node taken in [from, to) range, oldest first.
+func (h *historydb) ReputationSnapshots(ctx context.Context, nodeID storx.NodeID, from, to time.Time) (_ []history.ReputationSnapshot, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxSnapshots, err := h.methods.All_ReputationSnapshot_By_NodeId_And_CreatedAt_GreaterOrEqual_And_CreatedAt_Less_OrderBy_Asc_CreatedAt(ctx,
+		dbx.ReputationSnapshot_NodeId(nodeID.Bytes()),
+		dbx.ReputationSnapshot_CreatedAt(from.UTC()),
+		dbx.ReputationSnapshot_CreatedAt(to.UTC()),
+	)
+	if err != nil {
+		return nil, ErrHistoryDB.Wrap(err)
+	}
+
+	snapshots := make([]history.ReputationSnapshot, 0, len(dbxSnapshots))
+	for _, dbxSnapshot := range dbxSnapshots {
+		nodeID, err := storx.NodeIDFromBytes(dbxSnapshot.NodeId)
+		if err != nil {
+			return nil, ErrHistoryDB.Wrap(err)
+		}
+		satelliteID, err := storx.NodeIDFromBytes(dbxSnapshot.SatelliteId)
+		if err != nil {
+			return nil, ErrHistoryDB.Wrap(err)
+		}
+
+		snapshots = append(snapshots, history.ReputationSnapshot{
+			NodeID:          nodeID,
+			SatelliteID:     satelliteID,
+			CreatedAt:       dbxSnapshot.CreatedAt,
+			AuditScore:      dbxSnapshot.AuditScore,
+			SuspensionScore: dbxSnapshot.SuspensionScore,
+			OnlineScore:     dbxSnapshot.OnlineScore,
+		})
+	}
+
+	return snapshots, nil
+}
+
+// DeleteBefore removes all snapshots taken before specified time.
+func (h *historydb) DeleteBefore(ctx context.Context, before time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = h.methods.Delete_NodeSnapshot_By_CreatedAt_Less(ctx, dbx.NodeSnapshot_CreatedAt(before.UTC()))
+	if err != nil {
+		return ErrHistoryDB.Wrap(err)
+	}
+
+	_, err = h.methods.Delete_ReputationSnapshot_By_CreatedAt_Less(ctx, dbx.ReputationSnapshot_CreatedAt(before.UTC()))
+	return ErrHistoryDB.Wrap(err)
+}
+
+// fromDBXNodeSnapshots converts slice of dbx.NodeSnapshot to history.NodeSnapshot.
+func fromDBXNodeSnapshots(dbxSnapshots []*dbx.NodeSnapshot) ([]history.NodeSnapshot, error) {
+	snapshots := make([]history.NodeSnapshot, 0, len(dbxSnapshots))
+	for _, dbxSnapshot := range dbxSnapshots {
+		nodeID, err := storx.NodeIDFromBytes(dbxSnapshot.NodeId)
+		if err != nil {
+			return nil, ErrHistoryDB.Wrap(err)
+		}
+
+		snapshots = append(snapshots, history.NodeSnapshot{
+			NodeID:          nodeID,
+			CreatedAt:       dbxSnapshot.CreatedAt,
+			DiskAllocated:   dbxSnapshot.DiskAllocated,
+			DiskUsed:        dbxSnapshot.DiskUsed,
+			DiskTrash:       dbxSnapshot.DiskTrash,
+			DiskFree:        dbxSnapshot.DiskFree,
+			BandwidthUsed:   dbxSnapshot.BandwidthUsed,
+			EstimatedPayout: dbxSnapshot.EstimatedPayout,
+		})
+	}
+	return snapshots, nil
+}
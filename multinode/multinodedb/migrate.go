@@ -26,6 +26,60 @@ func (db *DB) SQLite3Migration() *migrate.Migration {
 					); `,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts table",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						node_id BLOB NOT NULL,
+						kind TEXT NOT NULL,
+						message TEXT NOT NULL,
+						triggered_at TIMESTAMP NOT NULL,
+						acknowledged_at TIMESTAMP,
+						silenced_until TIMESTAMP,
+						notified_at TIMESTAMP,
+						PRIMARY KEY ( node_id, kind )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node and reputation snapshots tables",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE node_snapshots (
+						node_id BLOB NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						disk_allocated INTEGER NOT NULL,
+						disk_used INTEGER NOT NULL,
+						disk_trash INTEGER NOT NULL,
+						disk_free INTEGER NOT NULL,
+						bandwidth_used INTEGER NOT NULL,
+						estimated_payout INTEGER NOT NULL,
+						PRIMARY KEY ( node_id, created_at )
+					);`,
+					`CREATE TABLE reputation_snapshots (
+						node_id BLOB NOT NULL,
+						satellite_id BLOB NOT NULL,
+						created_at TIMESTAMP NOT NULL,
+						audit_score REAL NOT NULL,
+						suspension_score REAL NOT NULL,
+						online_score REAL NOT NULL,
+						PRIMARY KEY ( node_id, satellite_id, created_at )
+					);`,
+					`CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at );`,
+					`CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add notified channels to alerts",
+				Version:     3,
+				Action: migrate.SQL{
+					`ALTER TABLE alerts ADD COLUMN notified_channels TEXT;`,
+				},
+			},
 		},
 	}
 }
@@ -49,6 +103,60 @@ func (db *DB) PostgresMigration() *migrate.Migration {
 					);`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add alerts table",
+				Version:     1,
+				Action: migrate.SQL{
+					`CREATE TABLE alerts (
+						node_id bytea NOT NULL,
+						kind text NOT NULL,
+						message text NOT NULL,
+						triggered_at timestamp with time zone NOT NULL,
+						acknowledged_at timestamp with time zone,
+						silenced_until timestamp with time zone,
+						notified_at timestamp with time zone,
+						PRIMARY KEY ( node_id, kind )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add node and reputation snapshots tables",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE node_snapshots (
+						node_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						disk_allocated bigint NOT NULL,
+						disk_used bigint NOT NULL,
+						disk_trash bigint NOT NULL,
+						disk_free bigint NOT NULL,
+						bandwidth_used bigint NOT NULL,
+						estimated_payout bigint NOT NULL,
+						PRIMARY KEY ( node_id, created_at )
+					);`,
+					`CREATE TABLE reputation_snapshots (
+						node_id bytea NOT NULL,
+						satellite_id bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						audit_score double precision NOT NULL,
+						suspension_score double precision NOT NULL,
+						online_score double precision NOT NULL,
+						PRIMARY KEY ( node_id, satellite_id, created_at )
+					);`,
+					`CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at );`,
+					`CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "Add notified channels to alerts",
+				Version:     3,
+				Action: migrate.SQL{
+					`ALTER TABLE alerts ADD COLUMN notified_channels text;`,
+				},
+			},
 		},
 	}
 }
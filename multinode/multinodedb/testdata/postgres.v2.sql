@@ -0,0 +1,51 @@
+-- AUTOGENERATED BY storx/dbx
+-- DO NOT EDIT
+CREATE TABLE alerts (
+	node_id bytea NOT NULL,
+	kind text NOT NULL,
+	message text NOT NULL,
+	triggered_at timestamp with time zone NOT NULL,
+	acknowledged_at timestamp with time zone,
+	silenced_until timestamp with time zone,
+	notified_at timestamp with time zone,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE node_snapshots (
+	node_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	disk_allocated bigint NOT NULL,
+	disk_used bigint NOT NULL,
+	disk_trash bigint NOT NULL,
+	disk_free bigint NOT NULL,
+	bandwidth_used bigint NOT NULL,
+	estimated_payout bigint NOT NULL,
+	PRIMARY KEY ( node_id, created_at )
+);
+CREATE TABLE nodes (
+	id bytea NOT NULL,
+	name text NOT NULL,
+	public_address text NOT NULL,
+	api_secret bytea NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE reputation_snapshots (
+	node_id bytea NOT NULL,
+	satellite_id bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	audit_score double precision NOT NULL,
+	suspension_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, created_at )
+);
+CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at ) ;
+CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at ) ;
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'node_name', '127.0.0.1:13000', E'\\153\\313\\233\\074\\327\\177\\136\\070\\346\\001');
+INSERT INTO alerts (node_id, kind, message, triggered_at, acknowledged_at, silenced_until, notified_at) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', 'node_offline', 'node is offline', '2022-08-10 10:00:00+00', NULL, NULL, '2022-08-10 10:00:00+00');
+
+-- NEW DATA --
+
+INSERT INTO node_snapshots (node_id, created_at, disk_allocated, disk_used, disk_trash, disk_free, bandwidth_used, estimated_payout) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2022-08-10 10:00:00+00', 2000000000000, 1500000000000, 10000000000, 3000000000000, 250000000000, 1250);
+INSERT INTO reputation_snapshots (node_id, satellite_id, created_at, audit_score, suspension_score, online_score) VALUES (E'\\006\\223\\250R\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', E'\\004\\242\\361\\043\\221\\005\\365\\377v>0\\266\\365\\216\\255?\\347\\244\\371?2\\264\\262\\230\\007<\\001\\262\\263\\237\\247n', '2022-08-10 10:00:00+00', 1, 0.5, 0.75);
@@ -0,0 +1,27 @@
+-- AUTOGENERATED BY storx/dbx
+-- DO NOT EDIT
+CREATE TABLE alerts (
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	message TEXT NOT NULL,
+	triggered_at TIMESTAMP NOT NULL,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+
+-- NEW DATA --
+
+INSERT INTO alerts (node_id, kind, message, triggered_at, acknowledged_at, silenced_until, notified_at) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_offline', 'node is offline', '2022-08-10 10:00:00+00:00', NULL, NULL, '2022-08-10 10:00:00+00:00');
@@ -0,0 +1,51 @@
+-- AUTOGENERATED BY storx/dbx
+-- DO NOT EDIT
+CREATE TABLE alerts (
+	node_id BLOB NOT NULL,
+	kind TEXT NOT NULL,
+	message TEXT NOT NULL,
+	triggered_at TIMESTAMP NOT NULL,
+	acknowledged_at TIMESTAMP,
+	silenced_until TIMESTAMP,
+	notified_at TIMESTAMP,
+	PRIMARY KEY ( node_id, kind )
+);
+CREATE TABLE node_snapshots (
+	node_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	disk_allocated INTEGER NOT NULL,
+	disk_used INTEGER NOT NULL,
+	disk_trash INTEGER NOT NULL,
+	disk_free INTEGER NOT NULL,
+	bandwidth_used INTEGER NOT NULL,
+	estimated_payout INTEGER NOT NULL,
+	PRIMARY KEY ( node_id, created_at )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	public_address TEXT NOT NULL,
+	api_secret BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE reputation_snapshots (
+	node_id BLOB NOT NULL,
+	satellite_id BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	audit_score REAL NOT NULL,
+	suspension_score REAL NOT NULL,
+	online_score REAL NOT NULL,
+	PRIMARY KEY ( node_id, satellite_id, created_at )
+);
+CREATE INDEX node_snapshots_created_at_index ON node_snapshots ( created_at ) ;
+CREATE INDEX reputation_snapshots_created_at_index ON reputation_snapshots ( created_at ) ;
+
+-- MAIN DATA --
+
+INSERT INTO nodes (id, name, public_address, api_secret) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_name', '127.0.0.1:13000', X'62180593328b8ff3c9f97565fdfd305d');
+INSERT INTO alerts (node_id, kind, message, triggered_at, acknowledged_at, silenced_until, notified_at) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', 'node_offline', 'node is offline', '2022-08-10 10:00:00+00:00', NULL, NULL, '2022-08-10 10:00:00+00:00');
+
+-- NEW DATA --
+
+INSERT INTO node_snapshots (node_id, created_at, disk_allocated, disk_used, disk_trash, disk_free, bandwidth_used, estimated_payout) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', '2022-08-10 10:00:00+00:00', 2000000000000, 1500000000000, 10000000000, 3000000000000, 250000000000, 1250);
+INSERT INTO reputation_snapshots (node_id, satellite_id, created_at, audit_score, suspension_score, online_score) VALUES (X'2b3a5863a41f25408a8f5348839d7a1361dbd886d75786bb139a8ca0bdf41000', X'04a2f1239105f5ff763e30b6f58ead3fe7a4f93f32b4b298073c01b2b39fa76e', '2022-08-10 10:00:00+00:00', 1, 0.5, 0.75);
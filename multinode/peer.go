@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 
 	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
@@ -18,8 +19,11 @@ import (
 	"common/peertls/tlsopts"
 	"common/rpc"
 	"private/debug"
+	"storx/multinode/alerts"
 	"storx/multinode/bandwidth"
 	"storx/multinode/console/server"
+	"storx/multinode/history"
+	"storx/multinode/management"
 	"storx/multinode/nodes"
 	"storx/multinode/operators"
 	"storx/multinode/payouts"
@@ -39,6 +43,10 @@ var (
 type DB interface {
 	// Nodes returns nodes database.
 	Nodes() nodes.DB
+	// Alerts returns alerts database.
+	Alerts() alerts.DB
+	// History returns node state history database.
+	History() history.DB
 
 	// MigrateToLatest initializes the database.
 	MigrateToLatest(ctx context.Context) error
@@ -53,6 +61,9 @@ type Config struct {
 	Debug    debug.Config
 
 	Console server.Config
+
+	Alerts  alerts.Config
+	History history.Config
 }
 
 // Peer is the a Multinode Dashboard application itself.
@@ -94,13 +105,30 @@ type Peer struct {
 		Service *reputation.Service
 	}
 
+	// monitors connected nodes and notifies operator about problems.
+	Alerts struct {
+		Notifier alerts.Notifiers
+		Service  *alerts.Service
+	}
+
+	// collects and serves history of connected nodes state.
+	History struct {
+		Service *history.Service
+	}
+
+	// performs actions on connected nodes remotely.
+	Management struct {
+		Service *management.Service
+	}
+
 	// Web server with web UI.
 	Console struct {
 		Listener net.Listener
 		Endpoint *server.Server
 	}
 
-	Servers *lifecycle.Group
+	Servers  *lifecycle.Group
+	Services *lifecycle.Group
 }
 
 // New creates a new instance of Multinode Dashboard application.
@@ -110,6 +138,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		Identity: full,
 		DB:       db,
 		Servers:  lifecycle.NewGroup(log.Named("servers")),
+		Services: lifecycle.NewGroup(log.Named("services")),
 	}
 
 	tlsConfig := tlsopts.Config{
@@ -172,6 +201,55 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 		)
 	}
 
+	{ // alerts setup
+		peer.Alerts.Notifier, err = alerts.NewNotifier(peer.Log.Named("alerts:notifier"), config.Alerts.Notify)
+		if err != nil {
+			return nil, err
+		}
+
+		peer.Alerts.Service, err = alerts.NewService(
+			peer.Log.Named("alerts:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.Alerts(),
+			peer.Alerts.Notifier,
+			config.Alerts,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "alerts:service",
+			Run:   peer.Alerts.Service.Run,
+			Close: peer.Alerts.Service.Close,
+		})
+	}
+
+	{ // history setup
+		peer.History.Service = history.NewService(
+			peer.Log.Named("history:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+			peer.DB.History(),
+			config.History,
+		)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "history:service",
+			Run:   peer.History.Service.Run,
+			Close: peer.History.Service.Close,
+		})
+	}
+
+	{ // management setup
+		peer.Management.Service = management.NewService(
+			peer.Log.Named("management:service"),
+			peer.Dialer,
+			peer.DB.Nodes(),
+		)
+	}
+
 	{ // console setup
 		peer.Console.Listener, err = net.Listen("tcp", config.Console.Address)
 		if err != nil {
@@ -199,6 +277,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, config Config, db DB) (_
 				Storage:    peer.Storage.Service,
 				Bandwidth:  peer.Bandwidth.Service,
 				Reputation: peer.Reputation.Service,
+				Alerts:     peer.Alerts.Service,
+				Management: peer.Management.Service,
+				History:    peer.History.Service,
 			},
 		)
 		if err != nil {
@@ -222,11 +303,15 @@ func (peer *Peer) Run(ctx context.Context) (err error) {
 	group, ctx := errgroup.WithContext(ctx)
 
 	peer.Servers.Run(ctx, group)
+	peer.Services.Run(ctx, group)
 
 	return group.Wait()
 }
 
 // Close closes all the resources.
 func (peer *Peer) Close() error {
-	return peer.Servers.Close()
+	return errs.Combine(
+		peer.Servers.Close(),
+		peer.Services.Close(),
+	)
 }
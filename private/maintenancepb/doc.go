@@ -0,0 +1,7 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+// Package maintenancepb contains protobuf definitions for planned storage node maintenance.
+package maintenancepb
+
+//go:generate go run gen.go
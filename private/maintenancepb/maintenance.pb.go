@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: maintenance.proto
+
+package maintenancepb
+
+import (
+	fmt "fmt"
+	math "math"
+	time "time"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AnnounceRequest struct {
+	Start                time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	End                  time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AnnounceRequest) Reset()         { *m = AnnounceRequest{} }
+func (m *AnnounceRequest) String() string { return proto.CompactTextString(m) }
+func (*AnnounceRequest) ProtoMessage()    {}
+func (*AnnounceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{0}
+}
+func (m *AnnounceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnounceRequest.Unmarshal(m, b)
+}
+func (m *AnnounceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnounceRequest.Marshal(b, m, deterministic)
+}
+func (m *AnnounceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnounceRequest.Merge(m, src)
+}
+func (m *AnnounceRequest) XXX_Size() int {
+	return xxx_messageInfo_AnnounceRequest.Size(m)
+}
+func (m *AnnounceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnounceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnounceRequest proto.InternalMessageInfo
+
+func (m *AnnounceRequest) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *AnnounceRequest) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+type AnnounceResponse struct {
+	// window accepted by the satellite, start can be moved to the time of announcement.
+	Start                time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	End                  time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AnnounceResponse) Reset()         { *m = AnnounceResponse{} }
+func (m *AnnounceResponse) String() string { return proto.CompactTextString(m) }
+func (*AnnounceResponse) ProtoMessage()    {}
+func (*AnnounceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{1}
+}
+func (m *AnnounceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnounceResponse.Unmarshal(m, b)
+}
+func (m *AnnounceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnounceResponse.Marshal(b, m, deterministic)
+}
+func (m *AnnounceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnounceResponse.Merge(m, src)
+}
+func (m *AnnounceResponse) XXX_Size() int {
+	return xxx_messageInfo_AnnounceResponse.Size(m)
+}
+func (m *AnnounceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnounceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnounceResponse proto.InternalMessageInfo
+
+func (m *AnnounceResponse) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *AnnounceResponse) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+type CancelRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelRequest) Reset()         { *m = CancelRequest{} }
+func (m *CancelRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelRequest) ProtoMessage()    {}
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{2}
+}
+func (m *CancelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelRequest.Unmarshal(m, b)
+}
+func (m *CancelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelRequest.Merge(m, src)
+}
+func (m *CancelRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelRequest.Size(m)
+}
+func (m *CancelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelRequest proto.InternalMessageInfo
+
+type CancelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelResponse) Reset()         { *m = CancelResponse{} }
+func (m *CancelResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelResponse) ProtoMessage()    {}
+func (*CancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6053ae89a3b3f561, []int{3}
+}
+func (m *CancelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelResponse.Unmarshal(m, b)
+}
+func (m *CancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelResponse.Merge(m, src)
+}
+func (m *CancelResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelResponse.Size(m)
+}
+func (m *CancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AnnounceRequest)(nil), "maintenance.AnnounceRequest")
+	proto.RegisterType((*AnnounceResponse)(nil), "maintenance.AnnounceResponse")
+	proto.RegisterType((*CancelRequest)(nil), "maintenance.CancelRequest")
+	proto.RegisterType((*CancelResponse)(nil), "maintenance.CancelResponse")
+}
+
+func init() { proto.RegisterFile("maintenance.proto", fileDescriptor_6053ae89a3b3f561) }
+
+var fileDescriptor_6053ae89a3b3f561 = []byte{
+	// 264 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcc, 0x4d, 0xcc, 0xcc,
+	0x2b, 0x49, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x46,
+	0x12, 0x92, 0xe2, 0x4a, 0xcf, 0x4f, 0xcf, 0x87, 0x48, 0x48, 0xc9, 0xa7, 0xe7, 0xe7, 0xa7, 0xe7,
+	0xa4, 0xea, 0x83, 0x79, 0x49, 0xa5, 0x69, 0xfa, 0x25, 0x99, 0xb9, 0xa9, 0xc5, 0x25, 0x89, 0xb9,
+	0x05, 0x10, 0x05, 0x4a, 0xad, 0x8c, 0x5c, 0xfc, 0x8e, 0x79, 0x79, 0xf9, 0xa5, 0x79, 0xc9, 0xa9,
+	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x56, 0x5c, 0xac, 0xc5, 0x25, 0x89, 0x45, 0x25,
+	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x10, 0x43, 0xf4, 0x60, 0x86, 0xe8, 0x85,
+	0xc0, 0x0c, 0x71, 0xe2, 0x38, 0x71, 0x4f, 0x9e, 0x61, 0xc2, 0x7d, 0x79, 0xc6, 0x20, 0x88, 0x16,
+	0x21, 0x33, 0x2e, 0xe6, 0xd4, 0xbc, 0x14, 0x09, 0x26, 0x12, 0x74, 0x82, 0x34, 0x28, 0xb5, 0x31,
+	0x72, 0x09, 0x20, 0xdc, 0x51, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x3a, 0x20, 0x0e, 0xe1, 0xe7, 0xe2,
+	0x75, 0x06, 0x05, 0x63, 0x0e, 0x34, 0x34, 0x94, 0x04, 0xb8, 0xf8, 0x60, 0x02, 0x10, 0x67, 0x19,
+	0xcd, 0x65, 0xe4, 0xe2, 0xf7, 0xcb, 0x4f, 0x49, 0xf5, 0x45, 0x04, 0xba, 0x90, 0x3b, 0x17, 0x07,
+	0xcc, 0xf9, 0x42, 0x32, 0x7a, 0xc8, 0x31, 0x84, 0x16, 0xba, 0x52, 0xb2, 0x38, 0x64, 0xa1, 0x7e,
+	0x76, 0xe4, 0x62, 0x83, 0x58, 0x27, 0x24, 0x85, 0xa2, 0x10, 0xc5, 0x51, 0x52, 0xd2, 0x58, 0xe5,
+	0x20, 0x46, 0x38, 0xc9, 0x46, 0x49, 0x17, 0x97, 0xe4, 0x17, 0x55, 0xe8, 0x17, 0x14, 0x65, 0x96,
+	0x25, 0x96, 0xa4, 0xea, 0x23, 0xa9, 0x2d, 0x48, 0x4a, 0x62, 0x03, 0x07, 0x82, 0x31, 0x60, 0x00,
+	0xe1, 0xbc, 0xd4, 0x26, 0x48, 0x02, 0x00, 0x00,
+}
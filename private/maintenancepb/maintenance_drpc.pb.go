@@ -0,0 +1,155 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.32
+// source: maintenance.proto
+
+package maintenancepb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "drpc"
+	drpcerr "drpc/drpcerr"
+)
+
+type drpcEncoding_File_maintenance_proto struct{}
+
+func (drpcEncoding_File_maintenance_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_maintenance_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_maintenance_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_maintenance_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCNodeMaintenanceClient interface {
+	DRPCConn() drpc.Conn
+
+	Announce(ctx context.Context, in *AnnounceRequest) (*AnnounceResponse, error)
+	Cancel(ctx context.Context, in *CancelRequest) (*CancelResponse, error)
+}
+
+type drpcNodeMaintenanceClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeMaintenanceClient(cc drpc.Conn) DRPCNodeMaintenanceClient {
+	return &drpcNodeMaintenanceClient{cc}
+}
+
+func (c *drpcNodeMaintenanceClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeMaintenanceClient) Announce(ctx context.Context, in *AnnounceRequest) (*AnnounceResponse, error) {
+	out := new(AnnounceResponse)
+	err := c.cc.Invoke(ctx, "/maintenance.NodeMaintenance/Announce", drpcEncoding_File_maintenance_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeMaintenanceClient) Cancel(ctx context.Context, in *CancelRequest) (*CancelResponse, error) {
+	out := new(CancelResponse)
+	err := c.cc.Invoke(ctx, "/maintenance.NodeMaintenance/Cancel", drpcEncoding_File_maintenance_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeMaintenanceServer interface {
+	Announce(context.Context, *AnnounceRequest) (*AnnounceResponse, error)
+	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+}
+
+type DRPCNodeMaintenanceUnimplementedServer struct{}
+
+func (s *DRPCNodeMaintenanceUnimplementedServer) Announce(context.Context, *AnnounceRequest) (*AnnounceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCNodeMaintenanceUnimplementedServer) Cancel(context.Context, *CancelRequest) (*CancelResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCNodeMaintenanceDescription struct{}
+
+func (DRPCNodeMaintenanceDescription) NumMethods() int { return 2 }
+
+func (DRPCNodeMaintenanceDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/maintenance.NodeMaintenance/Announce", drpcEncoding_File_maintenance_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeMaintenanceServer).
+					Announce(
+						ctx,
+						in1.(*AnnounceRequest),
+					)
+			}, DRPCNodeMaintenanceServer.Announce, true
+	case 1:
+		return "/maintenance.NodeMaintenance/Cancel", drpcEncoding_File_maintenance_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeMaintenanceServer).
+					Cancel(
+						ctx,
+						in1.(*CancelRequest),
+					)
+			}, DRPCNodeMaintenanceServer.Cancel, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterNodeMaintenance(mux drpc.Mux, impl DRPCNodeMaintenanceServer) error {
+	return mux.Register(impl, DRPCNodeMaintenanceDescription{})
+}
+
+type DRPCNodeMaintenance_AnnounceStream interface {
+	drpc.Stream
+	SendAndClose(*AnnounceResponse) error
+}
+
+type drpcNodeMaintenance_AnnounceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeMaintenance_AnnounceStream) SendAndClose(m *AnnounceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_maintenance_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeMaintenance_CancelStream interface {
+	drpc.Stream
+	SendAndClose(*CancelResponse) error
+}
+
+type drpcNodeMaintenance_CancelStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeMaintenance_CancelStream) SendAndClose(m *CancelResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_maintenance_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
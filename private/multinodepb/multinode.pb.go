@@ -1992,6 +1992,695 @@ func (m *OperatorResponse) GetWalletFeatures() []string {
 	return nil
 }
 
+type InitiateGracefulExitRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitiateGracefulExitRequest) Reset()         { *m = InitiateGracefulExitRequest{} }
+func (m *InitiateGracefulExitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitRequest) ProtoMessage()    {}
+func (*InitiateGracefulExitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{40}
+}
+func (m *InitiateGracefulExitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitRequest.Merge(m, src)
+}
+func (m *InitiateGracefulExitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitRequest.Size(m)
+}
+func (m *InitiateGracefulExitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitRequest proto.InternalMessageInfo
+
+func (m *InitiateGracefulExitRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type InitiateGracefulExitResponse struct {
+	DomainName           string   `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	PercentComplete      float32  `protobuf:"fixed32,3,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	Successful           bool     `protobuf:"varint,4,opt,name=successful,proto3" json:"successful,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateGracefulExitResponse) Reset()         { *m = InitiateGracefulExitResponse{} }
+func (m *InitiateGracefulExitResponse) String() string { return proto.CompactTextString(m) }
+func (*InitiateGracefulExitResponse) ProtoMessage()    {}
+func (*InitiateGracefulExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{41}
+}
+func (m *InitiateGracefulExitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Unmarshal(m, b)
+}
+func (m *InitiateGracefulExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Marshal(b, m, deterministic)
+}
+func (m *InitiateGracefulExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateGracefulExitResponse.Merge(m, src)
+}
+func (m *InitiateGracefulExitResponse) XXX_Size() int {
+	return xxx_messageInfo_InitiateGracefulExitResponse.Size(m)
+}
+func (m *InitiateGracefulExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateGracefulExitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateGracefulExitResponse proto.InternalMessageInfo
+
+func (m *InitiateGracefulExitResponse) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *InitiateGracefulExitResponse) GetPercentComplete() float32 {
+	if m != nil {
+		return m.PercentComplete
+	}
+	return 0
+}
+
+func (m *InitiateGracefulExitResponse) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+type SetAllocatedDiskSpaceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Allocated            int64          `protobuf:"varint,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceRequest) Reset()         { *m = SetAllocatedDiskSpaceRequest{} }
+func (m *SetAllocatedDiskSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceRequest) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{42}
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceRequest.Size(m)
+}
+func (m *SetAllocatedDiskSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceRequest proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetAllocatedDiskSpaceRequest) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type SetAllocatedDiskSpaceResponse struct {
+	Allocated            int64    `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAllocatedDiskSpaceResponse) Reset()         { *m = SetAllocatedDiskSpaceResponse{} }
+func (m *SetAllocatedDiskSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAllocatedDiskSpaceResponse) ProtoMessage()    {}
+func (*SetAllocatedDiskSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{43}
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Unmarshal(m, b)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.Merge(m, src)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAllocatedDiskSpaceResponse.Size(m)
+}
+func (m *SetAllocatedDiskSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAllocatedDiskSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAllocatedDiskSpaceResponse proto.InternalMessageInfo
+
+func (m *SetAllocatedDiskSpaceResponse) GetAllocated() int64 {
+	if m != nil {
+		return m.Allocated
+	}
+	return 0
+}
+
+type TrustExclusionsRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TrustExclusionsRequest) Reset()         { *m = TrustExclusionsRequest{} }
+func (m *TrustExclusionsRequest) String() string { return proto.CompactTextString(m) }
+func (*TrustExclusionsRequest) ProtoMessage()    {}
+func (*TrustExclusionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{44}
+}
+func (m *TrustExclusionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustExclusionsRequest.Unmarshal(m, b)
+}
+func (m *TrustExclusionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustExclusionsRequest.Marshal(b, m, deterministic)
+}
+func (m *TrustExclusionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustExclusionsRequest.Merge(m, src)
+}
+func (m *TrustExclusionsRequest) XXX_Size() int {
+	return xxx_messageInfo_TrustExclusionsRequest.Size(m)
+}
+func (m *TrustExclusionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustExclusionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustExclusionsRequest proto.InternalMessageInfo
+
+func (m *TrustExclusionsRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type TrustExclusionsResponse struct {
+	Exclusions           []string `protobuf:"bytes,1,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrustExclusionsResponse) Reset()         { *m = TrustExclusionsResponse{} }
+func (m *TrustExclusionsResponse) String() string { return proto.CompactTextString(m) }
+func (*TrustExclusionsResponse) ProtoMessage()    {}
+func (*TrustExclusionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{45}
+}
+func (m *TrustExclusionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrustExclusionsResponse.Unmarshal(m, b)
+}
+func (m *TrustExclusionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrustExclusionsResponse.Marshal(b, m, deterministic)
+}
+func (m *TrustExclusionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrustExclusionsResponse.Merge(m, src)
+}
+func (m *TrustExclusionsResponse) XXX_Size() int {
+	return xxx_messageInfo_TrustExclusionsResponse.Size(m)
+}
+func (m *TrustExclusionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrustExclusionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrustExclusionsResponse proto.InternalMessageInfo
+
+func (m *TrustExclusionsResponse) GetExclusions() []string {
+	if m != nil {
+		return m.Exclusions
+	}
+	return nil
+}
+
+type AddTrustExclusionRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Exclusion            string         `protobuf:"bytes,2,opt,name=exclusion,proto3" json:"exclusion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AddTrustExclusionRequest) Reset()         { *m = AddTrustExclusionRequest{} }
+func (m *AddTrustExclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*AddTrustExclusionRequest) ProtoMessage()    {}
+func (*AddTrustExclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{46}
+}
+func (m *AddTrustExclusionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTrustExclusionRequest.Unmarshal(m, b)
+}
+func (m *AddTrustExclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTrustExclusionRequest.Marshal(b, m, deterministic)
+}
+func (m *AddTrustExclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTrustExclusionRequest.Merge(m, src)
+}
+func (m *AddTrustExclusionRequest) XXX_Size() int {
+	return xxx_messageInfo_AddTrustExclusionRequest.Size(m)
+}
+func (m *AddTrustExclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTrustExclusionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTrustExclusionRequest proto.InternalMessageInfo
+
+func (m *AddTrustExclusionRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AddTrustExclusionRequest) GetExclusion() string {
+	if m != nil {
+		return m.Exclusion
+	}
+	return ""
+}
+
+type AddTrustExclusionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddTrustExclusionResponse) Reset()         { *m = AddTrustExclusionResponse{} }
+func (m *AddTrustExclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*AddTrustExclusionResponse) ProtoMessage()    {}
+func (*AddTrustExclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{47}
+}
+func (m *AddTrustExclusionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddTrustExclusionResponse.Unmarshal(m, b)
+}
+func (m *AddTrustExclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddTrustExclusionResponse.Marshal(b, m, deterministic)
+}
+func (m *AddTrustExclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddTrustExclusionResponse.Merge(m, src)
+}
+func (m *AddTrustExclusionResponse) XXX_Size() int {
+	return xxx_messageInfo_AddTrustExclusionResponse.Size(m)
+}
+func (m *AddTrustExclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddTrustExclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddTrustExclusionResponse proto.InternalMessageInfo
+
+type RemoveTrustExclusionRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Exclusion            string         `protobuf:"bytes,2,opt,name=exclusion,proto3" json:"exclusion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RemoveTrustExclusionRequest) Reset()         { *m = RemoveTrustExclusionRequest{} }
+func (m *RemoveTrustExclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTrustExclusionRequest) ProtoMessage()    {}
+func (*RemoveTrustExclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{48}
+}
+func (m *RemoveTrustExclusionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTrustExclusionRequest.Unmarshal(m, b)
+}
+func (m *RemoveTrustExclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTrustExclusionRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveTrustExclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTrustExclusionRequest.Merge(m, src)
+}
+func (m *RemoveTrustExclusionRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveTrustExclusionRequest.Size(m)
+}
+func (m *RemoveTrustExclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTrustExclusionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTrustExclusionRequest proto.InternalMessageInfo
+
+func (m *RemoveTrustExclusionRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RemoveTrustExclusionRequest) GetExclusion() string {
+	if m != nil {
+		return m.Exclusion
+	}
+	return ""
+}
+
+type RemoveTrustExclusionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTrustExclusionResponse) Reset()         { *m = RemoveTrustExclusionResponse{} }
+func (m *RemoveTrustExclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveTrustExclusionResponse) ProtoMessage()    {}
+func (*RemoveTrustExclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{49}
+}
+func (m *RemoveTrustExclusionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTrustExclusionResponse.Unmarshal(m, b)
+}
+func (m *RemoveTrustExclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTrustExclusionResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveTrustExclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTrustExclusionResponse.Merge(m, src)
+}
+func (m *RemoveTrustExclusionResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveTrustExclusionResponse.Size(m)
+}
+func (m *RemoveTrustExclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTrustExclusionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTrustExclusionResponse proto.InternalMessageInfo
+
+type RescanUsedSpaceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RescanUsedSpaceRequest) Reset()         { *m = RescanUsedSpaceRequest{} }
+func (m *RescanUsedSpaceRequest) String() string { return proto.CompactTextString(m) }
+func (*RescanUsedSpaceRequest) ProtoMessage()    {}
+func (*RescanUsedSpaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{50}
+}
+func (m *RescanUsedSpaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RescanUsedSpaceRequest.Unmarshal(m, b)
+}
+func (m *RescanUsedSpaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RescanUsedSpaceRequest.Marshal(b, m, deterministic)
+}
+func (m *RescanUsedSpaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescanUsedSpaceRequest.Merge(m, src)
+}
+func (m *RescanUsedSpaceRequest) XXX_Size() int {
+	return xxx_messageInfo_RescanUsedSpaceRequest.Size(m)
+}
+func (m *RescanUsedSpaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescanUsedSpaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescanUsedSpaceRequest proto.InternalMessageInfo
+
+func (m *RescanUsedSpaceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type RescanUsedSpaceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescanUsedSpaceResponse) Reset()         { *m = RescanUsedSpaceResponse{} }
+func (m *RescanUsedSpaceResponse) String() string { return proto.CompactTextString(m) }
+func (*RescanUsedSpaceResponse) ProtoMessage()    {}
+func (*RescanUsedSpaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{51}
+}
+func (m *RescanUsedSpaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RescanUsedSpaceResponse.Unmarshal(m, b)
+}
+func (m *RescanUsedSpaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RescanUsedSpaceResponse.Marshal(b, m, deterministic)
+}
+func (m *RescanUsedSpaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescanUsedSpaceResponse.Merge(m, src)
+}
+func (m *RescanUsedSpaceResponse) XXX_Size() int {
+	return xxx_messageInfo_RescanUsedSpaceResponse.Size(m)
+}
+func (m *RescanUsedSpaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescanUsedSpaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescanUsedSpaceResponse proto.InternalMessageInfo
+
+type AnnounceMaintenanceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Start                time.Time      `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+	End                  time.Time      `protobuf:"bytes,3,opt,name=end,proto3,stdtime" json:"end"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AnnounceMaintenanceRequest) Reset()         { *m = AnnounceMaintenanceRequest{} }
+func (m *AnnounceMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AnnounceMaintenanceRequest) ProtoMessage()    {}
+func (*AnnounceMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{52}
+}
+func (m *AnnounceMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnounceMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *AnnounceMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnounceMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *AnnounceMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnounceMaintenanceRequest.Merge(m, src)
+}
+func (m *AnnounceMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_AnnounceMaintenanceRequest.Size(m)
+}
+func (m *AnnounceMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnounceMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnounceMaintenanceRequest proto.InternalMessageInfo
+
+func (m *AnnounceMaintenanceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *AnnounceMaintenanceRequest) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *AnnounceMaintenanceRequest) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+type AnnounceMaintenanceResponse struct {
+	Satellites           []*AnnounceMaintenanceResponse_Satellite `protobuf:"bytes,1,rep,name=satellites,proto3" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *AnnounceMaintenanceResponse) Reset()         { *m = AnnounceMaintenanceResponse{} }
+func (m *AnnounceMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*AnnounceMaintenanceResponse) ProtoMessage()    {}
+func (*AnnounceMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{53}
+}
+func (m *AnnounceMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnounceMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *AnnounceMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnounceMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *AnnounceMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnounceMaintenanceResponse.Merge(m, src)
+}
+func (m *AnnounceMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_AnnounceMaintenanceResponse.Size(m)
+}
+func (m *AnnounceMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnounceMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnounceMaintenanceResponse proto.InternalMessageInfo
+
+func (m *AnnounceMaintenanceResponse) GetSatellites() []*AnnounceMaintenanceResponse_Satellite {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+type AnnounceMaintenanceResponse_Satellite struct {
+	SatelliteId          NodeID    `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	Start                time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+	End                  time.Time `protobuf:"bytes,3,opt,name=end,proto3,stdtime" json:"end"`
+	Error                string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *AnnounceMaintenanceResponse_Satellite) Reset()         { *m = AnnounceMaintenanceResponse_Satellite{} }
+func (m *AnnounceMaintenanceResponse_Satellite) String() string { return proto.CompactTextString(m) }
+func (*AnnounceMaintenanceResponse_Satellite) ProtoMessage()    {}
+func (*AnnounceMaintenanceResponse_Satellite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{53, 0}
+}
+func (m *AnnounceMaintenanceResponse_Satellite) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnounceMaintenanceResponse_Satellite.Unmarshal(m, b)
+}
+func (m *AnnounceMaintenanceResponse_Satellite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnounceMaintenanceResponse_Satellite.Marshal(b, m, deterministic)
+}
+func (m *AnnounceMaintenanceResponse_Satellite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnounceMaintenanceResponse_Satellite.Merge(m, src)
+}
+func (m *AnnounceMaintenanceResponse_Satellite) XXX_Size() int {
+	return xxx_messageInfo_AnnounceMaintenanceResponse_Satellite.Size(m)
+}
+func (m *AnnounceMaintenanceResponse_Satellite) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnounceMaintenanceResponse_Satellite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnounceMaintenanceResponse_Satellite proto.InternalMessageInfo
+
+func (m *AnnounceMaintenanceResponse_Satellite) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *AnnounceMaintenanceResponse_Satellite) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
+}
+
+func (m *AnnounceMaintenanceResponse_Satellite) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CancelMaintenanceRequest struct {
+	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CancelMaintenanceRequest) Reset()         { *m = CancelMaintenanceRequest{} }
+func (m *CancelMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceRequest) ProtoMessage()    {}
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{54}
+}
+func (m *CancelMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceRequest.Merge(m, src)
+}
+func (m *CancelMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceRequest.Size(m)
+}
+func (m *CancelMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceRequest proto.InternalMessageInfo
+
+func (m *CancelMaintenanceRequest) GetHeader() *RequestHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+type CancelMaintenanceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMaintenanceResponse) Reset()         { *m = CancelMaintenanceResponse{} }
+func (m *CancelMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceResponse) ProtoMessage()    {}
+func (*CancelMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a45fd79b06f3a1b, []int{55}
+}
+func (m *CancelMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceResponse.Merge(m, src)
+}
+func (m *CancelMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceResponse.Size(m)
+}
+func (m *CancelMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceResponse proto.InternalMessageInfo
+
 type EstimatedPayoutSatelliteRequest struct {
 	Header               *RequestHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	SatelliteId          NodeID         `protobuf:"bytes,2,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
@@ -2004,7 +2693,7 @@ func (m *EstimatedPayoutSatelliteRequest) Reset()         { *m = EstimatedPayout
 func (m *EstimatedPayoutSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutSatelliteRequest) ProtoMessage()    {}
 func (*EstimatedPayoutSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{40}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{56}
 }
 func (m *EstimatedPayoutSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutSatelliteRequest.Unmarshal(m, b)
@@ -2042,7 +2731,7 @@ func (m *EstimatedPayoutSatelliteResponse) Reset()         { *m = EstimatedPayou
 func (m *EstimatedPayoutSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutSatelliteResponse) ProtoMessage()    {}
 func (*EstimatedPayoutSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{41}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{57}
 }
 func (m *EstimatedPayoutSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutSatelliteResponse.Unmarshal(m, b)
@@ -2080,7 +2769,7 @@ func (m *EstimatedPayoutRequest) Reset()         { *m = EstimatedPayoutRequest{}
 func (m *EstimatedPayoutRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutRequest) ProtoMessage()    {}
 func (*EstimatedPayoutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{42}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{58}
 }
 func (m *EstimatedPayoutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutRequest.Unmarshal(m, b)
@@ -2118,7 +2807,7 @@ func (m *EstimatedPayoutResponse) Reset()         { *m = EstimatedPayoutResponse
 func (m *EstimatedPayoutResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutResponse) ProtoMessage()    {}
 func (*EstimatedPayoutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{43}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{59}
 }
 func (m *EstimatedPayoutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutResponse.Unmarshal(m, b)
@@ -2156,7 +2845,7 @@ func (m *SummaryRequest) Reset()         { *m = SummaryRequest{} }
 func (m *SummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SummaryRequest) ProtoMessage()    {}
 func (*SummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{44}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{60}
 }
 func (m *SummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryRequest.Unmarshal(m, b)
@@ -2194,7 +2883,7 @@ func (m *SummaryResponse) Reset()         { *m = SummaryResponse{} }
 func (m *SummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SummaryResponse) ProtoMessage()    {}
 func (*SummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{45}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{61}
 }
 func (m *SummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryResponse.Unmarshal(m, b)
@@ -2233,7 +2922,7 @@ func (m *SummaryPeriodRequest) Reset()         { *m = SummaryPeriodRequest{} }
 func (m *SummaryPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*SummaryPeriodRequest) ProtoMessage()    {}
 func (*SummaryPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{46}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{62}
 }
 func (m *SummaryPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryPeriodRequest.Unmarshal(m, b)
@@ -2278,7 +2967,7 @@ func (m *SummaryPeriodResponse) Reset()         { *m = SummaryPeriodResponse{} }
 func (m *SummaryPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*SummaryPeriodResponse) ProtoMessage()    {}
 func (*SummaryPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{47}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{63}
 }
 func (m *SummaryPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummaryPeriodResponse.Unmarshal(m, b)
@@ -2317,7 +3006,7 @@ func (m *SummarySatelliteRequest) Reset()         { *m = SummarySatelliteRequest
 func (m *SummarySatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*SummarySatelliteRequest) ProtoMessage()    {}
 func (*SummarySatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{48}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{64}
 }
 func (m *SummarySatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatelliteRequest.Unmarshal(m, b)
@@ -2355,7 +3044,7 @@ func (m *SummarySatelliteResponse) Reset()         { *m = SummarySatelliteRespon
 func (m *SummarySatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*SummarySatelliteResponse) ProtoMessage()    {}
 func (*SummarySatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{49}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{65}
 }
 func (m *SummarySatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatelliteResponse.Unmarshal(m, b)
@@ -2395,7 +3084,7 @@ func (m *SummarySatellitePeriodRequest) Reset()         { *m = SummarySatelliteP
 func (m *SummarySatellitePeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*SummarySatellitePeriodRequest) ProtoMessage()    {}
 func (*SummarySatellitePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{50}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{66}
 }
 func (m *SummarySatellitePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatellitePeriodRequest.Unmarshal(m, b)
@@ -2440,7 +3129,7 @@ func (m *SummarySatellitePeriodResponse) Reset()         { *m = SummarySatellite
 func (m *SummarySatellitePeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*SummarySatellitePeriodResponse) ProtoMessage()    {}
 func (*SummarySatellitePeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{51}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{67}
 }
 func (m *SummarySatellitePeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SummarySatellitePeriodResponse.Unmarshal(m, b)
@@ -2478,7 +3167,7 @@ func (m *EarnedRequest) Reset()         { *m = EarnedRequest{} }
 func (m *EarnedRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedRequest) ProtoMessage()    {}
 func (*EarnedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{52}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{68}
 }
 func (m *EarnedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedRequest.Unmarshal(m, b)
@@ -2516,7 +3205,7 @@ func (m *EarnedResponse) Reset()         { *m = EarnedResponse{} }
 func (m *EarnedResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedResponse) ProtoMessage()    {}
 func (*EarnedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{53}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{69}
 }
 func (m *EarnedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedResponse.Unmarshal(m, b)
@@ -2554,7 +3243,7 @@ func (m *EarnedSatelliteRequest) Reset()         { *m = EarnedSatelliteRequest{}
 func (m *EarnedSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatelliteRequest) ProtoMessage()    {}
 func (*EarnedSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{54}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{70}
 }
 func (m *EarnedSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatelliteRequest.Unmarshal(m, b)
@@ -2592,7 +3281,7 @@ func (m *EarnedSatelliteResponse) Reset()         { *m = EarnedSatelliteResponse
 func (m *EarnedSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatelliteResponse) ProtoMessage()    {}
 func (*EarnedSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{55}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{71}
 }
 func (m *EarnedSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatelliteResponse.Unmarshal(m, b)
@@ -2631,7 +3320,7 @@ func (m *EarnedSatellite) Reset()         { *m = EarnedSatellite{} }
 func (m *EarnedSatellite) String() string { return proto.CompactTextString(m) }
 func (*EarnedSatellite) ProtoMessage()    {}
 func (*EarnedSatellite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{56}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{72}
 }
 func (m *EarnedSatellite) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedSatellite.Unmarshal(m, b)
@@ -2669,7 +3358,7 @@ func (m *UndistributedRequest) Reset()         { *m = UndistributedRequest{} }
 func (m *UndistributedRequest) String() string { return proto.CompactTextString(m) }
 func (*UndistributedRequest) ProtoMessage()    {}
 func (*UndistributedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{57}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{73}
 }
 func (m *UndistributedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UndistributedRequest.Unmarshal(m, b)
@@ -2707,7 +3396,7 @@ func (m *UndistributedResponse) Reset()         { *m = UndistributedResponse{} }
 func (m *UndistributedResponse) String() string { return proto.CompactTextString(m) }
 func (*UndistributedResponse) ProtoMessage()    {}
 func (*UndistributedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{58}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{74}
 }
 func (m *UndistributedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UndistributedResponse.Unmarshal(m, b)
@@ -2746,7 +3435,7 @@ func (m *PaystubSatelliteRequest) Reset()         { *m = PaystubSatelliteRequest
 func (m *PaystubSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatelliteRequest) ProtoMessage()    {}
 func (*PaystubSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{59}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{75}
 }
 func (m *PaystubSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatelliteRequest.Unmarshal(m, b)
@@ -2784,7 +3473,7 @@ func (m *PaystubSatelliteResponse) Reset()         { *m = PaystubSatelliteRespon
 func (m *PaystubSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatelliteResponse) ProtoMessage()    {}
 func (*PaystubSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{60}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{76}
 }
 func (m *PaystubSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatelliteResponse.Unmarshal(m, b)
@@ -2822,7 +3511,7 @@ func (m *PaystubRequest) Reset()         { *m = PaystubRequest{} }
 func (m *PaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubRequest) ProtoMessage()    {}
 func (*PaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{61}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{77}
 }
 func (m *PaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubRequest.Unmarshal(m, b)
@@ -2860,7 +3549,7 @@ func (m *PaystubResponse) Reset()         { *m = PaystubResponse{} }
 func (m *PaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubResponse) ProtoMessage()    {}
 func (*PaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{62}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{78}
 }
 func (m *PaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubResponse.Unmarshal(m, b)
@@ -2899,7 +3588,7 @@ func (m *PaystubPeriodRequest) Reset()         { *m = PaystubPeriodRequest{} }
 func (m *PaystubPeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubPeriodRequest) ProtoMessage()    {}
 func (*PaystubPeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{63}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{79}
 }
 func (m *PaystubPeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubPeriodRequest.Unmarshal(m, b)
@@ -2944,7 +3633,7 @@ func (m *PaystubPeriodResponse) Reset()         { *m = PaystubPeriodResponse{} }
 func (m *PaystubPeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubPeriodResponse) ProtoMessage()    {}
 func (*PaystubPeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{64}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{80}
 }
 func (m *PaystubPeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubPeriodResponse.Unmarshal(m, b)
@@ -2984,7 +3673,7 @@ func (m *PaystubSatellitePeriodRequest) Reset()         { *m = PaystubSatelliteP
 func (m *PaystubSatellitePeriodRequest) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatellitePeriodRequest) ProtoMessage()    {}
 func (*PaystubSatellitePeriodRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{65}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{81}
 }
 func (m *PaystubSatellitePeriodRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatellitePeriodRequest.Unmarshal(m, b)
@@ -3029,7 +3718,7 @@ func (m *PaystubSatellitePeriodResponse) Reset()         { *m = PaystubSatellite
 func (m *PaystubSatellitePeriodResponse) String() string { return proto.CompactTextString(m) }
 func (*PaystubSatellitePeriodResponse) ProtoMessage()    {}
 func (*PaystubSatellitePeriodResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{66}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{82}
 }
 func (m *PaystubSatellitePeriodResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaystubSatellitePeriodResponse.Unmarshal(m, b)
@@ -3068,7 +3757,7 @@ func (m *PayoutInfo) Reset()         { *m = PayoutInfo{} }
 func (m *PayoutInfo) String() string { return proto.CompactTextString(m) }
 func (*PayoutInfo) ProtoMessage()    {}
 func (*PayoutInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{67}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{83}
 }
 func (m *PayoutInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayoutInfo.Unmarshal(m, b)
@@ -3124,7 +3813,7 @@ func (m *Paystub) Reset()         { *m = Paystub{} }
 func (m *Paystub) String() string { return proto.CompactTextString(m) }
 func (*Paystub) ProtoMessage()    {}
 func (*Paystub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{68}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{84}
 }
 func (m *Paystub) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Paystub.Unmarshal(m, b)
@@ -3239,7 +3928,7 @@ func (m *HeldAmountHistoryRequest) Reset()         { *m = HeldAmountHistoryReque
 func (m *HeldAmountHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryRequest) ProtoMessage()    {}
 func (*HeldAmountHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{69}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{85}
 }
 func (m *HeldAmountHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryRequest.Unmarshal(m, b)
@@ -3277,7 +3966,7 @@ func (m *HeldAmountHistoryResponse) Reset()         { *m = HeldAmountHistoryResp
 func (m *HeldAmountHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryResponse) ProtoMessage()    {}
 func (*HeldAmountHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{86}
 }
 func (m *HeldAmountHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse.Unmarshal(m, b)
@@ -3316,7 +4005,7 @@ func (m *HeldAmountHistoryResponse_HeldAmount) Reset()         { *m = HeldAmount
 func (m *HeldAmountHistoryResponse_HeldAmount) String() string { return proto.CompactTextString(m) }
 func (*HeldAmountHistoryResponse_HeldAmount) ProtoMessage()    {}
 func (*HeldAmountHistoryResponse_HeldAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70, 0}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{86, 0}
 }
 func (m *HeldAmountHistoryResponse_HeldAmount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmount.Unmarshal(m, b)
@@ -3366,7 +4055,7 @@ func (m *HeldAmountHistoryResponse_HeldAmountHistory) String() string {
 }
 func (*HeldAmountHistoryResponse_HeldAmountHistory) ProtoMessage() {}
 func (*HeldAmountHistoryResponse_HeldAmountHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{70, 1}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{86, 1}
 }
 func (m *HeldAmountHistoryResponse_HeldAmountHistory) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HeldAmountHistoryResponse_HeldAmountHistory.Unmarshal(m, b)
@@ -3404,7 +4093,7 @@ func (m *EstimatedPayoutTotalRequest) Reset()         { *m = EstimatedPayoutTota
 func (m *EstimatedPayoutTotalRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutTotalRequest) ProtoMessage()    {}
 func (*EstimatedPayoutTotalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{71}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{87}
 }
 func (m *EstimatedPayoutTotalRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutTotalRequest.Unmarshal(m, b)
@@ -3442,7 +4131,7 @@ func (m *EstimatedPayoutTotalResponse) Reset()         { *m = EstimatedPayoutTot
 func (m *EstimatedPayoutTotalResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimatedPayoutTotalResponse) ProtoMessage()    {}
 func (*EstimatedPayoutTotalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{72}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{88}
 }
 func (m *EstimatedPayoutTotalResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimatedPayoutTotalResponse.Unmarshal(m, b)
@@ -3480,7 +4169,7 @@ func (m *AllSatellitesSummaryRequest) Reset()         { *m = AllSatellitesSummar
 func (m *AllSatellitesSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesSummaryRequest) ProtoMessage()    {}
 func (*AllSatellitesSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{73}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{89}
 }
 func (m *AllSatellitesSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesSummaryRequest.Unmarshal(m, b)
@@ -3518,7 +4207,7 @@ func (m *AllSatellitesSummaryResponse) Reset()         { *m = AllSatellitesSumma
 func (m *AllSatellitesSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesSummaryResponse) ProtoMessage()    {}
 func (*AllSatellitesSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{74}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{90}
 }
 func (m *AllSatellitesSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesSummaryResponse.Unmarshal(m, b)
@@ -3557,7 +4246,7 @@ func (m *AllSatellitesPeriodSummaryRequest) Reset()         { *m = AllSatellites
 func (m *AllSatellitesPeriodSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesPeriodSummaryRequest) ProtoMessage()    {}
 func (*AllSatellitesPeriodSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{75}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{91}
 }
 func (m *AllSatellitesPeriodSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesPeriodSummaryRequest.Unmarshal(m, b)
@@ -3602,7 +4291,7 @@ func (m *AllSatellitesPeriodSummaryResponse) Reset()         { *m = AllSatellite
 func (m *AllSatellitesPeriodSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*AllSatellitesPeriodSummaryResponse) ProtoMessage()    {}
 func (*AllSatellitesPeriodSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{76}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{92}
 }
 func (m *AllSatellitesPeriodSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AllSatellitesPeriodSummaryResponse.Unmarshal(m, b)
@@ -3641,7 +4330,7 @@ func (m *SatelliteSummaryRequest) Reset()         { *m = SatelliteSummaryRequest
 func (m *SatelliteSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SatelliteSummaryRequest) ProtoMessage()    {}
 func (*SatelliteSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{77}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{93}
 }
 func (m *SatelliteSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatelliteSummaryRequest.Unmarshal(m, b)
@@ -3679,7 +4368,7 @@ func (m *SatelliteSummaryResponse) Reset()         { *m = SatelliteSummaryRespon
 func (m *SatelliteSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SatelliteSummaryResponse) ProtoMessage()    {}
 func (*SatelliteSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{78}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{94}
 }
 func (m *SatelliteSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatelliteSummaryResponse.Unmarshal(m, b)
@@ -3719,7 +4408,7 @@ func (m *SatellitePeriodSummaryRequest) Reset()         { *m = SatellitePeriodSu
 func (m *SatellitePeriodSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodSummaryRequest) ProtoMessage()    {}
 func (*SatellitePeriodSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{79}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{95}
 }
 func (m *SatellitePeriodSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodSummaryRequest.Unmarshal(m, b)
@@ -3764,7 +4453,7 @@ func (m *SatellitePeriodSummaryResponse) Reset()         { *m = SatellitePeriodS
 func (m *SatellitePeriodSummaryResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodSummaryResponse) ProtoMessage()    {}
 func (*SatellitePeriodSummaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{80}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{96}
 }
 func (m *SatellitePeriodSummaryResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodSummaryResponse.Unmarshal(m, b)
@@ -3802,7 +4491,7 @@ func (m *EarnedPerSatelliteRequest) Reset()         { *m = EarnedPerSatelliteReq
 func (m *EarnedPerSatelliteRequest) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteRequest) ProtoMessage()    {}
 func (*EarnedPerSatelliteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{81}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{97}
 }
 func (m *EarnedPerSatelliteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteRequest.Unmarshal(m, b)
@@ -3840,7 +4529,7 @@ func (m *EarnedPerSatelliteResponse) Reset()         { *m = EarnedPerSatelliteRe
 func (m *EarnedPerSatelliteResponse) String() string { return proto.CompactTextString(m) }
 func (*EarnedPerSatelliteResponse) ProtoMessage()    {}
 func (*EarnedPerSatelliteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{82}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{98}
 }
 func (m *EarnedPerSatelliteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EarnedPerSatelliteResponse.Unmarshal(m, b)
@@ -3879,7 +4568,7 @@ func (m *SatellitePaystubRequest) Reset()         { *m = SatellitePaystubRequest
 func (m *SatellitePaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePaystubRequest) ProtoMessage()    {}
 func (*SatellitePaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{83}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{99}
 }
 func (m *SatellitePaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePaystubRequest.Unmarshal(m, b)
@@ -3917,7 +4606,7 @@ func (m *SatellitePaystubResponse) Reset()         { *m = SatellitePaystubRespon
 func (m *SatellitePaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePaystubResponse) ProtoMessage()    {}
 func (*SatellitePaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{84}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{100}
 }
 func (m *SatellitePaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePaystubResponse.Unmarshal(m, b)
@@ -3956,7 +4645,7 @@ func (m *PeriodPaystubRequest) Reset()         { *m = PeriodPaystubRequest{} }
 func (m *PeriodPaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*PeriodPaystubRequest) ProtoMessage()    {}
 func (*PeriodPaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{85}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{101}
 }
 func (m *PeriodPaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeriodPaystubRequest.Unmarshal(m, b)
@@ -4001,7 +4690,7 @@ func (m *PeriodPaystubResponse) Reset()         { *m = PeriodPaystubResponse{} }
 func (m *PeriodPaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*PeriodPaystubResponse) ProtoMessage()    {}
 func (*PeriodPaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{86}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{102}
 }
 func (m *PeriodPaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PeriodPaystubResponse.Unmarshal(m, b)
@@ -4041,7 +4730,7 @@ func (m *SatellitePeriodPaystubRequest) Reset()         { *m = SatellitePeriodPa
 func (m *SatellitePeriodPaystubRequest) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodPaystubRequest) ProtoMessage()    {}
 func (*SatellitePeriodPaystubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{87}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{103}
 }
 func (m *SatellitePeriodPaystubRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodPaystubRequest.Unmarshal(m, b)
@@ -4086,7 +4775,7 @@ func (m *SatellitePeriodPaystubResponse) Reset()         { *m = SatellitePeriodP
 func (m *SatellitePeriodPaystubResponse) String() string { return proto.CompactTextString(m) }
 func (*SatellitePeriodPaystubResponse) ProtoMessage()    {}
 func (*SatellitePeriodPaystubResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a45fd79b06f3a1b, []int{88}
+	return fileDescriptor_9a45fd79b06f3a1b, []int{104}
 }
 func (m *SatellitePeriodPaystubResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SatellitePeriodPaystubResponse.Unmarshal(m, b)
@@ -4157,6 +4846,23 @@ func init() {
 	proto.RegisterType((*TrustedSatellitesResponse_NodeURL)(nil), "multinode.TrustedSatellitesResponse.NodeURL")
 	proto.RegisterType((*OperatorRequest)(nil), "multinode.OperatorRequest")
 	proto.RegisterType((*OperatorResponse)(nil), "multinode.OperatorResponse")
+	proto.RegisterType((*InitiateGracefulExitRequest)(nil), "multinode.InitiateGracefulExitRequest")
+	proto.RegisterType((*InitiateGracefulExitResponse)(nil), "multinode.InitiateGracefulExitResponse")
+	proto.RegisterType((*SetAllocatedDiskSpaceRequest)(nil), "multinode.SetAllocatedDiskSpaceRequest")
+	proto.RegisterType((*SetAllocatedDiskSpaceResponse)(nil), "multinode.SetAllocatedDiskSpaceResponse")
+	proto.RegisterType((*TrustExclusionsRequest)(nil), "multinode.TrustExclusionsRequest")
+	proto.RegisterType((*TrustExclusionsResponse)(nil), "multinode.TrustExclusionsResponse")
+	proto.RegisterType((*AddTrustExclusionRequest)(nil), "multinode.AddTrustExclusionRequest")
+	proto.RegisterType((*AddTrustExclusionResponse)(nil), "multinode.AddTrustExclusionResponse")
+	proto.RegisterType((*RemoveTrustExclusionRequest)(nil), "multinode.RemoveTrustExclusionRequest")
+	proto.RegisterType((*RemoveTrustExclusionResponse)(nil), "multinode.RemoveTrustExclusionResponse")
+	proto.RegisterType((*RescanUsedSpaceRequest)(nil), "multinode.RescanUsedSpaceRequest")
+	proto.RegisterType((*RescanUsedSpaceResponse)(nil), "multinode.RescanUsedSpaceResponse")
+	proto.RegisterType((*AnnounceMaintenanceRequest)(nil), "multinode.AnnounceMaintenanceRequest")
+	proto.RegisterType((*AnnounceMaintenanceResponse)(nil), "multinode.AnnounceMaintenanceResponse")
+	proto.RegisterType((*AnnounceMaintenanceResponse_Satellite)(nil), "multinode.AnnounceMaintenanceResponse.Satellite")
+	proto.RegisterType((*CancelMaintenanceRequest)(nil), "multinode.CancelMaintenanceRequest")
+	proto.RegisterType((*CancelMaintenanceResponse)(nil), "multinode.CancelMaintenanceResponse")
 	proto.RegisterType((*EstimatedPayoutSatelliteRequest)(nil), "multinode.EstimatedPayoutSatelliteRequest")
 	proto.RegisterType((*EstimatedPayoutSatelliteResponse)(nil), "multinode.EstimatedPayoutSatelliteResponse")
 	proto.RegisterType((*EstimatedPayoutRequest)(nil), "multinode.EstimatedPayoutRequest")
@@ -4213,186 +4919,213 @@ func init() {
 func init() { proto.RegisterFile("multinode.proto", fileDescriptor_9a45fd79b06f3a1b) }
 
 var fileDescriptor_9a45fd79b06f3a1b = []byte{
-	// 2886 bytes of a gzipped FileDescriptorProto
+	// 3327 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xff, 0x86, 0x8f, 0x5d, 0x6e, 0xed, 0xf2, 0xd5, 0xe2, 0x63, 0x39, 0xe2, 0x4b, 0x43, 0x7d,
-	0x12, 0x15, 0x4b, 0x94, 0x4d, 0x1b, 0x4e, 0xec, 0xd8, 0x88, 0x97, 0x92, 0x6c, 0xd2, 0x96, 0x2c,
-	0x66, 0x28, 0x39, 0x86, 0x15, 0x78, 0x3d, 0xe4, 0x34, 0xc9, 0xb1, 0x67, 0x67, 0xd6, 0x33, 0xbd,
-	0x64, 0x08, 0x04, 0x46, 0x0e, 0x89, 0x73, 0x0a, 0x90, 0xb3, 0x11, 0xe4, 0x9a, 0x5b, 0x0e, 0xb9,
-	0xe4, 0x98, 0x5b, 0x60, 0x20, 0xff, 0x41, 0x0e, 0x0e, 0x90, 0x5b, 0x2e, 0xb9, 0xe4, 0x96, 0x53,
-	0xd0, 0x8f, 0x79, 0xf4, 0xbc, 0x96, 0x9c, 0x95, 0x41, 0xdf, 0xa6, 0xab, 0xab, 0x7e, 0x5d, 0x55,
-	0xdd, 0x5d, 0xd3, 0x55, 0xdd, 0x30, 0xd9, 0xe9, 0xd9, 0xc4, 0x72, 0x5c, 0x13, 0x6f, 0x74, 0x3d,
-	0x97, 0xb8, 0xa8, 0x16, 0x12, 0x54, 0x38, 0x72, 0x8f, 0x5c, 0x4e, 0x56, 0x57, 0x8e, 0x5c, 0xf7,
-	0xc8, 0xc6, 0x77, 0x59, 0x6b, 0xbf, 0x77, 0x78, 0x97, 0x58, 0x1d, 0xec, 0x13, 0xa3, 0xd3, 0xe5,
-	0x0c, 0xda, 0x3a, 0x8c, 0xeb, 0xf8, 0xf3, 0x1e, 0xf6, 0xc9, 0x36, 0x36, 0x4c, 0xec, 0xa1, 0x79,
-	0xa8, 0x1a, 0x5d, 0xab, 0xfd, 0x19, 0x3e, 0x6b, 0x2a, 0xab, 0xca, 0x7a, 0x43, 0xaf, 0x18, 0x5d,
-	0xeb, 0x3d, 0x7c, 0xa6, 0xdd, 0x87, 0xa9, 0xfb, 0x96, 0xff, 0xd9, 0x5e, 0xd7, 0x38, 0xc0, 0x42,
-	0x04, 0xbd, 0x08, 0x95, 0x63, 0x26, 0xc6, 0x78, 0xeb, 0x9b, 0xcd, 0x8d, 0x48, 0x2f, 0x09, 0x56,
-	0x17, 0x7c, 0xda, 0x5f, 0x14, 0x98, 0x8e, 0xc1, 0xf8, 0x5d, 0xd7, 0xf1, 0x31, 0x5a, 0x84, 0x9a,
-	0x61, 0xdb, 0xee, 0x81, 0x41, 0xb0, 0xc9, 0xa0, 0x86, 0xf5, 0x88, 0x80, 0x56, 0xa0, 0xde, 0xf3,
-	0xb1, 0xd9, 0xee, 0x5a, 0xf8, 0x00, 0xfb, 0xcd, 0x21, 0xd6, 0x0f, 0x94, 0xb4, 0xcb, 0x28, 0x68,
-	0x09, 0x58, 0xab, 0x4d, 0x3c, 0xc3, 0x3f, 0x6e, 0x0e, 0x73, 0x79, 0x4a, 0x79, 0x42, 0x09, 0x08,
-	0xc1, 0xc8, 0xa1, 0x87, 0x71, 0x73, 0x84, 0x75, 0xb0, 0x6f, 0x36, 0xe2, 0x89, 0x61, 0xd9, 0xc6,
-	0xbe, 0x8d, 0x9b, 0xa3, 0x62, 0xc4, 0x80, 0x80, 0x54, 0x18, 0x73, 0x4f, 0xb0, 0x47, 0x21, 0x9a,
-	0x15, 0xd6, 0x19, 0xb6, 0xb5, 0x3f, 0x2a, 0xd0, 0xd8, 0x23, 0xae, 0x67, 0x1c, 0xe1, 0xa7, 0xbe,
-	0x71, 0x84, 0x91, 0x06, 0xe3, 0x06, 0x69, 0x7b, 0xd8, 0x27, 0x6d, 0xe2, 0x12, 0xc3, 0x66, 0x06,
-	0x28, 0x7a, 0xdd, 0x20, 0x3a, 0xf6, 0xc9, 0x13, 0x4a, 0x42, 0xef, 0xc1, 0x84, 0xe5, 0x10, 0xec,
-	0x9d, 0x18, 0x76, 0xdb, 0x27, 0x86, 0x47, 0x98, 0x15, 0xf5, 0x4d, 0x75, 0x83, 0x4f, 0xd0, 0x46,
-	0x30, 0x41, 0x1b, 0x4f, 0x82, 0x09, 0xda, 0x1a, 0xfb, 0xfa, 0x9b, 0x95, 0xff, 0xfb, 0xed, 0x3f,
-	0x56, 0x14, 0x7d, 0x3c, 0x90, 0xdd, 0xa3, 0xa2, 0xe8, 0x0e, 0x5c, 0x91, 0x06, 0x6c, 0xef, 0x9f,
-	0x11, 0xec, 0x33, 0xbb, 0x15, 0x7d, 0x2a, 0x36, 0xec, 0x16, 0xa5, 0x6b, 0x7f, 0x56, 0xe0, 0x4a,
-	0x5c, 0xe1, 0xd2, 0x93, 0x87, 0x7e, 0x40, 0x1d, 0xe9, 0x76, 0x2e, 0xa4, 0x3b, 0x93, 0x40, 0xaf,
-	0xc0, 0x10, 0x71, 0x99, 0x86, 0xe7, 0x95, 0x1b, 0x22, 0xae, 0xf6, 0x7b, 0x05, 0x66, 0x64, 0xcd,
-	0xc5, 0x7a, 0x79, 0x03, 0xc6, 0x7d, 0x4e, 0x6f, 0xf7, 0x68, 0x47, 0x53, 0x59, 0x1d, 0x5e, 0xaf,
-	0x6f, 0xce, 0xc7, 0x2c, 0x90, 0xe4, 0x1a, 0x7e, 0x7c, 0xc2, 0x9a, 0x50, 0xf5, 0x7b, 0x9d, 0x8e,
-	0xe1, 0x9d, 0x31, 0x4b, 0x14, 0x3d, 0x68, 0xa2, 0x0d, 0xb8, 0x62, 0x9c, 0xe0, 0x08, 0x57, 0xf2,
-	0xec, 0xb4, 0xe8, 0x62, 0x20, 0xdc, 0xb5, 0xff, 0x51, 0x60, 0x31, 0x3e, 0xd0, 0x9e, 0x41, 0xb0,
-	0x6d, 0x5b, 0x64, 0x00, 0x1f, 0xbf, 0x04, 0x0d, 0x3f, 0x40, 0x69, 0x5b, 0x26, 0xd3, 0xb0, 0xb1,
-	0x35, 0x41, 0xfd, 0xf2, 0xf7, 0x6f, 0x56, 0x2a, 0xef, 0xbb, 0x26, 0xde, 0xb9, 0xaf, 0xd7, 0x43,
-	0x9e, 0x1d, 0x33, 0x9c, 0x96, 0xe1, 0x92, 0xd3, 0x32, 0x72, 0xc1, 0x69, 0xf9, 0x83, 0x02, 0x4b,
-	0x39, 0x56, 0x7f, 0xc7, 0xe6, 0x67, 0x17, 0x16, 0xb7, 0x0c, 0xc7, 0x3c, 0xb5, 0x4c, 0x72, 0xfc,
-	0xc8, 0x75, 0xc8, 0xf1, 0x1e, 0x07, 0x2a, 0x1f, 0xbf, 0x5e, 0x86, 0xa5, 0x1c, 0x44, 0x61, 0x3a,
-	0x82, 0x11, 0x16, 0x36, 0x78, 0x14, 0x63, 0xdf, 0xda, 0xaf, 0x15, 0x58, 0x0d, 0xa5, 0x84, 0xc0,
-	0xa5, 0x2c, 0x15, 0xed, 0x4d, 0xb8, 0x56, 0xa0, 0x88, 0x30, 0x21, 0xe6, 0x7f, 0x6e, 0x45, 0xd0,
-	0xd4, 0xde, 0x83, 0xf9, 0xa4, 0x78, 0x79, 0x57, 0xbe, 0x02, 0xcd, 0x34, 0x58, 0x5f, 0x15, 0x7e,
-	0xa9, 0xc0, 0xd2, 0x83, 0x23, 0x0f, 0xfb, 0xfe, 0xa5, 0x3a, 0xf2, 0x75, 0x58, 0xce, 0xd3, 0xa2,
-	0xaf, 0x09, 0xdb, 0x30, 0x23, 0xc9, 0x96, 0x77, 0xe1, 0x4b, 0x30, 0x9b, 0x40, 0xea, 0x3b, 0xf8,
-	0xaf, 0x14, 0x58, 0xde, 0x71, 0x2e, 0xdf, 0x81, 0x3f, 0x84, 0x95, 0x5c, 0x35, 0xfa, 0x1a, 0xb1,
-	0x03, 0xb3, 0xb2, 0x70, 0x79, 0x17, 0x6e, 0xc2, 0x5c, 0x12, 0xaa, 0xef, 0xf0, 0x3f, 0x87, 0xd9,
-	0xfb, 0x86, 0x65, 0x5f, 0x92, 0xe7, 0xf6, 0x60, 0x2e, 0x39, 0xba, 0xd0, 0xf8, 0x35, 0x68, 0xf0,
-	0xb0, 0xe8, 0xb9, 0xb6, 0xdd, 0xeb, 0x8a, 0xa8, 0x3b, 0x17, 0x53, 0x82, 0x87, 0x5b, 0xd6, 0xab,
-	0xd7, 0x7b, 0x51, 0x43, 0x7b, 0x0b, 0x1a, 0x0c, 0xb4, 0xbc, 0x23, 0xdf, 0x85, 0x71, 0x81, 0x30,
-	0xb8, 0x36, 0x7f, 0x53, 0xa0, 0x1e, 0xeb, 0x44, 0xb7, 0xa0, 0x82, 0xd9, 0x1c, 0x09, 0x6d, 0xa6,
-	0x63, 0x20, 0x7c, 0x03, 0xe8, 0x82, 0x01, 0xdd, 0x86, 0xaa, 0xc5, 0xe7, 0x53, 0x1c, 0x53, 0x50,
-	0x8c, 0x57, 0xcc, 0xb4, 0x1e, 0xb0, 0xa0, 0x39, 0xa8, 0x98, 0xd8, 0xc6, 0x04, 0x8b, 0x53, 0xa3,
-	0x68, 0x65, 0x9c, 0xd7, 0x46, 0x4a, 0x9f, 0xd7, 0xb4, 0x87, 0x50, 0x79, 0x10, 0x0e, 0xe7, 0xe1,
-	0xae, 0x61, 0x79, 0x62, 0x45, 0x89, 0x16, 0x9a, 0x81, 0x51, 0xa3, 0x67, 0x5a, 0x44, 0x9c, 0x6d,
-	0x79, 0x83, 0x52, 0xf9, 0xdf, 0x93, 0xeb, 0xc6, 0x1b, 0xda, 0xf7, 0xa1, 0xba, 0xe3, 0xc8, 0x70,
-	0xa6, 0x04, 0x67, 0x46, 0x82, 0x43, 0x71, 0xc1, 0x2d, 0x98, 0xf8, 0x00, 0x7b, 0xbe, 0xe5, 0x3a,
-	0xe5, 0x27, 0xf9, 0x05, 0x98, 0x0c, 0x31, 0xa2, 0x6d, 0x72, 0xc2, 0x49, 0x0c, 0xa5, 0xa6, 0x07,
-	0x4d, 0xed, 0x6d, 0x40, 0x0f, 0x0d, 0x9f, 0xdc, 0x73, 0x1d, 0x62, 0x1c, 0x90, 0xf2, 0x83, 0x7e,
-	0x0c, 0x57, 0x24, 0x1c, 0x31, 0xf0, 0x3b, 0xd0, 0xb0, 0x0d, 0x9f, 0xb4, 0x0f, 0x38, 0x5d, 0xc0,
-	0x9d, 0x6f, 0x86, 0xea, 0x76, 0x04, 0xa8, 0xfd, 0x0c, 0xa6, 0x75, 0xdc, 0xed, 0x11, 0x83, 0x0c,
-	0xe2, 0x9b, 0x32, 0x5b, 0xf9, 0x2b, 0x05, 0xea, 0x2d, 0x3a, 0xd7, 0x3f, 0xb1, 0x1c, 0xd3, 0x3d,
-	0xa5, 0x26, 0x9d, 0xb2, 0x2f, 0xb1, 0xe8, 0x2e, 0x64, 0x12, 0x97, 0xe4, 0x29, 0xc2, 0x35, 0x68,
-	0xb8, 0x8e, 0x6d, 0x39, 0xb8, 0x7d, 0xe0, 0xf6, 0x1c, 0xbe, 0xae, 0x46, 0xf5, 0x3a, 0xa7, 0xdd,
-	0xa3, 0x24, 0x9a, 0x55, 0xf1, 0xec, 0x81, 0x73, 0x0c, 0x33, 0x0e, 0x60, 0x24, 0xc6, 0xa0, 0xfd,
-	0xb7, 0x0a, 0x28, 0xee, 0x97, 0xf0, 0x6c, 0x57, 0xe1, 0x30, 0x42, 0xbb, 0xeb, 0x92, 0x63, 0x92,
-	0xec, 0x1b, 0x8f, 0x19, 0xaf, 0x2e, 0x64, 0xd0, 0x6b, 0xf1, 0x95, 0x5e, 0xdf, 0x5c, 0x2b, 0x16,
-	0x66, 0xbe, 0x09, 0xb6, 0xc3, 0x23, 0x98, 0x34, 0x2d, 0xff, 0xf3, 0x9e, 0x61, 0x5b, 0x87, 0x16,
-	0x36, 0xdb, 0x06, 0x39, 0xe7, 0x89, 0x57, 0x61, 0xfe, 0x99, 0x88, 0x0b, 0xb7, 0x08, 0xf5, 0xb5,
-	0xdf, 0xf3, 0xbb, 0xd8, 0x31, 0x39, 0xd6, 0xc8, 0x05, 0xb0, 0xea, 0xa1, 0x64, 0x8b, 0xa0, 0x0f,
-	0x60, 0xc6, 0x3d, 0x3c, 0x64, 0xce, 0x96, 0x00, 0x47, 0x2f, 0x00, 0x88, 0x04, 0xc2, 0x5e, 0x0c,
-	0xf7, 0x19, 0xcc, 0x07, 0xb8, 0x3d, 0xc7, 0xc4, 0x5e, 0xdb, 0xc3, 0x27, 0x16, 0x3e, 0xa5, 0xd0,
-	0x95, 0x0b, 0x40, 0x07, 0xca, 0x3d, 0xa5, 0x18, 0x3a, 0x83, 0x68, 0x11, 0xd4, 0x82, 0xda, 0x09,
-	0x26, 0x84, 0x6b, 0x5a, 0xbb, 0x00, 0xdc, 0x18, 0x17, 0x6b, 0x11, 0x74, 0x0f, 0xa0, 0xd7, 0x35,
-	0x0d, 0x81, 0x51, 0xbd, 0xc0, 0x52, 0xad, 0x09, 0x39, 0xae, 0xc7, 0xa7, 0xae, 0xe5, 0x70, 0x8c,
-	0xb1, 0x0b, 0x60, 0x8c, 0x71, 0xb1, 0x16, 0x51, 0x97, 0xa1, 0xc2, 0x17, 0x19, 0x8d, 0x7b, 0xfe,
-	0x81, 0xeb, 0x61, 0x91, 0x81, 0xf3, 0x86, 0xfa, 0xa7, 0x21, 0x18, 0x6d, 0x05, 0x01, 0x35, 0xdd,
-	0x8f, 0x6e, 0xc1, 0x14, 0x9f, 0x37, 0x1a, 0xb4, 0xda, 0x9c, 0x81, 0xe7, 0x1d, 0x93, 0x11, 0x7d,
-	0x8f, 0xb1, 0x66, 0xec, 0x99, 0xe1, 0xf8, 0x9e, 0x41, 0x6b, 0x30, 0xee, 0xf7, 0x0e, 0x0e, 0xb0,
-	0xef, 0x0b, 0x16, 0x5e, 0x73, 0x68, 0x08, 0x22, 0x67, 0xa2, 0xd1, 0xde, 0xee, 0x1e, 0x1b, 0x6c,
-	0x85, 0x28, 0x3a, 0x6f, 0xd0, 0xc4, 0x61, 0x1f, 0x13, 0x83, 0xcd, 0xad, 0xa2, 0xb3, 0x6f, 0x0a,
-	0xd7, 0x73, 0x3e, 0x73, 0xdc, 0x53, 0xa7, 0xcd, 0x25, 0xaa, 0xac, 0xb3, 0x21, 0x88, 0x2d, 0x26,
-	0x78, 0x0d, 0x82, 0x76, 0x9b, 0x01, 0x8c, 0xf1, 0xf2, 0x83, 0xa0, 0x6d, 0x51, 0x9c, 0x17, 0xa1,
-	0x7a, 0x6c, 0xd1, 0x1c, 0xeb, 0xac, 0x59, 0x4b, 0xfd, 0x85, 0x63, 0x01, 0x48, 0x0f, 0xd8, 0xb4,
-	0x87, 0xd0, 0x7c, 0xe2, 0xf5, 0x7c, 0x82, 0xcd, 0xf0, 0x98, 0xe1, 0x97, 0x8f, 0xe0, 0x7f, 0x55,
-	0x60, 0x21, 0x03, 0x4e, 0x44, 0x94, 0x67, 0x80, 0x08, 0xef, 0x6c, 0x87, 0xc1, 0xd1, 0x17, 0xc7,
-	0x85, 0xdb, 0x31, 0xec, 0x5c, 0x84, 0x0d, 0x1a, 0x5b, 0x9f, 0xea, 0x0f, 0xf5, 0x69, 0x92, 0x64,
-	0x51, 0x1f, 0x42, 0x55, 0xf4, 0xa2, 0x9b, 0x50, 0xa5, 0x38, 0x6d, 0xf1, 0xbf, 0x4c, 0xc7, 0xe6,
-	0x0a, 0xed, 0xde, 0x31, 0xe9, 0x2f, 0xcd, 0x30, 0xcd, 0xf0, 0x0c, 0x51, 0xd3, 0x83, 0xa6, 0x76,
-	0x0f, 0x26, 0x1f, 0x77, 0xb1, 0x67, 0x10, 0xd7, 0x2b, 0xef, 0x0d, 0x0b, 0xa6, 0x22, 0x10, 0xe1,
-	0x83, 0x19, 0x18, 0xc5, 0x1d, 0xc3, 0xb2, 0xc5, 0x3f, 0x94, 0x37, 0xe8, 0x0f, 0xfe, 0xd4, 0xb0,
-	0x6d, 0x4c, 0x84, 0x1e, 0xa2, 0x85, 0x6e, 0xc2, 0x24, 0xff, 0x6a, 0x1f, 0x62, 0x83, 0xf4, 0x3c,
-	0x96, 0x03, 0x0f, 0xaf, 0xd7, 0xf4, 0x09, 0x4e, 0x7e, 0x5b, 0x50, 0xb5, 0x2f, 0x15, 0x58, 0x79,
-	0xe0, 0x13, 0xab, 0x43, 0xb7, 0xdb, 0xae, 0x71, 0xe6, 0xf6, 0xc8, 0xe5, 0x1c, 0x5a, 0x7f, 0x0c,
-	0xab, 0xf9, 0x7a, 0x08, 0x1f, 0xdc, 0x01, 0x84, 0x03, 0x9e, 0x36, 0x36, 0x3c, 0xc7, 0x72, 0x8e,
-	0x7c, 0x71, 0xb4, 0x99, 0x0e, 0x7b, 0x1e, 0x88, 0x0e, 0xed, 0x5d, 0x98, 0x4b, 0x40, 0x96, 0x9f,
-	0x92, 0x6d, 0x98, 0x4f, 0x61, 0x95, 0xd3, 0x6a, 0x0b, 0x26, 0x06, 0xce, 0x49, 0x76, 0x60, 0x32,
-	0x99, 0x8c, 0xbc, 0x0a, 0xf5, 0x2e, 0xd3, 0xab, 0x6d, 0x39, 0x87, 0xae, 0x40, 0x9a, 0x8d, 0x21,
-	0x71, 0xad, 0x77, 0x9c, 0x43, 0x57, 0x87, 0x6e, 0xf8, 0xad, 0x7d, 0x02, 0x33, 0x02, 0x6a, 0x17,
-	0x7b, 0x96, 0x6b, 0x96, 0x9f, 0xf4, 0x39, 0xa8, 0x74, 0x19, 0x44, 0xb0, 0x16, 0x79, 0x4b, 0x7b,
-	0x0c, 0xb3, 0x89, 0x11, 0x06, 0x54, 0xf9, 0x0b, 0x98, 0xbf, 0xd4, 0xcc, 0x54, 0x87, 0x66, 0x6e,
-	0x4a, 0x5a, 0xd6, 0xa6, 0xdf, 0x29, 0xb0, 0x94, 0x04, 0x1d, 0x74, 0x42, 0x4a, 0x54, 0x0a, 0xa3,
-	0x39, 0x1c, 0x96, 0xe6, 0xf0, 0x43, 0x58, 0xce, 0xd3, 0x6e, 0x40, 0xc3, 0x5b, 0x30, 0x4e, 0xb7,
-	0x06, 0x2e, 0x6f, 0xa7, 0x76, 0x03, 0x26, 0x02, 0x88, 0x28, 0x58, 0x46, 0x95, 0xf6, 0x61, 0x9d,
-	0x37, 0x58, 0x3c, 0x60, 0x7c, 0x83, 0x2f, 0x1b, 0xed, 0x13, 0x98, 0x4f, 0x61, 0x89, 0xc1, 0x1f,
-	0xc0, 0x14, 0x66, 0x5d, 0xd1, 0xcf, 0x4a, 0xfc, 0xab, 0xd4, 0x78, 0x56, 0x9a, 0x90, 0x9e, 0xc4,
-	0x32, 0x41, 0xfb, 0x08, 0x26, 0x13, 0x3c, 0xd9, 0x66, 0x95, 0x59, 0xc1, 0xdb, 0x30, 0xf3, 0xd4,
-	0x31, 0x2d, 0x9f, 0x78, 0xd6, 0x7e, 0x8f, 0x0c, 0xe2, 0xfb, 0x3b, 0x30, 0x9b, 0x40, 0x2a, 0x9c,
-	0x82, 0x2f, 0x60, 0x7e, 0xd7, 0x38, 0xf3, 0x49, 0x6f, 0xff, 0x72, 0xb6, 0xee, 0x36, 0x34, 0xd3,
-	0xe3, 0x0b, 0x8d, 0x6f, 0x43, 0xb5, 0xcb, 0xfb, 0x84, 0x06, 0x48, 0x5e, 0xbd, 0xb4, 0x47, 0x0f,
-	0x58, 0x68, 0x18, 0x0f, 0x68, 0xa5, 0x9d, 0xf7, 0x23, 0x98, 0x0c, 0x31, 0x4a, 0x29, 0xf1, 0x09,
-	0xcc, 0x08, 0xda, 0xb7, 0x15, 0xbc, 0x1f, 0xc0, 0x6c, 0x62, 0x84, 0x52, 0x8a, 0xd2, 0xf0, 0x96,
-	0x74, 0xfc, 0x77, 0x28, 0xbc, 0xbd, 0x0f, 0xcb, 0x79, 0xda, 0x95, 0x32, 0xf7, 0x15, 0x80, 0x28,
-	0xdc, 0xd1, 0x83, 0xfb, 0x31, 0xb6, 0xc3, 0x8a, 0x3f, 0xfd, 0xa6, 0x34, 0x56, 0x97, 0xe1, 0x05,
-	0x18, 0xf6, 0xad, 0xfd, 0x66, 0x18, 0xaa, 0x02, 0x0a, 0x69, 0x30, 0xce, 0x6b, 0x63, 0xe2, 0x22,
-	0x2f, 0xb8, 0x33, 0x64, 0xc4, 0x16, 0xbb, 0xc1, 0x43, 0x57, 0xa1, 0xc6, 0x79, 0x8e, 0x70, 0x50,
-	0x18, 0x1a, 0x63, 0x84, 0x77, 0x30, 0x41, 0xeb, 0x30, 0x15, 0x76, 0xb6, 0x45, 0x4d, 0x89, 0xa7,
-	0x23, 0x13, 0x01, 0x8f, 0xce, 0x6b, 0x4b, 0x37, 0x60, 0x32, 0xe2, 0xe4, 0xb9, 0x37, 0x4f, 0x4a,
-	0xc6, 0x03, 0x46, 0x9e, 0x1c, 0xad, 0x42, 0xe3, 0xc0, 0xed, 0x74, 0x43, 0x8d, 0xf8, 0xa5, 0x28,
-	0x50, 0x9a, 0x50, 0x68, 0x01, 0xc6, 0x18, 0x07, 0xd5, 0x87, 0xdf, 0x8a, 0x56, 0x69, 0x9b, 0xaa,
-	0x73, 0x03, 0x26, 0x83, 0xae, 0x40, 0x9b, 0x2a, 0x1f, 0x44, 0x70, 0x08, 0x65, 0xae, 0xc3, 0x44,
-	0xc8, 0xc7, 0x75, 0x19, 0xe3, 0x09, 0x92, 0x60, 0xe3, 0xaa, 0x04, 0x1e, 0xad, 0x65, 0x78, 0x14,
-	0x22, 0x8f, 0xa2, 0x55, 0xa8, 0xc7, 0x62, 0x53, 0xb3, 0xce, 0xba, 0xe2, 0x24, 0xa4, 0xc2, 0x98,
-	0x69, 0xf9, 0x5d, 0xd7, 0xc7, 0x66, 0xb3, 0xc1, 0x5d, 0x18, 0xb4, 0x69, 0x8a, 0xb3, 0x8d, 0x6d,
-	0xb3, 0xd5, 0xa1, 0x49, 0xd9, 0x36, 0xcf, 0x7b, 0xca, 0x6f, 0xf6, 0xaf, 0x87, 0x60, 0x21, 0x03,
-	0x4e, 0xac, 0xaf, 0xdd, 0x28, 0x01, 0xe3, 0xff, 0x8a, 0x57, 0x63, 0x80, 0xb9, 0x62, 0x19, 0x3d,
-	0x01, 0x8c, 0xfa, 0x06, 0x40, 0xd4, 0x1b, 0x5b, 0xf9, 0x4a, 0x7c, 0xe5, 0x53, 0xba, 0xd1, 0x09,
-	0x2b, 0x40, 0xc3, 0xba, 0x68, 0xa9, 0x5f, 0x29, 0x30, 0x9d, 0x02, 0x4f, 0x6d, 0x39, 0xa5, 0xff,
-	0x96, 0xd3, 0xa1, 0x41, 0xa7, 0xa7, 0xcd, 0x71, 0x69, 0xbe, 0x44, 0xad, 0xbb, 0x7b, 0x41, 0xeb,
-	0xf4, 0xfa, 0x71, 0xf8, 0xed, 0x6b, 0x8f, 0xe1, 0x6a, 0xe2, 0x30, 0xce, 0x6e, 0xb3, 0xcb, 0xcf,
-	0xcd, 0x23, 0x58, 0xcc, 0x06, 0x2c, 0x77, 0xc4, 0x7f, 0x0c, 0x57, 0x5b, 0xb6, 0x1d, 0xe5, 0x98,
-	0x03, 0x9f, 0xf7, 0x3f, 0x80, 0xc5, 0x6c, 0xc0, 0x01, 0x0f, 0x5f, 0x1d, 0xb8, 0x26, 0xe1, 0xf2,
-	0xa0, 0x37, 0xa8, 0xba, 0xb9, 0x3f, 0x93, 0x9f, 0x82, 0x56, 0x34, 0xdc, 0x73, 0x48, 0x0b, 0x02,
-	0xe8, 0x81, 0x4d, 0x28, 0x99, 0x16, 0xa4, 0xc6, 0x7f, 0x1e, 0x69, 0x81, 0xfc, 0x4b, 0xba, 0x04,
-	0xd3, 0x0a, 0xd3, 0x82, 0x1c, 0xed, 0x06, 0x34, 0xfc, 0x11, 0x2c, 0xf0, 0xd3, 0xef, 0x2e, 0xf6,
-	0x9e, 0xc3, 0x71, 0xfd, 0x00, 0xd4, 0x2c, 0xb8, 0xe7, 0x7b, 0x62, 0x8f, 0x2f, 0xc0, 0x41, 0xcf,
-	0x86, 0x25, 0x0f, 0xb7, 0xe9, 0xf1, 0x4b, 0x9f, 0x2b, 0xd9, 0x74, 0x0e, 0x6c, 0x46, 0xd1, 0xb9,
-	0x52, 0x1e, 0xa1, 0xf4, 0xb9, 0x32, 0xb1, 0x02, 0x2f, 0xc1, 0xf3, 0x45, 0xe7, 0xca, 0x3c, 0xed,
-	0xca, 0x98, 0xbb, 0xf9, 0x8b, 0x21, 0xa8, 0x8a, 0x77, 0x31, 0xe8, 0x6d, 0xa8, 0x85, 0xef, 0xe4,
-	0xd0, 0xd5, 0x98, 0x54, 0xf2, 0x11, 0x9e, 0xba, 0x98, 0xdd, 0x29, 0x34, 0xd8, 0x86, 0x51, 0xfe,
-	0xaa, 0x66, 0x39, 0xef, 0xf1, 0x8d, 0x80, 0x59, 0xc9, 0xed, 0x17, 0x48, 0x07, 0x30, 0x21, 0x3f,
-	0xf7, 0x41, 0x37, 0x73, 0x44, 0x92, 0x3b, 0x5a, 0x5d, 0xef, 0xcf, 0xc8, 0x07, 0xd9, 0xfc, 0x67,
-	0x05, 0x6a, 0xe1, 0xab, 0x10, 0x64, 0x40, 0x23, 0xfe, 0xc8, 0x46, 0x1a, 0xb0, 0xe8, 0x61, 0x8f,
-	0x34, 0x60, 0xf1, 0x7b, 0x9d, 0x13, 0x58, 0xc8, 0x7d, 0x11, 0x83, 0x5e, 0xc8, 0x82, 0xc9, 0x29,
-	0x4e, 0xa9, 0xb7, 0xcf, 0xc7, 0x1c, 0x16, 0xbd, 0xa7, 0x92, 0x4c, 0x48, 0x2b, 0x40, 0x08, 0x46,
-	0x59, 0x2b, 0xe4, 0x11, 0xe0, 0x1d, 0x98, 0xcb, 0x7e, 0x9d, 0x82, 0xd6, 0x53, 0x37, 0xe7, 0x79,
-	0xe6, 0xdc, 0x3a, 0x07, 0xa7, 0x18, 0x4e, 0x87, 0x71, 0x89, 0x03, 0xad, 0xe4, 0xc9, 0x06, 0xe0,
-	0xab, 0xf9, 0x0c, 0x02, 0xb3, 0x0b, 0xf3, 0x39, 0xef, 0x43, 0xd0, 0xad, 0xf4, 0x8d, 0x7e, 0x9e,
-	0x11, 0xdf, 0x3b, 0x0f, 0xab, 0x18, 0xf1, 0x29, 0x4c, 0xc8, 0x2c, 0x68, 0x35, 0x57, 0x3a, 0xc0,
-	0xbf, 0x56, 0xc0, 0x11, 0xc1, 0xca, 0xcf, 0x35, 0x24, 0xd8, 0xcc, 0x77, 0x24, 0x12, 0x6c, 0xce,
-	0x5b, 0x8f, 0xd7, 0x61, 0x94, 0xf5, 0xa0, 0xf9, 0x24, 0x6f, 0x00, 0xd2, 0x4c, 0x77, 0x88, 0x4d,
-	0xf6, 0xe5, 0x30, 0x8c, 0xd0, 0x38, 0x87, 0xde, 0x82, 0xaa, 0xb8, 0xce, 0x47, 0x0b, 0x31, 0x6e,
-	0xf9, 0x99, 0x80, 0xaa, 0x66, 0x75, 0x09, 0x35, 0x1e, 0x42, 0x3d, 0x76, 0x37, 0x8f, 0x96, 0x62,
-	0xac, 0xe9, 0xbb, 0x7f, 0x75, 0x39, 0xaf, 0x5b, 0xa0, 0xed, 0x00, 0x44, 0xb7, 0xc0, 0x68, 0x31,
-	0xe7, 0x72, 0x98, 0x63, 0x2d, 0x15, 0x5e, 0x1d, 0xa3, 0x8f, 0x61, 0x3a, 0x75, 0x5f, 0x84, 0xd6,
-	0x8a, 0x6f, 0x93, 0x38, 0xf0, 0xf5, 0xf3, 0x5c, 0x39, 0xa1, 0x7b, 0x30, 0x16, 0x5c, 0xe2, 0xa0,
-	0xb8, 0x83, 0x12, 0xd7, 0x43, 0xea, 0xd5, 0xcc, 0x3e, 0x31, 0x11, 0xff, 0xaa, 0xb1, 0x92, 0x80,
-	0xdb, 0x23, 0x3e, 0x9d, 0x8b, 0x60, 0xdd, 0xc5, 0xe7, 0x22, 0xb1, 0xe0, 0xd4, 0xac, 0xae, 0x68,
-	0x1b, 0x4a, 0x95, 0x78, 0x69, 0x1b, 0x66, 0xdd, 0x02, 0x48, 0xdb, 0x30, 0xbb, 0x88, 0xff, 0x0c,
-	0xa6, 0x52, 0xfb, 0x4f, 0x4b, 0x4b, 0xa5, 0x56, 0xf0, 0x5a, 0x21, 0x4f, 0x14, 0xa6, 0xb2, 0xcb,
-	0xce, 0x52, 0x98, 0x2a, 0xac, 0x9b, 0x4b, 0x61, 0xaa, 0x4f, 0x0d, 0xfb, 0x4d, 0xa8, 0xf0, 0x43,
-	0x1e, 0x6a, 0xa6, 0xce, 0x7d, 0x01, 0xdc, 0x42, 0x46, 0x8f, 0x10, 0xff, 0x30, 0x5d, 0xb1, 0xbd,
-	0x56, 0x70, 0x7e, 0x14, 0x80, 0x5a, 0x11, 0x8b, 0x40, 0xf6, 0xa1, 0x99, 0x77, 0x39, 0x86, 0xe2,
-	0x11, 0xac, 0xcf, 0x4d, 0x9e, 0xfa, 0xc2, 0xb9, 0x78, 0x63, 0xe6, 0xc8, 0x3c, 0xb2, 0x39, 0x99,
-	0x57, 0x6b, 0xb2, 0x39, 0x39, 0x37, 0x66, 0x3a, 0x8c, 0x4b, 0x45, 0x63, 0x69, 0x1d, 0x66, 0x15,
-	0xa6, 0xa5, 0x75, 0x98, 0x5d, 0x6f, 0x7e, 0x06, 0x53, 0xc9, 0x12, 0x9e, 0xb4, 0x0e, 0x73, 0xca,
-	0xce, 0xd2, 0x3a, 0xcc, 0x2d, 0x0d, 0xbf, 0x15, 0x15, 0xe6, 0x16, 0x32, 0xce, 0x67, 0x19, 0x5b,
-	0x2f, 0x79, 0xce, 0xd3, 0x61, 0x5c, 0xaa, 0xa3, 0x4a, 0x26, 0x67, 0xd5, 0x70, 0x25, 0x93, 0xb3,
-	0x4b, 0xb0, 0x1d, 0x98, 0xcb, 0xae, 0x5a, 0x4a, 0xbb, 0xa3, 0xb0, 0xec, 0x2a, 0xed, 0x8e, 0x3e,
-	0x25, 0xd0, 0x8f, 0xb3, 0x2a, 0x42, 0x6b, 0xc5, 0x85, 0x9c, 0x74, 0xc0, 0xcc, 0xad, 0xf6, 0x6c,
-	0xfe, 0xbb, 0x06, 0x15, 0xb1, 0xce, 0x8e, 0x60, 0x26, 0xab, 0xde, 0x81, 0x6e, 0xc4, 0x5f, 0x25,
-	0xe4, 0x57, 0x58, 0xd4, 0x9b, 0x7d, 0xf9, 0x84, 0x4d, 0x67, 0xa0, 0xe6, 0x57, 0x24, 0xd0, 0xed,
-	0x3c, 0x98, 0xac, 0x4c, 0x5c, 0xbd, 0x73, 0x4e, 0xee, 0x58, 0xe0, 0x4c, 0x94, 0x0b, 0xe4, 0xc0,
-	0x99, 0x5d, 0xcb, 0x90, 0x03, 0x67, 0x5e, 0xbd, 0x81, 0x06, 0xce, 0xcc, 0xc4, 0x5c, 0x0e, 0x9c,
-	0x45, 0x95, 0x05, 0x39, 0x70, 0x16, 0x67, 0xf9, 0x03, 0x06, 0x4e, 0x03, 0x50, 0x3a, 0x3b, 0x47,
-	0xd7, 0x53, 0x02, 0x19, 0xb5, 0x00, 0xf5, 0xff, 0xfb, 0x70, 0x5d, 0x66, 0x04, 0x3d, 0x82, 0x99,
-	0xac, 0xb2, 0xa2, 0xb4, 0x8c, 0x0b, 0x0a, 0x99, 0xd2, 0x32, 0x2e, 0xac, 0x4f, 0x7e, 0x4b, 0x01,
-	0x35, 0x59, 0x4d, 0xc8, 0x5e, 0x9f, 0x89, 0x28, 0xb8, 0x56, 0xc8, 0xf3, 0x5c, 0x03, 0x6a, 0x3c,
-	0xa3, 0x96, 0x03, 0x6a, 0x46, 0x25, 0x40, 0x0e, 0xa8, 0x99, 0xc9, 0x78, 0x7a, 0xd7, 0x04, 0xe0,
-	0x05, 0xbb, 0x26, 0x31, 0xca, 0xad, 0x73, 0x70, 0xf2, 0xe1, 0xb6, 0xae, 0x7f, 0xa4, 0xd1, 0x08,
-	0xf8, 0xe9, 0x86, 0xe5, 0xde, 0x65, 0x1f, 0x77, 0xbb, 0x9e, 0x75, 0x62, 0x10, 0x7c, 0x37, 0x84,
-	0xe8, 0xee, 0xef, 0x57, 0xd8, 0x2b, 0xb7, 0x97, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xde, 0x43,
-	0x56, 0xb1, 0xd2, 0x37, 0x00, 0x00,
+	0xd1, 0xff, 0x86, 0x4b, 0xee, 0x72, 0x6b, 0x97, 0xaf, 0x16, 0x1f, 0xcb, 0x21, 0xc5, 0xc7, 0x50,
+	0x96, 0xc8, 0xcf, 0x12, 0x65, 0xd3, 0x86, 0xbf, 0xcf, 0x8e, 0x8d, 0x78, 0x29, 0xd1, 0x26, 0x6d,
+	0xc9, 0x52, 0x86, 0x92, 0x63, 0xd8, 0x81, 0xd7, 0xc3, 0x9d, 0x26, 0x39, 0xf6, 0xec, 0xcc, 0x7a,
+	0xa6, 0x97, 0x12, 0x81, 0xc0, 0x08, 0x82, 0xc4, 0x39, 0x05, 0xc8, 0xd9, 0x08, 0x72, 0x0a, 0x90,
+	0x5b, 0x0e, 0xc9, 0x21, 0x40, 0x2e, 0x01, 0x72, 0x08, 0x0c, 0xe4, 0x3f, 0xc8, 0xc1, 0x01, 0x72,
+	0xcb, 0x25, 0x97, 0xdc, 0x72, 0x0a, 0xfa, 0x31, 0xef, 0xc7, 0x92, 0xb3, 0xb4, 0xe9, 0xdb, 0x74,
+	0x75, 0xd5, 0xaf, 0xab, 0xab, 0xbb, 0xab, 0x7b, 0xaa, 0x0a, 0x26, 0x3a, 0x3d, 0x93, 0x18, 0x96,
+	0xad, 0xe3, 0xcd, 0xae, 0x63, 0x13, 0x1b, 0x55, 0x7d, 0x82, 0x0c, 0x47, 0xf6, 0x91, 0xcd, 0xc9,
+	0xf2, 0xf2, 0x91, 0x6d, 0x1f, 0x99, 0xf8, 0x36, 0x6b, 0x1d, 0xf4, 0x0e, 0x6f, 0x13, 0xa3, 0x83,
+	0x5d, 0xa2, 0x75, 0xba, 0x9c, 0x41, 0x59, 0x87, 0x31, 0x15, 0x7f, 0xda, 0xc3, 0x2e, 0xd9, 0xc5,
+	0x9a, 0x8e, 0x1d, 0x34, 0x07, 0x15, 0xad, 0x6b, 0xb4, 0x3e, 0xc1, 0xa7, 0x0d, 0x69, 0x45, 0x5a,
+	0xaf, 0xab, 0x65, 0xad, 0x6b, 0xbc, 0x8d, 0x4f, 0x95, 0xbb, 0x30, 0x79, 0xd7, 0x70, 0x3f, 0xd9,
+	0xef, 0x6a, 0x6d, 0x2c, 0x44, 0xd0, 0x73, 0x50, 0x3e, 0x66, 0x62, 0x8c, 0xb7, 0xb6, 0xd5, 0xd8,
+	0x0c, 0xf4, 0x8a, 0xc0, 0xaa, 0x82, 0x4f, 0xf9, 0x93, 0x04, 0x53, 0x21, 0x18, 0xb7, 0x6b, 0x5b,
+	0x2e, 0x46, 0x8b, 0x50, 0xd5, 0x4c, 0xd3, 0x6e, 0x6b, 0x04, 0xeb, 0x0c, 0xaa, 0xa4, 0x06, 0x04,
+	0xb4, 0x0c, 0xb5, 0x9e, 0x8b, 0xf5, 0x56, 0xd7, 0xc0, 0x6d, 0xec, 0x36, 0x86, 0x58, 0x3f, 0x50,
+	0xd2, 0x43, 0x46, 0x41, 0x57, 0x81, 0xb5, 0x5a, 0xc4, 0xd1, 0xdc, 0xe3, 0x46, 0x89, 0xcb, 0x53,
+	0xca, 0x23, 0x4a, 0x40, 0x08, 0x86, 0x0f, 0x1d, 0x8c, 0x1b, 0xc3, 0xac, 0x83, 0x7d, 0xb3, 0x11,
+	0x4f, 0x34, 0xc3, 0xd4, 0x0e, 0x4c, 0xdc, 0x18, 0x11, 0x23, 0x7a, 0x04, 0x24, 0xc3, 0xa8, 0x7d,
+	0x82, 0x1d, 0x0a, 0xd1, 0x28, 0xb3, 0x4e, 0xbf, 0xad, 0xfc, 0x56, 0x82, 0xfa, 0x3e, 0xb1, 0x1d,
+	0xed, 0x08, 0x3f, 0x76, 0xb5, 0x23, 0x8c, 0x14, 0x18, 0xd3, 0x48, 0xcb, 0xc1, 0x2e, 0x69, 0x11,
+	0x9b, 0x68, 0x26, 0x9b, 0x80, 0xa4, 0xd6, 0x34, 0xa2, 0x62, 0x97, 0x3c, 0xa2, 0x24, 0xf4, 0x36,
+	0x8c, 0x1b, 0x16, 0xc1, 0xce, 0x89, 0x66, 0xb6, 0x5c, 0xa2, 0x39, 0x84, 0xcd, 0xa2, 0xb6, 0x25,
+	0x6f, 0xf2, 0x05, 0xda, 0xf4, 0x16, 0x68, 0xf3, 0x91, 0xb7, 0x40, 0xdb, 0xa3, 0x5f, 0x7e, 0xb5,
+	0xfc, 0x3f, 0xbf, 0xf8, 0xfb, 0xb2, 0xa4, 0x8e, 0x79, 0xb2, 0xfb, 0x54, 0x14, 0xdd, 0x82, 0x2b,
+	0x91, 0x01, 0x5b, 0x07, 0xa7, 0x04, 0xbb, 0x6c, 0xde, 0x92, 0x3a, 0x19, 0x1a, 0x76, 0x9b, 0xd2,
+	0x95, 0x3f, 0x48, 0x70, 0x25, 0xac, 0x70, 0xe1, 0xc5, 0x43, 0xff, 0x4f, 0x0d, 0x69, 0x77, 0xce,
+	0xa5, 0x3b, 0x93, 0x40, 0x2f, 0xc2, 0x10, 0xb1, 0x1b, 0xa5, 0x73, 0xc8, 0x0d, 0x11, 0x5b, 0xf9,
+	0x95, 0x04, 0xd3, 0x51, 0xcd, 0xc5, 0x7e, 0x79, 0x15, 0xc6, 0x5c, 0x4e, 0x6f, 0xf5, 0x68, 0x47,
+	0x43, 0x5a, 0x29, 0xad, 0xd7, 0xb6, 0xe6, 0x42, 0x33, 0x88, 0xc8, 0xd5, 0xdd, 0xf0, 0x82, 0x35,
+	0xa0, 0xe2, 0xf6, 0x3a, 0x1d, 0xcd, 0x39, 0x65, 0x33, 0x91, 0x54, 0xaf, 0x89, 0x36, 0xe1, 0x8a,
+	0x76, 0x82, 0x03, 0xdc, 0x88, 0x65, 0xa7, 0x44, 0x17, 0x03, 0xe1, 0xa6, 0xfd, 0xb7, 0x04, 0x8b,
+	0xe1, 0x81, 0xf6, 0x35, 0x82, 0x4d, 0xd3, 0x20, 0x03, 0xd8, 0xf8, 0x79, 0xa8, 0xbb, 0x1e, 0x4a,
+	0xcb, 0xd0, 0x99, 0x86, 0xf5, 0xed, 0x71, 0x6a, 0x97, 0xbf, 0x7d, 0xb5, 0x5c, 0x7e, 0xc7, 0xd6,
+	0xf1, 0xde, 0x5d, 0xb5, 0xe6, 0xf3, 0xec, 0xe9, 0xfe, 0xb2, 0x94, 0x0a, 0x2e, 0xcb, 0xf0, 0x39,
+	0x97, 0xe5, 0x37, 0x12, 0x5c, 0xcd, 0x98, 0xf5, 0xb7, 0x6c, 0x7d, 0x1e, 0xc2, 0xe2, 0xb6, 0x66,
+	0xe9, 0x4f, 0x0c, 0x9d, 0x1c, 0xdf, 0xb7, 0x2d, 0x72, 0xbc, 0xcf, 0x81, 0x8a, 0xfb, 0xaf, 0x17,
+	0xe0, 0x6a, 0x06, 0xa2, 0x98, 0x3a, 0x82, 0x61, 0xe6, 0x36, 0xb8, 0x17, 0x63, 0xdf, 0xca, 0xcf,
+	0x24, 0x58, 0xf1, 0xa5, 0x84, 0xc0, 0xa5, 0x6c, 0x15, 0xe5, 0x35, 0x58, 0xcd, 0x51, 0x44, 0x4c,
+	0x21, 0x64, 0x7f, 0x3e, 0x0b, 0xaf, 0xa9, 0xbc, 0x0d, 0x73, 0x71, 0xf1, 0xe2, 0xa6, 0x7c, 0x11,
+	0x1a, 0x49, 0xb0, 0xbe, 0x2a, 0xfc, 0x44, 0x82, 0xab, 0x3b, 0x47, 0x0e, 0x76, 0xdd, 0x4b, 0x35,
+	0xe4, 0x2b, 0xb0, 0x94, 0xa5, 0x45, 0xdf, 0x29, 0xec, 0xc2, 0x74, 0x44, 0xb6, 0xb8, 0x09, 0x9f,
+	0x87, 0x99, 0x18, 0x52, 0xdf, 0xc1, 0x7f, 0x2a, 0xc1, 0xd2, 0x9e, 0x75, 0xf9, 0x06, 0xfc, 0x0e,
+	0x2c, 0x67, 0xaa, 0xd1, 0x77, 0x12, 0x7b, 0x30, 0x13, 0x15, 0x2e, 0x6e, 0xc2, 0x2d, 0x98, 0x8d,
+	0x43, 0xf5, 0x1d, 0xfe, 0x87, 0x30, 0x73, 0x57, 0x33, 0xcc, 0x4b, 0xb2, 0xdc, 0x3e, 0xcc, 0xc6,
+	0x47, 0x17, 0x1a, 0xbf, 0x0c, 0x75, 0xee, 0x16, 0x1d, 0xdb, 0x34, 0x7b, 0x5d, 0xe1, 0x75, 0x67,
+	0x43, 0x4a, 0x70, 0x77, 0xcb, 0x7a, 0xd5, 0x5a, 0x2f, 0x68, 0x28, 0xaf, 0x43, 0x9d, 0x81, 0x16,
+	0x37, 0xe4, 0x5b, 0x30, 0x26, 0x10, 0x06, 0xd7, 0xe6, 0xaf, 0x12, 0xd4, 0x42, 0x9d, 0x68, 0x03,
+	0xca, 0x98, 0xad, 0x91, 0xd0, 0x66, 0x2a, 0x04, 0xc2, 0x0f, 0x80, 0x2a, 0x18, 0xd0, 0x4d, 0xa8,
+	0x18, 0x7c, 0x3d, 0xc5, 0x33, 0x05, 0x85, 0x78, 0xc5, 0x4a, 0xab, 0x1e, 0x0b, 0x9a, 0x85, 0xb2,
+	0x8e, 0x4d, 0x4c, 0xb0, 0x78, 0x35, 0x8a, 0x56, 0xca, 0x7b, 0x6d, 0xb8, 0xf0, 0x7b, 0x4d, 0xb9,
+	0x07, 0xe5, 0x1d, 0x7f, 0x38, 0x07, 0x77, 0x35, 0xc3, 0x11, 0x3b, 0x4a, 0xb4, 0xd0, 0x34, 0x8c,
+	0x68, 0x3d, 0xdd, 0x20, 0xe2, 0x6d, 0xcb, 0x1b, 0x94, 0xca, 0x6f, 0x4f, 0xae, 0x1b, 0x6f, 0x28,
+	0xff, 0x07, 0x95, 0x3d, 0x2b, 0x0a, 0xa7, 0x47, 0xe0, 0xf4, 0x40, 0x70, 0x28, 0x2c, 0xb8, 0x0d,
+	0xe3, 0xef, 0x62, 0xc7, 0x35, 0x6c, 0xab, 0xf8, 0x22, 0x3f, 0x0b, 0x13, 0x3e, 0x46, 0x70, 0x4c,
+	0x4e, 0x38, 0x89, 0xa1, 0x54, 0x55, 0xaf, 0xa9, 0xbc, 0x01, 0xe8, 0x9e, 0xe6, 0x92, 0x3b, 0xb6,
+	0x45, 0xb4, 0x36, 0x29, 0x3e, 0xe8, 0x87, 0x70, 0x25, 0x82, 0x23, 0x06, 0x7e, 0x13, 0xea, 0xa6,
+	0xe6, 0x92, 0x56, 0x9b, 0xd3, 0x1b, 0xd2, 0x39, 0x56, 0xa8, 0x66, 0x06, 0x80, 0xca, 0x53, 0x98,
+	0x52, 0x71, 0xb7, 0x47, 0x34, 0x32, 0x88, 0x6d, 0x8a, 0x1c, 0xe5, 0x2f, 0x24, 0xa8, 0x35, 0xe9,
+	0x5a, 0x7f, 0xdf, 0xb0, 0x74, 0xfb, 0x09, 0x9d, 0xd2, 0x13, 0xf6, 0x25, 0x36, 0xdd, 0xb9, 0xa6,
+	0xc4, 0x25, 0xf9, 0x2f, 0xc2, 0x2a, 0xd4, 0x6d, 0xcb, 0x34, 0x2c, 0xdc, 0x6a, 0xdb, 0x3d, 0x8b,
+	0xef, 0xab, 0x11, 0xb5, 0xc6, 0x69, 0x77, 0x28, 0x89, 0xfe, 0x55, 0xf1, 0xbf, 0x07, 0xce, 0x51,
+	0x62, 0x1c, 0xc0, 0x48, 0x8c, 0x41, 0xf9, 0x4f, 0x05, 0x50, 0xd8, 0x2e, 0xfe, 0xdb, 0xae, 0xcc,
+	0x61, 0x84, 0x76, 0xd7, 0x22, 0x86, 0x89, 0xb3, 0x6f, 0x3e, 0x60, 0xbc, 0xaa, 0x90, 0x41, 0x2f,
+	0x87, 0x77, 0x7a, 0x6d, 0x6b, 0x2d, 0x5f, 0x98, 0xd9, 0xc6, 0x3b, 0x0e, 0xf7, 0x61, 0x42, 0x37,
+	0xdc, 0x4f, 0x7b, 0x9a, 0x69, 0x1c, 0x1a, 0x58, 0x6f, 0x69, 0xe4, 0x8c, 0x2f, 0x5e, 0x89, 0xd9,
+	0x67, 0x3c, 0x2c, 0xdc, 0x24, 0xd4, 0xd6, 0x6e, 0xcf, 0xed, 0x62, 0x4b, 0xe7, 0x58, 0xc3, 0xe7,
+	0xc0, 0xaa, 0xf9, 0x92, 0x4d, 0x82, 0xde, 0x85, 0x69, 0xfb, 0xf0, 0x90, 0x19, 0x3b, 0x02, 0x38,
+	0x72, 0x0e, 0x40, 0x24, 0x10, 0xf6, 0x43, 0xb8, 0x1f, 0xc0, 0x9c, 0x87, 0xdb, 0xb3, 0x74, 0xec,
+	0xb4, 0x1c, 0x7c, 0x62, 0xe0, 0x27, 0x14, 0xba, 0x7c, 0x0e, 0x68, 0x4f, 0xb9, 0xc7, 0x14, 0x43,
+	0x65, 0x10, 0x4d, 0x82, 0x9a, 0x50, 0x3d, 0xc1, 0x84, 0x70, 0x4d, 0xab, 0xe7, 0x80, 0x1b, 0xe5,
+	0x62, 0x4d, 0x82, 0xee, 0x00, 0xf4, 0xba, 0xba, 0x26, 0x30, 0x2a, 0xe7, 0xd8, 0xaa, 0x55, 0x21,
+	0xc7, 0xf5, 0xf8, 0xd8, 0x36, 0x2c, 0x8e, 0x31, 0x7a, 0x0e, 0x8c, 0x51, 0x2e, 0xd6, 0x24, 0xf2,
+	0x12, 0x94, 0xf9, 0x26, 0xa3, 0x7e, 0xcf, 0x6d, 0xdb, 0x0e, 0x16, 0x7f, 0xe0, 0xbc, 0x21, 0xff,
+	0x6e, 0x08, 0x46, 0x9a, 0x9e, 0x43, 0x4d, 0xf6, 0xa3, 0x0d, 0x98, 0xe4, 0xeb, 0x46, 0x9d, 0x56,
+	0x8b, 0x33, 0xf0, 0xff, 0x8e, 0x89, 0x80, 0xbe, 0xcf, 0x58, 0x53, 0xce, 0x4c, 0x29, 0x7c, 0x66,
+	0xd0, 0x1a, 0x8c, 0xb9, 0xbd, 0x76, 0x1b, 0xbb, 0xae, 0x60, 0xe1, 0x31, 0x87, 0xba, 0x20, 0x72,
+	0x26, 0xea, 0xed, 0xcd, 0xee, 0xb1, 0xc6, 0x76, 0x88, 0xa4, 0xf2, 0x06, 0xfd, 0x71, 0x38, 0xc0,
+	0x44, 0x63, 0x6b, 0x2b, 0xa9, 0xec, 0x9b, 0xc2, 0xf5, 0xac, 0x4f, 0x2c, 0xfb, 0x89, 0xd5, 0xe2,
+	0x12, 0x15, 0xd6, 0x59, 0x17, 0xc4, 0x26, 0x13, 0x5c, 0x05, 0xaf, 0xdd, 0x62, 0x00, 0xa3, 0x8c,
+	0xa7, 0x26, 0x68, 0xdb, 0x14, 0xe7, 0x39, 0xa8, 0x1c, 0x1b, 0xf4, 0x1f, 0xeb, 0xb4, 0x51, 0x4d,
+	0xdc, 0xc2, 0x21, 0x07, 0xa4, 0x7a, 0x6c, 0xca, 0x3d, 0x68, 0x3c, 0x72, 0x7a, 0x2e, 0xc1, 0xba,
+	0xff, 0xcc, 0x70, 0x8b, 0x7b, 0xf0, 0xbf, 0x48, 0x30, 0x9f, 0x02, 0x27, 0x3c, 0xca, 0x07, 0x80,
+	0x08, 0xef, 0x6c, 0xf9, 0xce, 0xd1, 0x15, 0xcf, 0x85, 0x9b, 0x21, 0xec, 0x4c, 0x84, 0x4d, 0xea,
+	0x5b, 0x1f, 0xab, 0xf7, 0xd4, 0x29, 0x12, 0x67, 0x91, 0xef, 0x41, 0x45, 0xf4, 0xa2, 0x1b, 0x50,
+	0xa1, 0x38, 0x2d, 0x71, 0x5f, 0x26, 0x7d, 0x73, 0x99, 0x76, 0xef, 0xe9, 0xf4, 0x4a, 0xd3, 0x74,
+	0xdd, 0x7f, 0x43, 0x54, 0x55, 0xaf, 0xa9, 0xdc, 0x81, 0x89, 0x07, 0x5d, 0xec, 0x68, 0xc4, 0x76,
+	0x8a, 0x5b, 0xc3, 0x80, 0xc9, 0x00, 0x44, 0xd8, 0x60, 0x1a, 0x46, 0x70, 0x47, 0x33, 0x4c, 0x71,
+	0x87, 0xf2, 0x06, 0xbd, 0xe0, 0x9f, 0x68, 0xa6, 0x89, 0x89, 0xd0, 0x43, 0xb4, 0xd0, 0x0d, 0x98,
+	0xe0, 0x5f, 0xad, 0x43, 0xac, 0x91, 0x9e, 0xc3, 0xfe, 0x81, 0x4b, 0xeb, 0x55, 0x75, 0x9c, 0x93,
+	0xdf, 0x10, 0x54, 0xe5, 0xc7, 0x12, 0x2c, 0xec, 0x59, 0x06, 0x31, 0x34, 0x82, 0xdf, 0x74, 0xb4,
+	0x36, 0x3e, 0xec, 0x99, 0x3b, 0x4f, 0x0d, 0xf2, 0x8d, 0xde, 0x72, 0xbf, 0x97, 0x60, 0x31, 0x5d,
+	0x09, 0x31, 0xf9, 0x65, 0xa8, 0xe9, 0x76, 0x47, 0x33, 0xac, 0x96, 0xa5, 0x75, 0xb0, 0x30, 0x01,
+	0x70, 0xd2, 0x3b, 0x5a, 0x07, 0x87, 0x57, 0x6e, 0x28, 0x77, 0xe5, 0x36, 0x60, 0xb2, 0x8b, 0x9d,
+	0x36, 0xb6, 0xe8, 0xb3, 0xa0, 0xd3, 0xf5, 0x5f, 0x76, 0x43, 0xea, 0x84, 0xa0, 0xdf, 0x11, 0x64,
+	0xb4, 0x04, 0x20, 0x4e, 0xe5, 0x61, 0xcf, 0x64, 0xe7, 0x74, 0x54, 0x0d, 0x51, 0x14, 0x0b, 0x16,
+	0xf7, 0x31, 0x69, 0x7a, 0x51, 0xc8, 0xc1, 0x63, 0x9f, 0xd1, 0x28, 0xe7, 0x50, 0x2c, 0xca, 0xa9,
+	0xbc, 0x06, 0x57, 0x33, 0xc6, 0x3b, 0x4b, 0x90, 0x54, 0x79, 0x0b, 0x66, 0xd9, 0xf9, 0xd8, 0x79,
+	0xda, 0x36, 0x7b, 0xd4, 0x63, 0x0d, 0x70, 0x5c, 0x5f, 0x86, 0xb9, 0x04, 0x96, 0x50, 0x62, 0x09,
+	0x00, 0xfb, 0x54, 0x76, 0x46, 0xab, 0x6a, 0x88, 0xa2, 0x7c, 0x0c, 0x8d, 0xa6, 0xae, 0x47, 0xa5,
+	0x07, 0xb2, 0x98, 0x8f, 0x2d, 0x8e, 0x40, 0x40, 0x50, 0x16, 0x60, 0x3e, 0x65, 0x2c, 0xae, 0xa8,
+	0xd2, 0x81, 0x05, 0x15, 0x77, 0xec, 0x13, 0xfc, 0xcd, 0xe8, 0xb2, 0x04, 0x8b, 0xe9, 0xc3, 0x09,
+	0x75, 0xde, 0x82, 0x59, 0x15, 0xbb, 0x6d, 0xcd, 0x7a, 0xec, 0x62, 0x7d, 0xc0, 0x18, 0xfa, 0x3c,
+	0xcc, 0x25, 0xb0, 0xc4, 0x30, 0x7f, 0x96, 0x40, 0x6e, 0x5a, 0x96, 0xdd, 0xb3, 0xda, 0xf8, 0xbe,
+	0x66, 0x58, 0x04, 0x5b, 0x9a, 0x35, 0xc8, 0x9e, 0x7d, 0x05, 0x46, 0xce, 0x1f, 0xaf, 0xe6, 0x22,
+	0xe8, 0x25, 0x28, 0x61, 0x4b, 0x3f, 0x57, 0x58, 0x92, 0x0a, 0x28, 0x7f, 0x1c, 0x82, 0x85, 0xd4,
+	0x49, 0x88, 0x3d, 0xf8, 0x10, 0x20, 0x71, 0x4f, 0x3c, 0x17, 0xbe, 0xd0, 0xb2, 0x65, 0x37, 0x83,
+	0x9f, 0xe6, 0x10, 0x86, 0xfc, 0xa5, 0x04, 0x55, 0xbf, 0x27, 0xe1, 0xe2, 0xa4, 0xfe, 0x21, 0xd8,
+	0x4b, 0x30, 0x13, 0xbb, 0x32, 0x1c, 0xc7, 0x76, 0x1a, 0xc3, 0xe2, 0xca, 0xa0, 0x0d, 0x7a, 0x71,
+	0xdf, 0xa1, 0x33, 0x36, 0x2f, 0x62, 0xf9, 0xe9, 0x11, 0x4b, 0x41, 0x13, 0x9b, 0xed, 0x73, 0x09,
+	0x96, 0x77, 0x5c, 0x62, 0x74, 0x34, 0x82, 0xf5, 0x87, 0xda, 0xa9, 0xdd, 0x23, 0x97, 0x13, 0x11,
+	0xf9, 0x1e, 0xac, 0x64, 0xeb, 0x21, 0x36, 0xcd, 0x2d, 0x40, 0xd8, 0xe3, 0x69, 0x61, 0xcd, 0xb1,
+	0x0c, 0xeb, 0xc8, 0x15, 0x6e, 0x74, 0xca, 0xef, 0xd9, 0x11, 0x1d, 0xf4, 0xbc, 0xc6, 0x20, 0x8b,
+	0x1b, 0x71, 0x17, 0xe6, 0x12, 0x58, 0xc5, 0xb4, 0xda, 0x86, 0xf1, 0x81, 0x03, 0x5e, 0x7b, 0x30,
+	0x11, 0x8f, 0x74, 0xbd, 0x04, 0xb5, 0x2e, 0xd3, 0xab, 0x65, 0x58, 0x87, 0xb6, 0x40, 0x9a, 0x09,
+	0x21, 0x71, 0xad, 0xf7, 0xac, 0x43, 0x5b, 0x85, 0xae, 0xff, 0xad, 0x7c, 0x04, 0xd3, 0x02, 0xea,
+	0x21, 0x76, 0x0c, 0x5b, 0x2f, 0xbe, 0xe8, 0xb3, 0x50, 0xee, 0x32, 0x08, 0xef, 0xa1, 0xc3, 0x5b,
+	0xca, 0x03, 0x98, 0x89, 0x8d, 0x30, 0xa0, 0xca, 0x9f, 0xc1, 0xdc, 0xa5, 0x86, 0x3d, 0x55, 0x68,
+	0x64, 0xc6, 0x3b, 0x8b, 0xce, 0xe9, 0x97, 0x34, 0x1f, 0x13, 0x03, 0x1d, 0x74, 0x41, 0x0a, 0xa4,
+	0xa1, 0x82, 0x35, 0x2c, 0x45, 0xd6, 0xf0, 0x3d, 0x58, 0xca, 0xd2, 0x6e, 0xc0, 0x89, 0x37, 0x61,
+	0x8c, 0x1e, 0x0d, 0x5c, 0x7c, 0x9e, 0xca, 0x75, 0x18, 0xf7, 0x20, 0x82, 0x97, 0x78, 0x90, 0xc6,
+	0x2d, 0xa9, 0xbc, 0xc1, 0xfc, 0x01, 0xe3, 0x1b, 0x7c, 0xdb, 0x28, 0x1f, 0xc1, 0x5c, 0x02, 0x4b,
+	0x0c, 0xbe, 0x03, 0x93, 0x98, 0x75, 0x05, 0x7f, 0x42, 0xe2, 0x82, 0x93, 0xc3, 0x21, 0xcf, 0x98,
+	0xf4, 0x04, 0x8e, 0x12, 0x94, 0xf7, 0x61, 0x22, 0xc6, 0x93, 0x3e, 0xad, 0x22, 0x3b, 0x78, 0x17,
+	0xa6, 0x1f, 0x5b, 0xba, 0xe1, 0x12, 0xc7, 0x38, 0xe8, 0x91, 0x41, 0x6c, 0x7f, 0x0b, 0x66, 0x62,
+	0x48, 0xb9, 0x4b, 0xf0, 0x19, 0xcc, 0x3d, 0xd4, 0x4e, 0x5d, 0xd2, 0x3b, 0xb8, 0x9c, 0xa3, 0xbb,
+	0x0b, 0x8d, 0xe4, 0xf8, 0x42, 0xe3, 0x9b, 0x50, 0xe9, 0xf2, 0xbe, 0x86, 0x94, 0x88, 0x3a, 0x0b,
+	0x29, 0xd5, 0x63, 0xa1, 0x6e, 0xdc, 0xa3, 0x15, 0x36, 0xde, 0x77, 0x61, 0xc2, 0xc7, 0x28, 0xa4,
+	0xc4, 0x47, 0x30, 0x2d, 0x68, 0x5f, 0x97, 0xf3, 0xde, 0x81, 0x99, 0xd8, 0x08, 0x85, 0x14, 0xa5,
+	0xee, 0x2d, 0x6e, 0xf8, 0x6f, 0x91, 0x7b, 0x7b, 0x07, 0x96, 0xb2, 0xb4, 0x2b, 0x34, 0xdd, 0x17,
+	0x01, 0x02, 0x77, 0x47, 0xa3, 0x42, 0xc7, 0xd8, 0xf4, 0xd3, 0xc9, 0xf4, 0x9b, 0xd2, 0xba, 0x9a,
+	0x50, 0xba, 0xa4, 0xb2, 0x6f, 0xe5, 0xe7, 0x25, 0xa8, 0x08, 0x28, 0x5a, 0x90, 0xc2, 0x13, 0x2f,
+	0xa2, 0x4a, 0xc4, 0x2b, 0x48, 0x61, 0xc4, 0x26, 0x2b, 0x0f, 0x41, 0x0b, 0x50, 0xe5, 0x3c, 0x47,
+	0xd8, 0xcb, 0x3a, 0x8c, 0x32, 0xc2, 0x9b, 0x98, 0xa0, 0x75, 0x98, 0xf4, 0x3b, 0x5b, 0x22, 0x61,
+	0xc1, 0x63, 0x5d, 0xe3, 0x1e, 0x8f, 0xca, 0xa8, 0xe8, 0x3a, 0x4c, 0x04, 0x9c, 0x3c, 0xb0, 0xcb,
+	0x23, 0x5e, 0x63, 0x1e, 0x23, 0x8f, 0xbc, 0xad, 0x40, 0x9d, 0xfe, 0x8f, 0xfb, 0x1a, 0xf1, 0x8a,
+	0x1b, 0xa0, 0x34, 0xa1, 0xd0, 0x3c, 0x8c, 0x32, 0x0e, 0xaa, 0x0f, 0x2f, 0xb9, 0xa9, 0xd0, 0x36,
+	0x55, 0xe7, 0x3a, 0x4c, 0x78, 0x5d, 0x9e, 0x36, 0x15, 0x3e, 0x88, 0xe0, 0x10, 0xca, 0x5c, 0x83,
+	0x71, 0x9f, 0x8f, 0xeb, 0x32, 0xca, 0xa3, 0x6f, 0x82, 0x8d, 0xab, 0xe2, 0x59, 0xb4, 0x9a, 0x62,
+	0x51, 0x08, 0x2c, 0x8a, 0x56, 0xa0, 0x16, 0xf2, 0x4d, 0x8d, 0x1a, 0xeb, 0x0a, 0x93, 0x68, 0x95,
+	0x90, 0x6e, 0xb8, 0x5d, 0xdb, 0xc5, 0x7a, 0xa3, 0xce, 0x4d, 0xe8, 0xb5, 0xe9, 0x33, 0x7c, 0x17,
+	0x9b, 0x7a, 0xb3, 0x43, 0x23, 0x7e, 0xbb, 0x3c, 0xa8, 0x56, 0xfc, 0xb0, 0x7f, 0x39, 0x04, 0xf3,
+	0x29, 0x70, 0xfe, 0xff, 0x90, 0x1f, 0xdd, 0xe3, 0x77, 0xc5, 0x4b, 0x21, 0xc0, 0x4c, 0xb1, 0x94,
+	0x1e, 0x0f, 0x46, 0x7e, 0x15, 0x20, 0xe8, 0x0d, 0xed, 0x7c, 0x29, 0xbc, 0xf3, 0x29, 0x5d, 0xeb,
+	0xf8, 0xe9, 0x85, 0x92, 0x2a, 0x5a, 0xf2, 0x17, 0x12, 0x4c, 0x25, 0xc0, 0x8b, 0xfc, 0x55, 0xa9,
+	0x50, 0xa7, 0xcb, 0xd3, 0xe2, 0xb8, 0x34, 0x18, 0x47, 0x67, 0x77, 0xfb, 0x9c, 0xb3, 0x53, 0x6b,
+	0xc7, 0xfe, 0xb7, 0xab, 0x3c, 0x80, 0x85, 0xd8, 0x63, 0x9c, 0x95, 0x4a, 0x15, 0x5f, 0x9b, 0xfb,
+	0xb0, 0x98, 0x0e, 0x58, 0xec, 0x89, 0xff, 0x00, 0x16, 0x9a, 0xa6, 0x19, 0x04, 0x30, 0x07, 0x7e,
+	0xef, 0xbf, 0x0b, 0x8b, 0xe9, 0x80, 0x03, 0x3e, 0xbe, 0x3a, 0xb0, 0x1a, 0xc1, 0xe5, 0x4e, 0x6f,
+	0x50, 0x75, 0x33, 0x2f, 0x93, 0x1f, 0x80, 0x92, 0x37, 0xdc, 0x05, 0xfc, 0x16, 0x78, 0xd0, 0x03,
+	0x4f, 0xa1, 0xe0, 0x6f, 0x41, 0x62, 0xfc, 0x8b, 0xf8, 0x2d, 0x88, 0x5e, 0x49, 0x97, 0x30, 0xb5,
+	0xdc, 0xdf, 0x82, 0x0c, 0xed, 0x06, 0x9c, 0xf8, 0x7d, 0x98, 0xe7, 0xaf, 0xdf, 0x87, 0xd8, 0xb9,
+	0x80, 0xe7, 0x7a, 0x1b, 0xe4, 0x34, 0xb8, 0x8b, 0x7d, 0xb1, 0x87, 0x37, 0xe0, 0xa0, 0x6f, 0xc3,
+	0x82, 0x8f, 0xdb, 0xe4, 0xf8, 0x85, 0xdf, 0x95, 0x6c, 0x39, 0x07, 0x9e, 0x46, 0xde, 0xbb, 0x32,
+	0x3a, 0x42, 0xe1, 0x77, 0x65, 0x6c, 0x07, 0x5e, 0x82, 0xe5, 0xf3, 0xde, 0x95, 0x59, 0xda, 0x15,
+	0x99, 0xee, 0xd6, 0x8f, 0x86, 0xa0, 0x22, 0x8a, 0x2e, 0xd1, 0x1b, 0x50, 0xf5, 0xf3, 0x0b, 0x68,
+	0x21, 0x24, 0x15, 0xcf, 0x72, 0xc8, 0x8b, 0xe9, 0x9d, 0x42, 0x83, 0x5d, 0x18, 0xe1, 0x25, 0x9b,
+	0x4b, 0x59, 0x95, 0x9d, 0x02, 0x66, 0x39, 0xb3, 0x5f, 0x20, 0xb5, 0x61, 0x3c, 0x5a, 0x4b, 0x8a,
+	0x6e, 0x64, 0x88, 0xc4, 0x4f, 0xb4, 0xbc, 0xde, 0x9f, 0x91, 0x0f, 0xb2, 0xf5, 0x8f, 0x32, 0x54,
+	0xfd, 0x92, 0x43, 0xa4, 0x41, 0x3d, 0x5c, 0xc1, 0x19, 0x19, 0x30, 0xaf, 0x6a, 0x54, 0x5e, 0xef,
+	0xcf, 0x28, 0x66, 0x75, 0x02, 0xf3, 0x99, 0xe5, 0x96, 0xe8, 0xd9, 0x34, 0x98, 0x8c, 0xe0, 0x94,
+	0x7c, 0xf3, 0x6c, 0xcc, 0x7e, 0x46, 0x75, 0x32, 0xce, 0x84, 0x94, 0x1c, 0x04, 0x6f, 0x94, 0xb5,
+	0x5c, 0x1e, 0x01, 0xde, 0x81, 0xd9, 0xf4, 0xd2, 0x47, 0xb4, 0x9e, 0x28, 0xcb, 0xca, 0x9a, 0xce,
+	0xc6, 0x19, 0x38, 0xc5, 0x70, 0x2a, 0x8c, 0x45, 0x38, 0xd0, 0x72, 0x96, 0xac, 0x07, 0xbe, 0x92,
+	0xcd, 0x20, 0x30, 0xbb, 0x30, 0x97, 0x51, 0x7c, 0x88, 0x36, 0x92, 0xe5, 0x62, 0x59, 0x93, 0xf8,
+	0xdf, 0xb3, 0xb0, 0x8a, 0x11, 0x1f, 0xc3, 0x78, 0x94, 0x05, 0xad, 0x64, 0x4a, 0x7b, 0xf8, 0xab,
+	0x39, 0x1c, 0x01, 0x6c, 0xb4, 0x16, 0x30, 0x02, 0x9b, 0x5a, 0xa4, 0x28, 0xaf, 0xe6, 0x70, 0x08,
+	0xd8, 0x57, 0x60, 0x84, 0xf5, 0xa0, 0xb9, 0x38, 0xaf, 0x07, 0xd2, 0x48, 0x76, 0x88, 0x43, 0xf6,
+	0x79, 0x09, 0x86, 0xa9, 0x9f, 0x43, 0xaf, 0x43, 0x45, 0xd4, 0x8a, 0xa1, 0xf9, 0x10, 0x77, 0xb4,
+	0x06, 0x4d, 0x96, 0xd3, 0xba, 0x84, 0x1a, 0xf7, 0xa0, 0x16, 0x2a, 0xfc, 0x42, 0x57, 0x43, 0xac,
+	0xc9, 0xc2, 0x32, 0x79, 0x29, 0xab, 0x5b, 0xa0, 0xed, 0x01, 0x04, 0x25, 0x46, 0x68, 0x31, 0xa3,
+	0xf2, 0x88, 0x63, 0x5d, 0xcd, 0xad, 0x4b, 0x42, 0x1f, 0xc2, 0x54, 0xa2, 0x18, 0x01, 0xad, 0xe5,
+	0x97, 0x2a, 0x70, 0xe0, 0x6b, 0x67, 0xa9, 0x67, 0x40, 0x77, 0x60, 0xd4, 0xab, 0x10, 0x40, 0x61,
+	0x03, 0xc5, 0x6a, 0x0f, 0xe4, 0x85, 0xd4, 0x3e, 0xb1, 0x10, 0xbf, 0x2e, 0x03, 0xdc, 0xd7, 0x2c,
+	0xed, 0x08, 0x77, 0xb0, 0x45, 0xd0, 0x11, 0x4c, 0xa7, 0x25, 0xe1, 0xd1, 0xf5, 0xc8, 0x2e, 0xcb,
+	0x2c, 0x15, 0x90, 0x6f, 0xf4, 0xe5, 0x13, 0xca, 0x7f, 0x0c, 0x33, 0xa9, 0x89, 0xec, 0xa8, 0x47,
+	0xcf, 0x49, 0xad, 0xcb, 0xeb, 0xfd, 0x19, 0xc5, 0x58, 0xef, 0xc1, 0x44, 0x2c, 0x53, 0x8d, 0x56,
+	0xe3, 0x16, 0x4e, 0x64, 0xc4, 0x65, 0x25, 0x8f, 0x25, 0x58, 0xe2, 0x44, 0x72, 0x39, 0xb2, 0xc4,
+	0x59, 0x69, 0x6e, 0xf9, 0x5a, 0x3e, 0x93, 0xc0, 0x3f, 0x82, 0xe9, 0xb4, 0x84, 0x71, 0x64, 0x39,
+	0x72, 0x12, 0xd8, 0xf2, 0x8d, 0xbe, 0x7c, 0x81, 0x89, 0x62, 0xd9, 0xe2, 0x88, 0x89, 0xd2, 0xb3,
+	0xd2, 0xb2, 0x92, 0xc7, 0x22, 0x90, 0x75, 0xb8, 0x92, 0x92, 0x6a, 0x45, 0xcf, 0xf4, 0x4b, 0xc5,
+	0xf2, 0x11, 0xae, 0x9f, 0x2d, 0x63, 0x4b, 0x17, 0x22, 0x91, 0x82, 0x8c, 0x2c, 0x44, 0x56, 0xba,
+	0x53, 0xbe, 0x96, 0xcf, 0x24, 0x8e, 0xc9, 0x3f, 0xab, 0x2c, 0x72, 0x66, 0xf7, 0x88, 0x4b, 0x5d,
+	0x96, 0xe7, 0x9e, 0xc3, 0x2e, 0x2b, 0xe6, 0x97, 0xe5, 0xb4, 0xae, 0xe0, 0xb6, 0x8a, 0x24, 0xac,
+	0x22, 0xb7, 0x55, 0x5a, 0xb2, 0x4c, 0x5e, 0xc9, 0x66, 0x08, 0x6e, 0xf3, 0xc4, 0x35, 0xa5, 0x24,
+	0xa5, 0x12, 0x8e, 0x7e, 0x2d, 0x97, 0x27, 0xb8, 0xcd, 0xd3, 0xb3, 0x33, 0x91, 0xdb, 0x3c, 0x37,
+	0xbd, 0x24, 0x6f, 0x9c, 0x81, 0x53, 0x0c, 0xf7, 0x1a, 0x94, 0xf9, 0xbf, 0x10, 0x6a, 0x24, 0x7e,
+	0x8f, 0x3c, 0xb8, 0xf9, 0x94, 0x9e, 0x60, 0x33, 0xc7, 0x13, 0x1b, 0xab, 0x39, 0xbf, 0x59, 0x29,
+	0x9b, 0x39, 0x2b, 0xf3, 0xe2, 0x42, 0x23, 0x2b, 0x87, 0x8c, 0xc2, 0x17, 0x7d, 0x9f, 0x84, 0xb7,
+	0xfc, 0xec, 0x99, 0x78, 0x43, 0xd3, 0x89, 0xf2, 0x44, 0xa7, 0x93, 0x9a, 0x81, 0x96, 0x95, 0x3c,
+	0x96, 0x60, 0x1f, 0x46, 0x72, 0x2b, 0x91, 0x7d, 0x98, 0x96, 0xbf, 0x91, 0x57, 0xb2, 0x19, 0x82,
+	0x7d, 0x18, 0x8f, 0x74, 0x47, 0xf6, 0x61, 0x46, 0x76, 0x46, 0x5e, 0xcb, 0xe5, 0x11, 0xe0, 0xaf,
+	0x07, 0xf1, 0xeb, 0xf9, 0x24, 0x7f, 0xda, 0xd1, 0x8b, 0xff, 0x0e, 0xa9, 0x30, 0x16, 0x49, 0x37,
+	0x44, 0xa6, 0x9c, 0x96, 0xea, 0x90, 0x57, 0xb2, 0x19, 0x82, 0xd3, 0x91, 0x1e, 0xdc, 0x8f, 0x9c,
+	0x8e, 0xdc, 0xec, 0x84, 0xbc, 0x71, 0x06, 0xce, 0xc0, 0xd7, 0x25, 0x03, 0xa7, 0x6b, 0xf9, 0xf1,
+	0xce, 0xa4, 0xaf, 0xcb, 0x0c, 0x8a, 0x6e, 0xfd, 0xab, 0x0a, 0x65, 0xb1, 0xcf, 0x8e, 0x60, 0x3a,
+	0x2d, 0x2c, 0x18, 0xb9, 0x7f, 0x72, 0x02, 0x91, 0xf2, 0x8d, 0xbe, 0x7c, 0x62, 0x4e, 0xa7, 0x20,
+	0x67, 0x07, 0xee, 0xd0, 0xcd, 0x2c, 0x98, 0xb4, 0x80, 0x95, 0x7c, 0xeb, 0x8c, 0xdc, 0x21, 0xc7,
+	0x19, 0x8b, 0xaa, 0x45, 0x1d, 0x67, 0x7a, 0xc8, 0x4f, 0x5e, 0xcb, 0xe5, 0x09, 0x39, 0xce, 0xd4,
+	0xf8, 0x55, 0xd4, 0x71, 0xe6, 0x05, 0xe0, 0xe4, 0x8d, 0x33, 0x70, 0x5e, 0x8c, 0xe3, 0xd4, 0x00,
+	0x25, 0x83, 0x58, 0xe8, 0x5a, 0x42, 0x20, 0x25, 0x64, 0x26, 0x3f, 0xd3, 0x87, 0xeb, 0x32, 0x3d,
+	0xe8, 0x11, 0x4c, 0xa7, 0x45, 0xdf, 0x23, 0xdb, 0x38, 0x27, 0xde, 0x2f, 0xdf, 0xe8, 0xcb, 0xf7,
+	0xf5, 0x3a, 0xd4, 0x78, 0xd0, 0x2d, 0x7d, 0x7f, 0xc6, 0xbc, 0xe0, 0x5a, 0x2e, 0xcf, 0x85, 0x3a,
+	0xd4, 0x70, 0xe0, 0x29, 0xea, 0x50, 0x53, 0x02, 0x66, 0xf2, 0x4a, 0x36, 0x43, 0xe6, 0xa9, 0xf1,
+	0xc0, 0x73, 0x4e, 0x4d, 0x6c, 0x94, 0x8d, 0x33, 0x70, 0xf2, 0xe1, 0xb6, 0x17, 0xde, 0x9f, 0xa7,
+	0x1e, 0xf0, 0xe9, 0xed, 0xae, 0x63, 0x9c, 0x68, 0x04, 0xdf, 0xf6, 0x25, 0xbb, 0x07, 0x07, 0x65,
+	0x56, 0x63, 0xf7, 0xc2, 0x7f, 0x07, 0x00, 0x83, 0x1b, 0x9d, 0x5e, 0x4d, 0x41, 0x00, 0x00,
 }
@@ -824,6 +824,361 @@ func (x *drpcNode_OperatorStream) SendAndClose(m *OperatorResponse) error {
 	return x.CloseSend()
 }
 
+type DRPCManagementClient interface {
+	DRPCConn() drpc.Conn
+
+	InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
+	TrustExclusions(ctx context.Context, in *TrustExclusionsRequest) (*TrustExclusionsResponse, error)
+	AddTrustExclusion(ctx context.Context, in *AddTrustExclusionRequest) (*AddTrustExclusionResponse, error)
+	RemoveTrustExclusion(ctx context.Context, in *RemoveTrustExclusionRequest) (*RemoveTrustExclusionResponse, error)
+	RescanUsedSpace(ctx context.Context, in *RescanUsedSpaceRequest) (*RescanUsedSpaceResponse, error)
+	AnnounceMaintenance(ctx context.Context, in *AnnounceMaintenanceRequest) (*AnnounceMaintenanceResponse, error)
+	CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error)
+}
+
+type drpcManagementClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCManagementClient(cc drpc.Conn) DRPCManagementClient {
+	return &drpcManagementClient{cc}
+}
+
+func (c *drpcManagementClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcManagementClient) InitiateGracefulExit(ctx context.Context, in *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	out := new(InitiateGracefulExitResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/InitiateGracefulExit", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) SetAllocatedDiskSpace(ctx context.Context, in *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	out := new(SetAllocatedDiskSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) TrustExclusions(ctx context.Context, in *TrustExclusionsRequest) (*TrustExclusionsResponse, error) {
+	out := new(TrustExclusionsResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/TrustExclusions", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) AddTrustExclusion(ctx context.Context, in *AddTrustExclusionRequest) (*AddTrustExclusionResponse, error) {
+	out := new(AddTrustExclusionResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/AddTrustExclusion", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) RemoveTrustExclusion(ctx context.Context, in *RemoveTrustExclusionRequest) (*RemoveTrustExclusionResponse, error) {
+	out := new(RemoveTrustExclusionResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/RemoveTrustExclusion", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) RescanUsedSpace(ctx context.Context, in *RescanUsedSpaceRequest) (*RescanUsedSpaceResponse, error) {
+	out := new(RescanUsedSpaceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/RescanUsedSpace", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) AnnounceMaintenance(ctx context.Context, in *AnnounceMaintenanceRequest) (*AnnounceMaintenanceResponse, error) {
+	out := new(AnnounceMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/AnnounceMaintenance", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcManagementClient) CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error) {
+	out := new(CancelMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/multinode.Management/CancelMaintenance", drpcEncoding_File_multinode_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCManagementServer interface {
+	InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error)
+	SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error)
+	TrustExclusions(context.Context, *TrustExclusionsRequest) (*TrustExclusionsResponse, error)
+	AddTrustExclusion(context.Context, *AddTrustExclusionRequest) (*AddTrustExclusionResponse, error)
+	RemoveTrustExclusion(context.Context, *RemoveTrustExclusionRequest) (*RemoveTrustExclusionResponse, error)
+	RescanUsedSpace(context.Context, *RescanUsedSpaceRequest) (*RescanUsedSpaceResponse, error)
+	AnnounceMaintenance(context.Context, *AnnounceMaintenanceRequest) (*AnnounceMaintenanceResponse, error)
+	CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error)
+}
+
+type DRPCManagementUnimplementedServer struct{}
+
+func (s *DRPCManagementUnimplementedServer) InitiateGracefulExit(context.Context, *InitiateGracefulExitRequest) (*InitiateGracefulExitResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) SetAllocatedDiskSpace(context.Context, *SetAllocatedDiskSpaceRequest) (*SetAllocatedDiskSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) TrustExclusions(context.Context, *TrustExclusionsRequest) (*TrustExclusionsResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) AddTrustExclusion(context.Context, *AddTrustExclusionRequest) (*AddTrustExclusionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) RemoveTrustExclusion(context.Context, *RemoveTrustExclusionRequest) (*RemoveTrustExclusionResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) RescanUsedSpace(context.Context, *RescanUsedSpaceRequest) (*RescanUsedSpaceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) AnnounceMaintenance(context.Context, *AnnounceMaintenanceRequest) (*AnnounceMaintenanceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCManagementUnimplementedServer) CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCManagementDescription struct{}
+
+func (DRPCManagementDescription) NumMethods() int { return 8 }
+
+func (DRPCManagementDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/multinode.Management/InitiateGracefulExit", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					InitiateGracefulExit(
+						ctx,
+						in1.(*InitiateGracefulExitRequest),
+					)
+			}, DRPCManagementServer.InitiateGracefulExit, true
+	case 1:
+		return "/multinode.Management/SetAllocatedDiskSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					SetAllocatedDiskSpace(
+						ctx,
+						in1.(*SetAllocatedDiskSpaceRequest),
+					)
+			}, DRPCManagementServer.SetAllocatedDiskSpace, true
+	case 2:
+		return "/multinode.Management/TrustExclusions", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					TrustExclusions(
+						ctx,
+						in1.(*TrustExclusionsRequest),
+					)
+			}, DRPCManagementServer.TrustExclusions, true
+	case 3:
+		return "/multinode.Management/AddTrustExclusion", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					AddTrustExclusion(
+						ctx,
+						in1.(*AddTrustExclusionRequest),
+					)
+			}, DRPCManagementServer.AddTrustExclusion, true
+	case 4:
+		return "/multinode.Management/RemoveTrustExclusion", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					RemoveTrustExclusion(
+						ctx,
+						in1.(*RemoveTrustExclusionRequest),
+					)
+			}, DRPCManagementServer.RemoveTrustExclusion, true
+	case 5:
+		return "/multinode.Management/RescanUsedSpace", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					RescanUsedSpace(
+						ctx,
+						in1.(*RescanUsedSpaceRequest),
+					)
+			}, DRPCManagementServer.RescanUsedSpace, true
+	case 6:
+		return "/multinode.Management/AnnounceMaintenance", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					AnnounceMaintenance(
+						ctx,
+						in1.(*AnnounceMaintenanceRequest),
+					)
+			}, DRPCManagementServer.AnnounceMaintenance, true
+	case 7:
+		return "/multinode.Management/CancelMaintenance", drpcEncoding_File_multinode_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCManagementServer).
+					CancelMaintenance(
+						ctx,
+						in1.(*CancelMaintenanceRequest),
+					)
+			}, DRPCManagementServer.CancelMaintenance, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterManagement(mux drpc.Mux, impl DRPCManagementServer) error {
+	return mux.Register(impl, DRPCManagementDescription{})
+}
+
+type DRPCManagement_InitiateGracefulExitStream interface {
+	drpc.Stream
+	SendAndClose(*InitiateGracefulExitResponse) error
+}
+
+type drpcManagement_InitiateGracefulExitStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_InitiateGracefulExitStream) SendAndClose(m *InitiateGracefulExitResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_SetAllocatedDiskSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*SetAllocatedDiskSpaceResponse) error
+}
+
+type drpcManagement_SetAllocatedDiskSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_SetAllocatedDiskSpaceStream) SendAndClose(m *SetAllocatedDiskSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_TrustExclusionsStream interface {
+	drpc.Stream
+	SendAndClose(*TrustExclusionsResponse) error
+}
+
+type drpcManagement_TrustExclusionsStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_TrustExclusionsStream) SendAndClose(m *TrustExclusionsResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_AddTrustExclusionStream interface {
+	drpc.Stream
+	SendAndClose(*AddTrustExclusionResponse) error
+}
+
+type drpcManagement_AddTrustExclusionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_AddTrustExclusionStream) SendAndClose(m *AddTrustExclusionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_RemoveTrustExclusionStream interface {
+	drpc.Stream
+	SendAndClose(*RemoveTrustExclusionResponse) error
+}
+
+type drpcManagement_RemoveTrustExclusionStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_RemoveTrustExclusionStream) SendAndClose(m *RemoveTrustExclusionResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_RescanUsedSpaceStream interface {
+	drpc.Stream
+	SendAndClose(*RescanUsedSpaceResponse) error
+}
+
+type drpcManagement_RescanUsedSpaceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_RescanUsedSpaceStream) SendAndClose(m *RescanUsedSpaceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_AnnounceMaintenanceStream interface {
+	drpc.Stream
+	SendAndClose(*AnnounceMaintenanceResponse) error
+}
+
+type drpcManagement_AnnounceMaintenanceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_AnnounceMaintenanceStream) SendAndClose(m *AnnounceMaintenanceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCManagement_CancelMaintenanceStream interface {
+	drpc.Stream
+	SendAndClose(*CancelMaintenanceResponse) error
+}
+
+type drpcManagement_CancelMaintenanceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcManagement_CancelMaintenanceStream) SendAndClose(m *CancelMaintenanceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_multinode_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
 type DRPCPayoutsClient interface {
 	DRPCConn() drpc.Conn
 
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"runtime/pprof"
 	"strconv"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -17,7 +18,9 @@ import (
 	"common/storx"
 	"private/debug"
 	"storx/multinode"
+	"storx/multinode/alerts"
 	"storx/multinode/console/server"
+	"storx/multinode/history"
 	"storx/multinode/multinodedb"
 )
 
@@ -97,6 +100,19 @@ func (planet *Planet) newMultinode(ctx context.Context, prefix string, index int
 			Address:   "127.0.0.1:0",
 			StaticDir: filepath.Join(developmentRoot, "web/multinode/"),
 		},
+		Alerts: alerts.Config{
+			Interval:           defaultInterval,
+			PollTimeout:        time.Minute,
+			PollConcurrency:    10,
+			RenotifyInterval:   24 * time.Hour,
+			OfflineThreshold:   3 * time.Hour,
+			MinAuditScore:      0.98,
+			MinSuspensionScore: 0.98,
+			DiskUsageThreshold: 0.95,
+		},
+		History: history.Config{
+			Interval: defaultInterval,
+		},
 	}
 	if planet.config.Reconfigure.Multinode != nil {
 		planet.config.Reconfigure.Multinode(index, &config)
@@ -186,6 +186,10 @@ type Satellite struct {
 		Cache *accounting.ProjectLimitCache
 	}
 
+	BucketLimits struct {
+		Cache *accounting.BucketLimitCache
+	}
+
 	Mail struct {
 		Service *mailservice.Service
 	}
@@ -477,6 +481,7 @@ func (planet *Planet) newSatellite(ctx context.Context, prefix string, index int
 	config.Metainfo.RS.Success = atLeastOne(planet.config.StorageNodeCount * 3 / 5)
 	config.Metainfo.RS.Total = atLeastOne(planet.config.StorageNodeCount * 4 / 5)
 	config.Orders.EncryptionKeys = *encryptionKeys
+	config.Inventory.EncryptionKeys = *encryptionKeys
 	config.LiveAccounting.StorageBackend = "redis://" + redis.Addr() + "?db=0"
 	config.Mail.TemplatePath = filepath.Join(developmentRoot, "web/satellite/static/emails")
 	config.Console.StaticDir = filepath.Join(developmentRoot, "web/satellite")
@@ -668,6 +673,7 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.LiveAccounting = peer.LiveAccounting
 
 	system.ProjectLimits.Cache = api.ProjectLimits.Cache
+	system.BucketLimits.Cache = api.BucketLimits.Cache
 
 	system.GracefulExit.Chore = peer.GracefulExit.Chore
 	system.GracefulExit.Endpoint = api.GracefulExit.Endpoint
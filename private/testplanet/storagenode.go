@@ -185,6 +185,7 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 				VerifyDirWritableInterval: defaultInterval,
 				VerifyDirReadableTimeout:  10 * time.Second,
 				VerifyDirWritableTimeout:  10 * time.Second,
+				AllocationOverridePath:    filepath.Join(storageDir, "allocated-disk-space.json"),
 			},
 			Trust: trust.Config{
 				Sources:         sources,
@@ -205,7 +206,8 @@ func (planet *Planet) newStorageNode(ctx context.Context, prefix string, index,
 			Interval: defaultInterval,
 		},
 		Contact: contact.Config{
-			Interval: defaultInterval,
+			Interval:        defaultInterval,
+			MaintenancePath: filepath.Join(storageDir, "maintenance.json"),
 		},
 		GracefulExit: gracefulexit.Config{
 			ChoreInterval:          defaultInterval,
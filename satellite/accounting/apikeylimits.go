@@ -0,0 +1,39 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"time"
+
+	"common/uuid"
+)
+
+// apiKeyBandwidthTTL is how long the daily bandwidth usage of an api key is
+// kept in the cache. The cache key contains the day, hence it's never reused
+// within this period.
+const apiKeyBandwidthTTL = 24 * time.Hour
+
+// ExceedsAPIKeyBandwidthUsage returns true if the api key has downloaded at
+// least limit bytes in the current day.
+func (usage *Service) ExceedsAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, limit int64) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bandwidthUsage, err := usage.liveAccounting.GetAPIKeyBandwidthUsage(ctx, keyID, usage.nowFn())
+	if err != nil {
+		if ErrKeyNotFound.Has(err) {
+			return false, nil
+		}
+		return false, ErrProjectUsage.Wrap(err)
+	}
+
+	return bandwidthUsage >= limit, nil
+}
+
+// UpdateAPIKeyBandwidthUsage increments the daily bandwidth cache key of the api key.
+func (usage *Service) UpdateAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.UpdateAPIKeyBandwidthUsage(ctx, keyID, increment, apiKeyBandwidthTTL, usage.nowFn()))
+}
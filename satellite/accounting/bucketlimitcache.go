@@ -0,0 +1,67 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+
+	"github.com/zeebo/errs"
+
+	"common/lrucache"
+	"common/uuid"
+	"storx/satellite/buckets"
+	"storx/satellite/metabase"
+)
+
+// ErrGetBucketLimit error for getting bucket limits from database.
+var ErrGetBucketLimit = errs.Class("get bucket limits")
+
+// BucketLimitDB stores information about bucket limits.
+//
+// architecture: Database
+type BucketLimitDB interface {
+	// GetBucketLimits returns usage limits of an existing bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (buckets.Limits, error)
+}
+
+// BucketLimitCache stores the optional usage limits of buckets.
+type BucketLimitCache struct {
+	bucketLimitDB BucketLimitDB
+
+	state *lrucache.ExpiringLRU
+}
+
+// NewBucketLimitCache creates a new bucket limit cache to store the limits for each bucket.
+func NewBucketLimitCache(db BucketLimitDB, config ProjectLimitConfig) *BucketLimitCache {
+	return &BucketLimitCache{
+		bucketLimitDB: db,
+		state: lrucache.New(lrucache.Options{
+			Capacity:   config.CacheCapacity,
+			Expiration: config.CacheExpiration,
+		}),
+	}
+}
+
+// GetLimits returns the bucket limits from cache.
+func (c *BucketLimitCache) GetLimits(ctx context.Context, bucket metabase.BucketLocation) (_ buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fn := func() (interface{}, error) {
+		limits, err := c.bucketLimitDB.GetBucketLimits(ctx, []byte(bucket.BucketName), bucket.ProjectID)
+		if err != nil {
+			return nil, ErrGetBucketLimit.Wrap(err)
+		}
+		return limits, nil
+	}
+
+	bucketLimits, err := c.state.Get(string(bucket.Prefix()), fn)
+	if err != nil {
+		return buckets.Limits{}, err
+	}
+	limits, ok := bucketLimits.(buckets.Limits)
+	if !ok {
+		return buckets.Limits{}, ErrProjectLimitType.New("cache Get error")
+	}
+	return limits, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"time"
+
+	"common/memory"
+	"storx/satellite/metabase"
+)
+
+// ExceedsBucketUploadLimits returns combined checks for storage and segment limits of the bucket.
+// Supply nonzero headroom parameters to check if there is room for a new object.
+// Limits which are not set for the bucket are never exceeded.
+func (usage *Service) ExceedsBucketUploadLimits(
+	ctx context.Context, bucket metabase.BucketLocation, storageSizeHeadroom int64, segmentCountHeadroom int64) (limit UploadLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.GetLimits(ctx, bucket)
+	if err != nil {
+		return UploadLimit{}, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Storage == nil && limits.Segments == nil {
+		return UploadLimit{}, nil
+	}
+
+	current, err := usage.getBucketUsage(ctx, bucket)
+	if err != nil {
+		return UploadLimit{}, ErrProjectUsage.Wrap(err)
+	}
+
+	if limits.Storage != nil {
+		limit.StorageLimit = memory.Size(*limits.Storage)
+		limit.ExceedsStorage = (current.Storage + storageSizeHeadroom) > *limits.Storage
+	}
+	if limits.Segments != nil {
+		limit.SegmentsLimit = *limits.Segments
+		limit.ExceedsSegments = (current.Segments + segmentCountHeadroom) > *limits.Segments
+	}
+
+	return limit, nil
+}
+
+// AddBucketUsage lets the live accounting know that the given bucket has just
+// added storage bytes and segments. Buckets without storage and segment limits
+// aren't tracked.
+func (usage *Service) AddBucketUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.GetLimits(ctx, bucket)
+	if err != nil {
+		return ErrProjectUsage.Wrap(err)
+	}
+	if limits.Storage == nil && limits.Segments == nil {
+		return nil
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.AddBucketUsage(ctx, bucket, storage, segments))
+}
+
+// ExceedsBucketBandwidthUsage returns true if the bandwidth limit of the bucket
+// has been exceeded in the current month. Buckets without bandwidth limit never
+// exceed it.
+func (usage *Service) ExceedsBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation) (_ bool, limit memory.Size, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.GetLimits(ctx, bucket)
+	if err != nil {
+		return false, 0, ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return false, 0, nil
+	}
+	limit = memory.Size(*limits.Bandwidth)
+
+	now := usage.nowFn()
+	bandwidthUsage, err := usage.liveAccounting.GetBucketBandwidthUsage(ctx, bucket, now)
+	if err != nil {
+		if !ErrKeyNotFound.Has(err) {
+			return false, 0, ErrProjectUsage.Wrap(err)
+		}
+
+		// Get current bandwidth value from database.
+		year, month, _ := now.Date()
+		bandwidthUsage, err = usage.projectAccountingDB.GetBucketBandwidth(ctx, bucket.ProjectID, []byte(bucket.BucketName), time.Date(year, month, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			return false, 0, ErrProjectUsage.Wrap(err)
+		}
+
+		// Create cache key with database value.
+		_, err = usage.liveAccounting.InsertBucketBandwidthUsage(ctx, bucket, bandwidthUsage, usage.bandwidthCacheTTL, now)
+		if err != nil {
+			return false, 0, ErrProjectUsage.Wrap(err)
+		}
+	}
+
+	return bandwidthUsage >= limit.Int64(), limit, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth cache key of the bucket.
+// Buckets without bandwidth limit aren't tracked.
+func (usage *Service) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	limits, err := usage.bucketLimitCache.GetLimits(ctx, bucket)
+	if err != nil {
+		return ErrProjectUsage.Wrap(err)
+	}
+	if limits.Bandwidth == nil {
+		return nil
+	}
+
+	return ErrProjectUsage.Wrap(usage.liveAccounting.UpdateBucketBandwidthUsage(ctx, bucket, increment, usage.bandwidthCacheTTL, usage.nowFn()))
+}
+
+// getBucketUsage returns storage and segment usage of the bucket from the cache.
+// The usage is calculated from metabase when the bucket isn't tracked yet.
+func (usage *Service) getBucketUsage(ctx context.Context, bucket metabase.BucketLocation) (_ Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	current, err := usage.liveAccounting.GetBucketUsage(ctx, bucket)
+	if err == nil || !ErrKeyNotFound.Has(err) {
+		return current, err
+	}
+
+	tallies, err := usage.metabaseDB.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+		From: bucket,
+		To:   bucket,
+		Now:  usage.nowFn(),
+	})
+	if err != nil {
+		return Usage{}, err
+	}
+
+	current = Usage{}
+	for _, tally := range tallies {
+		current.Storage += tally.TotalBytes
+		current.Segments += tally.TotalSegments
+	}
+
+	inserted, err := usage.liveAccounting.InsertBucketUsage(ctx, bucket, current)
+	if err != nil {
+		return Usage{}, err
+	}
+	if !inserted {
+		// the bucket was concurrently inserted by another request.
+		return usage.liveAccounting.GetBucketUsage(ctx, bucket)
+	}
+
+	return current, nil
+}
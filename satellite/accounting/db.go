@@ -80,6 +80,13 @@ type ProjectUsage struct {
 	Before time.Time `json:"before"`
 }
 
+// PartnerPlacement identifies the partner and the placement constraint of the buckets
+// whose usage is priced together.
+type PartnerPlacement struct {
+	Partner   string
+	Placement storx.PlacementConstraint
+}
+
 // ProjectObjectsSegments consist of period total objects and segments count for certain Project.
 type ProjectObjectsSegments struct {
 	SegmentCount int64 `json:"segmentCount"`
@@ -164,6 +171,20 @@ type BucketUsageRollup struct {
 	Before time.Time `json:"before"`
 }
 
+// BucketDailyUsage is the usage of a bucket during a single UTC day.
+type BucketDailyUsage struct {
+	Date       time.Time
+	BucketName string
+
+	StorageByteHours float64
+	SegmentHours     float64
+	ObjectHours      float64
+
+	GetEgress    int64
+	RepairEgress int64
+	AuditEgress  int64
+}
+
 // Usage contains project's usage split on segments and storage.
 type Usage struct {
 	Storage  int64
@@ -214,6 +235,8 @@ type ProjectAccounting interface {
 	CreateStorageTally(ctx context.Context, tally BucketStorageTally) error
 	// GetProjectSettledBandwidthTotal returns the sum of GET bandwidth usage settled for a projectID in the past time frame.
 	GetProjectSettledBandwidthTotal(ctx context.Context, projectID uuid.UUID, from time.Time) (_ int64, err error)
+	// GetBucketBandwidth returns the sum of GET bandwidth allocated for a bucket since from.
+	GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (_ int64, err error)
 	// GetProjectBandwidth returns project allocated bandwidth for the specified year, month and day.
 	GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (int64, error)
 	// GetProjectDailyBandwidth returns bandwidth (allocated and settled) for the specified day.
@@ -242,12 +265,17 @@ type ProjectAccounting interface {
 	// GetProjectTotalByPartner retrieves project usage for a given period categorized by partner name.
 	// Unpartnered usage or usage for a partner not present in partnerNames is mapped to the empty string.
 	GetProjectTotalByPartner(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[string]ProjectUsage, err error)
+	// GetProjectTotalByPartnerAndPlacement retrieves project usage for a given period categorized by partner name and bucket placement.
+	// Unpartnered usage or usage for a partner not present in partnerNames is mapped to the empty string.
+	GetProjectTotalByPartnerAndPlacement(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[PartnerPlacement]ProjectUsage, err error)
 	// GetProjectObjectsSegments returns project objects and segments for specified period of time.
 	GetProjectObjectsSegments(ctx context.Context, projectID uuid.UUID) (*ProjectObjectsSegments, error)
 	// GetBucketUsageRollups returns usage rollup per each bucket for specified period of time.
 	GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketUsageRollup, error)
 	// GetSingleBucketUsageRollup returns usage rollup per single bucket for specified period of time.
 	GetSingleBucketUsageRollup(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (*BucketUsageRollup, error)
+	// GetBucketDailyUsages returns usage per bucket per UTC day for specified period of time.
+	GetBucketDailyUsages(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]BucketDailyUsage, error)
 	// GetBucketTotals returns per bucket total usage summary since bucket creation.
 	GetBucketTotals(ctx context.Context, projectID uuid.UUID, cursor BucketUsageCursor, before time.Time) (*BucketUsagePage, error)
 	// ArchiveRollupsBefore archives rollups older than a given time and returns number of bucket bandwidth rollups archived.
@@ -308,6 +336,32 @@ type Cache interface {
 	AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) error
 	// GetAllProjectTotals return the total projects' storage and segments used space.
 	GetAllProjectTotals(ctx context.Context) (map[uuid.UUID]Usage, error)
+	// GetBucketUsage returns the bucket's storage and segment usage.
+	GetBucketUsage(ctx context.Context, bucket metabase.BucketLocation) (usage Usage, err error)
+	// InsertBucketUsage inserts the bucket's storage and segment usage if it
+	// doesn't exist. It returns true if it's inserted, otherwise false.
+	InsertBucketUsage(ctx context.Context, bucket metabase.BucketLocation, usage Usage) (inserted bool, _ error)
+	// AddBucketUsage adds storage and segments to the bucket's usage.
+	// The bucket is inserted to the increment when it doesn't exists,
+	// hence this method will never return ErrKeyNotFound.
+	AddBucketUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64) error
+	// GetBucketBandwidthUsage returns the bucket's bandwidth usage.
+	GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error)
+	// InsertBucketBandwidthUsage inserts a bucket bandwidth usage if it
+	// doesn't exist. It returns true if it's inserted, otherwise false.
+	InsertBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, value int64, ttl time.Duration, now time.Time) (inserted bool, _ error)
+	// UpdateBucketBandwidthUsage updates the bucket's bandwidth usage increasing
+	// it. The bucket is inserted to the increment when it doesn't exists,
+	// hence this method will never return ErrKeyNotFound error's class.
+	UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) error
+	// GetAllBucketTotals returns the storage and segment usage of the buckets tracked by the cache.
+	GetAllBucketTotals(ctx context.Context) (map[metabase.BucketLocation]Usage, error)
+	// GetAPIKeyBandwidthUsage returns the api key's bandwidth usage of the current day.
+	GetAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, now time.Time) (currentUsed int64, err error)
+	// UpdateAPIKeyBandwidthUsage updates the api key's bandwidth usage of the
+	// current day increasing it. The key is inserted to the increment when it
+	// doesn't exists, hence this method will never return ErrKeyNotFound.
+	UpdateAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, increment int64, ttl time.Duration, now time.Time) error
 	// Close the client, releasing any open resources. Once it's called any other
 	// method must be called.
 	Close() error
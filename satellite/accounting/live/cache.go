@@ -23,7 +23,7 @@ var (
 
 // Config contains configurable values for the live accounting service.
 type Config struct {
-	StorageBackend     string        `help:"what to use for storing real-time accounting data"`
+	StorageBackend     string        `help:"what to use for storing real-time accounting data: a redis:// address, or a postgres:// or cockroach:// address for deployments without Redis"`
 	BandwidthCacheTTL  time.Duration `default:"5m" help:"bandwidth cache key time to live"`
 	AsOfSystemInterval time.Duration `default:"-10s" help:"as of system interval"`
 }
@@ -50,7 +50,31 @@ func OpenCache(ctx context.Context, log *zap.Logger, config Config) (accounting.
 	switch backendType {
 	case "redis":
 		return openRedisLiveAccounting(ctx, config.StorageBackend)
+	case "postgres", "postgresql", "cockroach":
+		return openPostgresLiveAccounting(ctx, config.StorageBackend)
 	default:
-		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently only redis is supported", backendType)
+		return nil, Error.New("unrecognized live accounting backend specifier %q. Currently redis, postgres and cockroach are supported", backendType)
 	}
 }
+
+// MigrateToLatest migrates the database of the backend specified in the
+// provided config to the latest version. Only the postgres and cockroach
+// backends have a schema, for other backends it does nothing.
+func MigrateToLatest(ctx context.Context, log *zap.Logger, config Config) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	backendType, _, _ := strings.Cut(config.StorageBackend, ":")
+	switch backendType {
+	case "postgres", "postgresql", "cockroach":
+	default:
+		return nil
+	}
+
+	db, err := openPostgresDB(ctx, config.StorageBackend)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, db.Close()) }()
+
+	return Error.Wrap(postgresMigration(&db).Run(ctx, log.Named("migrate")))
+}
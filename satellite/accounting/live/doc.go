@@ -5,5 +5,8 @@
 Package live provides live accounting functionality. That is, it keeps track
 of deltas in the amount of storage used by each project relative to the last
 tally operation (see satellite/accounting/tally).
+
+The data is kept either in Redis or, for deployments which don't want to
+operate Redis, in a table of a Postgres or CockroachDB database.
 */
 package live
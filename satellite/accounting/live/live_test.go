@@ -17,6 +17,8 @@ import (
 	"common/testcontext"
 	"common/testrand"
 	"common/uuid"
+	"private/dbutil/pgtest"
+	"private/dbutil/tempdb"
 	"storx/private/testredis"
 	"storx/satellite/accounting"
 	"storx/satellite/accounting/live"
@@ -29,6 +31,9 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "postgres",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -43,10 +48,15 @@ func TestAddGetProjectStorageAndBandwidthUsage(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "postgres":
+				config = live.Config{
+					StorageBackend: tempPostgres(ctx, t),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -103,6 +113,9 @@ func TestGetAllProjectTotals(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "postgres",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -117,10 +130,15 @@ func TestGetAllProjectTotals(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "postgres":
+				config = live.Config{
+					StorageBackend: tempPostgres(ctx, t),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -161,6 +179,9 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "postgres",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -176,10 +197,15 @@ func TestLiveAccountingCache_ProjectBandwidthUsage_expiration(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "postgres":
+				config = live.Config{
+					StorageBackend: tempPostgres(ctx, t),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -211,6 +237,9 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 		{
 			backend: "redis",
 		},
+		{
+			backend: "postgres",
+		},
 	}
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -226,10 +255,15 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 			ctx := testcontext.New(t)
 
 			var config live.Config
-			if tt.backend == "redis" {
+			switch tt.backend {
+			case "redis":
 				config = live.Config{
 					StorageBackend: "redis://" + redis.Addr() + "?db=0",
 				}
+			case "postgres":
+				config = live.Config{
+					StorageBackend: tempPostgres(ctx, t),
+				}
 			}
 
 			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
@@ -271,6 +305,119 @@ func TestInsertProjectBandwidthUsage(t *testing.T) {
 	}
 }
 
+func TestAPIKeyBandwidthUsage(t *testing.T) {
+	tests := []struct {
+		backend string
+	}{
+		{
+			backend: "redis",
+		},
+		{
+			backend: "postgres",
+		},
+	}
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	redis, err := testredis.Start(ctx)
+	require.NoError(t, err)
+
+	defer ctx.Check(redis.Close)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.backend, func(t *testing.T) {
+			ctx := testcontext.New(t)
+
+			var config live.Config
+			switch tt.backend {
+			case "redis":
+				config = live.Config{
+					StorageBackend: "redis://" + redis.Addr() + "?db=0",
+				}
+			case "postgres":
+				config = live.Config{
+					StorageBackend: tempPostgres(ctx, t),
+				}
+			}
+
+			cache, err := live.OpenCache(ctx, zaptest.NewLogger(t).Named("live-accounting"), config)
+			require.NoError(t, err)
+			defer ctx.Check(cache.Close)
+
+			var (
+				keyID     = testrand.UUID()
+				projectID = testrand.UUID()
+				now       = time.Now()
+			)
+
+			_, err = cache.GetAPIKeyBandwidthUsage(ctx, keyID, now)
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			require.NoError(t, cache.UpdateAPIKeyBandwidthUsage(ctx, keyID, 100, time.Hour, now))
+			require.NoError(t, cache.UpdateAPIKeyBandwidthUsage(ctx, keyID, 50, time.Hour, now))
+
+			usage, err := cache.GetAPIKeyBandwidthUsage(ctx, keyID, now)
+			require.NoError(t, err)
+			require.EqualValues(t, 150, usage)
+
+			// the usage of the next day is tracked separately.
+			_, err = cache.GetAPIKeyBandwidthUsage(ctx, keyID, now.AddDate(0, 0, 1))
+			require.True(t, accounting.ErrKeyNotFound.Has(err))
+
+			// api key usage must not be reported as project usage.
+			require.NoError(t, cache.AddProjectStorageUsage(ctx, projectID, 10))
+			totals, err := cache.GetAllProjectTotals(ctx)
+			require.NoError(t, err)
+			require.Len(t, totals, 1)
+			require.EqualValues(t, 10, totals[projectID].Storage)
+		})
+	}
+}
+
+func TestMigrateToLatest(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	tempDB, err := tempdb.OpenUnique(ctx, pgtest.PickPostgres(t), "live-accounting")
+	require.NoError(t, err)
+	defer ctx.Check(tempDB.Close)
+
+	config := live.Config{StorageBackend: tempDB.ConnStr}
+
+	cache, err := live.OpenCache(ctx, log, config)
+	require.NoError(t, err)
+	defer ctx.Check(cache.Close)
+
+	// the table doesn't exist before migrating.
+	_, err = cache.GetAllProjectTotals(ctx)
+	require.Error(t, err)
+
+	require.NoError(t, live.MigrateToLatest(ctx, log, config))
+	// migrating an up to date database does nothing.
+	require.NoError(t, live.MigrateToLatest(ctx, log, config))
+
+	totals, err := cache.GetAllProjectTotals(ctx)
+	require.NoError(t, err)
+	require.Empty(t, totals)
+
+	// backends without a schema aren't migrated.
+	require.NoError(t, live.MigrateToLatest(ctx, log, live.Config{StorageBackend: "redis://127.0.0.1:6379?db=0"}))
+}
+
+// tempPostgres returns the address of a migrated temporary Postgres schema.
+// The test is skipped when no Postgres database is configured.
+func tempPostgres(ctx *testcontext.Context, t *testing.T) string {
+	tempDB, err := tempdb.OpenUnique(ctx, pgtest.PickPostgres(t), "live-accounting")
+	require.NoError(t, err)
+	t.Cleanup(func() { ctx.Check(tempDB.Close) })
+
+	config := live.Config{StorageBackend: tempDB.ConnStr}
+	require.NoError(t, live.MigrateToLatest(ctx, zaptest.NewLogger(t), config))
+
+	return tempDB.ConnStr
+}
+
 type populateCacheData struct {
 	projectID    uuid.UUID
 	storageSum   int64
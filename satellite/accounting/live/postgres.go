@@ -0,0 +1,523 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package live
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	_ "github.com/jackc/pgx/v4/stdlib" // registers pgx as a tagsql driver.
+	"github.com/zeebo/errs"
+
+	"common/uuid"
+	"private/dbutil"
+	_ "private/dbutil/cockroachutil" // registers cockroach as a tagsql driver.
+	"private/dbutil/txutil"
+	"private/migrate"
+	"private/tagsql"
+	"storx/satellite/accounting"
+	"storx/satellite/metabase"
+)
+
+// postgresLiveAccounting is an accounting.Cache which keeps the live
+// accounting data in a Postgres or CockroachDB table, so that satellites can
+// share it without operating Redis.
+//
+// It uses the same keys as redisLiveAccounting. Keys which expire, i.e. the
+// bandwidth keys, are treated as missing once their expiration time is past
+// and they are removed by GetAllProjectTotals.
+type postgresLiveAccounting struct {
+	db tagsql.DB
+}
+
+// openPostgresLiveAccounting returns a postgresLiveAccounting cache instance.
+//
+// It returns accounting.ErrInvalidArgument if the connection address is not a
+// Postgres or CockroachDB one.
+//
+// The table used by the cache isn't created, MigrateToLatest has to be run
+// before using the cache.
+func openPostgresLiveAccounting(ctx context.Context, address string) (*postgresLiveAccounting, error) {
+	db, err := openPostgresDB(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &postgresLiveAccounting{db: db}, nil
+}
+
+// openPostgresDB opens the database of a Postgres or CockroachDB address.
+func openPostgresDB(ctx context.Context, address string) (tagsql.DB, error) {
+	_, _, impl, err := dbutil.SplitConnStr(address)
+	if err != nil {
+		return nil, accounting.ErrInvalidArgument.New("address: %w", err)
+	}
+
+	var driverName string
+	switch impl {
+	case dbutil.Postgres:
+		driverName = "pgx"
+	case dbutil.Cockroach:
+		driverName = "cockroach"
+	default:
+		return nil, accounting.ErrInvalidArgument.New("address: not a postgres:// or cockroach:// formatted address")
+	}
+
+	db, err := tagsql.Open(ctx, driverName, address)
+	if err != nil {
+		return nil, accounting.ErrInvalidArgument.New("address: %w", err)
+	}
+	dbutil.Configure(ctx, db, "live-accounting", mon)
+
+	return db, nil
+}
+
+// postgresMigration returns the steps needed for migrating the database of
+// the postgresLiveAccounting cache.
+func postgresMigration(db *tagsql.DB) *migrate.Migration {
+	return &migrate.Migration{
+		Table: "live_accounting_versions",
+		Steps: []*migrate.Step{
+			{
+				DB:          db,
+				Description: "initial setup",
+				Version:     0,
+				Action: migrate.SQL{
+					// keys without expiration have a NULL expires_at.
+					`CREATE TABLE live_accounting (
+						key bytea NOT NULL,
+						value bigint NOT NULL,
+						expires_at timestamp with time zone,
+						PRIMARY KEY ( key )
+					)`,
+				},
+			},
+		},
+	}
+}
+
+// GetProjectStorageUsage gets inline and remote storage totals for a given
+// project, back to the time of the last accounting tally.
+func (cache *postgresLiveAccounting) GetProjectStorageUsage(ctx context.Context, projectID uuid.UUID) (totalUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getInt64(ctx, string(projectID[:]))
+}
+
+// GetProjectBandwidthUsage returns the current bandwidth usage
+// from specific project.
+func (cache *postgresLiveAccounting) GetProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID, now)(&err)
+
+	return cache.getInt64(ctx, createBandwidthProjectIDKey(projectID, now))
+}
+
+// InsertProjectBandwidthUsage inserts a project bandwidth usage if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *postgresLiveAccounting) InsertProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, value int64, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, projectID, value, ttl, now)(&err)
+
+	return cache.insertWithTTL(ctx, createBandwidthProjectIDKey(projectID, now), value, ttl)
+}
+
+// UpdateProjectBandwidthUsage increment the bandwidth cache key value.
+func (cache *postgresLiveAccounting) UpdateProjectBandwidthUsage(ctx context.Context, projectID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, projectID, increment, ttl, now)(&err)
+
+	return cache.incrementWithTTL(ctx, createBandwidthProjectIDKey(projectID, now), increment, ttl)
+}
+
+// GetProjectSegmentUsage returns the current segment usage from specific project.
+func (cache *postgresLiveAccounting) GetProjectSegmentUsage(ctx context.Context, projectID uuid.UUID) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	return cache.getInt64(ctx, createSegmentProjectIDKey(projectID))
+}
+
+// UpdateProjectSegmentUsage increment the segment cache key value.
+func (cache *postgresLiveAccounting) UpdateProjectSegmentUsage(ctx context.Context, projectID uuid.UUID, increment int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	return cache.increment(ctx, createSegmentProjectIDKey(projectID), increment)
+}
+
+// AddProjectSegmentUsageUpToLimit increases segment usage up to the limit.
+// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *postgresLiveAccounting) AddProjectSegmentUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, segmentLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	err = cache.incrementUpToLimit(ctx, createSegmentProjectIDKey(projectID), increment, segmentLimit)
+	if accounting.ErrProjectLimitExceeded.Has(err) {
+		return accounting.ErrProjectLimitExceeded.New("Additional %d segments exceed project limit of %d", increment, segmentLimit)
+	}
+	return err
+}
+
+// AddProjectStorageUsage lets the live accounting know that the given
+// project has just added spaceUsed bytes of storage (from the user's
+// perspective; i.e. segment size).
+func (cache *postgresLiveAccounting) AddProjectStorageUsage(ctx context.Context, projectID uuid.UUID, spaceUsed int64) (err error) {
+	defer mon.Task()(&ctx, projectID, spaceUsed)(&err)
+
+	return cache.increment(ctx, string(projectID[:]), spaceUsed)
+}
+
+// AddProjectStorageUsageUpToLimit increases storage usage up to the limit.
+// If the limit is exceeded, the usage is not increased and accounting.ErrProjectLimitExceeded is returned.
+func (cache *postgresLiveAccounting) AddProjectStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, increment int64, spaceLimit int64) (err error) {
+	defer mon.Task()(&ctx, projectID, increment)(&err)
+
+	err = cache.incrementUpToLimit(ctx, string(projectID[:]), increment, spaceLimit)
+	if accounting.ErrProjectLimitExceeded.Has(err) {
+		return accounting.ErrProjectLimitExceeded.New("Additional storage of %d bytes exceeds project limit of %d", increment, spaceLimit)
+	}
+	return err
+}
+
+// GetAllProjectTotals returns a map of project IDs and totals, amount of segments.
+//
+// It also removes the expired keys, because it's called periodically by the
+// tally and there is no other process expiring them.
+func (cache *postgresLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ map[uuid.UUID]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `DELETE FROM live_accounting WHERE expires_at <= now()`)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Postgres delete failed: %w", err)
+	}
+
+	rows, err := cache.db.QueryContext(ctx, `SELECT key, value FROM live_accounting WHERE expires_at IS NULL`)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Postgres select failed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	projects := make(map[uuid.UUID]accounting.Usage)
+	for rows.Next() {
+		var key []byte
+		var value int64
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, accounting.ErrSystemOrNetError.New("Postgres scan failed: %w", err)
+		}
+
+		// skip bucket keys, they are returned by GetAllBucketTotals.
+		if bytes.HasPrefix(key, []byte(bucketKeyPrefix)) {
+			continue
+		}
+
+		if bytes.HasSuffix(key, []byte(":segment")) {
+			projectID, err := uuid.FromBytes(bytes.TrimSuffix(key, []byte(":segment")))
+			if err != nil {
+				return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as UUID; key=%q", key)
+			}
+
+			usage := projects[projectID]
+			usage.Segments = value
+			projects[projectID] = usage
+		} else {
+			projectID, err := uuid.FromBytes(key)
+			if err != nil {
+				return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as UUID; key=%q", key)
+			}
+
+			usage := projects[projectID]
+			usage.Storage = value
+			projects[projectID] = usage
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Postgres select failed: %w", err)
+	}
+
+	return projects, nil
+}
+
+// GetBucketUsage returns the storage and segment usage of the bucket.
+func (cache *postgresLiveAccounting) GetBucketUsage(ctx context.Context, bucket metabase.BucketLocation) (_ accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storage, err := cache.getInt64(ctx, createBucketStorageKey(bucket))
+	if err != nil {
+		return accounting.Usage{}, err
+	}
+
+	segments, err := cache.getInt64(ctx, createBucketSegmentKey(bucket))
+	if err != nil && !accounting.ErrKeyNotFound.Has(err) {
+		return accounting.Usage{}, err
+	}
+
+	return accounting.Usage{Storage: storage, Segments: segments}, nil
+}
+
+// InsertBucketUsage inserts the storage and segment usage of the bucket if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *postgresLiveAccounting) InsertBucketUsage(ctx context.Context, bucket metabase.BucketLocation, usage accounting.Usage) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// The storage key decides whether the bucket is tracked, hence the segment
+	// key is set only when the storage key was inserted.
+	err = txutil.WithTx(ctx, cache.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		result, err := tx.ExecContext(ctx, `
+			INSERT INTO live_accounting (key, value) VALUES ($1, $2)
+			ON CONFLICT (key) DO NOTHING
+		`, []byte(createBucketStorageKey(bucket)), usage.Storage)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		inserted = affected == 1
+		if !inserted {
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO live_accounting (key, value) VALUES ($1, $2)
+			ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = NULL
+		`, []byte(createBucketSegmentKey(bucket)), usage.Segments)
+		return err
+	})
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Postgres insert failed: %w", err)
+	}
+
+	return inserted, nil
+}
+
+// AddBucketUsage adds storage and segments to the usage of the bucket.
+func (cache *postgresLiveAccounting) AddBucketUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64) (err error) {
+	defer mon.Task()(&ctx, storage, segments)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting (key, value) VALUES ($1, $2), ($3, $4)
+		ON CONFLICT (key) DO UPDATE SET value = live_accounting.value + EXCLUDED.value
+	`, []byte(createBucketStorageKey(bucket)), storage, []byte(createBucketSegmentKey(bucket)), segments)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Postgres upsert failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *postgresLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createBucketBandwidthKey(bucket, now))
+}
+
+// InsertBucketBandwidthUsage inserts a bucket bandwidth usage if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *postgresLiveAccounting) InsertBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, value int64, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, value, ttl, now)(&err)
+
+	return cache.insertWithTTL(ctx, createBucketBandwidthKey(bucket, now), value, ttl)
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth cache key value of the bucket.
+func (cache *postgresLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	return cache.incrementWithTTL(ctx, createBucketBandwidthKey(bucket, now), increment, ttl)
+}
+
+// GetAPIKeyBandwidthUsage returns the bandwidth usage of the api key in the current day.
+func (cache *postgresLiveAccounting) GetAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createAPIKeyBandwidthKey(keyID, now))
+}
+
+// UpdateAPIKeyBandwidthUsage increments the bandwidth cache key value of the api key.
+func (cache *postgresLiveAccounting) UpdateAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	return cache.incrementWithTTL(ctx, createAPIKeyBandwidthKey(keyID, now), increment, ttl)
+}
+
+// GetAllBucketTotals returns the storage and segment usage of the tracked buckets.
+func (cache *postgresLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the bucket keys are the ones between the prefix and the prefix with
+	// the last byte incremented.
+	prefixEnd := []byte(bucketKeyPrefix)
+	prefixEnd[len(prefixEnd)-1]++
+
+	rows, err := cache.db.QueryContext(ctx, `
+		SELECT key, value FROM live_accounting
+		WHERE key >= $1 AND key < $2 AND expires_at IS NULL
+	`, []byte(bucketKeyPrefix), prefixEnd)
+	if err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Postgres select failed: %w", err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	buckets := make(map[metabase.BucketLocation]accounting.Usage)
+	segments := make(map[metabase.BucketLocation]int64)
+	for rows.Next() {
+		var key []byte
+		var value int64
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, accounting.ErrSystemOrNetError.New("Postgres scan failed: %w", err)
+		}
+
+		var suffix string
+		switch {
+		case bytes.HasSuffix(key, []byte(bucketStorageKeySuffix)):
+			suffix = bucketStorageKeySuffix
+		case bytes.HasSuffix(key, []byte(bucketSegmentKeySuffix)):
+			suffix = bucketSegmentKeySuffix
+		default:
+			continue
+		}
+
+		bucket, err := metabase.ParseCompactBucketPrefix(bytes.TrimSuffix(bytes.TrimPrefix(key, []byte(bucketKeyPrefix)), []byte(suffix)))
+		if err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as bucket location; key=%q", key)
+		}
+
+		if suffix == bucketStorageKeySuffix {
+			buckets[bucket] = accounting.Usage{Storage: value}
+		} else {
+			segments[bucket] = value
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Postgres select failed: %w", err)
+	}
+
+	// the storage key decides whether the bucket is tracked.
+	for bucket, usage := range buckets {
+		usage.Segments = segments[bucket]
+		buckets[bucket] = usage
+	}
+
+	return buckets, nil
+}
+
+// Close the DB connection.
+func (cache *postgresLiveAccounting) Close() error {
+	err := cache.db.Close()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Postgres close failed: %w", err)
+	}
+
+	return nil
+}
+
+func (cache *postgresLiveAccounting) getInt64(ctx context.Context, key string) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var value int64
+	err = cache.db.QueryRowContext(ctx, `
+		SELECT value FROM live_accounting
+		WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())
+	`, []byte(key)).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, accounting.ErrKeyNotFound.New("%q", key)
+		}
+
+		return 0, accounting.ErrSystemOrNetError.New("Postgres select failed: %w", err)
+	}
+
+	return value, nil
+}
+
+// increment increments the value of the key, inserting it when it doesn't exist.
+func (cache *postgresLiveAccounting) increment(ctx context.Context, key string, increment int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting (key, value) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET value = live_accounting.value + EXCLUDED.value
+	`, []byte(key), increment)
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Postgres upsert failed: %w", err)
+	}
+
+	return nil
+}
+
+// incrementUpToLimit increments the value of the key when the result doesn't
+// exceed the limit, otherwise it returns accounting.ErrProjectLimitExceeded.
+func (cache *postgresLiveAccounting) incrementUpToLimit(ctx context.Context, key string, increment, limit int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the existing value is only updated when the result doesn't exceed the
+	// limit, so the usage can exceed the limit only when the key is inserted.
+	var value int64
+	err = cache.db.QueryRowContext(ctx, `
+		INSERT INTO live_accounting (key, value) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET value = live_accounting.value + EXCLUDED.value
+			WHERE live_accounting.value + EXCLUDED.value <= $3
+		RETURNING value
+	`, []byte(key), increment, limit).Scan(&value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return accounting.ErrProjectLimitExceeded.New("")
+		}
+
+		return accounting.ErrSystemOrNetError.New("Postgres upsert failed: %w", err)
+	}
+
+	if value > limit {
+		// roll back
+		if err := cache.increment(ctx, key, -increment); err != nil {
+			return err
+		}
+
+		return accounting.ErrProjectLimitExceeded.New("")
+	}
+
+	return nil
+}
+
+// insertWithTTL inserts the key with the value and expiration time to live
+// when it doesn't exist or it's expired. It returns true if it's inserted,
+// otherwise false.
+func (cache *postgresLiveAccounting) insertWithTTL(ctx context.Context, key string, value int64, ttl time.Duration) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting (key, value, expires_at) VALUES ($1, $2, now() + $3::INT8 * INTERVAL '1 microsecond')
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = EXCLUDED.expires_at
+			WHERE live_accounting.expires_at <= now()
+	`, []byte(key), value, ttl.Microseconds())
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Postgres upsert failed: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Postgres upsert failed: %w", err)
+	}
+
+	return affected == 1, nil
+}
+
+// incrementWithTTL increments the value of the key. The expiration time to
+// live is set only when the key is inserted, either because it doesn't exist
+// or it's expired.
+func (cache *postgresLiveAccounting) incrementWithTTL(ctx context.Context, key string, increment int64, ttl time.Duration) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = cache.db.ExecContext(ctx, `
+		INSERT INTO live_accounting (key, value, expires_at) VALUES ($1, $2, now() + $3::INT8 * INTERVAL '1 microsecond')
+		ON CONFLICT (key) DO UPDATE SET
+			value = CASE WHEN live_accounting.expires_at <= now() THEN EXCLUDED.value ELSE live_accounting.value + EXCLUDED.value END,
+			expires_at = CASE WHEN live_accounting.expires_at <= now() THEN EXCLUDED.expires_at ELSE live_accounting.expires_at END
+	`, []byte(key), increment, ttl.Microseconds())
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Postgres upsert failed: %w", err)
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 
 	"common/uuid"
 	"storx/satellite/accounting"
+	"storx/satellite/metabase"
 )
 
 type redisLiveAccounting struct {
@@ -242,6 +243,11 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 	for it.Next(ctx) {
 		key := it.Val()
 
+		// skip bucket keys, they are returned by GetAllBucketTotals.
+		if strings.HasPrefix(key, bucketKeyPrefix) {
+			continue
+		}
+
 		// skip bandwidth keys
 		if strings.HasSuffix(key, "bandwidth") {
 			continue
@@ -305,6 +311,171 @@ func (cache *redisLiveAccounting) GetAllProjectTotals(ctx context.Context) (_ ma
 	return projects, nil
 }
 
+// GetBucketUsage returns the storage and segment usage of the bucket.
+func (cache *redisLiveAccounting) GetBucketUsage(ctx context.Context, bucket metabase.BucketLocation) (_ accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	storage, err := cache.getInt64(ctx, createBucketStorageKey(bucket))
+	if err != nil {
+		return accounting.Usage{}, err
+	}
+
+	segments, err := cache.getInt64(ctx, createBucketSegmentKey(bucket))
+	if err != nil && !accounting.ErrKeyNotFound.Has(err) {
+		return accounting.Usage{}, err
+	}
+
+	return accounting.Usage{Storage: storage, Segments: segments}, nil
+}
+
+// InsertBucketUsage inserts the storage and segment usage of the bucket if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *redisLiveAccounting) InsertBucketUsage(ctx context.Context, bucket metabase.BucketLocation, usage accounting.Usage) (inserted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// The storage key decides whether the bucket is tracked, hence the segment
+	// key is set only when the storage key was inserted.
+	script := redis.NewScript(`local inserted
+	inserted = redis.call("setnx", KEYS[1], ARGV[1])
+	if tonumber(inserted) == 1 then
+		redis.call("set", KEYS[2], ARGV[2])
+	end
+
+	return inserted
+	`)
+
+	keys := []string{createBucketStorageKey(bucket), createBucketSegmentKey(bucket)}
+	insert, err := script.Run(ctx, cache.client, keys, usage.Storage, usage.Segments).Int()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return insert == 1, nil
+}
+
+// AddBucketUsage adds storage and segments to the usage of the bucket.
+func (cache *redisLiveAccounting) AddBucketUsage(ctx context.Context, bucket metabase.BucketLocation, storage, segments int64) (err error) {
+	defer mon.Task()(&ctx, storage, segments)(&err)
+
+	pipe := cache.client.TxPipeline()
+	pipe.IncrBy(ctx, createBucketStorageKey(bucket), storage)
+	pipe.IncrBy(ctx, createBucketSegmentKey(bucket), segments)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis incrby failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetBucketBandwidthUsage returns the current bandwidth usage of the bucket.
+func (cache *redisLiveAccounting) GetBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createBucketBandwidthKey(bucket, now))
+}
+
+// InsertBucketBandwidthUsage inserts a bucket bandwidth usage if it
+// doesn't exist. It returns true if it's inserted, otherwise false.
+func (cache *redisLiveAccounting) InsertBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, value int64, ttl time.Duration, now time.Time) (inserted bool, err error) {
+	defer mon.Task()(&ctx, value, ttl, now)(&err)
+
+	inserted, err = cache.client.SetNX(ctx, createBucketBandwidthKey(bucket, now), value, ttl).Result()
+	if err != nil {
+		return false, accounting.ErrSystemOrNetError.New("Redis setnx failed: %w", err)
+	}
+
+	return inserted, nil
+}
+
+// UpdateBucketBandwidthUsage increments the bandwidth cache key value of the bucket.
+func (cache *redisLiveAccounting) UpdateBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	// see UpdateProjectBandwidthUsage for the explanation of the script.
+	script := redis.NewScript(`local current
+	current = redis.call("incrby", KEYS[1], ARGV[1])
+	if tonumber(current) == tonumber(ARGV[1]) then
+		redis.call("expire", KEYS[1], ARGV[2])
+	end
+	return current
+	`)
+
+	key := createBucketBandwidthKey(bucket, now)
+	err = script.Run(ctx, cache.client, []string{key}, increment, int(ttl.Seconds())).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetAPIKeyBandwidthUsage returns the bandwidth usage of the api key in the current day.
+func (cache *redisLiveAccounting) GetAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, now time.Time) (currentUsed int64, err error) {
+	defer mon.Task()(&ctx, now)(&err)
+
+	return cache.getInt64(ctx, createAPIKeyBandwidthKey(keyID, now))
+}
+
+// UpdateAPIKeyBandwidthUsage increments the bandwidth cache key value of the api key.
+func (cache *redisLiveAccounting) UpdateAPIKeyBandwidthUsage(ctx context.Context, keyID uuid.UUID, increment int64, ttl time.Duration, now time.Time) (err error) {
+	defer mon.Task()(&ctx, increment, ttl, now)(&err)
+
+	// see UpdateProjectBandwidthUsage for the explanation of the script.
+	script := redis.NewScript(`local current
+	current = redis.call("incrby", KEYS[1], ARGV[1])
+	if tonumber(current) == tonumber(ARGV[1]) then
+		redis.call("expire", KEYS[1], ARGV[2])
+	end
+	return current
+	`)
+
+	key := createAPIKeyBandwidthKey(keyID, now)
+	err = script.Run(ctx, cache.client, []string{key}, increment, int(ttl.Seconds())).Err()
+	if err != nil {
+		return accounting.ErrSystemOrNetError.New("Redis eval failed: %w", err)
+	}
+
+	return nil
+}
+
+// GetAllBucketTotals iterates through the live accounting DB and returns
+// the storage and segment usage of the tracked buckets.
+func (cache *redisLiveAccounting) GetAllBucketTotals(ctx context.Context) (_ map[metabase.BucketLocation]accounting.Usage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	buckets := make(map[metabase.BucketLocation]accounting.Usage)
+	it := cache.client.Scan(ctx, 0, bucketKeyPrefix+"*", 0).Iterator()
+	for it.Next(ctx) {
+		key := it.Val()
+
+		// bandwidth and segment usage is read together with the storage usage.
+		if !strings.HasSuffix(key, bucketStorageKeySuffix) {
+			continue
+		}
+
+		bucket, err := metabase.ParseCompactBucketPrefix([]byte(strings.TrimSuffix(strings.TrimPrefix(key, bucketKeyPrefix), bucketStorageKeySuffix)))
+		if err != nil {
+			return nil, accounting.ErrUnexpectedValue.New("cannot parse the key as bucket location; key=%q", key)
+		}
+
+		usage, err := cache.GetBucketUsage(ctx, bucket)
+		if err != nil {
+			if accounting.ErrKeyNotFound.Has(err) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		buckets[bucket] = usage
+	}
+	if err := it.Err(); err != nil {
+		return nil, accounting.ErrSystemOrNetError.New("Redis scan failed: %w", err)
+	}
+
+	return buckets, nil
+}
+
 // Close the DB connection.
 func (cache *redisLiveAccounting) Close() error {
 	err := cache.client.Close()
@@ -347,3 +518,38 @@ func createBandwidthProjectIDKey(projectID uuid.UUID, now time.Time) string {
 func createSegmentProjectIDKey(projectID uuid.UUID) string {
 	return string(projectID[:]) + ":segment"
 }
+
+const (
+	// bucketKeyPrefix is the prefix of bucket keys. It never collides with
+	// project keys because the 7th byte of a version 4 UUID isn't a colon.
+	bucketKeyPrefix        = "bucket:"
+	bucketStorageKeySuffix = ":storage"
+	bucketSegmentKeySuffix = ":segment"
+)
+
+// createBucketStorageKey creates the bucket storage key.
+func createBucketStorageKey(bucket metabase.BucketLocation) string {
+	return bucketKeyPrefix + string(bucket.CompactPrefix()) + bucketStorageKeySuffix
+}
+
+// createBucketSegmentKey creates the bucket segment key.
+func createBucketSegmentKey(bucket metabase.BucketLocation) string {
+	return bucketKeyPrefix + string(bucket.CompactPrefix()) + bucketSegmentKeySuffix
+}
+
+// createBucketBandwidthKey creates the bucket bandwidth key.
+// The current month and day are combined with the bucket location.
+func createBucketBandwidthKey(bucket metabase.BucketLocation, now time.Time) string {
+	_, month, day := now.Date()
+	return bucketKeyPrefix + string(bucket.CompactPrefix()) + ":" + string(byte(month)) + string(byte(day)) + ":bandwidth"
+}
+
+// apiKeyKeyPrefix is the prefix of api key keys.
+const apiKeyKeyPrefix = "apikey:"
+
+// createAPIKeyBandwidthKey creates the api key bandwidth key.
+// The current month and day are combined with the api key ID.
+func createAPIKeyBandwidthKey(keyID uuid.UUID, now time.Time) string {
+	_, month, day := now.Date()
+	return apiKeyKeyPrefix + string(keyID[:]) + ":" + string(byte(month)) + string(byte(day)) + ":bandwidth"
+}
@@ -31,6 +31,7 @@ type Service struct {
 	projectAccountingDB ProjectAccounting
 	liveAccounting      Cache
 	projectLimitCache   *ProjectLimitCache
+	bucketLimitCache    *BucketLimitCache
 	metabaseDB          metabase.DB
 	bandwidthCacheTTL   time.Duration
 	nowFn               func() time.Time
@@ -38,11 +39,12 @@ type Service struct {
 }
 
 // NewService created new instance of project usage service.
-func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, metabaseDB metabase.DB, bandwidthCacheTTL, asOfSystemInterval time.Duration) *Service {
+func NewService(projectAccountingDB ProjectAccounting, liveAccounting Cache, limitCache *ProjectLimitCache, bucketLimitCache *BucketLimitCache, metabaseDB metabase.DB, bandwidthCacheTTL, asOfSystemInterval time.Duration) *Service {
 	return &Service{
 		projectAccountingDB: projectAccountingDB,
 		liveAccounting:      liveAccounting,
 		projectLimitCache:   limitCache,
+		bucketLimitCache:    bucketLimitCache,
 		metabaseDB:          metabaseDB,
 		bandwidthCacheTTL:   bandwidthCacheTTL,
 		nowFn:               time.Now,
@@ -193,6 +193,14 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		}
 	}
 
+	initialLiveBucketTotals, err := service.liveAccounting.GetAllBucketTotals(ctx)
+	if err != nil {
+		service.log.Error(
+			"tally won't update the live accounting usages of the buckets in this cycle",
+			zap.Error(err),
+		)
+	}
+
 	// add up all buckets
 	collector := NewBucketTallyCollector(service.log.Named("observer"), service.nowFn(), service.metabase, service.bucketsDB, service.config)
 	err = collector.Run(ctx)
@@ -213,6 +221,10 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 		updateLiveAccountingTotals(projectTotalsFromBuckets(collector.Bucket))
 	}
 
+	if initialLiveBucketTotals != nil {
+		service.updateLiveBucketTotals(ctx, initialLiveBucketTotals, collector.Bucket)
+	}
+
 	if len(collector.Bucket) > 0 {
 		var total accounting.BucketTally
 		// TODO for now we don't have access to inline/remote stats per bucket
@@ -240,6 +252,54 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 	return errAtRest
 }
 
+// updateLiveBucketTotals updates the live accounting usages of the buckets
+// tracked because of their limits. The totals are calculated in the same way
+// as the project totals, see Tally.
+func (service *Service) updateLiveBucketTotals(ctx context.Context, initialLiveTotals map[metabase.BucketLocation]accounting.Usage, tallies map[metabase.BucketLocation]*accounting.BucketTally) {
+	latestLiveTotals, err := service.liveAccounting.GetAllBucketTotals(ctx)
+	if err != nil {
+		service.log.Error(
+			"tally isn't updating the live accounting usages of the buckets in this cycle",
+			zap.Error(err),
+		)
+		return
+	}
+
+	for bucket, latestTotal := range latestLiveTotals {
+		// empty buckets are not returned by the collector, their total is 0.
+		var tallyTotal accounting.Usage
+		if tally, ok := tallies[bucket]; ok {
+			tallyTotal.Storage = tally.TotalBytes
+			tallyTotal.Segments = tally.TotalSegments
+		}
+
+		delta := latestTotal.Storage - initialLiveTotals[bucket].Storage
+		if delta < 0 {
+			delta = 0
+		}
+
+		err := service.liveAccounting.AddBucketUsage(ctx, bucket,
+			-latestTotal.Storage+tallyTotal.Storage+(delta/2),
+			tallyTotal.Segments-latestTotal.Segments,
+		)
+		if err != nil {
+			if accounting.ErrSystemOrNetError.Has(err) {
+				service.log.Error(
+					"tally isn't updating the live accounting usages of the buckets in this cycle",
+					zap.Error(err),
+				)
+				return
+			}
+
+			service.log.Error(
+				"tally isn't updating the live accounting usage of the bucket in this cycle",
+				zap.Stringer("Project ID", bucket.ProjectID),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
 var objectFunc = mon.Task()
 
 // BucketTallyCollector collects and adds up tallies for buckets.
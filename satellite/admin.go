@@ -27,6 +27,7 @@ import (
 	"storx/satellite/console/restkeys"
 	"storx/satellite/metabase"
 	"storx/satellite/payments"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/stripecoinpayments"
 )
 
@@ -54,9 +55,10 @@ type Admin struct {
 	}
 
 	Payments struct {
-		Accounts payments.Accounts
-		Service  *stripecoinpayments.Service
-		Stripe   stripecoinpayments.StripeClient
+		Accounts    payments.Accounts
+		Service     *stripecoinpayments.Service
+		Stripe      stripecoinpayments.StripeClient
+		InvoiceOnly *invoiceonly.Service
 	}
 
 	Admin struct {
@@ -75,6 +77,10 @@ type Admin struct {
 	FreezeAccounts struct {
 		Service *console.AccountFreezeService
 	}
+
+	DeleteAccounts struct {
+		Service *console.AccountDeletionService
+	}
 }
 
 // NewAdmin creates a new satellite admin peer.
@@ -140,7 +146,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 
 		var stripeClient stripecoinpayments.StripeClient
 		switch pc.Provider {
-		case "": // just new mock, only used in testing binaries
+		case "", "invoice-only": // just new mock, used in testing binaries and by the stripe specific parts when invoices are paid outside of stripe
 			stripeClient = stripecoinpayments.NewStripeMock(
 				peer.DB.StripeCoinPayments().Customers(),
 				peer.DB.Console().Users(),
@@ -184,12 +190,35 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 
 		peer.Payments.Stripe = stripeClient
 		peer.Payments.Accounts = peer.Payments.Service.Accounts()
+
+		if pc.Provider == "invoice-only" {
+			peer.Payments.InvoiceOnly, err = invoiceonly.NewService(
+				peer.Log.Named("payments.invoiceonly:service"),
+				pc.InvoiceOnly,
+				peer.DB.InvoiceOnly(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				prices,
+				priceOverrides)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Payments.Accounts = peer.Payments.InvoiceOnly.Accounts()
+		}
+
 		peer.FreezeAccounts.Service = console.NewAccountFreezeService(
 			db.Console().AccountFreezeEvents(),
 			db.Console().Users(),
 			db.Console().Projects(),
 			analytics.NewService(peer.Log.Named("analytics:service"), config.Analytics, config.Console.SatelliteName),
 		)
+
+		peer.DeleteAccounts.Service = console.NewAccountDeletionService(
+			db.Console().AccountDeletions(),
+			peer.FreezeAccounts.Service,
+			config.Console.AccountDeletionGracePeriod,
+		)
 	}
 
 	{ // setup admin endpoint
@@ -202,7 +231,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		adminConfig := config.Admin
 		adminConfig.AuthorizationToken = config.Console.AuthToken
 
-		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.FreezeAccounts.Service, peer.Payments.Accounts, config.Console, adminConfig)
+		peer.Admin.Server = admin.NewServer(log.Named("admin"), peer.Admin.Listener, peer.DB, peer.Buckets.Service, peer.REST.Keys, peer.FreezeAccounts.Service, peer.DeleteAccounts.Service, peer.Payments.Accounts, peer.Payments.InvoiceOnly, config.Console, adminConfig)
 		peer.Servers.Add(lifecycle.Item{
 			Name:  "admin",
 			Run:   peer.Admin.Server.Run,
@@ -130,3 +130,72 @@ func (server *Server) getBucketInfo(w http.ResponseWriter, r *http.Request) {
 		sendJSONData(w, http.StatusOK, data)
 	}
 }
+
+func (server *Server) getBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	_, err = server.buckets.GetBucket(ctx, bucket, project.UUID)
+	if err != nil {
+		if storx.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "unable to check bucket", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	limits, err := server.buckets.GetBucketLimits(ctx, bucket, project.UUID)
+	if err != nil {
+		sendJSONError(w, "unable to get bucket limits", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(limits)
+	if err != nil {
+		sendJSONError(w, "failed to marshal bucket limits", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) putBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	project, bucket, err := validateBucketPathParameters(mux.Vars(r))
+	if err != nil {
+		sendJSONError(w, err.Error(), "", http.StatusBadRequest)
+		return
+	}
+
+	var limits buckets.Limits
+	if err := json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, limit := range []*int64{limits.Storage, limits.Bandwidth, limits.Segments} {
+		if limit != nil && *limit < 0 {
+			sendJSONError(w, "bucket limits can't be negative", "", http.StatusBadRequest)
+			return
+		}
+	}
+
+	err = server.buckets.UpdateBucketLimits(ctx, bucket, project.UUID, limits)
+	if err != nil {
+		if storx.ErrBucketNotFound.Has(err) {
+			sendJSONError(w, "bucket does not exist", "", http.StatusNotFound)
+		} else {
+			sendJSONError(w, "failed to update bucket limits", err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -113,3 +113,40 @@ func TestAdminBucketGeofenceAPI(t *testing.T) {
 		}
 	})
 }
+
+func TestAdminBucketLimitsAPI(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount:   1,
+		StorageNodeCount: 0,
+		UplinkCount:      1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(_ *zap.Logger, _ int, config *satellite.Config) {
+				config.Admin.Address = "127.0.0.1:0"
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		uplink := planet.Uplinks[0]
+		sat := planet.Satellites[0]
+		address := sat.Admin.Admin.Listener.Addr()
+		projectID := uplink.Projects[0].ID
+		authToken := sat.Config.Console.AuthToken
+
+		err := uplink.CreateBucket(ctx, sat, "limited")
+		require.NoError(t, err)
+
+		limitsURL := fmt.Sprintf("http://%s/api/projects/%s/buckets/%s/limits", address, projectID, "limited")
+
+		assertGet(ctx, t, limitsURL, `{"storage":null,"bandwidth":null,"segments":null}`, authToken)
+
+		assertReq(ctx, t, limitsURL, "PUT", `{"storage":1000,"segments":10}`, http.StatusOK, "", authToken)
+		assertGet(ctx, t, limitsURL, `{"storage":1000,"bandwidth":null,"segments":10}`, authToken)
+
+		assertReq(ctx, t, limitsURL, "PUT", `{"storage":-1}`, http.StatusBadRequest, `{"error":"bucket limits can't be negative","detail":""}`, authToken)
+
+		assertReq(ctx, t, limitsURL, "PUT", `{}`, http.StatusOK, "", authToken)
+		assertGet(ctx, t, limitsURL, `{"storage":null,"bandwidth":null,"segments":null}`, authToken)
+
+		missingURL := fmt.Sprintf("http://%s/api/projects/%s/buckets/%s/limits", address, projectID, "non-existent")
+		assertReq(ctx, t, missingURL, "PUT", `{"storage":1000}`, http.StatusNotFound, `{"error":"bucket does not exist","detail":""}`, authToken)
+	})
+}
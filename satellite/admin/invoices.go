@@ -0,0 +1,179 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"common/uuid"
+	"storx/satellite/payments/invoiceonly"
+)
+
+func (server *Server) listInvoices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if server.invoices == nil {
+		sendJSONError(w, "invoice-only payments provider is not enabled", "", http.StatusNotFound)
+		return
+	}
+
+	var invoices []invoiceonly.Invoice
+	var err error
+
+	query := r.URL.Query()
+	switch {
+	case query.Get("period") != "":
+		period, err := time.Parse("2006-01", query.Get("period"))
+		if err != nil {
+			sendJSONError(w, "invalid period, expected format is yyyy-mm", err.Error(), http.StatusBadRequest)
+			return
+		}
+		invoices, err = server.invoices.ListByPeriod(ctx, period)
+		if err != nil {
+			sendJSONError(w, "unable to list invoices", err.Error(), http.StatusInternalServerError)
+			return
+		}
+	case query.Get("status") != "":
+		invoices, err = server.invoices.ListByStatus(ctx, query.Get("status"))
+		if err != nil {
+			sendJSONError(w, "unable to list invoices", err.Error(), http.StatusInternalServerError)
+			return
+		}
+	default:
+		sendJSONError(w, "period or status query parameter is required", "", http.StatusBadRequest)
+		return
+	}
+
+	data, err := json.Marshal(invoices)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getInvoice(w http.ResponseWriter, r *http.Request) {
+	invoice, ok := server.invoiceFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(invoice)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) getInvoiceDocument(w http.ResponseWriter, r *http.Request) {
+	invoice, ok := server.invoiceFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	format := mux.Vars(r)["format"]
+
+	file, err := os.Open(server.invoices.DocumentPath(invoice, format))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			sendJSONError(w, "invoice document does not exist, the invoice needs to be created first", "", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "unable to open invoice document", err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	contentType := "application/json"
+	if format == "pdf" {
+		contentType = "application/pdf"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename=invoice-"+invoice.ID.String()+"."+format)
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.Copy(w, file) // any error here entitles a client side disconnect or similar, which we do not care about.
+}
+
+func (server *Server) markInvoicePaid(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	invoice, ok := server.invoiceFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		PaymentReference string `json:"paymentReference"`
+	}
+	if err := json.Unmarshal(body, &input); err != nil {
+		sendJSONError(w, "failed to unmarshal request", err.Error(), http.StatusBadRequest)
+		return
+	}
+	if input.PaymentReference == "" {
+		sendJSONError(w, "paymentReference is required", "", http.StatusBadRequest)
+		return
+	}
+
+	invoice, err = server.invoices.MarkPaid(ctx, invoice.ID, input.PaymentReference)
+	if err != nil {
+		if invoiceonly.ErrInvalidStatus.Has(err) {
+			sendJSONError(w, "unable to mark invoice as paid", err.Error(), http.StatusConflict)
+			return
+		}
+		sendJSONError(w, "unable to mark invoice as paid", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(invoice)
+	if err != nil {
+		sendJSONError(w, "json encoding failed", err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+// invoiceFromRequest returns the invoice identified by the request path. It
+// sends the error response and returns false when the invoice can't be found.
+func (server *Server) invoiceFromRequest(w http.ResponseWriter, r *http.Request) (invoiceonly.Invoice, bool) {
+	if server.invoices == nil {
+		sendJSONError(w, "invoice-only payments provider is not enabled", "", http.StatusNotFound)
+		return invoiceonly.Invoice{}, false
+	}
+
+	invoiceID, err := uuid.FromString(mux.Vars(r)["invoice"])
+	if err != nil {
+		sendJSONError(w, "invalid invoice id", err.Error(), http.StatusBadRequest)
+		return invoiceonly.Invoice{}, false
+	}
+
+	invoice, err := server.invoices.Get(r.Context(), invoiceID)
+	if err != nil {
+		if invoiceonly.ErrInvoiceNotFound.Has(err) {
+			sendJSONError(w, "invoice does not exist", "", http.StatusNotFound)
+			return invoiceonly.Invoice{}, false
+		}
+		sendJSONError(w, "unable to get invoice", err.Error(), http.StatusInternalServerError)
+		return invoiceonly.Invoice{}, false
+	}
+
+	return invoice, true
+}
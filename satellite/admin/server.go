@@ -27,6 +27,7 @@ import (
 	"storx/satellite/console/restkeys"
 	"storx/satellite/oidc"
 	"storx/satellite/payments"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/stripecoinpayments"
 )
 
@@ -66,9 +67,11 @@ type Server struct {
 
 	db             DB
 	payments       payments.Accounts
+	invoices       *invoiceonly.Service
 	buckets        *buckets.Service
 	restKeys       *restkeys.Service
 	freezeAccounts *console.AccountFreezeService
+	deleteAccounts *console.AccountDeletionService
 
 	nowFn func() time.Time
 
@@ -77,7 +80,7 @@ type Server struct {
 }
 
 // NewServer returns a new administration Server.
-func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, accounts payments.Accounts, console consoleweb.Config, config Config) *Server {
+func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.Service, restKeys *restkeys.Service, freezeAccounts *console.AccountFreezeService, deleteAccounts *console.AccountDeletionService, accounts payments.Accounts, invoices *invoiceonly.Service, console consoleweb.Config, config Config) *Server {
 	server := &Server{
 		log: log,
 
@@ -85,9 +88,11 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 
 		db:             db,
 		payments:       accounts,
+		invoices:       invoices,
 		buckets:        buckets,
 		restKeys:       restKeys,
 		freezeAccounts: freezeAccounts,
+		deleteAccounts: deleteAccounts,
 
 		nowFn: time.Now,
 
@@ -108,6 +113,9 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	fullAccessAPI.HandleFunc("/users/{useremail}", server.updateUser).Methods("PUT")
 	fullAccessAPI.HandleFunc("/users/{useremail}", server.deleteUser).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/users/{useremail}/mfa", server.disableUserMFA).Methods("DELETE")
+	fullAccessAPI.HandleFunc("/users/{useremail}/deletion", server.accountDeletionInfo).Methods("GET")
+	fullAccessAPI.HandleFunc("/users/{useremail}/deletion", server.scheduleAccountDeletion).Methods("PUT")
+	fullAccessAPI.HandleFunc("/users/{useremail}/deletion", server.cancelAccountDeletion).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/oauth/clients", server.createOAuthClient).Methods("POST")
 	fullAccessAPI.HandleFunc("/oauth/clients/{id}", server.updateOAuthClient).Methods("PUT")
 	fullAccessAPI.HandleFunc("/oauth/clients/{id}", server.deleteOAuthClient).Methods("DELETE")
@@ -125,6 +133,10 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	fullAccessAPI.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	fullAccessAPI.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	fullAccessAPI.HandleFunc("/invoices", server.listInvoices).Methods("GET")
+	fullAccessAPI.HandleFunc("/invoices/{invoice}", server.getInvoice).Methods("GET")
+	fullAccessAPI.HandleFunc("/invoices/{invoice}/{format:json|pdf}", server.getInvoiceDocument).Methods("GET")
+	fullAccessAPI.HandleFunc("/invoices/{invoice}/paid", server.markInvoicePaid).Methods("PUT")
 
 	// limit update access required
 	limitUpdateAPI := api.NewRoute().Subrouter()
@@ -136,6 +148,8 @@ func NewServer(log *zap.Logger, listener net.Listener, db DB, buckets *buckets.S
 	limitUpdateAPI.HandleFunc("/users/{useremail}/freeze", server.unfreezeUser).Methods("DELETE")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.getProjectLimit).Methods("GET")
 	limitUpdateAPI.HandleFunc("/projects/{project}/limit", server.putProjectLimit).Methods("PUT", "POST")
+	limitUpdateAPI.HandleFunc("/projects/{project}/buckets/{bucket}/limits", server.getBucketLimits).Methods("GET")
+	limitUpdateAPI.HandleFunc("/projects/{project}/buckets/{bucket}/limits", server.putBucketLimits).Methods("PUT")
 
 	// This handler must be the last one because it uses the root as prefix,
 	// otherwise will try to serve all the handlers set after this one.
@@ -512,6 +512,151 @@ func (server *Server) unfreezeUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (server *Server) accountDeletionInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	u, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	deletion, err := server.deleteAccounts.Get(ctx, u.ID)
+	if err != nil {
+		sendJSONError(w, "failed to get account deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	events, err := server.db.Console().AccountDeletions().GetEvents(ctx, u.ID)
+	if err != nil {
+		sendJSONError(w, "failed to get account deletion events",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var output struct {
+		Deletion *console.AccountDeletion       `json:"deletion"`
+		Events   []console.AccountDeletionEvent `json:"events"`
+	}
+	output.Deletion = deletion
+	output.Events = events
+
+	data, err := json.Marshal(output)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) scheduleAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	u, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	existing, err := server.deleteAccounts.Get(ctx, u.ID)
+	if err != nil {
+		sendJSONError(w, "failed to get account deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if existing != nil {
+		sendJSONError(w, "account deletion is already scheduled",
+			fmt.Sprintf("the account will be deleted after %s", existing.DeleteAfter), http.StatusConflict)
+		return
+	}
+
+	deletion, err := server.deleteAccounts.Schedule(ctx, u.ID, console.AccountDeletionByAdmin)
+	if err != nil {
+		sendJSONError(w, "failed to schedule account deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(deletion)
+	if err != nil {
+		sendJSONError(w, "json encoding failed",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sendJSONData(w, http.StatusOK, data)
+}
+
+func (server *Server) cancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	userEmail, ok := vars["useremail"]
+	if !ok {
+		sendJSONError(w, "user-email missing", "", http.StatusBadRequest)
+		return
+	}
+
+	u, err := server.db.Console().Users().GetByEmail(ctx, userEmail)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			sendJSONError(w, fmt.Sprintf("user with email %q does not exist", userEmail),
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to get user details",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	deletion, err := server.deleteAccounts.Get(ctx, u.ID)
+	if err != nil {
+		sendJSONError(w, "failed to get account deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if deletion == nil {
+		sendJSONError(w, "account deletion isn't scheduled",
+			"", http.StatusNotFound)
+		return
+	}
+
+	if err = server.deleteAccounts.Cancel(ctx, u.ID); err != nil {
+		sendJSONError(w, "failed to cancel account deletion",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
 func (server *Server) deleteUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
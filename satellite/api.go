@@ -25,6 +25,7 @@ import (
 	"private/debug"
 	"private/version"
 	"storx/private/lifecycle"
+	"storx/private/maintenancepb"
 	"storx/private/server"
 	"storx/private/version/checker"
 	"storx/satellite/abtesting"
@@ -49,6 +50,7 @@ import (
 	"storx/satellite/orders"
 	"storx/satellite/overlay"
 	"storx/satellite/payments"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/storxscan"
 	"storx/satellite/payments/stripecoinpayments"
 	"storx/satellite/reputation"
@@ -81,8 +83,9 @@ type API struct {
 	}
 
 	Contact struct {
-		Service  *contact.Service
-		Endpoint *contact.Endpoint
+		Service             *contact.Service
+		Endpoint            *contact.Endpoint
+		MaintenanceEndpoint *contact.MaintenanceEndpoint
 	}
 
 	Overlay struct {
@@ -127,6 +130,10 @@ type API struct {
 		Cache *accounting.ProjectLimitCache
 	}
 
+	BucketLimits struct {
+		Cache *accounting.BucketLimitCache
+	}
+
 	Mail struct {
 		Service *mailservice.Service
 	}
@@ -338,6 +345,10 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterNode(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		peer.Contact.MaintenanceEndpoint = contact.NewMaintenanceEndpoint(peer.Log.Named("contact:maintenance"), peer.Overlay.Service)
+		if err := maintenancepb.DRPCRegisterNodeMaintenance(peer.Server.DRPC(), peer.Contact.MaintenanceEndpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:service",
@@ -358,11 +369,16 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		)
 	}
 
+	{ // setup bucket limits
+		peer.BucketLimits.Cache = accounting.NewBucketLimitCache(peer.DB.Buckets(), config.ProjectLimit)
+	}
+
 	{ // setup accounting project usage
 		peer.Accounting.ProjectUsage = accounting.NewService(
 			peer.DB.ProjectAccounting(),
 			peer.LiveAccounting.Cache,
 			peer.ProjectLimits.Cache,
+			peer.BucketLimits.Cache,
 			*metabaseDB,
 			config.LiveAccounting.BandwidthCacheTTL,
 			config.LiveAccounting.AsOfSystemInterval,
@@ -521,7 +537,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		var stripeClient stripecoinpayments.StripeClient
 		switch pc.Provider {
-		case "": // just new mock, only used in testing binaries
+		case "", "invoice-only": // just new mock, used in testing binaries and by the stripe specific parts when invoices are paid outside of stripe
 			stripeClient = stripecoinpayments.NewStripeMock(
 				peer.DB.StripeCoinPayments().Customers(),
 				peer.DB.Console().Users(),
@@ -566,6 +582,22 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.Payments.StripeClient = stripeClient
 		peer.Payments.Accounts = peer.Payments.StripeService.Accounts()
 
+		if pc.Provider == "invoice-only" {
+			invoiceOnly, err := invoiceonly.NewService(
+				peer.Log.Named("payments.invoiceonly:service"),
+				pc.InvoiceOnly,
+				peer.DB.InvoiceOnly(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				prices,
+				priceOverrides)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Payments.Accounts = invoiceOnly.Accounts()
+		}
+
 		peer.Payments.StorxscanClient = storxscan.NewClient(
 			pc.Storxscan.Endpoint,
 			pc.Storxscan.Auth.Identifier,
@@ -603,6 +635,8 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			externalAddress = "http://" + peer.Console.Listener.Addr().String()
 		}
 
+		accountFreezeService := console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), peer.Analytics.Service)
+
 		peer.Console.Service, err = console.NewService(
 			peer.Log.Named("console:service"),
 			peer.DB.Console(),
@@ -614,8 +648,10 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.Payments.DepositWallets,
 			peer.DB.Billing(),
 			peer.Analytics.Service,
+			accountFreezeService,
 			peer.Console.AuthTokens,
 			peer.Mail.Service,
+			&config.Inventory.EncryptionKeys,
 			externalAddress,
 			consoleConfig.Config,
 		)
@@ -623,8 +659,6 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			return nil, errs.Combine(err, peer.Close())
 		}
 
-		accountFreezeService := console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), peer.Analytics.Service)
-
 		peer.Console.Endpoint = consoleweb.NewServer(
 			peer.Log.Named("console:endpoint"),
 			consoleConfig,
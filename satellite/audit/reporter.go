@@ -82,6 +82,20 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 
 	nodesReputation := req.NodesReputation
 
+	// nodes in planned maintenance are still audited, only their offline and
+	// unknown results are ignored, so the downtime doesn't count against their
+	// reputation. Successes, failures and pending reverifications are recorded
+	// as usual, so a node which is online but doesn't return a piece is still
+	// contained.
+	inMaintenance, err := reporter.inMaintenance(ctx, offlines, unknowns)
+	if err != nil {
+		reporter.log.Warn("failed to check nodes maintenance windows", zap.Error(err))
+	}
+	if len(inMaintenance) > 0 {
+		offlines = withoutNodes(offlines, inMaintenance)
+		unknowns = withoutNodes(unknowns, inMaintenance)
+	}
+
 	reportFailures := func(tries int, resultType string, err error, nodes storx.NodeIDList, pending []*ReverificationJob) {
 		if err == nil || tries < reporter.maxRetries {
 			// don't need to report anything until the last time through
@@ -94,7 +108,6 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 			zap.Any("pending segment audits", pending))
 	}
 
-	var err error
 	for tries := 0; tries <= reporter.maxRetries; tries++ {
 		if len(successes) == 0 && len(fails) == 0 && len(unknowns) == 0 && len(offlines) == 0 && len(pendingAudits) == 0 {
 			return
@@ -113,6 +126,39 @@ func (reporter *reporter) RecordAudits(ctx context.Context, req Report) {
 	}
 }
 
+// inMaintenance returns the set of nodes among audited ones which are currently in planned maintenance.
+func (reporter *reporter) inMaintenance(ctx context.Context, offlines, unknowns storx.NodeIDList) (_ map[storx.NodeID]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeIDs := make(storx.NodeIDList, 0, len(offlines)+len(unknowns))
+	nodeIDs = append(nodeIDs, offlines...)
+	nodeIDs = append(nodeIDs, unknowns...)
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	inMaintenance, err := reporter.overlay.InMaintenance(ctx, nodeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	set := make(map[storx.NodeID]bool, len(inMaintenance))
+	for _, nodeID := range inMaintenance {
+		set[nodeID] = true
+	}
+	return set, nil
+}
+
+// withoutNodes returns nodeIDs without the nodes in the excluded set.
+func withoutNodes(nodeIDs storx.NodeIDList, excluded map[storx.NodeID]bool) (filtered storx.NodeIDList) {
+	for _, nodeID := range nodeIDs {
+		if !excluded[nodeID] {
+			filtered = append(filtered, nodeID)
+		}
+	}
+	return filtered
+}
+
 func (reporter *reporter) recordAuditStatus(ctx context.Context, nodeIDs storx.NodeIDList, nodesReputation map[storx.NodeID]overlay.ReputationStatus, auditOutcome reputation.AuditType) (failed storx.NodeIDList, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -261,3 +261,46 @@ func TestReportOfflineAudits(t *testing.T) {
 		require.EqualValues(t, 0, info.UnknownAuditReputationBeta)
 	})
 }
+
+func TestReportAuditsInMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				// disable reputation write cache so changes are immediate
+				config.Reputation.FlushInterval = 0
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+		audits.Worker.Loop.Pause()
+
+		nodeID := planet.StorageNodes[0].ID()
+
+		now := time.Now()
+		_, err := satellite.Overlay.Service.ScheduleMaintenance(ctx, nodeID, now, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		pending := audit.ReverificationJob{
+			Locator: audit.PieceLocator{
+				NodeID: nodeID,
+			},
+		}
+
+		audits.Reporter.RecordAudits(ctx, audit.Report{
+			Offlines:      storx.NodeIDList{nodeID},
+			PendingAudits: []*audit.ReverificationJob{&pending},
+		})
+
+		// the offline audit is ignored during the maintenance.
+		info, err := satellite.Core.Reputation.Service.Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Zero(t, info.TotalAuditCount)
+
+		// a node which is online but doesn't return the piece is still contained.
+		pa, err := satellite.DB.Containment().Get(ctx, nodeID)
+		require.NoError(t, err)
+		require.Equal(t, pending.Locator, pa.Locator)
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"common/macaroon"
 	"common/storx"
 	"common/uuid"
@@ -19,6 +21,71 @@ type Bucket struct {
 	CreatedAt time.Time
 }
 
+// Limits contains optional usage limits of a single bucket.
+// A nil limit means that the bucket is limited only by its project limits.
+type Limits struct {
+	Storage   *int64 `json:"storage"`
+	Bandwidth *int64 `json:"bandwidth"`
+	Segments  *int64 `json:"segments"`
+}
+
+// IsZero returns true when none of the limits is set.
+func (limits Limits) IsZero() bool {
+	return limits.Storage == nil && limits.Bandwidth == nil && limits.Segments == nil
+}
+
+// ErrInventoryNotFound is returned when a bucket has no inventory configured.
+var ErrInventoryNotFound = errs.Class("bucket inventory not found")
+
+// InventoryFrequency is how often the inventory of a bucket is generated.
+type InventoryFrequency string
+
+const (
+	// InventoryDaily generates an inventory every day.
+	InventoryDaily = InventoryFrequency("daily")
+	// InventoryWeekly generates an inventory every week, starting on Monday.
+	InventoryWeekly = InventoryFrequency("weekly")
+)
+
+// Valid returns whether the frequency is known.
+func (frequency InventoryFrequency) Valid() bool {
+	return frequency == InventoryDaily || frequency == InventoryWeekly
+}
+
+// PeriodStart returns the start of the period the time is in. Periods start at
+// midnight UTC.
+func (frequency InventoryFrequency) PeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	start := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if frequency == InventoryWeekly {
+		sinceMonday := (int(start.Weekday()) + 6) % 7
+		start = start.AddDate(0, 0, -sinceMonday)
+	}
+	return start
+}
+
+// Inventory configures the scheduled inventory reports of a bucket. The reports
+// are uploaded with the access grant into the destination bucket, which is in
+// the same project as the bucket.
+type Inventory struct {
+	Frequency         InventoryFrequency `json:"frequency"`
+	DestinationBucket string             `json:"destinationBucket"`
+	DestinationPrefix string             `json:"destinationPrefix"`
+	// AccessGrant is only set when the configuration is received, it's never stored.
+	AccessGrant string `json:"accessGrant,omitempty"`
+	// EncryptedAccessGrant is the stored access grant, encrypted by the satellite.
+	EncryptedAccessGrant []byte `json:"-"`
+}
+
+// BucketInventory is the inventory configuration of a bucket.
+type BucketInventory struct {
+	metabase.BucketLocation
+	Inventory
+
+	// GeneratedFor is the start of the last period an inventory was uploaded for.
+	GeneratedFor *time.Time
+}
+
 // DB is the interface for the database to interact with buckets.
 //
 // architecture: Database
@@ -43,6 +110,20 @@ type DB interface {
 	ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storx.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storx.BucketList, err error)
 	// CountBuckets returns the number of buckets a project currently has
 	CountBuckets(ctx context.Context, projectID uuid.UUID) (int, error)
+	// GetBucketLimits returns usage limits of an existing bucket.
+	GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (limits Limits, err error)
+	// UpdateBucketLimits replaces usage limits of an existing bucket.
+	UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits Limits) (err error)
+	// GetBucketInventory returns the inventory configuration of an existing bucket.
+	GetBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (inventory BucketInventory, err error)
+	// UpdateBucketInventory replaces the inventory configuration of an existing bucket.
+	UpdateBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID, inventory Inventory) (err error)
+	// DeleteBucketInventory removes the inventory configuration of a bucket.
+	DeleteBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error)
+	// ListBucketInventories returns the inventory configurations of all buckets.
+	ListBucketInventories(ctx context.Context) (inventories []BucketInventory, err error)
+	// SetBucketInventoryGenerated records the start of the period an inventory was uploaded for.
+	SetBucketInventoryGenerated(ctx context.Context, bucket metabase.BucketLocation, periodStart time.Time) (err error)
 	// IterateBucketLocations iterates through all buckets from some point with limit.
 	IterateBucketLocations(ctx context.Context, projectID uuid.UUID, bucketName string, limit int, fn func([]metabase.BucketLocation) error) (more bool, err error)
 }
@@ -7,6 +7,7 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -16,6 +17,7 @@ import (
 	"common/testrand"
 	"common/uuid"
 	"storx/private/testplanet"
+	"storx/satellite/buckets"
 	"storx/satellite/console"
 	"storx/satellite/metabase"
 )
@@ -291,6 +293,83 @@ func TestBatchBuckets(t *testing.T) {
 	})
 }
 
+func TestBucketInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		db := planet.Satellites[0].DB
+
+		project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "testproject"})
+		require.NoError(t, err)
+
+		config := buckets.Inventory{
+			Frequency:            buckets.InventoryDaily,
+			DestinationBucket:    "inventories",
+			DestinationPrefix:    "reports/",
+			EncryptedAccessGrant: []byte("encrypted access"),
+		}
+
+		err = db.Buckets().UpdateBucketInventory(ctx, []byte("testbucket"), project.ID, config)
+		require.True(t, storx.ErrBucketNotFound.Has(err), err)
+
+		_, err = db.Buckets().CreateBucket(ctx, newTestBucket("testbucket", project.ID))
+		require.NoError(t, err)
+
+		_, err = db.Buckets().GetBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.True(t, buckets.ErrInventoryNotFound.Has(err), err)
+
+		require.NoError(t, db.Buckets().UpdateBucketInventory(ctx, []byte("testbucket"), project.ID, config))
+
+		location := metabase.BucketLocation{ProjectID: project.ID, BucketName: "testbucket"}
+		inventory, err := db.Buckets().GetBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, buckets.BucketInventory{BucketLocation: location, Inventory: config}, inventory)
+
+		periodStart := buckets.InventoryDaily.PeriodStart(time.Now())
+		require.NoError(t, db.Buckets().SetBucketInventoryGenerated(ctx, location, periodStart))
+
+		inventories, err := db.Buckets().ListBucketInventories(ctx)
+		require.NoError(t, err)
+		require.Len(t, inventories, 1)
+		require.Equal(t, config, inventories[0].Inventory)
+		require.NotNil(t, inventories[0].GeneratedFor)
+		require.WithinDuration(t, periodStart, *inventories[0].GeneratedFor, time.Second)
+
+		// changing the configuration generates the inventory again.
+		config.Frequency = buckets.InventoryWeekly
+		require.NoError(t, db.Buckets().UpdateBucketInventory(ctx, []byte("testbucket"), project.ID, config))
+
+		inventory, err = db.Buckets().GetBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.NoError(t, err)
+		require.Equal(t, config, inventory.Inventory)
+		require.Nil(t, inventory.GeneratedFor)
+
+		require.NoError(t, db.Buckets().DeleteBucketInventory(ctx, []byte("testbucket"), project.ID))
+		err = db.Buckets().DeleteBucketInventory(ctx, []byte("testbucket"), project.ID)
+		require.True(t, buckets.ErrInventoryNotFound.Has(err), err)
+
+		// deleting the bucket deletes its inventory configuration.
+		require.NoError(t, db.Buckets().UpdateBucketInventory(ctx, []byte("testbucket"), project.ID, config))
+		require.NoError(t, db.Buckets().DeleteBucket(ctx, []byte("testbucket"), project.ID))
+
+		inventories, err = db.Buckets().ListBucketInventories(ctx)
+		require.NoError(t, err)
+		require.Empty(t, inventories)
+	})
+}
+
+func TestInventoryFrequencyPeriodStart(t *testing.T) {
+	// 2023-03-16 is a Thursday.
+	now := time.Date(2023, 3, 16, 15, 4, 5, 0, time.UTC)
+
+	require.Equal(t, time.Date(2023, 3, 16, 0, 0, 0, 0, time.UTC), buckets.InventoryDaily.PeriodStart(now))
+	require.Equal(t, time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC), buckets.InventoryWeekly.PeriodStart(now))
+
+	monday := time.Date(2023, 3, 13, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, monday, buckets.InventoryWeekly.PeriodStart(monday))
+
+	sunday := time.Date(2023, 3, 19, 23, 59, 0, 0, time.UTC)
+	require.Equal(t, monday, buckets.InventoryWeekly.PeriodStart(sunday))
+}
+
 func sortBucketLocations(locations []metabase.BucketLocation) {
 	sort.Slice(locations, func(i, j int) bool {
 		if locations[i].ProjectID == locations[j].ProjectID {
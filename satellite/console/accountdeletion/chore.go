@@ -0,0 +1,388 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accountdeletion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/macaroon"
+	"common/storx"
+	"common/sync2"
+	"common/uuid"
+	"storx/private/post"
+	"storx/satellite/buckets"
+	"storx/satellite/console"
+	"storx/satellite/mailservice"
+	"storx/satellite/metabase"
+	"storx/satellite/payments"
+)
+
+var (
+	// Error is the standard error class for account deletion errors.
+	Error = errs.Class("account-deletion-chore")
+	// ErrBillingPending is returned when an account can't be wiped yet
+	// because its usage wasn't billed or an invoice is unpaid.
+	ErrBillingPending = errs.Class("billing pending")
+	mon               = monkit.Package()
+)
+
+// pageLimit is the number of buckets or api keys fetched at once when deleting them.
+const pageLimit = 100
+
+// Config contains configurations for scheduled account deletions.
+type Config struct {
+	Enabled  bool          `help:"whether to notify users about scheduled account deletions and wipe the accounts once their grace period is over" default:"true"`
+	Interval time.Duration `help:"how often to process the scheduled account deletions" default:"1h"`
+}
+
+// Chore emails the users whose account deletion was scheduled and wipes the
+// accounts whose grace period is over. Every step of the deletion is recorded
+// as an account deletion event.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	deletionsDB console.AccountDeletions
+	consoleDB   console.DB
+	buckets     buckets.DB
+	metabase    *metabase.DB
+	accounts    payments.Accounts
+	mailService *mailservice.Service
+	config      Config
+	address     string
+	nowFn       func() time.Time
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, consoleDB console.DB, buckets buckets.DB, metabase *metabase.DB, accounts payments.Accounts, mailService *mailservice.Service, config Config, address string) *Chore {
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+	return &Chore{
+		log:         log,
+		Loop:        sync2.NewCycle(config.Interval),
+		deletionsDB: consoleDB.AccountDeletions(),
+		consoleDB:   consoleDB,
+		buckets:     buckets,
+		metabase:    metabase,
+		accounts:    accounts,
+		mailService: mailService,
+		config:      config,
+		address:     address,
+		nowFn:       time.Now,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		deletions, err := chore.deletionsDB.GetAll(ctx)
+		if err != nil {
+			chore.log.Error("error getting scheduled account deletions", zap.Error(Error.Wrap(err)))
+			return nil
+		}
+
+		now := chore.nowFn()
+		for _, deletion := range deletions {
+			if deletion.NotifiedAt == nil {
+				err := chore.notify(ctx, deletion)
+				if err != nil {
+					chore.log.Error("error notifying user about scheduled account deletion",
+						zap.Stringer("User ID", deletion.UserID),
+						zap.Error(Error.Wrap(err)))
+					continue
+				}
+			}
+
+			if now.Before(deletion.DeleteAfter) {
+				continue
+			}
+
+			// a failed deletion is retried on the next run, the steps
+			// which were already completed are no-ops then.
+			err := chore.wipe(ctx, deletion.UserID)
+			if ErrBillingPending.Has(err) {
+				chore.log.Info("account wipe is waiting for billing",
+					zap.Stringer("User ID", deletion.UserID),
+					zap.Error(err))
+				continue
+			}
+			if err != nil {
+				chore.log.Error("error wiping account",
+					zap.Stringer("User ID", deletion.UserID),
+					zap.Error(Error.Wrap(err)))
+			}
+		}
+
+		return nil
+	})
+}
+
+// notify emails the user about the scheduled deletion of their account.
+func (chore *Chore) notify(ctx context.Context, deletion console.AccountDeletion) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := chore.consoleDB.Users().Get(ctx, deletion.UserID)
+	if err != nil {
+		return err
+	}
+
+	chore.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: user.Email, Name: user.FullName}},
+		&console.AccountDeletionScheduledEmail{
+			Name:         user.FullName,
+			DeleteAfter:  deletion.DeleteAfter.Format("January 2, 2006"),
+			SettingsLink: chore.address + "account-settings",
+		},
+	)
+
+	err = chore.deletionsDB.UpdateNotifiedAt(ctx, deletion.UserID, chore.nowFn())
+	if err != nil {
+		return err
+	}
+
+	return chore.deletionsDB.InsertEvent(ctx, deletion.UserID, console.AccountDeletionNotified, user.Email)
+}
+
+// wipe deletes all the data of the account and anonymizes the user.
+//
+// The payment methods are removed, but the customer of the payment provider is
+// kept, because the invoices reference it.
+//
+// The stored data and the api keys are deleted first, so that the projects
+// don't accrue more usage. The projects and the user are only deleted once
+// all the usage was billed and no invoice is unpaid, until then
+// ErrBillingPending is returned. The invoices are kept as billing records of
+// the anonymized user.
+func (chore *Chore) wipe(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects, err := chore.consoleDB.Projects().GetOwn(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		err = chore.deleteProjectData(ctx, userID, project)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = chore.checkBilled(ctx, userID, projects)
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		err = chore.deleteProject(ctx, userID, project)
+		if err != nil {
+			return err
+		}
+	}
+
+	memberships, err := chore.consoleDB.ProjectMembers().GetByMemberID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, membership := range memberships {
+		err = chore.consoleDB.ProjectMembers().Delete(ctx, userID, membership.ProjectID)
+		if err != nil {
+			return err
+		}
+	}
+	err = chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionMembershipsDeleted, fmt.Sprintf("%d memberships", len(memberships)))
+	if err != nil {
+		return err
+	}
+
+	_, err = chore.consoleDB.WebappSessions().DeleteAllByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = chore.consoleDB.AccountExports().DeleteByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	err = chore.accounts.CreditCards().RemoveAll(ctx, userID)
+	if err != nil {
+		return err
+	}
+	err = chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionPaymentMethodsRemoved, "")
+	if err != nil {
+		return err
+	}
+
+	deactivatedEmail := fmt.Sprintf("deactivated+%s@storx", userID.String())
+	err = chore.consoleDB.Users().Anonymize(ctx, userID, deactivatedEmail)
+	if err != nil {
+		return err
+	}
+	err = chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionUserAnonymized, "")
+	if err != nil {
+		return err
+	}
+
+	err = chore.deletionsDB.Delete(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionCompleted, "")
+}
+
+// checkBilled returns ErrBillingPending if the usage of the projects wasn't
+// billed yet or if the user has an unpaid invoice.
+func (chore *Chore) checkBilled(ctx context.Context, userID uuid.UUID, projects []console.Project) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, project := range projects {
+		err = chore.accounts.CheckProjectUsageStatus(ctx, project.ID)
+		if err != nil {
+			return ErrBillingPending.New("project %s: %v", project.ID, err)
+		}
+		err = chore.accounts.CheckProjectInvoicingStatus(ctx, project.ID)
+		if err != nil {
+			return ErrBillingPending.New("project %s: %v", project.ID, err)
+		}
+	}
+
+	invoices, err := chore.accounts.Invoices().List(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, invoice := range invoices {
+		if invoice.Status == payments.InvoiceStatusDraft || invoice.Status == payments.InvoiceStatusOpen {
+			return ErrBillingPending.New("invoice %s is %s", invoice.ID, invoice.Status)
+		}
+	}
+
+	return nil
+}
+
+// deleteProjectData deletes the buckets, the objects and the api keys of the project.
+// The events are only recorded when something was deleted, because it's
+// called again on every run until the usage of the project is billed.
+func (chore *Chore) deleteProjectData(ctx context.Context, userID uuid.UUID, project console.Project) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketCount := 0
+	for {
+		// the deleted buckets aren't listed anymore, so the listing always starts from the beginning.
+		list, err := chore.buckets.ListBuckets(ctx, project.ID, storx.BucketListOptions{
+			Limit:     pageLimit,
+			Direction: storx.Forward,
+		}, macaroon.AllowedBuckets{All: true})
+		if err != nil {
+			return err
+		}
+		if len(list.Items) == 0 {
+			break
+		}
+
+		for _, bucket := range list.Items {
+			_, err = chore.metabase.DeleteBucketObjects(ctx, metabase.DeleteBucketObjects{
+				Bucket: metabase.BucketLocation{
+					ProjectID:  project.ID,
+					BucketName: bucket.Name,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			// the usage limits of the bucket are deleted with it.
+			err = chore.buckets.DeleteBucket(ctx, []byte(bucket.Name), project.ID)
+			if err != nil {
+				return err
+			}
+			bucketCount++
+		}
+	}
+	if bucketCount > 0 {
+		err = chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionBucketsDeleted, fmt.Sprintf("project %s: %d buckets", project.ID, bucketCount))
+		if err != nil {
+			return err
+		}
+	}
+
+	keyCount := 0
+	for {
+		page, err := chore.consoleDB.APIKeys().GetPagedByProjectID(ctx, project.ID, console.APIKeyCursor{
+			Limit:          pageLimit,
+			Page:           1,
+			Order:          console.CreationDate,
+			OrderDirection: console.Ascending,
+		})
+		if err != nil {
+			return err
+		}
+		if len(page.APIKeys) == 0 {
+			break
+		}
+
+		for _, key := range page.APIKeys {
+			err = chore.consoleDB.APIKeys().Delete(ctx, key.ID)
+			if err != nil {
+				return err
+			}
+			keyCount++
+		}
+	}
+	if keyCount > 0 {
+		err = chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionKeysRevoked, fmt.Sprintf("project %s: %d api keys", project.ID, keyCount))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteProject deletes the budget and the budget alerts of the project and then the project itself.
+func (chore *Chore) deleteProject(ctx context.Context, userID uuid.UUID, project console.Project) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.consoleDB.ProjectBudgets().Delete(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+	err = chore.consoleDB.ProjectBudgets().DeleteAlerts(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	err = chore.consoleDB.Projects().Delete(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	return chore.deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionProjectDeleted, project.ID.String())
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestSetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accountdeletion_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+	"common/pb"
+	"common/storx"
+	"common/testcontext"
+	"common/testrand"
+	"storx/private/testplanet"
+	"storx/satellite/buckets"
+	"storx/satellite/console"
+)
+
+func TestAccountDeletionChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.Console.AccountDeletion
+		chore.Loop.Pause()
+
+		service := sat.API.Console.Service
+		deletionsDB := sat.DB.Console().AccountDeletions()
+		freezes := console.NewAccountFreezeService(sat.DB.Console().AccountFreezeEvents(), sat.DB.Console().Users(), sat.DB.Console().Projects(), sat.API.Analytics.Service)
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName:       "Test User",
+			ShortName:      "Test",
+			Email:          "user@mail.test",
+			UserAgent:      []byte("agent"),
+			IsProfessional: true,
+			Position:       "position",
+			CompanyName:    "company",
+			WorkingOn:      "working on",
+			EmployeeCount:  "1-50",
+		}, 1)
+		require.NoError(t, err)
+
+		mfaEnabled := true
+		mfaSecretKey := "secret"
+		mfaSecretKeyPtr := &mfaSecretKey
+		err = sat.DB.Console().Users().Update(ctx, user.ID, console.UpdateUserRequest{
+			MFAEnabled:       &mfaEnabled,
+			MFASecretKey:     &mfaSecretKeyPtr,
+			MFARecoveryCodes: &[]string{"code"},
+		})
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "project")
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		_, _, err = service.CreateAPIKey(userCtx, project.ID, "key")
+		require.NoError(t, err)
+
+		bucketName := testrand.BucketName()
+		_, err = sat.DB.Buckets().CreateBucket(ctx, storx.Bucket{
+			ID:        testrand.UUID(),
+			Name:      bucketName,
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		storageLimit := int64(memory.GiB)
+		err = sat.DB.Buckets().UpdateBucketLimits(ctx, []byte(bucketName), project.ID, buckets.Limits{Storage: &storageLimit})
+		require.NoError(t, err)
+
+		budgets := sat.DB.Console().ProjectBudgets()
+		require.NoError(t, budgets.Upsert(ctx, project.ID, 1000, false))
+		require.NoError(t, budgets.InsertAlert(ctx, console.ProjectBudgetAlert{
+			ProjectID: project.ID,
+			Period:    time.Now().Truncate(time.Hour),
+			Threshold: 50,
+			Spent:     500,
+			Budget:    1000,
+		}))
+
+		_, err = sat.DB.Console().AccountExports().Create(ctx, user.ID)
+		require.NoError(t, err)
+
+		_, err = service.ScheduleAccountDeletion(userCtx, "wrong password")
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		deletion, err := service.ScheduleAccountDeletion(userCtx, user.FullName)
+		require.NoError(t, err)
+		require.Equal(t, console.AccountDeletionByUser, deletion.RequestedBy)
+
+		_, err = service.ScheduleAccountDeletion(userCtx, user.FullName)
+		require.True(t, console.ErrValidation.Has(err))
+
+		frozen, err := freezes.IsUserFrozen(ctx, user.ID)
+		require.NoError(t, err)
+		require.True(t, frozen)
+
+		// the user is notified while the account is kept during the grace period.
+		chore.Loop.TriggerWait()
+
+		deletion, err = service.GetAccountDeletion(userCtx)
+		require.NoError(t, err)
+		require.NotNil(t, deletion)
+		require.NotNil(t, deletion.NotifiedAt)
+
+		_, err = sat.DB.Console().Projects().Get(ctx, project.ID)
+		require.NoError(t, err)
+
+		chore.TestSetNow(func() time.Time {
+			return deletion.DeleteAfter.Add(time.Minute)
+		})
+		chore.Loop.TriggerWait()
+
+		_, err = deletionsDB.Get(ctx, user.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		_, err = sat.DB.Console().Projects().Get(ctx, project.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		limits, err := sat.DB.Buckets().GetBucketLimits(ctx, []byte(bucketName), project.ID)
+		require.NoError(t, err)
+		require.True(t, limits.IsZero())
+
+		_, err = budgets.Get(ctx, project.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		alerts, err := budgets.GetAlerts(ctx, project.ID)
+		require.NoError(t, err)
+		require.Empty(t, alerts)
+
+		exports, err := sat.DB.Console().AccountExports().ListByUserID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Empty(t, exports)
+
+		deleted, err := sat.DB.Console().Users().Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.Deleted, deleted.Status)
+		require.NotEqual(t, user.Email, deleted.Email)
+		require.Empty(t, deleted.FullName)
+		require.Empty(t, deleted.ShortName)
+		require.Empty(t, deleted.PasswordHash)
+		require.Empty(t, deleted.UserAgent)
+		require.False(t, deleted.IsProfessional)
+		require.Empty(t, deleted.Position)
+		require.Empty(t, deleted.CompanyName)
+		require.Empty(t, deleted.WorkingOn)
+		require.Empty(t, deleted.EmployeeCount)
+		require.False(t, deleted.MFAEnabled)
+		require.Empty(t, deleted.MFASecretKey)
+		require.Empty(t, deleted.MFARecoveryCodes)
+		require.Empty(t, deleted.SignupPromoCode)
+
+		events, err := deletionsDB.GetEvents(ctx, user.ID)
+		require.NoError(t, err)
+
+		var steps []console.AccountDeletionStep
+		for _, event := range events {
+			steps = append(steps, event.Step)
+		}
+		require.Equal(t, []console.AccountDeletionStep{
+			console.AccountDeletionScheduled,
+			console.AccountDeletionFrozen,
+			console.AccountDeletionNotified,
+			console.AccountDeletionBucketsDeleted,
+			console.AccountDeletionKeysRevoked,
+			console.AccountDeletionProjectDeleted,
+			console.AccountDeletionMembershipsDeleted,
+			console.AccountDeletionPaymentMethodsRemoved,
+			console.AccountDeletionUserAnonymized,
+			console.AccountDeletionCompleted,
+		}, steps)
+	})
+}
+
+func TestAccountDeletionBillingPending(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.Console.AccountDeletion
+		chore.Loop.Pause()
+
+		service := sat.API.Console.Service
+		deletionsDB := sat.DB.Console().AccountDeletions()
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "project")
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		bucketName := testrand.BucketName()
+		_, err = sat.DB.Buckets().CreateBucket(ctx, storx.Bucket{
+			ID:        testrand.UUID(),
+			Name:      bucketName,
+			ProjectID: project.ID,
+		})
+		require.NoError(t, err)
+
+		// the usage of the current month isn't billed yet.
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte(bucketName),
+			pb.PieceAction_GET, memory.GiB.Int64(), 0, time.Now())
+		require.NoError(t, err)
+
+		deletion, err := service.ScheduleAccountDeletion(userCtx, user.FullName)
+		require.NoError(t, err)
+
+		chore.TestSetNow(func() time.Time {
+			return deletion.DeleteAfter.Add(time.Minute)
+		})
+		chore.Loop.TriggerWait()
+
+		// the data is deleted, but the project and the user are kept until the usage is billed.
+		_, err = sat.DB.Buckets().GetBucket(ctx, []byte(bucketName), project.ID)
+		require.True(t, storx.ErrBucketNotFound.Has(err))
+
+		_, err = sat.DB.Console().Projects().Get(ctx, project.ID)
+		require.NoError(t, err)
+
+		_, err = deletionsDB.Get(ctx, user.ID)
+		require.NoError(t, err)
+
+		kept, err := sat.DB.Console().Users().Get(ctx, user.ID)
+		require.NoError(t, err)
+		require.Equal(t, user.Email, kept.Email)
+
+		// the events of the deleted data aren't repeated while waiting.
+		chore.Loop.TriggerWait()
+
+		events, err := deletionsDB.GetEvents(ctx, user.ID)
+		require.NoError(t, err)
+
+		var steps []console.AccountDeletionStep
+		for _, event := range events {
+			steps = append(steps, event.Step)
+		}
+		require.Equal(t, []console.AccountDeletionStep{
+			console.AccountDeletionScheduled,
+			console.AccountDeletionFrozen,
+			console.AccountDeletionNotified,
+			console.AccountDeletionBucketsDeleted,
+		}, steps)
+	})
+}
+
+func TestAccountDeletionCancel(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		freezes := console.NewAccountFreezeService(sat.DB.Console().AccountFreezeEvents(), sat.DB.Console().Users(), sat.DB.Console().Projects(), sat.API.Analytics.Service)
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		err = service.CancelAccountDeletion(userCtx)
+		require.True(t, console.ErrValidation.Has(err))
+
+		_, err = service.ScheduleAccountDeletion(userCtx, user.FullName)
+		require.NoError(t, err)
+
+		require.NoError(t, service.CancelAccountDeletion(userCtx))
+
+		deletion, err := service.GetAccountDeletion(userCtx)
+		require.NoError(t, err)
+		require.Nil(t, deletion)
+
+		frozen, err := freezes.IsUserFrozen(ctx, user.ID)
+		require.NoError(t, err)
+		require.False(t, frozen)
+
+		// an account frozen before the deletion was scheduled stays frozen.
+		require.NoError(t, freezes.FreezeUser(ctx, user.ID))
+
+		_, err = service.ScheduleAccountDeletion(userCtx, user.FullName)
+		require.NoError(t, err)
+		require.NoError(t, service.CancelAccountDeletion(userCtx))
+
+		frozen, err = freezes.IsUserFrozen(ctx, user.ID)
+		require.NoError(t, err)
+		require.True(t, frozen)
+	})
+}
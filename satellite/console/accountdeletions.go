@@ -0,0 +1,228 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/uuid"
+)
+
+// ErrAccountDeletion is the class for errors that occur during operation of the account deletion service.
+var ErrAccountDeletion = errs.Class("account deletion service")
+
+// accountAlreadyFrozenDetails are the details of the frozen step when the account was frozen before the deletion was scheduled.
+const accountAlreadyFrozenDetails = "account was already frozen"
+
+// AccountDeletions exposes methods to manage the scheduled account deletions in the database.
+//
+// architecture: Database
+type AccountDeletions interface {
+	// Create schedules the deletion of the user account.
+	Create(ctx context.Context, userID uuid.UUID, requestedBy AccountDeletionRequester, deleteAfter time.Time) (*AccountDeletion, error)
+	// Get returns the scheduled deletion of the user account.
+	Get(ctx context.Context, userID uuid.UUID) (*AccountDeletion, error)
+	// GetAll returns all the scheduled account deletions, the earliest due first.
+	GetAll(ctx context.Context) ([]AccountDeletion, error)
+	// UpdateNotifiedAt records when the user was notified about the scheduled deletion.
+	UpdateNotifiedAt(ctx context.Context, userID uuid.UUID, notifiedAt time.Time) error
+	// Delete removes the scheduled deletion of the user account.
+	Delete(ctx context.Context, userID uuid.UUID) error
+
+	// InsertEvent records a step of the deletion of the user account.
+	InsertEvent(ctx context.Context, userID uuid.UUID, step AccountDeletionStep, details string) error
+	// GetEvents returns the recorded steps of the deletion of the user account, oldest first.
+	GetEvents(ctx context.Context, userID uuid.UUID) ([]AccountDeletionEvent, error)
+}
+
+// AccountDeletionRequester indicates who requested the deletion of an account.
+type AccountDeletionRequester string
+
+const (
+	// AccountDeletionByUser indicates that the user requested the deletion of their account.
+	AccountDeletionByUser AccountDeletionRequester = "user"
+	// AccountDeletionByAdmin indicates that an admin scheduled the deletion of the account.
+	AccountDeletionByAdmin AccountDeletionRequester = "admin"
+)
+
+// AccountDeletionStep is a step of the account deletion workflow.
+type AccountDeletionStep string
+
+const (
+	// AccountDeletionScheduled indicates that the deletion was scheduled.
+	AccountDeletionScheduled AccountDeletionStep = "scheduled"
+	// AccountDeletionFrozen indicates that the account was frozen.
+	AccountDeletionFrozen AccountDeletionStep = "frozen"
+	// AccountDeletionNotified indicates that the user was emailed about the scheduled deletion.
+	AccountDeletionNotified AccountDeletionStep = "notified"
+	// AccountDeletionCanceled indicates that the deletion was canceled during the grace period.
+	AccountDeletionCanceled AccountDeletionStep = "canceled"
+	// AccountDeletionBucketsDeleted indicates that the buckets and objects of a project were deleted.
+	AccountDeletionBucketsDeleted AccountDeletionStep = "buckets_deleted"
+	// AccountDeletionKeysRevoked indicates that the api keys of a project were revoked.
+	AccountDeletionKeysRevoked AccountDeletionStep = "keys_revoked"
+	// AccountDeletionProjectDeleted indicates that a project owned by the user was deleted.
+	AccountDeletionProjectDeleted AccountDeletionStep = "project_deleted"
+	// AccountDeletionMembershipsDeleted indicates that the memberships in other projects were removed.
+	AccountDeletionMembershipsDeleted AccountDeletionStep = "memberships_deleted"
+	// AccountDeletionPaymentMethodsRemoved indicates that the payment methods of the user were removed.
+	// The customer of the payment provider is kept for the billing records.
+	AccountDeletionPaymentMethodsRemoved AccountDeletionStep = "payment_methods_removed"
+	// AccountDeletionUserAnonymized indicates that the personal data and the credentials of the
+	// user row were erased.
+	AccountDeletionUserAnonymized AccountDeletionStep = "user_anonymized"
+	// AccountDeletionCompleted indicates that all the account data was wiped.
+	AccountDeletionCompleted AccountDeletionStep = "completed"
+)
+
+// AccountDeletion is a scheduled deletion of a user account.
+type AccountDeletion struct {
+	UserID      uuid.UUID                `json:"-"`
+	RequestedBy AccountDeletionRequester `json:"requestedBy"`
+	// DeleteAfter is the end of the grace period, when the account data is wiped.
+	DeleteAfter time.Time  `json:"deleteAfter"`
+	NotifiedAt  *time.Time `json:"notifiedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+// AccountDeletionEvent is a recorded step of an account deletion.
+type AccountDeletionEvent struct {
+	UserID    uuid.UUID           `json:"-"`
+	Step      AccountDeletionStep `json:"step"`
+	Details   string              `json:"details"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+// AccountDeletionService schedules and cancels account deletions.
+// The accounts are wiped by the account deletion chore once the grace period is over.
+type AccountDeletionService struct {
+	deletionsDB AccountDeletions
+	freezes     *AccountFreezeService
+	gracePeriod time.Duration
+	nowFn       func() time.Time
+}
+
+// NewAccountDeletionService creates a new account deletion service.
+func NewAccountDeletionService(deletionsDB AccountDeletions, freezes *AccountFreezeService, gracePeriod time.Duration) *AccountDeletionService {
+	return &AccountDeletionService{
+		deletionsDB: deletionsDB,
+		freezes:     freezes,
+		gracePeriod: gracePeriod,
+		nowFn:       time.Now,
+	}
+}
+
+// Schedule schedules the deletion of the user account after the grace period
+// and freezes the account in the meantime.
+func (s *AccountDeletionService) Schedule(ctx context.Context, userID uuid.UUID, requestedBy AccountDeletionRequester) (_ *AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.deletionsDB.Get(ctx, userID)
+	if err == nil {
+		return nil, ErrAccountDeletion.New("account deletion is already scheduled")
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAccountDeletion.Wrap(err)
+	}
+
+	deletion, err := s.deletionsDB.Create(ctx, userID, requestedBy, s.nowFn().Add(s.gracePeriod))
+	if err != nil {
+		return nil, ErrAccountDeletion.Wrap(err)
+	}
+
+	err = s.deletionsDB.InsertEvent(ctx, userID, AccountDeletionScheduled, "requested by "+string(requestedBy))
+	if err != nil {
+		return nil, ErrAccountDeletion.Wrap(err)
+	}
+
+	// an account which is already frozen, e.g. because of unpaid invoices,
+	// must stay frozen when the deletion is canceled.
+	frozen, err := s.freezes.IsUserFrozen(ctx, userID)
+	if err != nil {
+		return nil, ErrAccountDeletion.Wrap(err)
+	}
+
+	details := ""
+	if frozen {
+		details = accountAlreadyFrozenDetails
+	} else {
+		err = s.freezes.FreezeUser(ctx, userID)
+		if err != nil {
+			return nil, ErrAccountDeletion.Wrap(err)
+		}
+	}
+
+	err = s.deletionsDB.InsertEvent(ctx, userID, AccountDeletionFrozen, details)
+	if err != nil {
+		return nil, ErrAccountDeletion.Wrap(err)
+	}
+
+	return deletion, nil
+}
+
+// Cancel cancels the scheduled deletion of the user account and unfreezes the account.
+func (s *AccountDeletionService) Cancel(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.deletionsDB.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrAccountDeletion.New("account deletion isn't scheduled")
+		}
+		return ErrAccountDeletion.Wrap(err)
+	}
+
+	err = s.deletionsDB.Delete(ctx, userID)
+	if err != nil {
+		return ErrAccountDeletion.Wrap(err)
+	}
+
+	err = s.deletionsDB.InsertEvent(ctx, userID, AccountDeletionCanceled, "")
+	if err != nil {
+		return ErrAccountDeletion.Wrap(err)
+	}
+
+	events, err := s.deletionsDB.GetEvents(ctx, userID)
+	if err != nil {
+		return ErrAccountDeletion.Wrap(err)
+	}
+
+	// the freeze is only lifted if it was placed by the deletion.
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Step != AccountDeletionFrozen {
+			continue
+		}
+		if events[i].Details == accountAlreadyFrozenDetails {
+			return nil
+		}
+		break
+	}
+
+	return ErrAccountDeletion.Wrap(s.freezes.UnfreezeUser(ctx, userID))
+}
+
+// Get returns the scheduled deletion of the user account. It returns nil if no deletion is scheduled.
+func (s *AccountDeletionService) Get(ctx context.Context, userID uuid.UUID) (_ *AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletion, err := s.deletionsDB.Get(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, ErrAccountDeletion.Wrap(err)
+	}
+
+	return deletion, nil
+}
+
+// TestSetNow allows tests to have the service act as if the current time is whatever they want.
+func (s *AccountDeletionService) TestSetNow(nowFn func() time.Time) {
+	s.nowFn = nowFn
+}
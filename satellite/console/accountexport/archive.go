@@ -0,0 +1,124 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accountexport
+
+import (
+	"time"
+
+	"common/uuid"
+	"storx/satellite/console"
+	"storx/satellite/console/consoleauth"
+	"storx/satellite/payments"
+)
+
+// exportedUser is the user record included in the archive. Credentials such
+// as the password hash and the MFA secrets are left out.
+type exportedUser struct {
+	ID              uuid.UUID          `json:"id"`
+	FullName        string             `json:"fullName"`
+	ShortName       string             `json:"shortName"`
+	Email           string             `json:"email"`
+	Status          console.UserStatus `json:"status"`
+	UserAgent       string             `json:"userAgent"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	PaidTier        bool               `json:"paidTier"`
+	IsProfessional  bool               `json:"isProfessional"`
+	Position        string             `json:"position"`
+	CompanyName     string             `json:"companyName"`
+	CompanySize     int                `json:"companySize"`
+	WorkingOn       string             `json:"workingOn"`
+	EmployeeCount   string             `json:"employeeCount"`
+	MFAEnabled      bool               `json:"mfaEnabled"`
+	SignupPromoCode string             `json:"signupPromoCode"`
+	ProjectLimit    int                `json:"projectLimit"`
+}
+
+func newExportedUser(user *console.User) exportedUser {
+	return exportedUser{
+		ID:              user.ID,
+		FullName:        user.FullName,
+		ShortName:       user.ShortName,
+		Email:           user.Email,
+		Status:          user.Status,
+		UserAgent:       string(user.UserAgent),
+		CreatedAt:       user.CreatedAt,
+		PaidTier:        user.PaidTier,
+		IsProfessional:  user.IsProfessional,
+		Position:        user.Position,
+		CompanyName:     user.CompanyName,
+		CompanySize:     user.CompanySize,
+		WorkingOn:       user.WorkingOn,
+		EmployeeCount:   user.EmployeeCount,
+		MFAEnabled:      user.MFAEnabled,
+		SignupPromoCode: user.SignupPromoCode,
+		ProjectLimit:    user.ProjectLimit,
+	}
+}
+
+// exportedMembership is a project membership included in the archive.
+type exportedMembership struct {
+	ProjectID uuid.UUID `json:"projectId"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func newExportedMemberships(memberships []console.ProjectMember) []exportedMembership {
+	exported := make([]exportedMembership, 0, len(memberships))
+	for _, membership := range memberships {
+		exported = append(exported, exportedMembership{
+			ProjectID: membership.ProjectID,
+			CreatedAt: membership.CreatedAt,
+		})
+	}
+	return exported
+}
+
+// exportedCouponUsage is the discount of a coupon on an invoice included in the archive.
+type exportedCouponUsage struct {
+	Coupon      payments.Coupon `json:"coupon"`
+	Amount      int64           `json:"amount"`
+	PeriodStart time.Time       `json:"periodStart"`
+	PeriodEnd   time.Time       `json:"periodEnd"`
+}
+
+// exportedCoupons are the active coupon and the past coupon usages included in the archive.
+type exportedCoupons struct {
+	Active *payments.Coupon      `json:"active"`
+	Usages []exportedCouponUsage `json:"usages"`
+}
+
+func newExportedCoupons(active *payments.Coupon, usages []payments.CouponUsage) exportedCoupons {
+	exported := exportedCoupons{
+		Active: active,
+		Usages: make([]exportedCouponUsage, 0, len(usages)),
+	}
+	for _, usage := range usages {
+		exported.Usages = append(exported.Usages, exportedCouponUsage{
+			Coupon:      usage.Coupon,
+			Amount:      usage.Amount,
+			PeriodStart: usage.PeriodStart,
+			PeriodEnd:   usage.PeriodEnd,
+		})
+	}
+	return exported
+}
+
+// exportedSession is a webapp session included in the archive. The session
+// ID is left out as it identifies the session token.
+type exportedSession struct {
+	Address   string    `json:"address"`
+	UserAgent string    `json:"userAgent"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func newExportedSessions(sessions []consoleauth.WebappSession) []exportedSession {
+	exported := make([]exportedSession, 0, len(sessions))
+	for _, session := range sessions {
+		exported = append(exported, exportedSession{
+			Address:   session.Address,
+			UserAgent: session.UserAgent,
+			ExpiresAt: session.ExpiresAt,
+		})
+	}
+	return exported
+}
@@ -0,0 +1,304 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accountexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/sync2"
+	"common/uuid"
+	"storx/private/post"
+	"storx/satellite/console"
+	"storx/satellite/mailservice"
+	"storx/satellite/payments"
+)
+
+var (
+	// Error is the standard error class for account export errors.
+	Error = errs.Class("account-export-chore")
+	mon   = monkit.Package()
+)
+
+// apiKeysPageLimit is the number of api keys fetched at once when exporting them.
+const apiKeysPageLimit = 100
+
+// Config contains configurations for account data exports.
+type Config struct {
+	Enabled   bool          `help:"whether to generate the account data exports requested by users" default:"true"`
+	Interval  time.Duration `help:"how often to generate the pending account data exports" default:"1m"`
+	Retention time.Duration `help:"how long the generated account data exports are available for download" default:"168h"`
+}
+
+// Chore generates the archives of the account data exports requested by
+// users, emails the users once their archive is ready and deletes the
+// archives older than the retention period.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	exportsDB   console.AccountExports
+	consoleDB   console.DB
+	accounts    payments.Accounts
+	mailService *mailservice.Service
+	config      Config
+	address     string
+	nowFn       func() time.Time
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, consoleDB console.DB, accounts payments.Accounts, mailService *mailservice.Service, config Config, address string) *Chore {
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+	return &Chore{
+		log:         log,
+		Loop:        sync2.NewCycle(config.Interval),
+		exportsDB:   consoleDB.AccountExports(),
+		consoleDB:   consoleDB,
+		accounts:    accounts,
+		mailService: mailService,
+		config:      config,
+		address:     address,
+		nowFn:       time.Now,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		deleted, err := chore.exportsDB.DeleteCompletedBefore(ctx, chore.nowFn().Add(-chore.config.Retention))
+		if err != nil {
+			chore.log.Error("error deleting expired account data exports", zap.Error(Error.Wrap(err)))
+		} else if deleted > 0 {
+			chore.log.Info("deleted expired account data exports", zap.Int64("count", deleted))
+		}
+
+		exports, err := chore.exportsDB.ListPending(ctx)
+		if err != nil {
+			chore.log.Error("error getting pending account data exports", zap.Error(Error.Wrap(err)))
+			return nil
+		}
+
+		for _, export := range exports {
+			err := chore.processExport(ctx, export)
+			if err != nil {
+				chore.log.Error("error generating account data export",
+					zap.Stringer("Export ID", export.ID),
+					zap.Stringer("User ID", export.UserID),
+					zap.Error(Error.Wrap(err)))
+
+				if err := chore.exportsDB.Fail(ctx, export.ID); err != nil {
+					chore.log.Error("error marking account data export as failed",
+						zap.Stringer("Export ID", export.ID),
+						zap.Error(Error.Wrap(err)))
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// processExport generates the archive of the export and notifies the user.
+func (chore *Chore) processExport(ctx context.Context, export console.AccountExport) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := chore.consoleDB.Users().Get(ctx, export.UserID)
+	if err != nil {
+		return err
+	}
+
+	archive, err := chore.generateArchive(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	err = chore.exportsDB.Complete(ctx, export.ID, archive)
+	if err != nil {
+		return err
+	}
+
+	chore.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: user.Email, Name: user.FullName}},
+		&console.AccountExportReadyEmail{
+			Name:         user.FullName,
+			ExpiresAt:    chore.nowFn().Add(chore.config.Retention).Format("January 2, 2006"),
+			DownloadLink: chore.address + "api/v0/auth/account/exports/" + export.ID.String(),
+		},
+	)
+
+	return nil
+}
+
+// generateArchive collects all the account data of the user into a zip archive
+// with a JSON file per kind of data.
+func (chore *Chore) generateArchive(ctx context.Context, user *console.User) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	projects, err := chore.consoleDB.Projects().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := chore.consoleDB.ProjectMembers().GetByMemberID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys, err := chore.listAPIKeys(ctx, projects)
+	if err != nil {
+		return nil, err
+	}
+
+	invoices, couponUsages, err := chore.accounts.Invoices().ListWithDiscounts(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	billingHistory, err := chore.listBillingHistory(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	coupon, err := chore.accounts.Coupons().GetByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := chore.consoleDB.WebappSessions().GetAllByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"user.json", newExportedUser(user)},
+		{"projects.json", projects},
+		{"memberships.json", newExportedMemberships(memberships)},
+		{"api_keys.json", apiKeys},
+		{"billing_history.json", billingHistory},
+		{"invoices.json", invoices},
+		{"coupons.json", newExportedCoupons(coupon, couponUsages)},
+		{"sessions.json", newExportedSessions(sessions)},
+	}
+	for _, file := range files {
+		w, err := zw.Create(file.name)
+		if err != nil {
+			return nil, err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// listAPIKeys returns the metadata of the api keys of the projects. The secrets are never included.
+func (chore *Chore) listAPIKeys(ctx context.Context, projects []console.Project) (_ []console.APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	apiKeys := []console.APIKeyInfo{}
+	for _, project := range projects {
+		cursor := console.APIKeyCursor{
+			Limit:          apiKeysPageLimit,
+			Page:           1,
+			Order:          console.CreationDate,
+			OrderDirection: console.Ascending,
+		}
+		for {
+			page, err := chore.consoleDB.APIKeys().GetPagedByProjectID(ctx, project.ID, cursor)
+			if err != nil {
+				return nil, err
+			}
+			apiKeys = append(apiKeys, page.APIKeys...)
+
+			if page.CurrentPage >= page.PageCount {
+				break
+			}
+			cursor.Page++
+		}
+	}
+
+	return apiKeys, nil
+}
+
+// listBillingHistory returns the card payments and token deposits of the user.
+func (chore *Chore) listBillingHistory(ctx context.Context, userID uuid.UUID) (_ []console.BillingHistoryItem, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	billingHistory := []console.BillingHistoryItem{}
+
+	charges, err := chore.accounts.Charges(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, charge := range charges {
+		billingHistory = append(billingHistory, console.BillingHistoryItem{
+			ID:          charge.ID,
+			Description: fmt.Sprintf("Payment(%s %s)", charge.CardInfo.Brand, charge.CardInfo.LastFour),
+			Amount:      charge.Amount,
+			Start:       charge.CreatedAt,
+			Type:        console.Charge,
+		})
+	}
+
+	txsInfos, err := chore.accounts.StorxTokens().ListTransactionInfos(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range txsInfos {
+		billingHistory = append(billingHistory, console.BillingHistoryItem{
+			ID:          info.ID.String(),
+			Description: "STORX Token Deposit",
+			Amount:      info.AmountCents,
+			Received:    info.ReceivedCents,
+			Status:      info.Status.String(),
+			Link:        info.Link,
+			Start:       info.CreatedAt,
+			End:         info.ExpiresAt,
+			Type:        console.Transaction,
+		})
+	}
+
+	return billingHistory, nil
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestSetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
@@ -0,0 +1,122 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package accountexport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite/console"
+)
+
+func TestAccountExportChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.Console.AccountExport
+		chore.Loop.Pause()
+
+		exportsDB := sat.DB.Console().AccountExports()
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "project")
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		apiKeyInfo, _, err := sat.API.Console.Service.CreateAPIKey(userCtx, project.ID, "key")
+		require.NoError(t, err)
+
+		export, err := sat.API.Console.Service.RequestAccountDataExport(userCtx)
+		require.NoError(t, err)
+		require.Equal(t, console.AccountExportPending, export.Status)
+
+		// only one export may be pending at a time.
+		_, err = sat.API.Console.Service.RequestAccountDataExport(userCtx)
+		require.True(t, console.ErrValidation.Has(err))
+
+		_, err = sat.API.Console.Service.GetAccountDataExport(userCtx, export.ID)
+		require.True(t, console.ErrValidation.Has(err))
+
+		chore.Loop.TriggerWait()
+
+		ready, err := sat.API.Console.Service.GetAccountDataExport(userCtx, export.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.AccountExportReady, ready.Status)
+		require.NotNil(t, ready.CompletedAt)
+
+		files := make(map[string][]byte)
+		zr, err := zip.NewReader(bytes.NewReader(ready.Archive), int64(len(ready.Archive)))
+		require.NoError(t, err)
+		for _, file := range zr.File {
+			rc, err := file.Open()
+			require.NoError(t, err)
+			files[file.Name], err = io.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+		}
+
+		for _, name := range []string{
+			"user.json", "projects.json", "memberships.json", "api_keys.json",
+			"billing_history.json", "invoices.json", "coupons.json", "sessions.json",
+		} {
+			require.Contains(t, files, name)
+		}
+
+		var exportedUser map[string]interface{}
+		require.NoError(t, json.Unmarshal(files["user.json"], &exportedUser))
+		require.Equal(t, user.Email, exportedUser["email"])
+		require.NotContains(t, exportedUser, "passwordHash")
+		require.NotContains(t, exportedUser, "mfaSecretKey")
+
+		var projects []console.Project
+		require.NoError(t, json.Unmarshal(files["projects.json"], &projects))
+		require.Len(t, projects, 1)
+		require.Equal(t, project.ID, projects[0].ID)
+
+		var apiKeys []map[string]interface{}
+		require.NoError(t, json.Unmarshal(files["api_keys.json"], &apiKeys))
+		require.Len(t, apiKeys, 1)
+		require.Equal(t, apiKeyInfo.ID.String(), apiKeys[0]["id"])
+		require.NotContains(t, apiKeys[0], "secret")
+
+		// exports of other users can't be downloaded.
+		other, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Other User",
+			Email:    "other@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		otherCtx, err := sat.UserContext(ctx, other.ID)
+		require.NoError(t, err)
+
+		_, err = sat.API.Console.Service.GetAccountDataExport(otherCtx, export.ID)
+		require.True(t, console.ErrNoAccountExport.Has(err))
+
+		// exports are removed after the retention period.
+		chore.TestSetNow(func() time.Time {
+			return time.Now().Add(8 * 24 * time.Hour)
+		})
+		chore.Loop.TriggerWait()
+
+		exports, err := exportsDB.ListByUserID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Empty(t, exports)
+	})
+}
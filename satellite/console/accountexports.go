@@ -0,0 +1,76 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"common/uuid"
+)
+
+// AccountExports exposes methods to manage the account data exports in the database.
+//
+// architecture: Database
+type AccountExports interface {
+	// Create inserts a new pending account data export of the user.
+	Create(ctx context.Context, userID uuid.UUID) (*AccountExport, error)
+	// Get returns the account data export with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (*AccountExport, error)
+	// ListByUserID returns the account data exports of the user, newest first.
+	// The archives aren't included.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]AccountExport, error)
+	// ListPending returns the account data exports which haven't been processed yet, oldest first.
+	ListPending(ctx context.Context) ([]AccountExport, error)
+	// Complete stores the archive of the account data export and marks it as ready.
+	Complete(ctx context.Context, id uuid.UUID, archive []byte) error
+	// Fail marks the account data export as failed.
+	Fail(ctx context.Context, id uuid.UUID) error
+	// DeleteCompletedBefore removes the account data exports completed before the given time.
+	DeleteCompletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeleteByUserID removes all the account data exports of the user.
+	DeleteByUserID(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+// AccountExportStatus is the status of an account data export.
+type AccountExportStatus int
+
+const (
+	// AccountExportPending indicates that the archive hasn't been generated yet.
+	AccountExportPending AccountExportStatus = 0
+	// AccountExportReady indicates that the archive is available for download.
+	AccountExportReady AccountExportStatus = 1
+	// AccountExportFailed indicates that the archive couldn't be generated.
+	AccountExportFailed AccountExportStatus = 2
+)
+
+// String returns a string representation of the account data export status.
+func (status AccountExportStatus) String() string {
+	switch status {
+	case AccountExportPending:
+		return "pending"
+	case AccountExportReady:
+		return "ready"
+	case AccountExportFailed:
+		return "failed"
+	default:
+		return ""
+	}
+}
+
+// MarshalJSON encodes the account data export status as a string.
+func (status AccountExportStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + status.String() + `"`), nil
+}
+
+// AccountExport is a request of a user for an archive of all of their account data.
+type AccountExport struct {
+	ID     uuid.UUID           `json:"id"`
+	UserID uuid.UUID           `json:"-"`
+	Status AccountExportStatus `json:"status"`
+	// Archive is the zip archive of the account data. It's nil until the export is ready.
+	Archive     []byte     `json:"-"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+}
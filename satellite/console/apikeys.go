@@ -41,6 +41,8 @@ type RESTKeys interface {
 type CreateAPIKeyRequest struct {
 	ProjectID string `json:"projectID"`
 	Name      string `json:"name"`
+
+	APIKeyLimits
 }
 
 // CreateAPIKeyResponse holds macaroon.APIKey and APIKeyInfo.
@@ -58,6 +60,40 @@ type APIKeyInfo struct {
 	Head      []byte    `json:"-"`
 	Secret    []byte    `json:"-"`
 	CreatedAt time.Time `json:"createdAt"`
+
+	APIKeyLimits
+
+	// ExpiringSoon is set when listing keys and indicates that the key
+	// expires within the configured warning period or has already expired.
+	// It's the only warning about expiring keys, no email is sent.
+	ExpiringSoon bool `json:"expiringSoon"`
+}
+
+// APIKeyLimits holds the server side expiration and quotas of an api key.
+// Nil values mean that the key is not limited.
+type APIKeyLimits struct {
+	ExpiresAt         *time.Time `json:"expiresAt"`
+	DailyEgressLimit  *int64     `json:"dailyEgressLimit"`
+	RequestsPerMinute *int       `json:"requestsPerMinute"`
+}
+
+// IsExpired returns whether the api key is expired at the given time.
+func (limits APIKeyLimits) IsExpired(now time.Time) bool {
+	return limits.ExpiresAt != nil && !now.Before(*limits.ExpiresAt)
+}
+
+// Validate checks that the limits are sensible.
+func (limits APIKeyLimits) Validate(now time.Time) error {
+	if limits.ExpiresAt != nil && !limits.ExpiresAt.After(now) {
+		return ErrAPIKeyRequest.New("expiration must be in the future")
+	}
+	if limits.DailyEgressLimit != nil && *limits.DailyEgressLimit <= 0 {
+		return ErrAPIKeyRequest.New("daily egress limit must be positive")
+	}
+	if limits.RequestsPerMinute != nil && *limits.RequestsPerMinute <= 0 {
+		return ErrAPIKeyRequest.New("requests per minute must be positive")
+	}
+	return nil
 }
 
 // APIKeyCursor holds info for api keys cursor pagination.
@@ -6,8 +6,10 @@ package console_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"common/macaroon"
 	"common/testcontext"
@@ -121,6 +123,50 @@ func TestApiKeysRepository(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("Update limits success", func(t *testing.T) {
+			key, err := macaroon.NewAPIKey([]byte("testSecret"))
+			require.NoError(t, err)
+
+			expiresAt := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+			dailyEgressLimit := int64(1000)
+			requestsPerMinute := 60
+
+			created, err := apikeys.Create(ctx, key.Head(), console.APIKeyInfo{
+				Name:      "key with limits",
+				ProjectID: project.ID,
+				Secret:    []byte("testSecret"),
+				APIKeyLimits: console.APIKeyLimits{
+					ExpiresAt:         &expiresAt,
+					DailyEgressLimit:  &dailyEgressLimit,
+					RequestsPerMinute: &requestsPerMinute,
+				},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, created.ExpiresAt)
+			require.WithinDuration(t, expiresAt, *created.ExpiresAt, time.Microsecond)
+			require.Equal(t, &dailyEgressLimit, created.DailyEgressLimit)
+			require.Equal(t, &requestsPerMinute, created.RequestsPerMinute)
+
+			page, err := apikeys.GetPagedByProjectID(ctx, project.ID, console.APIKeyCursor{
+				Page:   1,
+				Limit:  10,
+				Search: "key with limits",
+			})
+			require.NoError(t, err)
+			require.Len(t, page.APIKeys, 1)
+			require.Equal(t, created.APIKeyLimits.DailyEgressLimit, page.APIKeys[0].DailyEgressLimit)
+			require.Equal(t, created.APIKeyLimits.RequestsPerMinute, page.APIKeys[0].RequestsPerMinute)
+
+			created.APIKeyLimits = console.APIKeyLimits{}
+			require.NoError(t, apikeys.Update(ctx, *created))
+
+			updated, err := apikeys.Get(ctx, created.ID)
+			require.NoError(t, err)
+			require.Equal(t, console.APIKeyLimits{}, updated.APIKeyLimits)
+
+			require.NoError(t, apikeys.Delete(ctx, created.ID))
+		})
+
 		t.Run("Delete success", func(t *testing.T) {
 			cursor := console.APIKeyCursor{
 				Page:   1,
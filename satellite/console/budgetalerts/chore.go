@@ -0,0 +1,212 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package budgetalerts
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/sync2"
+	"storx/private/post"
+	"storx/satellite/console"
+	"storx/satellite/mailservice"
+	"storx/satellite/payments"
+)
+
+var (
+	// Error is the standard error class for budget alerts errors.
+	Error = errs.Class("budget-alerts-chore")
+	mon   = monkit.Package()
+)
+
+// Config contains configurations for project budget alerts.
+type Config struct {
+	Enabled  bool          `help:"whether to email project owners when their project spending reaches the budget thresholds" default:"true"`
+	Interval time.Duration `help:"how often to check project spending against the budgets" default:"1h"`
+}
+
+// Chore compares the spending of projects with their budgets. It emails the
+// project owner when a budget threshold is reached and applies the hard cap
+// once the whole budget is spent.
+//
+// architecture: Chore
+type Chore struct {
+	log  *zap.Logger
+	Loop *sync2.Cycle
+
+	budgetsDB      console.ProjectBudgets
+	projectsDB     console.Projects
+	usersDB        console.Users
+	freezeEventsDB console.AccountFreezeEvents
+	budgets        *console.ProjectBudgetService
+	mailService    *mailservice.Service
+	address        string
+	nowFn          func() time.Time
+}
+
+// NewChore instantiates Chore.
+func NewChore(log *zap.Logger, consoleDB console.DB, accounts payments.Accounts, mailService *mailservice.Service, config Config, address string) *Chore {
+	if !strings.HasSuffix(address, "/") {
+		address += "/"
+	}
+	return &Chore{
+		log:            log,
+		Loop:           sync2.NewCycle(config.Interval),
+		budgetsDB:      consoleDB.ProjectBudgets(),
+		projectsDB:     consoleDB.Projects(),
+		usersDB:        consoleDB.Users(),
+		freezeEventsDB: consoleDB.AccountFreezeEvents(),
+		budgets:        console.NewProjectBudgetService(consoleDB.ProjectBudgets(), consoleDB.Projects(), accounts),
+		mailService:    mailService,
+		address:        address,
+		nowFn:          time.Now,
+	}
+}
+
+// Run starts the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		budgets, err := chore.budgetsDB.GetAll(ctx)
+		if err != nil {
+			chore.log.Error("error getting project budgets", zap.Error(Error.Wrap(err)))
+			return nil
+		}
+
+		for i := range budgets {
+			err := chore.checkBudget(ctx, &budgets[i])
+			if err != nil {
+				chore.log.Error("error checking project budget",
+					zap.Stringer("Project ID", budgets[i].ProjectID),
+					zap.Error(Error.Wrap(err)))
+			}
+		}
+
+		return nil
+	})
+}
+
+// checkBudget compares the spending of the project with its budget.
+func (chore *Chore) checkBudget(ctx context.Context, budget *console.ProjectBudget) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+	period := console.BudgetPeriod(now)
+
+	project, err := chore.projectsDB.Get(ctx, budget.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	// the hard cap only lasts until the end of the billing period it was applied in.
+	// Frozen accounts keep their zeroed limits until they are unfrozen.
+	if budget.IsCapped() && budget.CappedAt.Before(period) {
+		_, err := chore.freezeEventsDB.Get(ctx, project.OwnerID, console.Freeze)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if err := chore.budgets.Uncap(ctx, budget); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		}
+	}
+
+	spent, err := chore.budgets.GetSpending(ctx, project)
+	if err != nil {
+		return err
+	}
+
+	alerts, err := chore.budgetsDB.GetAlerts(ctx, budget.ProjectID)
+	if err != nil {
+		return err
+	}
+	sent := make(map[int]bool)
+	for _, alert := range alerts {
+		if alert.Period.Equal(period) {
+			sent[alert.Threshold] = true
+		}
+	}
+
+	reached := 0
+	for _, threshold := range console.BudgetAlertThresholds {
+		if spent*100 < budget.Amount*int64(threshold) {
+			break
+		}
+		if sent[threshold] {
+			continue
+		}
+
+		err := chore.budgetsDB.InsertAlert(ctx, console.ProjectBudgetAlert{
+			ProjectID: budget.ProjectID,
+			Period:    period,
+			Threshold: threshold,
+			Spent:     spent,
+			Budget:    budget.Amount,
+		})
+		if err != nil {
+			return err
+		}
+		reached = threshold
+	}
+
+	if budget.HardCap && spent >= budget.Amount {
+		if err := chore.budgets.Cap(ctx, budget); err != nil {
+			return err
+		}
+	}
+
+	if reached == 0 {
+		return nil
+	}
+
+	// only the highest of the newly reached thresholds is emailed.
+	owner, err := chore.usersDB.Get(ctx, project.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	chore.mailService.SendRenderedAsync(
+		ctx,
+		[]post.Address{{Address: owner.Email, Name: owner.FullName}},
+		&console.ProjectBudgetAlertEmail{
+			Name:                 owner.FullName,
+			ProjectName:          project.Name,
+			Threshold:            reached,
+			Spent:                formatCents(spent),
+			Budget:               formatCents(budget.Amount),
+			Capped:               budget.IsCapped(),
+			ProjectDashboardLink: chore.address + "project-dashboard",
+		},
+	)
+
+	return nil
+}
+
+// formatCents formats the amount of cents as dollars.
+func formatCents(cents int64) string {
+	return fmt.Sprintf("$%d.%02d", cents/100, cents%100)
+}
+
+// Close closes chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestSetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) TestSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+	chore.budgets.TestSetNow(nowFn)
+}
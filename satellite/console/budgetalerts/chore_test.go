@@ -0,0 +1,106 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package budgetalerts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+	"common/pb"
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite/console"
+)
+
+func TestBudgetAlertsChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		chore := sat.Core.Payments.BudgetAlerts
+		chore.Loop.Pause()
+
+		budgetsDB := sat.DB.Console().ProjectBudgets()
+		projectsDB := sat.DB.Console().Projects()
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Test User",
+			Email:    "user@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "project")
+		require.NoError(t, err)
+
+		storageLimit, segmentLimit := (25 * memory.GB).Int64(), int64(1000)
+		limits := console.NullableUsageLimits{
+			Storage: &storageLimit,
+			Segment: &segmentLimit,
+		}
+		require.NoError(t, projectsDB.UpdateNullableUsageLimits(ctx, project.ID, limits))
+
+		now := time.Date(2030, time.May, 15, 12, 0, 0, 0, time.UTC)
+		chore.TestSetNow(func() time.Time { return now })
+
+		// 10GB of egress is charged 45 cents with the test prices.
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("bucket"), pb.PieceAction_GET, (10 * memory.GB).Int64(), 0, now.Add(-2*time.Hour))
+		require.NoError(t, err)
+
+		require.NoError(t, budgetsDB.Upsert(ctx, project.ID, 80, true))
+
+		chore.Loop.TriggerWait()
+
+		alerts, err := budgetsDB.GetAlerts(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		require.Equal(t, 50, alerts[0].Threshold)
+		require.Equal(t, int64(45), alerts[0].Spent)
+		require.Equal(t, time.Date(2030, time.May, 1, 0, 0, 0, 0, time.UTC), alerts[0].Period.UTC())
+
+		// the same threshold isn't alerted twice in a billing period.
+		chore.Loop.TriggerWait()
+
+		alerts, err = budgetsDB.GetAlerts(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+
+		// lowering the budget reaches the remaining thresholds and applies the hard cap.
+		require.NoError(t, budgetsDB.Upsert(ctx, project.ID, 40, true))
+
+		chore.Loop.TriggerWait()
+
+		alerts, err = budgetsDB.GetAlerts(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, alerts, 3)
+
+		budget, err := budgetsDB.Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.True(t, budget.IsCapped())
+		require.Equal(t, limits, *budget.CappedLimits)
+
+		capped, err := projectsDB.Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Zero(t, capped.StorageLimit.Int64())
+		require.Zero(t, capped.BandwidthLimit.Int64())
+		require.Zero(t, *capped.SegmentLimit)
+
+		// the hard cap is lifted in the next billing period.
+		now = time.Date(2030, time.June, 1, 1, 0, 0, 0, time.UTC)
+
+		chore.Loop.TriggerWait()
+
+		budget, err = budgetsDB.Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.False(t, budget.IsCapped())
+
+		restored, err := projectsDB.Get(ctx, project.ID)
+		require.NoError(t, err)
+		require.Equal(t, storageLimit, restored.StorageLimit.Int64())
+		require.Nil(t, restored.BandwidthLimit)
+		require.Equal(t, segmentLimit, *restored.SegmentLimit)
+	})
+}
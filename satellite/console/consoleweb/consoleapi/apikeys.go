@@ -4,6 +4,7 @@
 package consoleapi
 
 import (
+	"encoding/json"
 	"net/http"
 
 	"github.com/zeebo/errs"
@@ -84,6 +85,46 @@ func (keys *APIKeys) DeleteByNameAndProjectID(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// UpdateLimits sets the server side expiration and quotas of an api key.
+// Limits which are omitted from the request body are removed.
+func (keys *APIKeys) UpdateLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	keyID, err := uuid.FromString(r.URL.Query().Get("id"))
+	if err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, ErrAPIKeysAPI.New("invalid api key ID: %v", err))
+		return
+	}
+
+	var limits console.APIKeyLimits
+	if err = json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		keys.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	info, err := keys.service.UpdateAPIKeyLimits(ctx, keyID, limits)
+	if err != nil {
+		switch {
+		case console.ErrUnauthorized.Has(err):
+			keys.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrNoAPIKey.Has(err):
+			keys.serveJSONError(w, http.StatusNotFound, err)
+		case console.ErrAPIKeyRequest.Has(err):
+			keys.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			keys.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(info)
+	if err != nil {
+		keys.log.Error("failed to write json api key response", zap.Error(ErrAPIKeysAPI.Wrap(err)))
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (keys *APIKeys) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(keys.log, w, status, err)
@@ -6,9 +6,11 @@ package consoleapi
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"html/template"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -491,6 +493,138 @@ func (a *Auth) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 	a.serveJSONError(w, errNotImplemented)
 }
 
+// ScheduleAccountDeletion authorizes the user by password and schedules the deletion of their account.
+func (a *Auth) ScheduleAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	var request struct {
+		Password string `json:"password"`
+	}
+
+	err = json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		a.serveJSONError(w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	deletion, err := a.service.ScheduleAccountDeletion(ctx, request.Password)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(deletion)
+	if err != nil {
+		a.log.Error("could not encode account deletion", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// GetAccountDeletion returns the scheduled deletion of the account of the user, or null if there is none.
+func (a *Auth) GetAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	deletion, err := a.service.GetAccountDeletion(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(deletion)
+	if err != nil {
+		a.log.Error("could not encode account deletion", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// CancelAccountDeletion cancels the scheduled deletion of the account of the user.
+func (a *Auth) CancelAccountDeletion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	err = a.service.CancelAccountDeletion(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+	}
+}
+
+// RequestAccountExport requests an archive of all of the account data of the user.
+func (a *Auth) RequestAccountExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	export, err := a.service.RequestAccountDataExport(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	err = json.NewEncoder(w).Encode(export)
+	if err != nil {
+		a.log.Error("could not encode account data export", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// GetAccountExports returns the account data exports of the user.
+func (a *Auth) GetAccountExports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	exports, err := a.service.GetAccountDataExports(ctx)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(exports)
+	if err != nil {
+		a.log.Error("could not encode account data exports", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
+// DownloadAccountExport serves the archive of a ready account data export of the user.
+func (a *Auth) DownloadAccountExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		a.serveJSONError(w, console.ErrValidation.New("missing id route param"))
+		return
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		a.serveJSONError(w, console.ErrValidation.Wrap(err))
+		return
+	}
+
+	export, err := a.service.GetAccountDataExport(ctx, id)
+	if err != nil {
+		a.serveJSONError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=account-export-%s.zip", export.CompletedAt.Format("2006-01-02")))
+	w.Header().Set("Content-Length", strconv.Itoa(len(export.Archive)))
+	_, err = w.Write(export.Archive)
+	if err != nil {
+		a.log.Error("could not write account data export", zap.Error(ErrAuthAPI.Wrap(err)))
+	}
+}
+
 // ChangeEmail auth user, changes users email for a new one.
 func (a *Auth) ChangeEmail(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -927,6 +1061,10 @@ func (a *Auth) getStatusCode(err error) int {
 		return http.StatusUnauthorized
 	case console.ErrEmailUsed.Has(err), console.ErrMFAConflict.Has(err):
 		return http.StatusConflict
+	case console.ErrSSORequired.Has(err):
+		return http.StatusForbidden
+	case console.ErrNoAccountExport.Has(err):
+		return http.StatusNotFound
 	case errors.Is(err, errNotImplemented):
 		return http.StatusNotImplemented
 	default:
@@ -958,6 +1096,10 @@ func (a *Auth) getUserErrorMessage(err error) string {
 		return "The MFA recovery code is not valid or has been previously used"
 	case console.ErrLoginCredentials.Has(err):
 		return "Your login credentials are incorrect, please try again"
+	case console.ErrNoAccountExport.Has(err):
+		return "The account data export doesn't exist"
+	case console.ErrSSORequired.Has(err):
+		return "Your organization requires you to log in through its identity provider"
 	case console.ErrValidation.Has(err), console.ErrChangePassword.Has(err):
 		return err.Error()
 	case errors.Is(err, errNotImplemented):
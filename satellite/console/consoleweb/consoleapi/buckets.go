@@ -10,8 +10,10 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
+	"common/storx"
 	"common/uuid"
 	"storx/private/web"
+	"storx/satellite/buckets"
 	"storx/satellite/console"
 )
 
@@ -80,6 +82,163 @@ func (b *Buckets) AllBucketNames(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetBucketLimits returns the usage limits of a bucket.
+func (b *Buckets) GetBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	limits, err := b.service.GetBucketLimits(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveJSONError(w, bucketErrorStatus(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(limits)
+	if err != nil {
+		b.log.Error("failed to write json bucket limits response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateBucketLimits sets the usage limits of a bucket.
+// Limits which are omitted from the request body are removed.
+func (b *Buckets) UpdateBucketLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var limits buckets.Limits
+	if err = json.NewDecoder(r.Body).Decode(&limits); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketLimits(ctx, projectID, bucketName, limits)
+	if err != nil {
+		b.serveJSONError(w, bucketErrorStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetBucketInventory returns the inventory configuration of a bucket.
+func (b *Buckets) GetBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	inventory, err := b.service.GetBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveJSONError(w, bucketErrorStatus(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(inventory)
+	if err != nil {
+		b.log.Error("failed to write json bucket inventory response", zap.Error(ErrBucketsAPI.Wrap(err)))
+	}
+}
+
+// UpdateBucketInventory configures the inventory of a bucket.
+func (b *Buckets) UpdateBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var inventory buckets.Inventory
+	if err = json.NewDecoder(r.Body).Decode(&inventory); err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.UpdateBucketInventory(ctx, projectID, bucketName, inventory)
+	if err != nil {
+		b.serveJSONError(w, bucketErrorStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteBucketInventory stops generating the inventory of a bucket.
+func (b *Buckets) DeleteBucketInventory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	projectID, bucketName, err := bucketFromQuery(r)
+	if err != nil {
+		b.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = b.service.DeleteBucketInventory(ctx, projectID, bucketName)
+	if err != nil {
+		b.serveJSONError(w, bucketErrorStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// bucketFromQuery parses the project ID and bucket name from the request query.
+func bucketFromQuery(r *http.Request) (projectID uuid.UUID, bucketName string, err error) {
+	projectID, err = uuid.FromString(r.URL.Query().Get("projectID"))
+	if err != nil {
+		return uuid.UUID{}, "", ErrBucketsAPI.New("invalid project ID: %v", err)
+	}
+
+	bucketName = r.URL.Query().Get("bucket")
+	if bucketName == "" {
+		return uuid.UUID{}, "", ErrBucketsAPI.New("bucket name was not provided")
+	}
+
+	return projectID, bucketName, nil
+}
+
+// bucketErrorStatus maps bucket limits and inventory service errors to HTTP status codes.
+func bucketErrorStatus(err error) int {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	case storx.ErrBucketNotFound.Has(err), buckets.ErrInventoryNotFound.Has(err):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (b *Buckets) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(b.log, w, status, err)
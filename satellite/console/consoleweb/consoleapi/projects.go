@@ -64,6 +64,105 @@ func (p *Projects) GetSalt(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// GetBudget returns the project's budget with the current spending and the alert history.
+func (p *Projects) GetBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, err := projectIDFromVars(r)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	budget, err := p.service.GetProjectBudget(ctx, id)
+	if err != nil {
+		p.serveJSONError(w, budgetErrorStatus(err), err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(budget)
+	if err != nil {
+		p.log.Error("failed to write json project budget response", zap.Error(ErrProjectsAPI.Wrap(err)))
+	}
+}
+
+// SetBudget sets the project's monthly budget.
+func (p *Projects) SetBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := projectIDFromVars(r)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var request struct {
+		Amount  int64 `json:"amount"`
+		HardCap bool  `json:"hardCap"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.SetProjectBudget(ctx, id, request.Amount, request.HardCap)
+	if err != nil {
+		p.serveJSONError(w, budgetErrorStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// DeleteBudget removes the project's budget.
+func (p *Projects) DeleteBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := projectIDFromVars(r)
+	if err != nil {
+		p.serveJSONError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = p.service.DeleteProjectBudget(ctx, id)
+	if err != nil {
+		p.serveJSONError(w, budgetErrorStatus(err), err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// projectIDFromVars parses the project ID from the route parameters.
+func projectIDFromVars(r *http.Request) (uuid.UUID, error) {
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		return uuid.UUID{}, errs.New("missing id route param")
+	}
+
+	return uuid.FromString(idParam)
+}
+
+// budgetErrorStatus maps project budget service errors to HTTP status codes.
+func budgetErrorStatus(err error) int {
+	switch {
+	case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+		return http.StatusUnauthorized
+	case console.ErrValidation.Has(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (p *Projects) serveJSONError(w http.ResponseWriter, status int, err error) {
 	web.ServeJSONError(p.log, w, status, err)
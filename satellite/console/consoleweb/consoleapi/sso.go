@@ -0,0 +1,160 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storx/private/web"
+	"storx/satellite/console"
+	"storx/satellite/console/consoleweb/consolewebauth"
+	"storx/satellite/console/sso"
+)
+
+var (
+	// ErrSSOAPI - console sso api error type.
+	ErrSSOAPI = errs.Class("console sso")
+)
+
+// ssoStateCookie is the cookie with the state and the nonce of a started login.
+const ssoStateCookie = "_ssoState"
+
+// SSO is an api controller that exposes the login through the identity providers of the organizations.
+type SSO struct {
+	log             *zap.Logger
+	service         *console.Service
+	sso             *sso.Service
+	cookieAuth      *consolewebauth.CookieAuth
+	externalAddress string
+}
+
+// NewSSO is a constructor for api sso controller.
+func NewSSO(log *zap.Logger, service *console.Service, ssoService *sso.Service, cookieAuth *consolewebauth.CookieAuth, externalAddress string) *SSO {
+	return &SSO{
+		log:             log,
+		service:         service,
+		sso:             ssoService,
+		cookieAuth:      cookieAuth,
+		externalAddress: externalAddress,
+	}
+}
+
+// Login redirects the user to the identity provider which handles the domain of their email.
+func (s *SSO) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	email := r.URL.Query().Get("email")
+
+	provider := s.sso.Config().ProviderForEmail(email)
+	if provider == nil {
+		s.redirectFailed(w, r, "no identity provider for email domain", ErrSSOAPI.New("%q", email))
+		return
+	}
+
+	state, err := sso.NewState()
+	if err != nil {
+		s.redirectFailed(w, r, "failed to generate state", err)
+		return
+	}
+	nonce, err := sso.NewState()
+	if err != nil {
+		s.redirectFailed(w, r, "failed to generate nonce", err)
+		return
+	}
+
+	authURL, err := s.sso.AuthCodeURL(ctx, provider.Name, state, nonce, email)
+	if err != nil {
+		s.redirectFailed(w, r, "failed to get identity provider login url", err)
+		return
+	}
+
+	// the cookie is sent back on the redirect from the identity provider,
+	// which is a cross-site navigation, so it can't be strict.
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    state + "." + nonce,
+		Path:     "/api/v0/auth/sso/callback/" + provider.Name,
+		Expires:  time.Now().Add(s.sso.Config().StateExpiry),
+		HttpOnly: true,
+		Secure:   r.TLS != nil || strings.HasPrefix(s.externalAddress, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, authURL, http.StatusFound)
+}
+
+// Callback completes the login when the identity provider redirects the user back
+// and redirects the user to the satellite console.
+func (s *SSO) Callback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	providerName := mux.Vars(r)["provider"]
+
+	cookie, err := r.Cookie(ssoStateCookie)
+	if err != nil {
+		s.redirectFailed(w, r, "missing login state", err)
+		return
+	}
+
+	// the state is only usable once.
+	http.SetCookie(w, &http.Cookie{
+		Name:     ssoStateCookie,
+		Value:    "",
+		Path:     "/api/v0/auth/sso/callback/" + providerName,
+		Expires:  time.Unix(0, 0),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	query := r.URL.Query()
+	if idpErr := query.Get("error"); idpErr != "" {
+		s.redirectFailed(w, r, "identity provider returned an error", ErrSSOAPI.New("%s: %s", idpErr, query.Get("error_description")))
+		return
+	}
+
+	state, nonce, ok := strings.Cut(cookie.Value, ".")
+	if !ok || subtle.ConstantTimeCompare([]byte(state), []byte(query.Get("state"))) != 1 {
+		s.redirectFailed(w, r, "login state mismatch", ErrSSOAPI.New("state mismatch"))
+		return
+	}
+
+	identity, err := s.sso.Exchange(ctx, providerName, query.Get("code"), nonce)
+	if err != nil {
+		s.redirectFailed(w, r, "failed to verify identity", err)
+		return
+	}
+
+	ip, err := web.GetRequestIP(r)
+	if err != nil {
+		s.redirectFailed(w, r, "failed to get request ip", err)
+		return
+	}
+
+	tokenInfo, err := s.service.LoginWithSSO(ctx, identity, ip, r.UserAgent())
+	if err != nil {
+		s.redirectFailed(w, r, "failed to log in", err)
+		return
+	}
+
+	s.cookieAuth.SetTokenCookie(w, *tokenInfo)
+
+	http.Redirect(w, r, s.externalAddress, http.StatusTemporaryRedirect)
+}
+
+// redirectFailed logs the failure and redirects the user back to the login page.
+func (s *SSO) redirectFailed(w http.ResponseWriter, r *http.Request, msg string, err error) {
+	s.log.Info("sso login: "+msg, zap.Error(ErrSSOAPI.Wrap(err)))
+	http.Redirect(w, r, s.externalAddress+"login?sso=failed", http.StatusTemporaryRedirect)
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite"
+	"storx/satellite/console"
+	"storx/satellite/console/sso"
+	"storx/satellite/console/sso/ssotest"
+)
+
+func TestSSOLogin(t *testing.T) {
+	idp, err := ssotest.NewIdP("client-id", "client-secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.RateLimit.Burst = 10
+				config.Console.SSO = sso.Config{
+					Enabled: true,
+					Providers: sso.Providers{{
+						Name:         "acme",
+						IssuerURL:    idp.Issuer(),
+						ClientID:     idp.ClientID,
+						ClientSecret: idp.ClientSecret,
+						EmailDomains: []string{"acme.test"},
+					}},
+					StateExpiry: 10 * time.Minute,
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		email := "user@acme.test"
+
+		client := &http.Client{
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+
+		get := func(rawURL string, cookies ...*http.Cookie) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+			require.NoError(t, err)
+			for _, cookie := range cookies {
+				req.AddCookie(cookie)
+			}
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			require.NoError(t, resp.Body.Close())
+			return resp
+		}
+
+		findCookie := func(resp *http.Response, name string) *http.Cookie {
+			for _, cookie := range resp.Cookies() {
+				if cookie.Name == name && cookie.Value != "" {
+					return cookie
+				}
+			}
+			return nil
+		}
+
+		// callbackURL points the redirect of the identity provider to the console of the satellite.
+		callbackURL := func(redirect string) string {
+			u, err := url.Parse(redirect)
+			require.NoError(t, err)
+			return sat.ConsoleURL() + u.RequestURI()
+		}
+
+		resp := get(sat.ConsoleURL() + "/api/v0/auth/sso/login?email=" + url.QueryEscape(email))
+		require.Equal(t, http.StatusFound, resp.StatusCode)
+		authURL := resp.Header.Get("Location")
+		require.True(t, strings.HasPrefix(authURL, idp.Issuer()+"/authorize"))
+
+		stateCookie := findCookie(resp, "_ssoState")
+		require.NotNil(t, stateCookie)
+
+		redirect, err := idp.Authorize(authURL, map[string]interface{}{
+			"sub":   "user-1",
+			"email": email,
+			"name":  "Acme User",
+		})
+		require.NoError(t, err)
+
+		resp = get(callbackURL(redirect), stateCookie)
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+		require.NotContains(t, resp.Header.Get("Location"), "sso=failed")
+
+		tokenCookie := findCookie(resp, "_tokenKey")
+		require.NotNil(t, tokenCookie)
+
+		user, err := sat.DB.Console().Users().GetByEmail(ctx, email)
+		require.NoError(t, err)
+		require.Equal(t, console.Active, user.Status)
+		require.Equal(t, "Acme User", user.FullName)
+
+		resp = get(sat.ConsoleURL()+"/api/v0/auth/account", tokenCookie)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		// the login can't be completed without the state of the browser which started it.
+		redirect, err = idp.Authorize(authURL, map[string]interface{}{"email": email})
+		require.NoError(t, err)
+
+		resp = get(callbackURL(redirect))
+		require.Equal(t, http.StatusTemporaryRedirect, resp.StatusCode)
+		require.Contains(t, resp.Header.Get("Location"), "login?sso=failed")
+		require.Nil(t, findCookie(resp, "_tokenKey"))
+
+		resp = get(callbackURL(redirect), &http.Cookie{Name: "_ssoState", Value: "forged.nonce"})
+		require.Contains(t, resp.Header.Get("Location"), "login?sso=failed")
+		require.Nil(t, findCookie(resp, "_tokenKey"))
+
+		// emails of other domains can't log in through the identity provider.
+		resp = get(sat.ConsoleURL() + "/api/v0/auth/sso/login?email=" + url.QueryEscape("user@mail.test"))
+		require.Contains(t, resp.Header.Get("Location"), "login?sso=failed")
+
+		// the users of the identity provider can't log in with a password.
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, sat.ConsoleURL()+"/api/v0/auth/token",
+			bytes.NewBufferString(`{"email":"user@acme.test","password":"password"}`))
+		require.NoError(t, err)
+		tokenResp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusForbidden, tokenResp.StatusCode)
+		require.NoError(t, tokenResp.Body.Close())
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/uuid"
+	"storx/private/web"
+	"storx/satellite/console"
+)
+
+var (
+	// ErrUsageExportAPI - console usage export api error type.
+	ErrUsageExportAPI = errs.Class("console usage export")
+)
+
+// usageExportDateLayout is the layout of the dates in usage export requests and CSV rows.
+const usageExportDateLayout = "2006-01-02"
+
+// usageExportCSVHeader is the header row of the CSV usage export.
+var usageExportCSVHeader = []string{
+	"date", "project_id", "bucket_name",
+	"storage_byte_hours", "segment_hours", "object_hours",
+	"get_egress_bytes", "repair_egress_bytes", "audit_egress_bytes",
+}
+
+// UsageExport is an api controller that exports the historical usage of projects.
+type UsageExport struct {
+	log     *zap.Logger
+	service *console.Service
+}
+
+// NewUsageExport is a constructor for api usage export controller.
+func NewUsageExport(log *zap.Logger, service *console.Service) *UsageExport {
+	return &UsageExport{
+		log:     log,
+		service: service,
+	}
+}
+
+// ExportUsage streams the daily usage of every bucket of the project.
+// The date range is given by the since (inclusive) and before (exclusive)
+// query parameters formatted as YYYY-MM-DD. The format query parameter
+// selects between "csv" (default) and "jsonl".
+func (ue *UsageExport) ExportUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		ue.serveJSONError(w, http.StatusBadRequest, errs.New("missing project id route param"))
+		return
+	}
+	projectID, err := uuid.FromString(idParam)
+	if err != nil {
+		ue.serveJSONError(w, http.StatusBadRequest, errs.New("invalid project id: %v", err))
+		return
+	}
+
+	since, err := time.Parse(usageExportDateLayout, r.URL.Query().Get("since"))
+	if err != nil {
+		ue.serveJSONError(w, http.StatusBadRequest, errs.New("invalid since date: %v", err))
+		return
+	}
+	before, err := time.Parse(usageExportDateLayout, r.URL.Query().Get("before"))
+	if err != nil {
+		ue.serveJSONError(w, http.StatusBadRequest, errs.New("invalid before date: %v", err))
+		return
+	}
+
+	var rows usageExportWriter
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "csv":
+		rows = newUsageExportCSVWriter(w)
+	case "jsonl":
+		rows = newUsageExportJSONLWriter(w)
+	default:
+		ue.serveJSONError(w, http.StatusBadRequest, errs.New("unsupported format %q, expected csv or jsonl", format))
+		return
+	}
+
+	// headers are written with the first row, so that errors before it can
+	// still be reported with a proper status code.
+	started := false
+	start := func() error {
+		if started {
+			return nil
+		}
+		started = true
+
+		w.Header().Set("Content-Type", rows.ContentType())
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=usage-%s-%s-%s.%s",
+			projectID, since.Format(usageExportDateLayout), before.Format(usageExportDateLayout), rows.Extension()))
+		w.WriteHeader(http.StatusOK)
+		return rows.Start()
+	}
+
+	err = ue.service.ExportProjectUsage(ctx, projectID, since, before, func(row console.UsageExportRow) error {
+		if err := start(); err != nil {
+			return err
+		}
+		return rows.Write(row)
+	})
+	if err != nil {
+		if started {
+			ue.log.Error("failed to export project usage", zap.Error(ErrUsageExportAPI.Wrap(err)))
+			return
+		}
+
+		switch {
+		case console.ErrUnauthorized.Has(err), console.ErrNoMembership.Has(err):
+			ue.serveJSONError(w, http.StatusUnauthorized, err)
+		case console.ErrValidation.Has(err):
+			ue.serveJSONError(w, http.StatusBadRequest, err)
+		default:
+			ue.serveJSONError(w, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if err = start(); err == nil {
+		err = rows.Flush()
+	}
+	if err != nil {
+		ue.log.Error("failed to write project usage export", zap.Error(ErrUsageExportAPI.Wrap(err)))
+	}
+}
+
+// serveJSONError writes JSON error to response output stream.
+func (ue *UsageExport) serveJSONError(w http.ResponseWriter, status int, err error) {
+	web.ServeJSONError(ue.log, w, status, err)
+}
+
+// usageExportWriter writes usage export rows in a specific format.
+type usageExportWriter interface {
+	ContentType() string
+	Extension() string
+	Start() error
+	Write(row console.UsageExportRow) error
+	Flush() error
+}
+
+// usageExportCSVWriter writes usage export rows as CSV.
+type usageExportCSVWriter struct {
+	csv *csv.Writer
+}
+
+func newUsageExportCSVWriter(w http.ResponseWriter) *usageExportCSVWriter {
+	return &usageExportCSVWriter{csv: csv.NewWriter(w)}
+}
+
+func (writer *usageExportCSVWriter) ContentType() string { return "text/csv" }
+
+func (writer *usageExportCSVWriter) Extension() string { return "csv" }
+
+func (writer *usageExportCSVWriter) Start() error {
+	return writer.csv.Write(usageExportCSVHeader)
+}
+
+func (writer *usageExportCSVWriter) Write(row console.UsageExportRow) error {
+	return writer.csv.Write([]string{
+		row.Date.Format(usageExportDateLayout),
+		row.ProjectID.String(),
+		row.BucketName,
+		strconv.FormatFloat(row.StorageByteHours, 'f', -1, 64),
+		strconv.FormatFloat(row.SegmentHours, 'f', -1, 64),
+		strconv.FormatFloat(row.ObjectHours, 'f', -1, 64),
+		strconv.FormatInt(row.GetEgress, 10),
+		strconv.FormatInt(row.RepairEgress, 10),
+		strconv.FormatInt(row.AuditEgress, 10),
+	})
+}
+
+func (writer *usageExportCSVWriter) Flush() error {
+	writer.csv.Flush()
+	return writer.csv.Error()
+}
+
+// usageExportJSONLWriter writes usage export rows as JSON lines.
+type usageExportJSONLWriter struct {
+	encoder *json.Encoder
+}
+
+func newUsageExportJSONLWriter(w http.ResponseWriter) *usageExportJSONLWriter {
+	return &usageExportJSONLWriter{encoder: json.NewEncoder(w)}
+}
+
+func (writer *usageExportJSONLWriter) ContentType() string { return "application/x-ndjson" }
+
+func (writer *usageExportJSONLWriter) Extension() string { return "jsonl" }
+
+func (writer *usageExportJSONLWriter) Start() error { return nil }
+
+func (writer *usageExportJSONLWriter) Write(row console.UsageExportRow) error {
+	return writer.encoder.Encode(row)
+}
+
+func (writer *usageExportJSONLWriter) Flush() error { return nil }
@@ -0,0 +1,128 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleapi_test
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+	"common/pb"
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite/console"
+)
+
+func TestUsageExport(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Usage Export Test",
+			Email:    "usage-export@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, user.ID, "project")
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, user.ID)
+		require.NoError(t, err)
+
+		restKey, _, err := sat.API.Console.Service.CreateRESTKey(userCtx, time.Hour)
+		require.NoError(t, err)
+
+		yesterday := time.Now().UTC().AddDate(0, 0, -1)
+		day := time.Date(yesterday.Year(), yesterday.Month(), yesterday.Day(), 0, 0, 0, 0, time.UTC)
+
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("bucket"), pb.PieceAction_GET, memory.GB.Int64(), 0, day.Add(time.Hour))
+		require.NoError(t, err)
+		err = sat.DB.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("bucket"), pb.PieceAction_GET_REPAIR, memory.MB.Int64(), 0, day.Add(time.Hour))
+		require.NoError(t, err)
+
+		exportURL := func(query string) string {
+			return fmt.Sprintf("http://%s/api/v0/projects/%s/usage-export?since=%s&before=%s%s",
+				sat.API.Console.Listener.Addr().String(), project.PublicID,
+				day.Format("2006-01-02"), day.AddDate(0, 0, 1).Format("2006-01-02"), query)
+		}
+
+		doRequest := func(url, key string) *http.Response {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+			require.NoError(t, err)
+			if key != "" {
+				req.Header.Set("Authorization", "Bearer "+key)
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			return resp
+		}
+
+		t.Run("csv", func(t *testing.T) {
+			resp := doRequest(exportURL(""), restKey)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "text/csv", resp.Header.Get("Content-Type"))
+
+			records, err := csv.NewReader(resp.Body).ReadAll()
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+			require.Equal(t, "date", records[0][0])
+			require.Equal(t, []string{
+				day.Format("2006-01-02"), project.PublicID.String(), "bucket",
+				"0", "0", "0",
+				fmt.Sprint(memory.GB.Int64()), fmt.Sprint(memory.MB.Int64()), "0",
+			}, records[1])
+		})
+
+		t.Run("jsonl", func(t *testing.T) {
+			resp := doRequest(exportURL("&format=jsonl"), restKey)
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+			var rows []console.UsageExportRow
+			scanner := bufio.NewScanner(resp.Body)
+			for scanner.Scan() {
+				var row console.UsageExportRow
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+				rows = append(rows, row)
+			}
+			require.NoError(t, scanner.Err())
+
+			require.Len(t, rows, 1)
+			require.Equal(t, "bucket", rows[0].BucketName)
+			require.True(t, day.Equal(rows[0].Date))
+			require.Equal(t, memory.GB.Int64(), rows[0].GetEgress)
+			require.Equal(t, memory.MB.Int64(), rows[0].RepairEgress)
+		})
+
+		t.Run("errors", func(t *testing.T) {
+			resp := doRequest(exportURL(""), "")
+			require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+
+			resp = doRequest(exportURL("&format=xml"), restKey)
+			require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+
+			tooLong := fmt.Sprintf("http://%s/api/v0/projects/%s/usage-export?since=2020-01-01&before=2022-01-01",
+				sat.API.Console.Listener.Addr().String(), project.PublicID)
+			resp = doRequest(tooLong, restKey)
+			require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			require.NoError(t, resp.Body.Close())
+		})
+	})
+}
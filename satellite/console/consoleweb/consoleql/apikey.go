@@ -17,6 +17,12 @@ const (
 	CreateAPIKeyType = "graphqlCreateAPIKey"
 	// FieldKey is field name for the actual key in createAPIKey.
 	FieldKey = "key"
+	// FieldDailyEgressLimit is field name for the daily egress limit of an api key.
+	FieldDailyEgressLimit = "dailyEgressLimit"
+	// FieldRequestsPerMinute is field name for the request rate limit of an api key.
+	FieldRequestsPerMinute = "requestsPerMinute"
+	// FieldExpiringSoon is field name for the expiration warning of an api key.
+	FieldExpiringSoon = "expiringSoon"
 )
 
 // graphqlAPIKeyInfo creates satellite.APIKeyInfo graphql object.
@@ -36,10 +42,42 @@ func graphqlAPIKeyInfo() *graphql.Object {
 			FieldCreatedAt: &graphql.Field{
 				Type: graphql.DateTime,
 			},
+			FieldExpiresAt: &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return apiKeyLimitsFromSource(p.Source).ExpiresAt, nil
+				},
+			},
+			FieldDailyEgressLimit: &graphql.Field{
+				Type: graphql.Float,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return apiKeyLimitsFromSource(p.Source).DailyEgressLimit, nil
+				},
+			},
+			FieldRequestsPerMinute: &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return apiKeyLimitsFromSource(p.Source).RequestsPerMinute, nil
+				},
+			},
+			FieldExpiringSoon: &graphql.Field{
+				Type: graphql.Boolean,
+			},
 		},
 	})
 }
 
+// apiKeyLimitsFromSource returns the limits of the api key being resolved.
+func apiKeyLimitsFromSource(source interface{}) console.APIKeyLimits {
+	switch key := source.(type) {
+	case console.APIKeyInfo:
+		return key.APIKeyLimits
+	case *console.APIKeyInfo:
+		return key.APIKeyLimits
+	}
+	return console.APIKeyLimits{}
+}
+
 // graphqlCreateAPIKey creates createAPIKey graphql object.
 func graphqlCreateAPIKey(types *TypeCreator) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -61,8 +61,9 @@ func TestGraphqlMutation(t *testing.T) {
 		require.NoError(t, err)
 
 		projectLimitCache := accounting.NewProjectLimitCache(db.ProjectAccounting(), 0, 0, 0, accounting.ProjectLimitConfig{CacheCapacity: 100})
+		bucketLimitCache := accounting.NewBucketLimitCache(db.Buckets(), accounting.ProjectLimitConfig{CacheCapacity: 100})
 
-		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, *sat.Metabase.DB, 5*time.Minute, -10*time.Second)
+		projectUsage := accounting.NewService(db.ProjectAccounting(), cache, projectLimitCache, bucketLimitCache, *sat.Metabase.DB, 5*time.Minute, -10*time.Second)
 
 		// TODO maybe switch this test to testplanet to avoid defining config and Stripe service
 		pc := paymentsconfig.Config{
@@ -110,10 +111,12 @@ func TestGraphqlMutation(t *testing.T) {
 			nil,
 			db.Billing(),
 			analyticsService,
+			console.NewAccountFreezeService(db.Console().AccountFreezeEvents(), db.Console().Users(), db.Console().Projects(), analyticsService),
 			consoleauth.NewService(consoleauth.Config{
 				TokenExpirationTime: 24 * time.Hour,
 			}, &consoleauth.Hmac{Secret: []byte("my-suppa-secret-key")}),
 			nil,
+			nil,
 			"",
 			console.Config{
 				PasswordCost:        console.TestPasswordCost,
@@ -40,6 +40,7 @@ import (
 	"storx/satellite/console/consoleweb/consoleapi"
 	"storx/satellite/console/consoleweb/consoleql"
 	"storx/satellite/console/consoleweb/consolewebauth"
+	"storx/satellite/console/sso"
 	"storx/satellite/mailservice"
 	"storx/satellite/oidc"
 	"storx/satellite/payments/paymentsconfig"
@@ -255,6 +256,18 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		"/api/v0/projects/{id}/salt",
 		server.withAuth(http.HandlerFunc(projectsController.GetSalt)),
 	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/budget",
+		server.withAuth(http.HandlerFunc(projectsController.GetBudget)),
+	).Methods(http.MethodGet)
+	router.Handle(
+		"/api/v0/projects/{id}/budget",
+		server.withAuth(http.HandlerFunc(projectsController.SetBudget)),
+	).Methods(http.MethodPut)
+	router.Handle(
+		"/api/v0/projects/{id}/budget",
+		server.withAuth(http.HandlerFunc(projectsController.DeleteBudget)),
+	).Methods(http.MethodDelete)
 
 	router.HandleFunc("/config", server.frontendConfigHandler)
 	router.HandleFunc("/registrationToken/", server.createRegistrationTokenHandler)
@@ -276,6 +289,12 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 		server.withAuth(http.HandlerFunc(usageLimitsController.DailyUsage)),
 	).Methods(http.MethodGet)
 
+	usageExportController := consoleapi.NewUsageExport(logger, service)
+	router.Handle(
+		"/api/v0/projects/{id}/usage-export",
+		server.withAuthOrKey(http.HandlerFunc(usageExportController.ExportUsage)),
+	).Methods(http.MethodGet)
+
 	authController := consoleapi.NewAuth(logger, service, accountFreezeService, mailService, server.cookieAuth, server.analytics, config.SatelliteName, server.config.ExternalAddress, config.LetUsKnowURL, config.TermsAndConditionsURL, config.ContactInfoURL, config.GeneralRequestURL)
 	authRouter := router.PathPrefix("/api/v0/auth").Subrouter()
 	authRouter.Handle("/account", server.withAuth(http.HandlerFunc(authController.GetAccount))).Methods(http.MethodGet)
@@ -284,6 +303,12 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/account/change-password", server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.ChangePassword)))).Methods(http.MethodPost)
 	authRouter.Handle("/account/freezestatus", server.withAuth(http.HandlerFunc(authController.IsAccountFrozen))).Methods(http.MethodGet)
 	authRouter.Handle("/account/delete", server.withAuth(http.HandlerFunc(authController.DeleteAccount))).Methods(http.MethodPost)
+	authRouter.Handle("/account/deletion", server.withAuth(http.HandlerFunc(authController.GetAccountDeletion))).Methods(http.MethodGet)
+	authRouter.Handle("/account/deletion", server.withAuth(server.userIDRateLimiter.Limit(http.HandlerFunc(authController.ScheduleAccountDeletion)))).Methods(http.MethodPost)
+	authRouter.Handle("/account/deletion", server.withAuth(http.HandlerFunc(authController.CancelAccountDeletion))).Methods(http.MethodDelete)
+	authRouter.Handle("/account/exports", server.withAuth(http.HandlerFunc(authController.RequestAccountExport))).Methods(http.MethodPost)
+	authRouter.Handle("/account/exports", server.withAuth(http.HandlerFunc(authController.GetAccountExports))).Methods(http.MethodGet)
+	authRouter.Handle("/account/exports/{id}", server.withAuth(http.HandlerFunc(authController.DownloadAccountExport))).Methods(http.MethodGet)
 	authRouter.Handle("/mfa/enable", server.withAuth(http.HandlerFunc(authController.EnableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/disable", server.withAuth(http.HandlerFunc(authController.DisableUserMFA))).Methods(http.MethodPost)
 	authRouter.Handle("/mfa/generate-secret-key", server.withAuth(http.HandlerFunc(authController.GenerateMFASecretKey))).Methods(http.MethodPost)
@@ -296,6 +321,13 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	authRouter.Handle("/reset-password", server.ipRateLimiter.Limit(http.HandlerFunc(authController.ResetPassword))).Methods(http.MethodPost)
 	authRouter.Handle("/refresh-session", server.withAuth(http.HandlerFunc(authController.RefreshSession))).Methods(http.MethodPost)
 
+	if config.SSO.Enabled {
+		ssoService := sso.NewService(logger.Named("sso"), config.SSO, server.config.ExternalAddress+"api/v0/auth/sso/callback")
+		ssoController := consoleapi.NewSSO(logger, service, ssoService, server.cookieAuth, server.config.ExternalAddress)
+		authRouter.Handle("/sso/login", server.ipRateLimiter.Limit(http.HandlerFunc(ssoController.Login))).Methods(http.MethodGet)
+		authRouter.Handle("/sso/callback/{provider}", server.ipRateLimiter.Limit(http.HandlerFunc(ssoController.Callback))).Methods(http.MethodGet)
+	}
+
 	if config.ABTesting.Enabled {
 		abController := consoleapi.NewABTesting(logger, abTesting)
 		abRouter := router.PathPrefix("/api/v0/ab").Subrouter()
@@ -329,11 +361,17 @@ func NewServer(logger *zap.Logger, config Config, service *console.Service, oidc
 	bucketsRouter := router.PathPrefix("/api/v0/buckets").Subrouter()
 	bucketsRouter.Use(server.withAuth)
 	bucketsRouter.HandleFunc("/bucket-names", bucketsController.AllBucketNames).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/limits", bucketsController.GetBucketLimits).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/limits", bucketsController.UpdateBucketLimits).Methods(http.MethodPatch)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.GetBucketInventory).Methods(http.MethodGet)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.UpdateBucketInventory).Methods(http.MethodPut)
+	bucketsRouter.HandleFunc("/inventory", bucketsController.DeleteBucketInventory).Methods(http.MethodDelete)
 
 	apiKeysController := consoleapi.NewAPIKeys(logger, service)
 	apiKeysRouter := router.PathPrefix("/api/v0/api-keys").Subrouter()
 	apiKeysRouter.Use(server.withAuth)
 	apiKeysRouter.HandleFunc("/delete-by-name", apiKeysController.DeleteByNameAndProjectID).Methods(http.MethodDelete)
+	apiKeysRouter.HandleFunc("/limits", apiKeysController.UpdateLimits).Methods(http.MethodPatch)
 
 	analyticsController := consoleapi.NewAnalytics(logger, service, server.analytics)
 	analyticsRouter := router.PathPrefix("/api/v0/analytics").Subrouter()
@@ -570,6 +608,25 @@ func (server *Server) withAuth(handler http.Handler) http.Handler {
 	})
 }
 
+// withAuthOrKey performs initial authorization before every request
+// using either the session cookie or a REST API key.
+func (server *Server) withAuthOrKey(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		ctx := r.Context()
+
+		defer mon.Task()(&ctx)(&err)
+
+		newCtx, err := (&apiAuth{server}).IsAuthenticated(ctx, r, true, true)
+		if err != nil {
+			web.ServeJSONError(server.log, w, http.StatusUnauthorized, console.ErrUnauthorized.Wrap(err))
+			return
+		}
+
+		handler.ServeHTTP(w, r.Clone(newCtx))
+	})
+}
+
 // withRequest ensures the http request itself is reachable from the context.
 func (server *Server) withRequest(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -694,6 +751,7 @@ func (server *Server) frontendConfigHandler(w http.ResponseWriter, r *http.Reque
 		PasswordMaximumLength:           console.PasswordMaximumLength,
 		ABTestingEnabled:                server.config.ABTesting.Enabled,
 		NewAccessGrantFlow:              server.config.NewAccessGrantFlow,
+		SSOEnabled:                      server.config.SSO.Enabled,
 	}
 
 	err := json.NewEncoder(w).Encode(&cfg)
@@ -29,6 +29,12 @@ type DB interface {
 	WebappSessions() consoleauth.WebappSessions
 	// AccountFreezeEvents is a getter for AccountFreezeEvents repository.
 	AccountFreezeEvents() AccountFreezeEvents
+	// ProjectBudgets is a getter for ProjectBudgets repository.
+	ProjectBudgets() ProjectBudgets
+	// AccountExports is a getter for AccountExports repository.
+	AccountExports() AccountExports
+	// AccountDeletions is a getter for AccountDeletions repository.
+	AccountDeletions() AccountDeletions
 
 	// WithTx is a method for executing transactions with retrying as necessary.
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx DBTx) error) error
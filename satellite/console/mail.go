@@ -3,7 +3,10 @@
 
 package console
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // AccountActivationEmail is mailservice template with activation data.
 type AccountActivationEmail struct {
@@ -102,3 +105,50 @@ func (*LockAccountEmail) Template() string { return "LockAccount" }
 
 // Subject gets email subject.
 func (*LockAccountEmail) Subject() string { return "Account Lock" }
+
+// ProjectBudgetAlertEmail is mailservice template with project budget alert data.
+type ProjectBudgetAlertEmail struct {
+	Name                 string
+	ProjectName          string
+	Threshold            int
+	Spent                string
+	Budget               string
+	Capped               bool
+	ProjectDashboardLink string
+}
+
+// Template returns email template name.
+func (*ProjectBudgetAlertEmail) Template() string { return "BudgetAlert" }
+
+// Subject gets email subject.
+func (email *ProjectBudgetAlertEmail) Subject() string {
+	return fmt.Sprintf("Your project %s reached %d%% of its budget", email.ProjectName, email.Threshold)
+}
+
+// AccountExportReadyEmail is mailservice template with account data export data.
+type AccountExportReadyEmail struct {
+	Name         string
+	ExpiresAt    string
+	DownloadLink string
+}
+
+// Template returns email template name.
+func (*AccountExportReadyEmail) Template() string { return "AccountExportReady" }
+
+// Subject gets email subject.
+func (*AccountExportReadyEmail) Subject() string { return "Your account data export is ready" }
+
+// AccountDeletionScheduledEmail is mailservice template with scheduled account deletion data.
+type AccountDeletionScheduledEmail struct {
+	Name         string
+	DeleteAfter  string
+	SettingsLink string
+}
+
+// Template returns email template name.
+func (*AccountDeletionScheduledEmail) Template() string { return "AccountDeletionScheduled" }
+
+// Subject gets email subject.
+func (*AccountDeletionScheduledEmail) Subject() string {
+	return "Your account is scheduled for deletion"
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/uuid"
+	"storx/satellite/payments"
+)
+
+// ErrProjectBudget is the class for errors that occur during operation of the project budget service.
+var ErrProjectBudget = errs.Class("project budget service")
+
+// BudgetAlertThresholds are the percentages of the project budget at which alerts are sent.
+var BudgetAlertThresholds = []int{50, 80, 100}
+
+// ProjectBudgets exposes methods to manage the project budgets in the database.
+//
+// architecture: Database
+type ProjectBudgets interface {
+	// Get returns the budget of the project.
+	Get(ctx context.Context, projectID uuid.UUID) (*ProjectBudget, error)
+	// GetAll returns the budgets of all projects.
+	GetAll(ctx context.Context) ([]ProjectBudget, error)
+	// Upsert creates the budget of the project or updates the amount and hard cap of an existing one.
+	Upsert(ctx context.Context, projectID uuid.UUID, amount int64, hardCap bool) error
+	// UpdateCap sets the project usage limits which were in place before the hard cap was applied.
+	// Passing nil limits removes the hard cap.
+	UpdateCap(ctx context.Context, projectID uuid.UUID, cappedLimits *NullableUsageLimits, cappedAt time.Time) error
+	// Delete removes the budget of the project.
+	Delete(ctx context.Context, projectID uuid.UUID) error
+
+	// InsertAlert records that a budget alert was sent.
+	InsertAlert(ctx context.Context, alert ProjectBudgetAlert) error
+	// GetAlerts returns the budget alerts of the project, newest first.
+	GetAlerts(ctx context.Context, projectID uuid.UUID) ([]ProjectBudgetAlert, error)
+	// DeleteAlerts removes all the budget alerts of the project.
+	DeleteAlerts(ctx context.Context, projectID uuid.UUID) error
+}
+
+// ProjectBudget is the monthly spending budget of a project.
+type ProjectBudget struct {
+	ProjectID uuid.UUID `json:"-"`
+	// Amount is the monthly budget in cents.
+	Amount int64 `json:"amount"`
+	// HardCap indicates whether the project usage limits are zeroed once the budget is reached.
+	HardCap bool `json:"hardCap"`
+	// CappedLimits are the project usage limits before the hard cap was applied.
+	// It is nil when the hard cap isn't in effect.
+	CappedLimits *NullableUsageLimits `json:"-"`
+	CappedAt     *time.Time           `json:"cappedAt"`
+	CreatedAt    time.Time            `json:"createdAt"`
+}
+
+// IsCapped returns whether the hard cap is in effect for the project.
+func (budget *ProjectBudget) IsCapped() bool {
+	return budget.CappedLimits != nil
+}
+
+// ProjectBudgetAlert is a budget alert sent for a project.
+type ProjectBudgetAlert struct {
+	ProjectID uuid.UUID `json:"-"`
+	// Period is the beginning of the billing period the alert was sent for.
+	Period time.Time `json:"period"`
+	// Threshold is the percentage of the budget which was reached.
+	Threshold int `json:"threshold"`
+	// Spent is the project spending in cents when the alert was sent.
+	Spent int64 `json:"spent"`
+	// Budget is the project budget in cents when the alert was sent.
+	Budget    int64     `json:"budget"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ProjectBudgetInfo contains the budget of a project with its current spending and alert history.
+type ProjectBudgetInfo struct {
+	// Budget is nil when the project has no budget.
+	Budget *ProjectBudget `json:"budget"`
+	// Spent is the project spending in cents in the current billing period.
+	Spent  int64                `json:"spent"`
+	Alerts []ProjectBudgetAlert `json:"alerts"`
+}
+
+// ProjectBudgetService calculates the spending of projects and applies or removes the hard cap of their budgets.
+type ProjectBudgetService struct {
+	budgetsDB  ProjectBudgets
+	projectsDB Projects
+	accounts   payments.Accounts
+	nowFn      func() time.Time
+}
+
+// NewProjectBudgetService creates a new project budget service.
+func NewProjectBudgetService(budgetsDB ProjectBudgets, projectsDB Projects, accounts payments.Accounts) *ProjectBudgetService {
+	return &ProjectBudgetService{
+		budgetsDB:  budgetsDB,
+		projectsDB: projectsDB,
+		accounts:   accounts,
+		nowFn:      time.Now,
+	}
+}
+
+// BudgetPeriod returns the beginning of the billing period containing the given time.
+func BudgetPeriod(now time.Time) time.Time {
+	year, month, _ := now.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// GetSpending returns how many cents the project will be charged for the current billing period so far.
+// The amount is calculated from the usage totals with the project usage price model.
+func (s *ProjectBudgetService) GetSpending(ctx context.Context, project *Project) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := s.nowFn()
+	charges, err := s.accounts.ProjectCharges(ctx, project.OwnerID, BudgetPeriod(now), now)
+	if err != nil {
+		return 0, ErrProjectBudget.Wrap(err)
+	}
+
+	for _, charge := range charges {
+		if charge.ProjectID == project.PublicID {
+			return charge.StorageGbHrs + charge.Egress + charge.SegmentCount, nil
+		}
+	}
+
+	return 0, nil
+}
+
+// Cap zeroes the usage limits of the project, so that the existing limit
+// enforcement rejects further uploads and downloads. The previous limits are
+// kept with the budget, so that they can be restored by Uncap.
+func (s *ProjectBudgetService) Cap(ctx context.Context, budget *ProjectBudget) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if budget.IsCapped() {
+		return nil
+	}
+
+	project, err := s.projectsDB.Get(ctx, budget.ProjectID)
+	if err != nil {
+		return ErrProjectBudget.Wrap(err)
+	}
+
+	limits := NullableUsageLimits{Segment: project.SegmentLimit}
+	if project.StorageLimit != nil {
+		storage := project.StorageLimit.Int64()
+		limits.Storage = &storage
+	}
+	if project.BandwidthLimit != nil {
+		bandwidth := project.BandwidthLimit.Int64()
+		limits.Bandwidth = &bandwidth
+	}
+
+	now := s.nowFn()
+	err = s.budgetsDB.UpdateCap(ctx, budget.ProjectID, &limits, now)
+	if err != nil {
+		return ErrProjectBudget.Wrap(err)
+	}
+
+	err = s.projectsDB.UpdateUsageLimits(ctx, budget.ProjectID, UsageLimits{})
+	if err != nil {
+		return ErrProjectBudget.Wrap(err)
+	}
+
+	budget.CappedLimits = &limits
+	budget.CappedAt = &now
+	return nil
+}
+
+// Uncap restores the usage limits the project had before the hard cap was applied.
+// Limits which weren't set on the project are unset again.
+func (s *ProjectBudgetService) Uncap(ctx context.Context, budget *ProjectBudget) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !budget.IsCapped() {
+		return nil
+	}
+
+	err = s.projectsDB.UpdateNullableUsageLimits(ctx, budget.ProjectID, *budget.CappedLimits)
+	if err != nil {
+		return ErrProjectBudget.Wrap(err)
+	}
+
+	err = s.budgetsDB.UpdateCap(ctx, budget.ProjectID, nil, time.Time{})
+	if err != nil {
+		return ErrProjectBudget.Wrap(err)
+	}
+
+	budget.CappedLimits = nil
+	budget.CappedAt = nil
+	return nil
+}
+
+// TestSetNow allows tests to have the service act as if the current time is whatever they want.
+func (s *ProjectBudgetService) TestSetNow(nowFn func() time.Time) {
+	s.nowFn = nowFn
+}
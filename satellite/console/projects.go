@@ -54,6 +54,8 @@ type Projects interface {
 
 	// UpdateUsageLimits is a method for updating project's usage limits.
 	UpdateUsageLimits(ctx context.Context, id uuid.UUID, limits UsageLimits) error
+	// UpdateNullableUsageLimits is a method for updating project's usage limits, nil limits are unset.
+	UpdateNullableUsageLimits(ctx context.Context, id uuid.UUID, limits NullableUsageLimits) error
 }
 
 // UsageLimitsConfig is a configuration struct for default per-project usage limits.
@@ -23,3 +23,11 @@ type UsageLimits struct {
 	Bandwidth int64 `json:"bandwidth"`
 	Segment   int64 `json:"segment"`
 }
+
+// NullableUsageLimits represents storage, bandwidth, and segment limits of a project
+// where a nil limit isn't set and the default limit applies.
+type NullableUsageLimits struct {
+	Storage   *int64 `json:"storage"`
+	Bandwidth *int64 `json:"bandwidth"`
+	Segment   *int64 `json:"segment"`
+}
@@ -5,6 +5,7 @@ package console
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -23,8 +24,11 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"common/currency"
+	"common/encryption"
+	"common/grant"
 	"common/macaroon"
 	"common/memory"
+	"common/paths"
 	"common/storx"
 	"common/uuid"
 	"private/cfgstruct"
@@ -35,7 +39,11 @@ import (
 	"storx/satellite/analytics"
 	"storx/satellite/buckets"
 	"storx/satellite/console/consoleauth"
+	"storx/satellite/console/sso"
+	inventories "storx/satellite/inventory"
 	"storx/satellite/mailservice"
+	"storx/satellite/metabase"
+	"storx/satellite/orders"
 	"storx/satellite/payments"
 	"storx/satellite/payments/billing"
 )
@@ -65,10 +73,15 @@ const (
 	apiKeyWithNameDoesntExistErrMsg      = "An API Key with this name doesn't exist in this project."
 	teamMemberDoesNotExistErrMsg         = `There is no account on this Satellite for the user(s) you have entered.
 									     Please add team members with active accounts`
-	activationTokenExpiredErrMsg = "This activation token has expired, please request another one"
-	usedRegTokenErrMsg           = "This registration token has already been used"
-	projLimitErrMsg              = "Sorry, project creation is limited for your account. Please contact support!"
-	projNameErrMsg               = "The new project must have a name you haven't used before!"
+	activationTokenExpiredErrMsg      = "This activation token has expired, please request another one"
+	usedRegTokenErrMsg                = "This registration token has already been used"
+	projLimitErrMsg                   = "Sorry, project creation is limited for your account. Please contact support!"
+	projNameErrMsg                    = "The new project must have a name you haven't used before!"
+	accountExportInProgressErrMsg     = "Your account data export is already being prepared"
+	accountExportNotReadyErrMsg       = "Your account data export isn't ready yet"
+	accountDeletionScheduledErrMsg    = "Your account is already scheduled for deletion"
+	accountDeletionNotScheduledErrMsg = "Your account isn't scheduled for deletion"
+	ssoRequiredErrMsg                 = "Your organization requires you to log in through its identity provider"
 )
 
 var (
@@ -125,6 +138,13 @@ var (
 
 	// ErrPurchaseDesc is error that occurs when something is wrong with Purchase description.
 	ErrPurchaseDesc = errs.Class("purchase description")
+
+	// ErrNoAccountExport is error type that occurs when there is no account data export found.
+	ErrNoAccountExport = errs.Class("no account export found")
+
+	// ErrSSORequired is error type that occurs when a user whose email domain is handled
+	// by an identity provider tries to register or log in with a password.
+	ErrSSORequired = errs.Class("sso login required")
 )
 
 // Service is handling accounts related logic.
@@ -145,6 +165,10 @@ type Service struct {
 	analytics                  *analytics.Service
 	tokens                     *consoleauth.Service
 	mailService                *mailservice.Service
+	inventoryKeys              *orders.EncryptionKeys
+	accountFreezeService       *AccountFreezeService
+	budgets                    *ProjectBudgetService
+	deletions                  *AccountDeletionService
 
 	satelliteAddress string
 
@@ -171,9 +195,12 @@ type Config struct {
 	AsOfSystemTimeDuration      time.Duration `help:"default duration for AS OF SYSTEM TIME" devDefault:"-5m" releaseDefault:"-5m" testDefault:"0"`
 	LoginAttemptsWithoutPenalty int           `help:"number of times user can try to login without penalty" default:"3"`
 	FailedLoginPenalty          float64       `help:"incremental duration of penalty for failed login attempts in minutes" default:"2.0"`
+	APIKeyExpirationWarning     time.Duration `help:"how long before an api key expires it is flagged as expiring soon when listing the keys of a project" default:"168h"`
+	AccountDeletionGracePeriod  time.Duration `help:"how long a scheduled account deletion can be canceled before the account data is wiped" default:"720h"`
 	UsageLimits                 UsageLimitsConfig
 	Captcha                     CaptchaConfig
 	Session                     SessionConfig
+	SSO                         sso.Config
 }
 
 // CaptchaConfig contains configurations for login/registration captcha system.
@@ -209,7 +236,7 @@ type Payments struct {
 }
 
 // NewService returns new instance of Service.
-func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service, satelliteAddress string, config Config) (*Service, error) {
+func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting accounting.ProjectAccounting, projectUsage *accounting.Service, buckets buckets.DB, accounts payments.Accounts, depositWallets payments.DepositWallets, billing billing.TransactionsDB, analytics *analytics.Service, accountFreezeService *AccountFreezeService, tokens *consoleauth.Service, mailService *mailservice.Service, inventoryKeys *orders.EncryptionKeys, satelliteAddress string, config Config) (*Service, error) {
 	if store == nil {
 		return nil, errs.New("store can't be nil")
 	}
@@ -253,6 +280,10 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		analytics:                  analytics,
 		tokens:                     tokens,
 		mailService:                mailService,
+		inventoryKeys:              inventoryKeys,
+		accountFreezeService:       accountFreezeService,
+		budgets:                    NewProjectBudgetService(store.ProjectBudgets(), store.Projects(), accounts),
+		deletions:                  NewAccountDeletionService(store.AccountDeletions(), accountFreezeService, config.AccountDeletionGracePeriod),
 		satelliteAddress:           satelliteAddress,
 		config:                     config,
 	}, nil
@@ -729,6 +760,11 @@ func (s *Service) CreateUser(ctx context.Context, user CreateUser, tokenSecret R
 		return nil, err
 	}
 
+	if s.config.SSO.ProviderForEmail(user.Email) != nil {
+		mon.Counter("create_user_sso_required").Inc(1) //mon:locked
+		return nil, ErrSSORequired.New(ssoRequiredErrMsg)
+	}
+
 	registrationToken, err := s.checkRegistrationSecret(ctx, tokenSecret)
 	if err != nil {
 		return nil, ErrRegToken.Wrap(err)
@@ -1064,6 +1100,12 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 		}
 	}
 
+	if s.config.SSO.ProviderForEmail(request.Email) != nil {
+		mon.Counter("login_sso_required").Inc(1) //mon:locked
+		s.auditLog(ctx, "login: failed sso required", nil, request.Email)
+		return nil, ErrSSORequired.New(ssoRequiredErrMsg)
+	}
+
 	user, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, request.Email)
 	if user == nil {
 		if len(unverified) > 0 {
@@ -1203,6 +1245,184 @@ func (s *Service) Token(ctx context.Context, request AuthUser) (response *TokenI
 	return response, nil
 }
 
+// LoginWithSSO authenticates the user asserted by an identity provider and returns session token.
+// Users logging in for the first time are provisioned, and users are added to the projects
+// mapped to their identity provider groups.
+func (s *Service) LoginWithSSO(ctx context.Context, identity *sso.Identity, ip, userAgent string) (response *TokenInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	mon.Counter("login_sso_attempt").Inc(1) //mon:locked
+
+	provider := s.config.SSO.ProviderForEmail(identity.Email)
+	if provider == nil || provider.Name != identity.Provider {
+		s.auditLog(ctx, "login: failed sso provider mismatch", nil, identity.Email, zap.String("provider", identity.Provider))
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	user, err := s.provisionSSOUser(ctx, identity)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	if user.Status != Active {
+		mon.Counter("login_sso_inactive").Inc(1) //mon:locked
+		s.auditLog(ctx, "login: failed sso user not active", &user.ID, user.Email)
+		return nil, ErrUnauthorized.New(unauthorizedErrMsg)
+	}
+
+	err = s.addSSOProjectMemberships(ctx, user, identity.ProjectIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	response, err = s.GenerateSessionToken(ctx, user.ID, user.Email, ip, userAgent)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditLog(ctx, "login: sso", &user.ID, user.Email, zap.String("provider", identity.Provider))
+	mon.Counter("login_sso_success").Inc(1) //mon:locked
+
+	return response, nil
+}
+
+// provisionSSOUser returns the user with the email asserted by the identity provider
+// and creates an active user if there is none.
+func (s *Service) provisionSSOUser(ctx context.Context, identity *sso.Identity) (_ *User, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	verified, unverified, err := s.store.Users().GetByEmailWithUnverified(ctx, identity.Email)
+	if err != nil {
+		return nil, err
+	}
+	if verified != nil {
+		return verified, nil
+	}
+
+	active := Active
+
+	// the users of identity providers never log in with a password,
+	// so the password is random and never disclosed.
+	hash, err := s.randomPasswordHash()
+	if err != nil {
+		return nil, err
+	}
+
+	// the email is verified by the identity provider, so an account
+	// registered before SSO was enabled for its domain is activated.
+	// The password it was registered with is replaced, because whoever
+	// registered it didn't prove the ownership of the email.
+	if len(unverified) > 0 {
+		user := unverified[0]
+		err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{
+			Status:       &active,
+			PasswordHash: hash,
+		})
+		if err != nil {
+			return nil, err
+		}
+		user.Status = Active
+		user.PasswordHash = hash
+
+		s.auditLog(ctx, "sso: activate user", &user.ID, user.Email)
+		return &user, nil
+	}
+
+	userID, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	fullName := identity.FullName
+	if fullName == "" {
+		fullName = identity.Email[:strings.LastIndex(identity.Email, "@")]
+	}
+
+	user, err := s.store.Users().Insert(ctx, &User{
+		ID:                    userID,
+		Email:                 identity.Email,
+		FullName:              fullName,
+		PasswordHash:          hash,
+		ProjectLimit:          s.config.UsageLimits.Project.Free,
+		ProjectStorageLimit:   s.config.UsageLimits.Storage.Free.Int64(),
+		ProjectBandwidthLimit: s.config.UsageLimits.Bandwidth.Free.Int64(),
+		ProjectSegmentLimit:   s.config.UsageLimits.Segment.Free,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.Users().Update(ctx, user.ID, UpdateUserRequest{Status: &active})
+	if err != nil {
+		return nil, err
+	}
+	user.Status = Active
+
+	s.auditLog(ctx, "sso: create user", &user.ID, user.Email, zap.String("provider", identity.Provider))
+	mon.Counter("create_user_sso").Inc(1) //mon:locked
+
+	return user, nil
+}
+
+// randomPasswordHash returns the hash of a random password which is never disclosed.
+func (s *Service) randomPasswordHash() ([]byte, error) {
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return nil, err
+	}
+	return bcrypt.GenerateFromPassword(password, s.config.PasswordCost)
+}
+
+// addSSOProjectMemberships adds the user to the projects mapped to their identity provider groups.
+// Memberships are never removed, as they may have been granted by the project owners.
+func (s *Service) addSSOProjectMemberships(ctx context.Context, user *User, publicIDs []string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(publicIDs) == 0 {
+		return nil
+	}
+
+	memberships, err := s.store.ProjectMembers().GetByMemberID(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	isMember := make(map[uuid.UUID]bool, len(memberships))
+	for _, membership := range memberships {
+		isMember[membership.ProjectID] = true
+	}
+
+	for _, publicID := range publicIDs {
+		id, err := uuid.FromString(publicID)
+		if err != nil {
+			s.log.Warn("invalid project id mapped to identity provider group", zap.String("Project ID", publicID))
+			continue
+		}
+
+		project, err := s.store.Projects().GetByPublicID(ctx, id)
+		if err != nil {
+			if errs.Is(err, sql.ErrNoRows) {
+				s.log.Warn("project mapped to identity provider group doesn't exist", zap.String("Project ID", publicID))
+				continue
+			}
+			return err
+		}
+
+		if project.OwnerID == user.ID || isMember[project.ID] {
+			continue
+		}
+
+		_, err = s.store.ProjectMembers().Insert(ctx, user.ID, project.ID)
+		if err != nil {
+			return err
+		}
+		isMember[project.ID] = true
+
+		s.auditLog(ctx, "sso: add project member", &user.ID, user.Email, zap.String("projectID", project.ID.String()))
+	}
+
+	return nil
+}
+
 // UpdateUsersFailedLoginState updates User's failed login state.
 func (s *Service) UpdateUsersFailedLoginState(ctx context.Context, user *User) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2137,6 +2357,13 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 
 	projectID := isMember.project.ID
 
+	if err = requestInfo.APIKeyLimits.Validate(time.Now()); err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.Wrap(err),
+		}
+	}
+
 	_, err = s.store.APIKeys().GetByNameAndProjectID(ctx, requestInfo.Name, projectID)
 	if err == nil {
 		return nil, api.HTTPError{
@@ -2162,10 +2389,11 @@ func (s *Service) GenCreateAPIKey(ctx context.Context, requestInfo CreateAPIKeyR
 	}
 
 	apikey := APIKeyInfo{
-		Name:      requestInfo.Name,
-		ProjectID: projectID,
-		Secret:    secret,
-		UserAgent: user.UserAgent,
+		Name:         requestInfo.Name,
+		ProjectID:    projectID,
+		Secret:       secret,
+		UserAgent:    user.UserAgent,
+		APIKeyLimits: requestInfo.APIKeyLimits,
 	}
 
 	info, err := s.store.APIKeys().Create(ctx, key.Head(), apikey)
@@ -2388,9 +2616,47 @@ func (s *Service) GetAPIKeys(ctx context.Context, reqProjectID uuid.UUID, cursor
 		}
 	}
 
+	warnAfter := time.Now().Add(s.config.APIKeyExpirationWarning)
+	for i := range page.APIKeys {
+		page.APIKeys[i].ExpiringSoon = page.APIKeys[i].IsExpired(warnAfter)
+	}
+
 	return page, err
 }
 
+// UpdateAPIKeyLimits sets the server side expiration and quotas of an api key.
+// Nil values remove the corresponding limit.
+func (s *Service) UpdateAPIKeyLimits(ctx context.Context, id uuid.UUID, limits APIKeyLimits) (_ *APIKeyInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update api key limits", zap.String("apiKeyID", id.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	key, err := s.store.APIKeys().Get(ctx, id)
+	if err != nil {
+		return nil, ErrNoAPIKey.Wrap(err)
+	}
+
+	_, err = s.isProjectMember(ctx, user.ID, key.ProjectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	if err = limits.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+
+	key.APIKeyLimits = limits
+	err = s.store.APIKeys().Update(ctx, *key)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return key, nil
+}
+
 // CreateRESTKey creates a satellite rest key.
 func (s *Service) CreateRESTKey(ctx context.Context, expiration time.Duration) (apiKey string, expiresAt time.Time, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -2503,6 +2769,464 @@ func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_
 	return list, nil
 }
 
+// GetBucketLimits returns the usage limits of the bucket.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) GetBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string) (_ buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+
+	limits, err := s.buckets.GetBucketLimits(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return buckets.Limits{}, Error.Wrap(err)
+	}
+
+	return limits, nil
+}
+
+// UpdateBucketLimits sets the usage limits of the bucket. Only the project owner is allowed to change them.
+// Limits which are nil are removed from the bucket.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) UpdateBucketLimits(ctx context.Context, projectID uuid.UUID, bucketName string, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update bucket limits", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, limit := range []*int64{limits.Storage, limits.Bandwidth, limits.Segments} {
+		if limit != nil && *limit < 0 {
+			return ErrValidation.New("bucket limits can't be negative")
+		}
+	}
+
+	err = s.buckets.UpdateBucketLimits(ctx, []byte(bucketName), project.ID, limits)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetBucketInventory returns the inventory configuration of the bucket. The access grant of the
+// configuration isn't returned.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) GetBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (_ buckets.Inventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return buckets.Inventory{}, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return buckets.Inventory{}, Error.Wrap(err)
+	}
+
+	inventory, err := s.buckets.GetBucketInventory(ctx, []byte(bucketName), isMember.project.ID)
+	if err != nil {
+		return buckets.Inventory{}, Error.Wrap(err)
+	}
+
+	inventory.EncryptedAccessGrant = nil
+	return inventory.Inventory, nil
+}
+
+// UpdateBucketInventory configures the inventory of the bucket. Only the project owner is allowed to
+// change it. The inventory is uploaded with the access grant into a destination bucket of the same project.
+// The access grant is stored encrypted, because it contains the encryption keys of the project.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) UpdateBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string, inventory buckets.Inventory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "update bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if !inventory.Frequency.Valid() {
+		return ErrValidation.New("inventory frequency must be %q or %q", buckets.InventoryDaily, buckets.InventoryWeekly)
+	}
+	if inventory.DestinationBucket == "" {
+		return ErrValidation.New("inventory destination bucket is required")
+	}
+
+	exists, err := s.buckets.HasBucket(ctx, []byte(inventory.DestinationBucket), project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !exists {
+		return ErrValidation.New("inventory destination bucket %q doesn't exist", inventory.DestinationBucket)
+	}
+
+	access, err := grant.ParseAccess(inventory.AccessGrant)
+	if err != nil {
+		return ErrValidation.New("invalid inventory access grant")
+	}
+
+	keyInfo, err := s.store.APIKeys().GetByHead(ctx, access.APIKey.Head())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrValidation.New("inventory access grant doesn't belong to the project")
+		}
+		return Error.Wrap(err)
+	}
+	if keyInfo.ProjectID != project.ID {
+		return ErrValidation.New("inventory access grant doesn't belong to the project")
+	}
+
+	// the key of the manifest of the current period is checked, since the keys of all
+	// objects of an inventory are below the same prefix.
+	now := time.Now()
+	manifestKey := inventories.Prefix(buckets.BucketInventory{
+		BucketLocation: metabase.BucketLocation{ProjectID: project.ID, BucketName: bucketName},
+		Inventory:      inventory,
+	}, inventory.Frequency.PeriodStart(now)) + inventories.ManifestName
+
+	encryptedKey, err := encryption.EncryptPathWithStoreCipher(inventory.DestinationBucket, paths.NewUnencrypted(manifestKey), access.EncAccess.Store)
+	if err != nil {
+		return ErrValidation.New("inventory access grant can't encrypt keys in the destination bucket")
+	}
+
+	err = access.APIKey.Check(ctx, keyInfo.Secret, macaroon.Action{
+		Op:            macaroon.ActionWrite,
+		Bucket:        []byte(inventory.DestinationBucket),
+		EncryptedPath: []byte(encryptedKey.Raw()),
+		Time:          now,
+	}, noRevocations{})
+	if err != nil {
+		return ErrValidation.New("inventory access grant doesn't allow uploads to the destination")
+	}
+
+	if s.inventoryKeys == nil {
+		return Error.New("bucket inventories aren't configured")
+	}
+	inventory.EncryptedAccessGrant, err = inventories.EncryptAccessGrant(s.inventoryKeys, inventory.AccessGrant)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	inventory.AccessGrant = ""
+
+	err = s.buckets.UpdateBucketInventory(ctx, []byte(bucketName), project.ID, inventory)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// noRevocations doesn't revoke any key. Revocations are checked again when the
+// inventories are uploaded.
+type noRevocations struct{}
+
+// Check implements the revoker of macaroon.APIKey.Check.
+func (noRevocations) Check(ctx context.Context, tails [][]byte) (bool, error) { return false, nil }
+
+// DeleteBucketInventory stops generating the inventory of the bucket. Only the project owner is allowed
+// to delete it.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) DeleteBucketInventory(ctx context.Context, projectID uuid.UUID, bucketName string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete bucket inventory", zap.String("projectID", projectID.String()), zap.String("bucket", bucketName))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	err = s.buckets.DeleteBucketInventory(ctx, []byte(bucketName), project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return nil
+}
+
+// GetProjectBudget returns the budget of the project with its current spending and alert history.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) GetProjectBudget(ctx context.Context, projectID uuid.UUID) (_ *ProjectBudgetInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get project budget", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	info := &ProjectBudgetInfo{}
+
+	info.Budget, err = s.store.ProjectBudgets().Get(ctx, isMember.project.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, Error.Wrap(err)
+	}
+
+	info.Spent, err = s.budgets.GetSpending(ctx, isMember.project)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	info.Alerts, err = s.store.ProjectBudgets().GetAlerts(ctx, isMember.project.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return info, nil
+}
+
+// SetProjectBudget sets the monthly budget of the project in cents. Only the project owner is allowed to change it.
+// When hardCap is set, the project usage limits are zeroed once the budget is reached.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) SetProjectBudget(ctx context.Context, projectID uuid.UUID, amount int64, hardCap bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "set project budget", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if amount <= 0 {
+		return ErrValidation.New("budget amount must be positive")
+	}
+
+	err = s.store.ProjectBudgets().Upsert(ctx, project.ID, amount, hardCap)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// frozen accounts keep their zeroed limits until they are unfrozen.
+	frozen, err := s.accountFreezeService.IsUserFrozen(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if frozen {
+		return nil
+	}
+
+	// the hard cap is applied again by the budget chore if the new budget is still reached.
+	budget, err := s.store.ProjectBudgets().Get(ctx, project.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return Error.Wrap(s.budgets.Uncap(ctx, budget))
+}
+
+// DeleteProjectBudget removes the budget of the project and restores the project usage limits if the hard cap was applied.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) DeleteProjectBudget(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "delete project budget", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, project, err := s.isProjectOwner(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	budget, err := s.store.ProjectBudgets().Get(ctx, project.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return Error.Wrap(err)
+	}
+
+	if budget.IsCapped() {
+		// the limits before the hard cap are kept with the budget, so it can't
+		// be removed while the zeroed limits of a frozen account are kept.
+		frozen, err := s.accountFreezeService.IsUserFrozen(ctx, user.ID)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if frozen {
+			return ErrValidation.New("budget with an applied hard cap can't be removed while the account is frozen")
+		}
+
+		err = s.budgets.Uncap(ctx, budget)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	return Error.Wrap(s.store.ProjectBudgets().Delete(ctx, project.ID))
+}
+
+// RequestAccountDataExport requests an archive of all of the account data of the user.
+// The archive is generated asynchronously and the user is notified by email once it's ready.
+func (s *Service) RequestAccountDataExport(ctx context.Context) (_ *AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "request account data export")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	exports, err := s.store.AccountExports().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, export := range exports {
+		if export.Status == AccountExportPending {
+			return nil, ErrValidation.New(accountExportInProgressErrMsg)
+		}
+	}
+
+	export, err := s.store.AccountExports().Create(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return export, nil
+}
+
+// GetAccountDataExports returns the account data exports of the user, newest first.
+func (s *Service) GetAccountDataExports(ctx context.Context) (_ []AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get account data exports")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	exports, err := s.store.AccountExports().ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return exports, nil
+}
+
+// GetAccountDataExport returns the account data export of the user with its archive.
+func (s *Service) GetAccountDataExport(ctx context.Context, id uuid.UUID) (_ *AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get account data export", zap.String("exportID", id.String()))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	export, err := s.store.AccountExports().Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoAccountExport.New("%s", id)
+		}
+		return nil, Error.Wrap(err)
+	}
+	if export.UserID != user.ID {
+		return nil, ErrNoAccountExport.New("%s", id)
+	}
+	if export.Status != AccountExportReady {
+		return nil, ErrValidation.New(accountExportNotReadyErrMsg)
+	}
+
+	return export, nil
+}
+
+// ScheduleAccountDeletion schedules the deletion of the account of the user after the grace period.
+// The account is frozen until it's deleted or the deletion is canceled.
+func (s *Service) ScheduleAccountDeletion(ctx context.Context, password string) (_ *AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "schedule account deletion")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	err = bcrypt.CompareHashAndPassword(user.PasswordHash, []byte(password))
+	if err != nil {
+		return nil, ErrUnauthorized.New(credentialsErrMsg)
+	}
+
+	deletion, err := s.deletions.Get(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if deletion != nil {
+		return nil, ErrValidation.New(accountDeletionScheduledErrMsg)
+	}
+
+	deletion, err = s.deletions.Schedule(ctx, user.ID, AccountDeletionByUser)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return deletion, nil
+}
+
+// GetAccountDeletion returns the scheduled deletion of the account of the user.
+// It returns nil if no deletion is scheduled.
+func (s *Service) GetAccountDeletion(ctx context.Context) (_ *AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get account deletion")
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	deletion, err := s.deletions.Get(ctx, user.ID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return deletion, nil
+}
+
+// CancelAccountDeletion cancels the scheduled deletion of the account of the user.
+func (s *Service) CancelAccountDeletion(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "cancel account deletion")
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	deletion, err := s.deletions.Get(ctx, user.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if deletion == nil {
+		return ErrValidation.New(accountDeletionNotScheduledErrMsg)
+	}
+
+	return Error.Wrap(s.deletions.Cancel(ctx, user.ID))
+}
+
 // GetBucketUsageRollups retrieves summed usage rollups for every bucket of particular project for a given period.
 // projectID here may be Project.ID or Project.PublicID.
 func (s *Service) GetBucketUsageRollups(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketUsageRollup, err error) {
@@ -27,12 +27,16 @@ import (
 	"storx/private/blockchain"
 	"storx/private/testplanet"
 	"storx/satellite"
+	"storx/satellite/buckets"
 	"storx/satellite/console"
+	"storx/satellite/console/sso"
+	"storx/satellite/inventory"
 	"storx/satellite/payments"
 	"storx/satellite/payments/coinpayments"
 	"storx/satellite/payments/storxscan"
 	"storx/satellite/payments/storxscan/blockchaintest"
 	"storx/satellite/payments/stripecoinpayments"
+	"uplink"
 )
 
 func TestService(t *testing.T) {
@@ -1696,3 +1700,223 @@ func TestServiceGenMethods(t *testing.T) {
 		}
 	})
 }
+
+func TestLoginWithSSO(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Console.SSO = sso.Config{
+					Enabled: true,
+					Providers: sso.Providers{{
+						Name:         "acme",
+						IssuerURL:    "https://idp.acme.test",
+						ClientID:     "client-id",
+						EmailDomains: []string{"acme.test"},
+					}},
+				}
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		usersDB := sat.DB.Console().Users()
+
+		owner, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Project Owner",
+			Email:    "owner@mail.test",
+		}, 1)
+		require.NoError(t, err)
+
+		project, err := sat.AddProject(ctx, owner.ID, "mapped")
+		require.NoError(t, err)
+
+		identity := &sso.Identity{
+			Provider: "acme",
+			Subject:  "user-1",
+			Email:    "user@acme.test",
+			FullName: "Acme User",
+			// unknown and malformed project IDs are skipped.
+			ProjectIDs: []string{project.PublicID.String(), testrand.UUID().String(), "invalid"},
+		}
+
+		token, err := service.LoginWithSSO(ctx, identity, "127.0.0.1", "")
+		require.NoError(t, err)
+		require.NotNil(t, token)
+
+		user, err := usersDB.GetByEmail(ctx, identity.Email)
+		require.NoError(t, err)
+		require.Equal(t, console.Active, user.Status)
+		require.Equal(t, identity.FullName, user.FullName)
+
+		memberships, err := sat.DB.Console().ProjectMembers().GetByMemberID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, memberships, 1)
+		require.Equal(t, project.ID, memberships[0].ProjectID)
+
+		// logging in again reuses the user and the memberships.
+		_, err = service.LoginWithSSO(ctx, identity, "127.0.0.1", "")
+		require.NoError(t, err)
+
+		again, err := usersDB.GetByEmail(ctx, identity.Email)
+		require.NoError(t, err)
+		require.Equal(t, user.ID, again.ID)
+
+		memberships, err = sat.DB.Console().ProjectMembers().GetByMemberID(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, memberships, 1)
+
+		// identities are only accepted from the provider of the email domain.
+		_, err = service.LoginWithSSO(ctx, &sso.Identity{Provider: "other", Email: "user@acme.test"}, "127.0.0.1", "")
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		_, err = service.LoginWithSSO(ctx, &sso.Identity{Provider: "acme", Email: "user@mail.test"}, "127.0.0.1", "")
+		require.True(t, console.ErrUnauthorized.Has(err))
+
+		// users of the email domain can't log in or register with a password.
+		_, err = service.Token(ctx, console.AuthUser{Email: identity.Email, Password: "password"})
+		require.True(t, console.ErrSSORequired.Has(err))
+
+		_, err = service.CreateUser(ctx, console.CreateUser{
+			FullName: "Other Acme User",
+			Email:    "other@acme.test",
+			Password: "password123",
+		}, console.RegistrationSecret{})
+		require.True(t, console.ErrSSORequired.Has(err))
+
+		// an account registered before SSO was enabled is activated,
+		// but the password it was registered with can't be used anymore.
+		pendingHash, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+		require.NoError(t, err)
+		pending, err := usersDB.Insert(ctx, &console.User{
+			ID:           testrand.UUID(),
+			FullName:     "Pending Acme User",
+			Email:        "pending@acme.test",
+			PasswordHash: pendingHash,
+		})
+		require.NoError(t, err)
+
+		_, err = service.LoginWithSSO(ctx, &sso.Identity{Provider: "acme", Subject: "user-2", Email: pending.Email}, "127.0.0.1", "")
+		require.NoError(t, err)
+
+		activated, err := usersDB.Get(ctx, pending.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.Active, activated.Status)
+		require.Error(t, bcrypt.CompareHashAndPassword(activated.PasswordHash, []byte("password123")))
+	})
+}
+
+func TestProjectBudgetFrozenAccount(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.DB.Console()
+		service := sat.API.Console.Service
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		project, err := db.Projects().Get(ctx, projectID)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, project.OwnerID)
+		require.NoError(t, err)
+
+		storageLimit := memory.TB.Int64()
+		require.NoError(t, db.Projects().UpdateNullableUsageLimits(ctx, projectID, console.NullableUsageLimits{
+			Storage: &storageLimit,
+		}))
+
+		require.NoError(t, service.SetProjectBudget(userCtx, projectID, 100, true))
+
+		budget, err := db.ProjectBudgets().Get(ctx, projectID)
+		require.NoError(t, err)
+
+		budgets := console.NewProjectBudgetService(db.ProjectBudgets(), db.Projects(), sat.API.Payments.Accounts)
+		require.NoError(t, budgets.Cap(ctx, budget))
+
+		freezes := console.NewAccountFreezeService(db.AccountFreezeEvents(), db.Users(), db.Projects(), sat.API.Analytics.Service)
+		require.NoError(t, freezes.FreezeUser(ctx, project.OwnerID))
+
+		// the zeroed limits are kept while the account is frozen.
+		require.NoError(t, service.SetProjectBudget(userCtx, projectID, 200, true))
+
+		budget, err = db.ProjectBudgets().Get(ctx, projectID)
+		require.NoError(t, err)
+		require.True(t, budget.IsCapped())
+
+		err = service.DeleteProjectBudget(userCtx, projectID)
+		require.True(t, console.ErrValidation.Has(err), err)
+
+		require.NoError(t, freezes.UnfreezeUser(ctx, project.OwnerID))
+		require.NoError(t, service.DeleteProjectBudget(userCtx, projectID))
+
+		// limits which weren't set are unset again.
+		restored, err := db.Projects().Get(ctx, projectID)
+		require.NoError(t, err)
+		require.NotNil(t, restored.StorageLimit)
+		require.Equal(t, storageLimit, restored.StorageLimit.Int64())
+		require.Nil(t, restored.BandwidthLimit)
+		require.Nil(t, restored.SegmentLimit)
+	})
+}
+
+func TestUpdateBucketInventory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.API.Console.Service
+		upl := planet.Uplinks[0]
+		projectID := upl.Projects[0].ID
+
+		project, err := sat.DB.Console().Projects().Get(ctx, projectID)
+		require.NoError(t, err)
+
+		userCtx, err := sat.UserContext(ctx, project.OwnerID)
+		require.NoError(t, err)
+
+		require.NoError(t, upl.CreateBucket(ctx, sat, "testbucket"))
+		require.NoError(t, upl.CreateBucket(ctx, sat, "inventories"))
+
+		share := func(permission uplink.Permission, prefix string) string {
+			access, err := upl.Access[sat.ID()].Share(permission, uplink.SharePrefix{Bucket: "inventories", Prefix: prefix})
+			require.NoError(t, err)
+			serialized, err := access.Serialize()
+			require.NoError(t, err)
+			return serialized
+		}
+
+		config := buckets.Inventory{
+			Frequency:         buckets.InventoryDaily,
+			DestinationBucket: "inventories",
+			DestinationPrefix: "reports/",
+		}
+
+		for _, accessGrant := range []string{
+			share(uplink.ReadOnlyPermission(), ""),
+			share(uplink.WriteOnlyPermission(), "other/"),
+		} {
+			config.AccessGrant = accessGrant
+			err = service.UpdateBucketInventory(userCtx, projectID, "testbucket", config)
+			require.True(t, console.ErrValidation.Has(err), err)
+		}
+
+		config.AccessGrant = share(uplink.WriteOnlyPermission(), "reports/")
+		require.NoError(t, service.UpdateBucketInventory(userCtx, projectID, "testbucket", config))
+
+		// the access grant is stored encrypted and isn't returned.
+		stored, err := sat.DB.Buckets().GetBucketInventory(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.Empty(t, stored.AccessGrant)
+		require.NotContains(t, string(stored.EncryptedAccessGrant), config.AccessGrant)
+
+		decrypted, err := inventory.DecryptAccessGrant(&sat.Config.Inventory.EncryptionKeys, stored.EncryptedAccessGrant)
+		require.NoError(t, err)
+		require.Equal(t, config.AccessGrant, decrypted)
+
+		configured, err := service.GetBucketInventory(userCtx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Empty(t, configured.AccessGrant)
+		require.Empty(t, configured.EncryptedAccessGrant)
+	})
+}
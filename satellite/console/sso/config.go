@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package sso
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// Config contains configurations for logging in to the satellite console
+// through external OpenID Connect identity providers.
+type Config struct {
+	Enabled     bool          `help:"whether users of the email domains of the identity providers log in through them instead of with a password" default:"false"`
+	Providers   Providers     `help:"identity providers in JSON list format, e.g. [{\"name\":\"acme\",\"issuerURL\":\"https://idp.acme.com\",\"clientID\":\"id\",\"clientSecret\":\"secret\",\"emailDomains\":[\"acme.com\"],\"groupProjects\":{\"storage\":[\"<project public id>\"]}}]" default:"[]"`
+	StateExpiry time.Duration `help:"how long a user has to complete the login at the identity provider" default:"10m"`
+}
+
+// ProviderForEmail returns the configuration of the identity provider
+// which handles the domain of the email. It returns nil if SSO is disabled
+// or no identity provider handles the domain.
+func (config Config) ProviderForEmail(email string) *ProviderConfig {
+	if !config.Enabled {
+		return nil
+	}
+
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return nil
+	}
+	domain := strings.ToLower(email[at+1:])
+
+	for i := range config.Providers {
+		for _, providerDomain := range config.Providers[i].EmailDomains {
+			if strings.ToLower(providerDomain) == domain {
+				return &config.Providers[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// Providers is a configuration value that contains a list of identity providers.
+//
+// Can be used as a flag.
+type Providers []ProviderConfig
+
+// ProviderConfig contains the configuration of an OpenID Connect identity provider.
+// Identity providers which only support SAML can be used through an OpenID Connect bridge.
+type ProviderConfig struct {
+	// Name identifies the provider in the login URLs.
+	Name string `json:"name"`
+	// IssuerURL is the issuer identifier of the provider, used for the discovery of its endpoints.
+	IssuerURL    string `json:"issuerURL"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+	// EmailDomains are the email domains whose users must log in through the provider.
+	EmailDomains []string `json:"emailDomains"`
+	// Scopes are requested in addition to the openid scope. Defaults to email, profile and groups.
+	Scopes []string `json:"scopes,omitempty"`
+	// GroupsClaim is the ID token claim with the groups of the user. Defaults to groups.
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// GroupProjects maps the groups of the provider to the public IDs of the projects
+	// which the members of the group are added to when they log in.
+	GroupProjects map[string][]string `json:"groupProjects,omitempty"`
+}
+
+// Type implements pflag.Value.
+func (Providers) Type() string { return "sso.Providers" }
+
+// String is required for pflag.Value.
+func (providers *Providers) String() string {
+	data, err := json.Marshal(*providers)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// Set does validation on the configured JSON.
+func (providers *Providers) Set(s string) (err error) {
+	var configs []ProviderConfig
+
+	err = json.Unmarshal([]byte(s), &configs)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{}, len(configs))
+	for _, config := range configs {
+		if config.Name == "" || config.IssuerURL == "" || config.ClientID == "" {
+			return Error.New("provider name, issuer url and client id are required")
+		}
+		if _, ok := names[config.Name]; ok {
+			return Error.New("duplicate provider name %q", config.Name)
+		}
+		names[config.Name] = struct{}{}
+	}
+
+	*providers = configs
+	return nil
+}
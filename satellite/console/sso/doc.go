@@ -0,0 +1,7 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+// Package sso provides the login to the satellite console through the external OpenID Connect
+// identity providers of organizations, with the satellite acting as the relying party. Identity
+// providers which only support SAML can be used through an OpenID Connect bridge.
+package sso
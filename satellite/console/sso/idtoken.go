@@ -0,0 +1,219 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package sso
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// clockSkew is the tolerated difference between the clocks of the satellite and the identity provider.
+const clockSkew = time.Minute
+
+// idTokenClaims are the claims of an ID token used by the service.
+type idTokenClaims struct {
+	Issuer        string   `json:"iss"`
+	Subject       string   `json:"sub"`
+	Audience      audience `json:"aud"`
+	Expiry        int64    `json:"exp"`
+	IssuedAt      int64    `json:"iat"`
+	Nonce         string   `json:"nonce"`
+	Email         string   `json:"email"`
+	EmailVerified *bool    `json:"email_verified"`
+	Name          string   `json:"name"`
+
+	// raw contains all the claims, including the groups claim configured for the provider.
+	raw map[string]json.RawMessage
+}
+
+// groups returns the groups of the user from the configured claim.
+func (claims *idTokenClaims) groups(claim string) []string {
+	if claim == "" {
+		claim = "groups"
+	}
+
+	raw, ok := claims.raw[claim]
+	if !ok {
+		return nil
+	}
+
+	var groups []string
+	if err := json.Unmarshal(raw, &groups); err == nil {
+		return groups
+	}
+
+	// some identity providers send a single group as a string.
+	var group string
+	if err := json.Unmarshal(raw, &group); err == nil && group != "" {
+		return []string{group}
+	}
+
+	return nil
+}
+
+// audience is the aud claim, which is either a string or a list of strings.
+type audience []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (aud *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*aud = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+	*aud = multiple
+	return nil
+}
+
+func (aud audience) contains(clientID string) bool {
+	for _, a := range aud {
+		if a == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// jwtHeader is the header of a JSON web token.
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+}
+
+// jsonWebKey is a public key of a JSON web key set.
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	N       string `json:"n"`
+	E       string `json:"e"`
+}
+
+// verifyIDToken checks the signature, the issuer, the audience and the expiration of the ID token.
+func (service *Service) verifyIDToken(ctx context.Context, p *provider, rawIDToken string) (_ *idTokenClaims, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	parts := strings.Split(rawIDToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidIDToken.New("malformed token")
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidIDToken.Wrap(err)
+	}
+	var header jwtHeader
+	if err := json.Unmarshal(headerJSON, &header); err != nil {
+		return nil, ErrInvalidIDToken.Wrap(err)
+	}
+	// only RS256 is accepted, which is the algorithm every OpenID Connect provider must support.
+	if header.Algorithm != "RS256" {
+		return nil, ErrInvalidIDToken.New("unsupported signing algorithm %q", header.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidIDToken.Wrap(err)
+	}
+
+	key, err := service.signingKey(ctx, p, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		return nil, ErrInvalidIDToken.New("invalid signature")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidIDToken.Wrap(err)
+	}
+	var claims idTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidIDToken.Wrap(err)
+	}
+	if err := json.Unmarshal(payload, &claims.raw); err != nil {
+		return nil, ErrInvalidIDToken.Wrap(err)
+	}
+
+	if strings.TrimSuffix(claims.Issuer, "/") != strings.TrimSuffix(p.config.IssuerURL, "/") {
+		return nil, ErrInvalidIDToken.New("unexpected issuer %q", claims.Issuer)
+	}
+	if !claims.Audience.contains(p.config.ClientID) {
+		return nil, ErrInvalidIDToken.New("token isn't issued for the satellite")
+	}
+	if service.nowFn().After(time.Unix(claims.Expiry, 0).Add(clockSkew)) {
+		return nil, ErrInvalidIDToken.New("token expired")
+	}
+
+	return &claims, nil
+}
+
+// signingKey returns the public key of the identity provider with the key ID.
+// The key set is fetched again when the key is unknown, as providers rotate their keys.
+func (service *Service) signingKey(ctx context.Context, p *provider, keyID string) (_ *rsa.PublicKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if key, ok := p.keys[keyID]; ok {
+		return key, nil
+	}
+
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	err = service.getJSON(ctx, p.discovery.JWKSURI, &keySet)
+	if err != nil {
+		return nil, Error.New("fetching keys of %q failed: %v", p.config.Name, err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.KeyType != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			service.log.Warn("skipping malformed key", zap.String("Provider", p.config.Name), zap.String("Key ID", jwk.KeyID))
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			service.log.Warn("skipping malformed key", zap.String("Provider", p.config.Name), zap.String("Key ID", jwk.KeyID))
+			continue
+		}
+
+		keys[jwk.KeyID] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+	p.keys = keys
+
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, ErrInvalidIDToken.New("unknown signing key %q", keyID)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,255 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package sso
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// Error is the default error class for the sso package.
+	Error = errs.Class("sso")
+	// ErrUnknownProvider is returned when no identity provider is configured with the requested name.
+	ErrUnknownProvider = errs.Class("unknown identity provider")
+	// ErrInvalidIDToken is returned when the ID token returned by the identity provider can't be trusted.
+	ErrInvalidIDToken = errs.Class("invalid id token")
+
+	mon = monkit.Package()
+)
+
+// defaultScopes are requested from the identity providers which don't configure their scopes.
+var defaultScopes = []string{"email", "profile", "groups"}
+
+// Identity is the identity of a user asserted by an identity provider.
+type Identity struct {
+	Provider string
+	Subject  string
+	Email    string
+	FullName string
+	Groups   []string
+	// ProjectIDs are the public IDs of the projects mapped to the groups of the user.
+	ProjectIDs []string
+}
+
+// Service logs users in through OpenID Connect identity providers
+// using the authorization code flow.
+type Service struct {
+	log        *zap.Logger
+	config     Config
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	providers map[string]*provider
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new sso service.
+func NewService(log *zap.Logger, config Config, redirectURL string) *Service {
+	service := &Service{
+		log:        log,
+		config:     config,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		providers:  make(map[string]*provider, len(config.Providers)),
+		nowFn:      time.Now,
+	}
+
+	for _, providerConfig := range config.Providers {
+		scopes := providerConfig.Scopes
+		if len(scopes) == 0 {
+			scopes = defaultScopes
+		}
+		service.providers[providerConfig.Name] = &provider{
+			config: providerConfig,
+			oauth: oauth2.Config{
+				ClientID:     providerConfig.ClientID,
+				ClientSecret: providerConfig.ClientSecret,
+				RedirectURL:  strings.TrimSuffix(redirectURL, "/") + "/" + providerConfig.Name,
+				Scopes:       append([]string{"openid"}, scopes...),
+			},
+		}
+	}
+
+	return service
+}
+
+// Config returns the configuration of the service.
+func (service *Service) Config() Config {
+	return service.config
+}
+
+// AuthCodeURL returns the URL of the identity provider where the user logs in.
+// The state and the nonce must be checked when the user is redirected back.
+func (service *Service) AuthCodeURL(ctx context.Context, providerName, state, nonce, email string) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	p, err := service.provider(ctx, providerName)
+	if err != nil {
+		return "", err
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("nonce", nonce)}
+	if email != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("login_hint", email))
+	}
+
+	return p.oauth.AuthCodeURL(state, opts...), nil
+}
+
+// Exchange exchanges the authorization code returned by the identity provider
+// for an ID token and returns the identity of the user it asserts.
+func (service *Service) Exchange(ctx context.Context, providerName, code, nonce string) (_ *Identity, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	p, err := service.provider(ctx, providerName)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := p.oauth.Exchange(context.WithValue(ctx, oauth2.HTTPClient, service.httpClient), code)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, ErrInvalidIDToken.New("token response doesn't contain an id token")
+	}
+
+	claims, err := service.verifyIDToken(ctx, p, rawIDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Nonce != nonce {
+		return nil, ErrInvalidIDToken.New("nonce mismatch")
+	}
+	if claims.Email == "" {
+		return nil, ErrInvalidIDToken.New("id token doesn't contain an email")
+	}
+	if claims.EmailVerified != nil && !*claims.EmailVerified {
+		return nil, ErrInvalidIDToken.New("email isn't verified by the identity provider")
+	}
+
+	// the identity provider may only assert the identities of its own domains.
+	if domainProvider := service.config.ProviderForEmail(claims.Email); domainProvider == nil || domainProvider.Name != p.config.Name {
+		return nil, ErrInvalidIDToken.New("email domain isn't handled by the identity provider")
+	}
+
+	identity := &Identity{
+		Provider: p.config.Name,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		FullName: claims.Name,
+		Groups:   claims.groups(p.config.GroupsClaim),
+	}
+
+	seen := make(map[string]struct{})
+	for _, group := range identity.Groups {
+		for _, projectID := range p.config.GroupProjects[group] {
+			if _, ok := seen[projectID]; ok {
+				continue
+			}
+			seen[projectID] = struct{}{}
+			identity.ProjectIDs = append(identity.ProjectIDs, projectID)
+		}
+	}
+
+	return identity, nil
+}
+
+// provider returns the identity provider with the endpoints from its discovery document.
+func (service *Service) provider(ctx context.Context, name string) (_ *provider, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	p, ok := service.providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider.New("%q", name)
+	}
+
+	if p.discovery == nil {
+		var discovery discoveryDocument
+		err = service.getJSON(ctx, strings.TrimSuffix(p.config.IssuerURL, "/")+"/.well-known/openid-configuration", &discovery)
+		if err != nil {
+			return nil, Error.New("discovery of %q failed: %v", name, err)
+		}
+		if strings.TrimSuffix(discovery.Issuer, "/") != strings.TrimSuffix(p.config.IssuerURL, "/") {
+			return nil, Error.New("discovery of %q returned issuer %q", name, discovery.Issuer)
+		}
+
+		p.discovery = &discovery
+		p.oauth.Endpoint = oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		}
+	}
+
+	return p, nil
+}
+
+// getJSON fetches and decodes a JSON document.
+func (service *Service) getJSON(ctx context.Context, url string, v interface{}) (err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := service.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, resp.Body.Close()) }()
+
+	if resp.StatusCode != http.StatusOK {
+		return errs.New("unexpected status %q from %s", resp.Status, url)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// TestSetNow allows tests to have the service act as if the current time is whatever they want.
+func (service *Service) TestSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// NewState returns a random value suitable for the state and the nonce of a login.
+func NewState() (string, error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
+// provider is a configured identity provider.
+type provider struct {
+	config    ProviderConfig
+	oauth     oauth2.Config
+	discovery *discoveryDocument
+	keys      map[string]*rsa.PublicKey
+}
+
+// discoveryDocument contains the OpenID Connect provider metadata used by the service.
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
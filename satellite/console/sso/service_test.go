@@ -0,0 +1,135 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package sso_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"common/testcontext"
+	"storx/satellite/console/sso"
+	"storx/satellite/console/sso/ssotest"
+)
+
+func TestProviderForEmail(t *testing.T) {
+	config := sso.Config{
+		Enabled: true,
+		Providers: sso.Providers{
+			{Name: "acme", EmailDomains: []string{"acme.test", "Acme.example"}},
+			{Name: "other", EmailDomains: []string{"other.test"}},
+		},
+	}
+
+	require.Equal(t, "acme", config.ProviderForEmail("user@acme.test").Name)
+	require.Equal(t, "acme", config.ProviderForEmail("user@ACME.example").Name)
+	require.Equal(t, "other", config.ProviderForEmail("user@other.test").Name)
+	require.Nil(t, config.ProviderForEmail("user@sub.acme.test"))
+	require.Nil(t, config.ProviderForEmail("invalid"))
+
+	config.Enabled = false
+	require.Nil(t, config.ProviderForEmail("user@acme.test"))
+}
+
+func TestProvidersSet(t *testing.T) {
+	var providers sso.Providers
+
+	require.NoError(t, providers.Set(`[{"name":"acme","issuerURL":"https://idp.acme.test","clientID":"id","emailDomains":["acme.test"]}]`))
+	require.Len(t, providers, 1)
+	require.Equal(t, "https://idp.acme.test", providers[0].IssuerURL)
+
+	require.Error(t, providers.Set(`[{"name":"acme"}]`))
+	require.Error(t, providers.Set(`[{"name":"a","issuerURL":"u","clientID":"c"},{"name":"a","issuerURL":"u","clientID":"c"}]`))
+	require.Error(t, providers.Set(`{`))
+}
+
+func TestLogin(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	idp, err := ssotest.NewIdP("client-id", "client-secret")
+	require.NoError(t, err)
+	defer idp.Close()
+
+	service := sso.NewService(zaptest.NewLogger(t), sso.Config{
+		Enabled: true,
+		Providers: sso.Providers{{
+			Name:          "acme",
+			IssuerURL:     idp.Issuer(),
+			ClientID:      idp.ClientID,
+			ClientSecret:  idp.ClientSecret,
+			EmailDomains:  []string{"acme.test"},
+			GroupProjects: map[string][]string{"storage": {"project-a", "project-b"}, "admins": {"project-a"}},
+		}},
+	}, "https://satellite.test/api/v0/auth/sso/callback")
+
+	login := func(claims map[string]interface{}) (*sso.Identity, error) {
+		authURL, err := service.AuthCodeURL(ctx, "acme", "state", "nonce", "user@acme.test")
+		require.NoError(t, err)
+
+		redirect, err := idp.Authorize(authURL, claims)
+		require.NoError(t, err)
+
+		u, err := url.Parse(redirect)
+		require.NoError(t, err)
+		require.Equal(t, "/api/v0/auth/sso/callback/acme", u.Path)
+		require.Equal(t, "state", u.Query().Get("state"))
+
+		return service.Exchange(ctx, "acme", u.Query().Get("code"), "nonce")
+	}
+
+	identity, err := login(map[string]interface{}{
+		"sub":            "user-1",
+		"email":          "user@acme.test",
+		"email_verified": true,
+		"name":           "Test User",
+		"groups":         []string{"storage", "admins", "unmapped"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "acme", identity.Provider)
+	require.Equal(t, "user-1", identity.Subject)
+	require.Equal(t, "user@acme.test", identity.Email)
+	require.Equal(t, "Test User", identity.FullName)
+	require.Equal(t, []string{"storage", "admins", "unmapped"}, identity.Groups)
+	require.Equal(t, []string{"project-a", "project-b"}, identity.ProjectIDs)
+
+	for name, claims := range map[string]map[string]interface{}{
+		"wrong nonce":    {"email": "user@acme.test", "nonce": "other"},
+		"wrong audience": {"email": "user@acme.test", "aud": "other-client"},
+		"wrong issuer":   {"email": "user@acme.test", "iss": "https://evil.test"},
+		"expired":        {"email": "user@acme.test", "exp": time.Now().Add(-time.Hour).Unix()},
+		"unverified":     {"email": "user@acme.test", "email_verified": false},
+		"foreign domain": {"email": "user@other.test"},
+		"missing email":  {},
+		"audience list":  {"email": "user@acme.test", "aud": []string{"other-client"}},
+	} {
+		_, err := login(claims)
+		require.Error(t, err, name)
+		require.True(t, sso.ErrInvalidIDToken.Has(err), name)
+	}
+
+	identity, err = login(map[string]interface{}{
+		"email":  "user@acme.test",
+		"aud":    []string{"other-client", idp.ClientID},
+		"groups": "storage",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"storage"}, identity.Groups)
+
+	// the keys are fetched again when the identity provider rotates its signing key.
+	require.NoError(t, idp.RotateKey())
+	_, err = login(map[string]interface{}{"email": "user@acme.test"})
+	require.NoError(t, err)
+
+	// tokens modified after they were signed are rejected.
+	idp.Tamper = true
+	_, err = login(map[string]interface{}{"email": "user@acme.test"})
+	require.True(t, sso.ErrInvalidIDToken.Has(err))
+	idp.Tamper = false
+
+	_, err = service.AuthCodeURL(ctx, "unknown", "state", "nonce", "")
+	require.True(t, sso.ErrUnknownProvider.Has(err))
+}
@@ -0,0 +1,250 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+// Package ssotest implements a mock OpenID Connect identity provider for tests.
+package ssotest
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the error class for the mock identity provider.
+var Error = errs.Class("ssotest")
+
+// IdP is a mock OpenID Connect identity provider which issues RS256 signed ID tokens
+// with the claims set by the test.
+type IdP struct {
+	Server       *httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	// Tamper makes the identity provider modify the claims of the ID tokens after signing them.
+	Tamper bool
+
+	mu    sync.Mutex
+	key   *rsa.PrivateKey
+	keyID string
+	codes map[string]map[string]interface{}
+}
+
+// NewIdP starts a new mock identity provider.
+func NewIdP(clientID, clientSecret string) (*IdP, error) {
+	idp := &IdP{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		codes:        make(map[string]map[string]interface{}),
+	}
+	if err := idp.RotateKey(); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", idp.discovery)
+	mux.HandleFunc("/keys", idp.keys)
+	mux.HandleFunc("/token", idp.token)
+	idp.Server = httptest.NewServer(mux)
+
+	return idp, nil
+}
+
+// Issuer returns the issuer identifier of the identity provider.
+func (idp *IdP) Issuer() string { return idp.Server.URL }
+
+// Close stops the identity provider.
+func (idp *IdP) Close() { idp.Server.Close() }
+
+// RotateKey replaces the signing key of the identity provider with a new key.
+func (idp *IdP) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	keyID, err := randomString()
+	if err != nil {
+		return err
+	}
+
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+
+	idp.key = key
+	idp.keyID = keyID
+	return nil
+}
+
+// Authorize simulates a user logging in at the identity provider: it issues an
+// authorization code for the ID token claims and returns the URL the user is
+// redirected back to. The claims override the default claims of the ID token.
+func (idp *IdP) Authorize(authURL string, claims map[string]interface{}) (redirectURL string, err error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	query := u.Query()
+	if query.Get("client_id") != idp.ClientID {
+		return "", Error.New("unexpected client id %q", query.Get("client_id"))
+	}
+
+	redirect, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	code, err := randomString()
+	if err != nil {
+		return "", err
+	}
+
+	idTokenClaims := map[string]interface{}{
+		"iss":   idp.Issuer(),
+		"aud":   idp.ClientID,
+		"sub":   "subject",
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"nonce": query.Get("nonce"),
+	}
+	for k, v := range claims {
+		idTokenClaims[k] = v
+	}
+
+	idp.mu.Lock()
+	idp.codes[code] = idTokenClaims
+	idp.mu.Unlock()
+
+	redirectQuery := redirect.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", query.Get("state"))
+	redirect.RawQuery = redirectQuery.Encode()
+
+	return redirect.String(), nil
+}
+
+// SignIDToken returns an ID token with the claims signed by the identity provider.
+func (idp *IdP) SignIDToken(claims map[string]interface{}) (string, error) {
+	idp.mu.Lock()
+	key, keyID := idp.key, idp.keyID
+	idp.mu.Unlock()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": keyID})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+
+	if idp.Tamper {
+		claims["email"] = "attacker@example.test"
+		payload, err = json.Marshal(claims)
+		if err != nil {
+			return "", Error.Wrap(err)
+		}
+		signed = base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (idp *IdP) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{
+		"issuer":                 idp.Issuer(),
+		"authorization_endpoint": idp.Issuer() + "/authorize",
+		"token_endpoint":         idp.Issuer() + "/token",
+		"jwks_uri":               idp.Issuer() + "/keys",
+	})
+}
+
+func (idp *IdP) keys(w http.ResponseWriter, r *http.Request) {
+	idp.mu.Lock()
+	key, keyID := idp.key, idp.keyID
+	idp.mu.Unlock()
+
+	writeJSON(w, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+}
+
+func (idp *IdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != idp.ClientID || clientSecret != idp.ClientSecret {
+		writeJSONStatus(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	// authorization codes may only be used once.
+	idp.mu.Lock()
+	claims, ok := idp.codes[r.PostForm.Get("code")]
+	delete(idp.codes, r.PostForm.Get("code"))
+	idp.mu.Unlock()
+	if !ok {
+		writeJSONStatus(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	idToken, err := idp.SignIDToken(claims)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"access_token": "access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	writeJSONStatus(w, http.StatusOK, v)
+}
+
+func writeJSONStatus(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func randomString() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"common/uuid"
+)
+
+// MaxUsageExportDays is the maximum number of days which can be exported at once.
+const MaxUsageExportDays = 366
+
+// UsageExportRow contains the usage of a single bucket during a single day.
+type UsageExportRow struct {
+	Date       time.Time `json:"date"`
+	ProjectID  uuid.UUID `json:"projectID"`
+	BucketName string    `json:"bucketName"`
+
+	StorageByteHours float64 `json:"storageByteHours"`
+	SegmentHours     float64 `json:"segmentHours"`
+	ObjectHours      float64 `json:"objectHours"`
+
+	GetEgress    int64 `json:"getEgress"`
+	RepairEgress int64 `json:"repairEgress"`
+	AuditEgress  int64 `json:"auditEgress"`
+}
+
+// ExportProjectUsage calls fn with the daily usage of every bucket of the project
+// for the days between since and before. The days are in UTC, since is rounded
+// down and before is rounded up to the day boundary.
+// projectID here may be Project.ID or Project.PublicID.
+func (s *Service) ExportProjectUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time, fn func(UsageExportRow) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "export project usage", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	since = truncateDay(since)
+	if !truncateDay(before).Equal(before.UTC()) {
+		before = truncateDay(before).AddDate(0, 0, 1)
+	}
+	before = before.UTC()
+
+	if !since.Before(before) {
+		return ErrValidation.New("since must be before before")
+	}
+	if before.Sub(since) > MaxUsageExportDays*24*time.Hour {
+		return ErrValidation.New("at most %d days can be exported at once", MaxUsageExportDays)
+	}
+
+	usages, err := s.projectAccounting.GetBucketDailyUsages(ctx, isMember.project.ID, since, before)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, usage := range usages {
+		err := fn(UsageExportRow{
+			Date:       usage.Date,
+			ProjectID:  projectID,
+			BucketName: usage.BucketName,
+
+			StorageByteHours: usage.StorageByteHours,
+			SegmentHours:     usage.SegmentHours,
+			ObjectHours:      usage.ObjectHours,
+
+			GetEgress:    usage.GetEgress,
+			RepairEgress: usage.RepairEgress,
+			AuditEgress:  usage.AuditEgress,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// truncateDay returns the beginning of the UTC day containing t.
+func truncateDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -37,6 +37,9 @@ type Users interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	// Update is a method for updating user entity.
 	Update(ctx context.Context, userID uuid.UUID, request UpdateUserRequest) error
+	// Anonymize erases the personal data and the credentials of the user, replaces its email
+	// and marks it as deleted.
+	Anonymize(ctx context.Context, id uuid.UUID, email string) error
 	// UpdatePaidTier sets whether the user is in the paid tier.
 	UpdatePaidTier(ctx context.Context, id uuid.UUID, paidTier bool, projectBandwidthLimit, projectStorageLimit memory.Size, projectSegmentLimit int64, projectLimit int) error
 	// UpdateUserProjectLimits is a method to update the user's usage limits for new projects.
@@ -0,0 +1,81 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"common/identity"
+	"common/rpc/rpcstatus"
+	"storx/private/maintenancepb"
+	"storx/satellite/overlay"
+)
+
+// MaintenanceEndpoint implements announcing planned downtime by storage nodes.
+type MaintenanceEndpoint struct {
+	maintenancepb.DRPCNodeMaintenanceUnimplementedServer
+	log     *zap.Logger
+	overlay *overlay.Service
+}
+
+// NewMaintenanceEndpoint returns a new maintenance endpoint.
+func NewMaintenanceEndpoint(log *zap.Logger, overlay *overlay.Service) *MaintenanceEndpoint {
+	return &MaintenanceEndpoint{
+		log:     log,
+		overlay: overlay,
+	}
+}
+
+// Announce stores planned downtime of the node. During the window the node is not selected
+// for uploads and offline or unknown audit results don't affect its reputation.
+func (endpoint *MaintenanceEndpoint) Announce(ctx context.Context, req *maintenancepb.AnnounceRequest) (_ *maintenancepb.AnnounceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	window, err := endpoint.overlay.ScheduleMaintenance(ctx, peerID.ID, req.Start, req.End)
+	if err != nil {
+		if overlay.ErrInvalidMaintenanceWindow.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+		}
+		endpoint.log.Error("failed to schedule maintenance", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	endpoint.log.Info("node announced maintenance",
+		zap.Stringer("Node ID", peerID.ID),
+		zap.Time("start", window.Start),
+		zap.Time("end", window.End))
+
+	return &maintenancepb.AnnounceResponse{
+		Start: window.Start,
+		End:   window.End,
+	}, nil
+}
+
+// Cancel removes planned downtime of the node.
+func (endpoint *MaintenanceEndpoint) Cancel(ctx context.Context, req *maintenancepb.CancelRequest) (_ *maintenancepb.CancelResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.overlay.CancelMaintenance(ctx, peerID.ID)
+	if err != nil {
+		if overlay.ErrMaintenanceWindowNotFound.Has(err) {
+			return nil, rpcstatus.Error(rpcstatus.NotFound, err.Error())
+		}
+		endpoint.log.Error("failed to cancel maintenance", zap.Stringer("Node ID", peerID.ID), zap.Error(err))
+		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+
+	return &maintenancepb.CancelResponse{}, nil
+}
@@ -32,9 +32,13 @@ import (
 	"storx/satellite/analytics"
 	"storx/satellite/audit"
 	"storx/satellite/console"
+	"storx/satellite/console/accountdeletion"
+	"storx/satellite/console/accountexport"
+	"storx/satellite/console/budgetalerts"
 	"storx/satellite/console/consoleauth"
 	"storx/satellite/console/emailreminders"
 	"storx/satellite/gracefulexit"
+	"storx/satellite/inventory"
 	"storx/satellite/mailservice"
 	"storx/satellite/metabase"
 	"storx/satellite/metabase/segmentloop"
@@ -48,6 +52,7 @@ import (
 	"storx/satellite/payments"
 	"storx/satellite/payments/accountfreeze"
 	"storx/satellite/payments/billing"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/storxscan"
 	"storx/satellite/payments/stripecoinpayments"
 	"storx/satellite/repair/checker"
@@ -125,6 +130,10 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	Inventory struct {
+		Chore *inventory.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		NodeTally             *nodetally.Service
@@ -140,12 +149,18 @@ type Core struct {
 	Payments struct {
 		AccountFreeze    *accountfreeze.Chore
 		Accounts         payments.Accounts
+		BudgetAlerts     *budgetalerts.Chore
 		BillingChore     *billing.Chore
 		StorxscanClient  *storxscan.Client
 		StorxscanService *storxscan.Service
 		StorxscanChore   *storxscan.Chore
 	}
 
+	Console struct {
+		AccountExport   *accountexport.Chore
+		AccountDeletion *accountdeletion.Chore
+	}
+
 	GracefulExit struct {
 		Chore *gracefulexit.Chore
 	}
@@ -440,6 +455,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket inventories
+		if config.Inventory.Enabled {
+			peer.Inventory.Chore = inventory.NewChore(
+				peer.Log.Named("core-inventory"),
+				config.Inventory,
+				peer.DB.Buckets(),
+				peer.Metainfo.Metabase,
+			)
+			peer.Services.Add(lifecycle.Item{
+				Name:  "inventory:chore",
+				Run:   peer.Inventory.Chore.Run,
+				Close: peer.Inventory.Chore.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Bucket Inventory Chore", peer.Inventory.Chore.Loop))
+		} else {
+			peer.Log.Named("inventory").Info("disabled")
+		}
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, peer.DB.Buckets(), config.Tally)
 		peer.Services.Add(lifecycle.Item{
@@ -504,7 +539,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		var stripeClient stripecoinpayments.StripeClient
 		switch pc.Provider {
-		case "": // just new mock, only used in testing binaries
+		case "", "invoice-only": // just new mock, used in testing binaries and by the stripe specific parts when invoices are paid outside of stripe
 			stripeClient = stripecoinpayments.NewStripeMock(
 				peer.DB.StripeCoinPayments().Customers(),
 				peer.DB.Console().Users(),
@@ -547,6 +582,22 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 
 		peer.Payments.Accounts = service.Accounts()
 
+		if pc.Provider == "invoice-only" {
+			invoiceOnly, err := invoiceonly.NewService(
+				peer.Log.Named("payments.invoiceonly:service"),
+				pc.InvoiceOnly,
+				peer.DB.InvoiceOnly(),
+				peer.DB.Console().Projects(),
+				peer.DB.Console().Users(),
+				peer.DB.ProjectAccounting(),
+				prices,
+				priceOverrides)
+			if err != nil {
+				return nil, errs.Combine(err, peer.Close())
+			}
+			peer.Payments.Accounts = invoiceOnly.Accounts()
+		}
+
 		peer.Payments.StorxscanClient = storxscan.NewClient(
 			pc.Storxscan.Endpoint,
 			pc.Storxscan.Auth.Identifier,
@@ -611,6 +662,65 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup project budget alerts
+		if config.BudgetAlerts.Enabled {
+			peer.Payments.BudgetAlerts = budgetalerts.NewChore(
+				peer.Log.Named("payments.budgetalerts:chore"),
+				peer.DB.Console(),
+				peer.Payments.Accounts,
+				peer.Mail.Service,
+				config.BudgetAlerts,
+				config.Console.ExternalAddress,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "budgetalerts:chore",
+				Run:   peer.Payments.BudgetAlerts.Run,
+				Close: peer.Payments.BudgetAlerts.Close,
+			})
+		}
+	}
+
+	{ // setup account data exports
+		if config.AccountExport.Enabled {
+			peer.Console.AccountExport = accountexport.NewChore(
+				peer.Log.Named("console.accountexport:chore"),
+				peer.DB.Console(),
+				peer.Payments.Accounts,
+				peer.Mail.Service,
+				config.AccountExport,
+				config.Console.ExternalAddress,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "accountexport:chore",
+				Run:   peer.Console.AccountExport.Run,
+				Close: peer.Console.AccountExport.Close,
+			})
+		}
+	}
+
+	{ // setup scheduled account deletions
+		if config.AccountDeletion.Enabled {
+			peer.Console.AccountDeletion = accountdeletion.NewChore(
+				peer.Log.Named("console.accountdeletion:chore"),
+				peer.DB.Console(),
+				peer.DB.Buckets(),
+				peer.Metainfo.Metabase,
+				peer.Payments.Accounts,
+				peer.Mail.Service,
+				config.AccountDeletion,
+				config.Console.ExternalAddress,
+			)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "accountdeletion:chore",
+				Run:   peer.Console.AccountDeletion.Run,
+				Close: peer.Console.AccountDeletion.Close,
+			})
+		}
+	}
+
 	{ // setup graceful exit
 		log := peer.Log.Named("gracefulexit")
 		switch {
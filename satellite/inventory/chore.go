@@ -0,0 +1,244 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/sync2"
+	"storx/satellite/buckets"
+	"storx/satellite/metabase"
+	"uplink"
+)
+
+// Chore uploads the inventories of the configured buckets when they are due.
+//
+// The objects are read directly from the metabase instead of listing them
+// through metainfo. An inventory contains the objects that existed when the
+// chore started generating it.
+//
+// It isn't a ranged loop observer: the ranged loop visits the segments of all
+// buckets split across ranges, while an inventory lists the objects of only
+// the configured buckets, and each one has to be written by a single uploader.
+//
+// architecture: Chore
+type Chore struct {
+	log      *zap.Logger
+	config   Config
+	buckets  buckets.DB
+	metabase *metabase.DB
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the inventory chore.
+func NewChore(log *zap.Logger, config Config, bucketsDB buckets.DB, metabaseDB *metabase.DB) *Chore {
+	return &Chore{
+		log:      log,
+		config:   config,
+		buckets:  bucketsDB,
+		metabase: metabaseDB,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run starts the inventory chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		err := chore.RunOnce(ctx)
+		if err != nil {
+			chore.log.Error("failed to upload inventories", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce uploads the inventories which are due.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	inventories, err := chore.buckets.ListBucketInventories(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var group errs.Group
+	for _, inventory := range inventories {
+		now := chore.nowFn()
+		periodStart := inventory.Frequency.PeriodStart(now)
+		if inventory.GeneratedFor != nil && !inventory.GeneratedFor.Before(periodStart) {
+			continue
+		}
+
+		if err := chore.generate(ctx, inventory, now, periodStart); err != nil {
+			chore.log.Error("failed to upload inventory",
+				zap.Stringer("Project ID", inventory.ProjectID),
+				zap.String("Bucket", inventory.BucketName),
+				zap.Error(err))
+			group.Add(err)
+			continue
+		}
+
+		err := chore.buckets.SetBucketInventoryGenerated(ctx, inventory.BucketLocation, periodStart)
+		if err != nil {
+			group.Add(Error.Wrap(err))
+		}
+	}
+	return group.Err()
+}
+
+// Close stops the inventory chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// TestingSetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) TestingSetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// generate uploads the inventory of the bucket for the period into its
+// destination. It's canceled when it takes longer than the configured timeout.
+func (chore *Chore) generate(ctx context.Context, inventory buckets.BucketInventory, now, periodStart time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if chore.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, chore.config.Timeout)
+		defer cancel()
+	}
+
+	serializedAccess, err := DecryptAccessGrant(&chore.config.EncryptionKeys, inventory.EncryptedAccessGrant)
+	if err != nil {
+		return err
+	}
+
+	accessGrant, err := uplink.ParseAccess(serializedAccess)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project, err := uplink.OpenProject(ctx, accessGrant)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	return chore.uploadInventory(ctx, project, inventory, now, Prefix(inventory, periodStart))
+}
+
+// uploadInventory uploads the chunks of the inventory of the bucket followed by
+// its manifest. Chunks of a failed upload are overwritten by the next attempt.
+func (chore *Chore) uploadInventory(ctx context.Context, project *uplink.Project, inventory buckets.BucketInventory, now time.Time, prefix string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	manifest := Manifest{
+		ProjectID:  inventory.ProjectID,
+		BucketName: inventory.BucketName,
+		Frequency:  inventory.Frequency,
+		CreatedAt:  now.UTC(),
+		Format:     "csv",
+		Columns:    Columns,
+		Files:      []File{},
+	}
+
+	current := newChunk()
+	flush := func() error {
+		if current.objects == 0 {
+			return nil
+		}
+		data, err := current.bytes()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		file := File{
+			Key:         fmt.Sprintf("%sdata-%05d.csv", prefix, len(manifest.Files)),
+			ObjectCount: current.objects,
+		}
+		if err := chore.upload(ctx, project, inventory.DestinationBucket, file.Key, data, now); err != nil {
+			return err
+		}
+
+		manifest.Files = append(manifest.Files, file)
+		current.reset()
+		return nil
+	}
+
+	err = chore.metabase.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+		ProjectID:             inventory.ProjectID,
+		BucketName:            inventory.BucketName,
+		Recursive:             true,
+		Status:                metabase.Committed,
+		IncludeSystemMetadata: true,
+	}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+		var entry metabase.ObjectEntry
+		for it.Next(ctx, &entry) {
+			if entry.CreatedAt.After(now) {
+				continue
+			}
+			if entry.ExpiresAt != nil && !entry.ExpiresAt.After(now) {
+				continue
+			}
+
+			if err := current.add(&entry); err != nil {
+				return Error.Wrap(err)
+			}
+			manifest.ObjectCount++
+			manifest.TotalSize += entry.TotalEncryptedSize
+
+			if current.objects >= int64(chore.config.ChunkSize) {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return chore.upload(ctx, project, inventory.DestinationBucket, prefix+ManifestName, data, now)
+}
+
+// upload uploads a single object to the destination bucket. All objects of an
+// inventory expire together, counted from when the inventory was started.
+func (chore *Chore) upload(ctx context.Context, project *uplink.Project, bucket, key string, data []byte, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var options uplink.UploadOptions
+	if chore.config.ExpireIn > 0 {
+		options.Expires = now.Add(chore.config.ExpireIn)
+	}
+
+	upload, err := project.UploadObject(ctx, bucket, key, &options)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if _, err := upload.Write(data); err != nil {
+		return Error.Wrap(errs.Combine(err, upload.Abort()))
+	}
+	return Error.Wrap(upload.Commit())
+}
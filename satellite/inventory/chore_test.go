@@ -0,0 +1,160 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"common/memory"
+	"common/storx"
+	"common/testcontext"
+	"common/testrand"
+	"storx/private/testplanet"
+	"storx/satellite/buckets"
+	"storx/satellite/inventory"
+	"storx/satellite/orders"
+	"uplink"
+)
+
+func TestChore(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		projectID := planet.Uplinks[0].Projects[0].ID
+
+		for _, key := range []string{"a", "b", "c"} {
+			err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", key, testrand.Bytes(5*memory.KiB))
+			require.NoError(t, err)
+		}
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "inventories"))
+
+		objects, err := satellite.Metabase.DB.TestingAllCommittedObjects(ctx, projectID, "testbucket")
+		require.NoError(t, err)
+		require.Len(t, objects, 3)
+
+		accessString, err := planet.Uplinks[0].Access[satellite.ID()].Serialize()
+		require.NoError(t, err)
+
+		config := satellite.Config.Inventory
+		config.Enabled = true
+		config.ChunkSize = 2
+
+		encryptedAccess, err := inventory.EncryptAccessGrant(&config.EncryptionKeys, accessString)
+		require.NoError(t, err)
+		require.NotContains(t, string(encryptedAccess), accessString)
+
+		err = satellite.DB.Buckets().UpdateBucketInventory(ctx, []byte("testbucket"), projectID, buckets.Inventory{
+			Frequency:            buckets.InventoryDaily,
+			DestinationBucket:    "inventories",
+			DestinationPrefix:    "reports/",
+			EncryptedAccessGrant: encryptedAccess,
+		})
+		require.NoError(t, err)
+
+		chore := inventory.NewChore(zaptest.NewLogger(t), config, satellite.DB.Buckets(), satellite.Metabase.DB)
+
+		require.NoError(t, chore.RunOnce(ctx))
+
+		project, err := planet.Uplinks[0].OpenProject(ctx, satellite)
+		require.NoError(t, err)
+		defer ctx.Check(project.Close)
+
+		listInventories := func() (keys []string) {
+			iterator := project.ListObjects(ctx, "inventories", &uplink.ListObjectsOptions{Recursive: true})
+			for iterator.Next() {
+				keys = append(keys, iterator.Item().Key)
+			}
+			require.NoError(t, iterator.Err())
+			return keys
+		}
+
+		uploaded := listInventories()
+		require.Len(t, uploaded, 3)
+
+		var manifestKey string
+		for _, key := range uploaded {
+			if strings.HasSuffix(key, "/"+inventory.ManifestName) {
+				manifestKey = key
+			}
+		}
+		require.True(t, strings.HasPrefix(manifestKey, "reports/testbucket/"), manifestKey)
+
+		data, err := planet.Uplinks[0].Download(ctx, satellite, "inventories", manifestKey)
+		require.NoError(t, err)
+
+		var manifest inventory.Manifest
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		require.Equal(t, projectID, manifest.ProjectID)
+		require.Equal(t, "testbucket", manifest.BucketName)
+		require.Equal(t, buckets.InventoryDaily, manifest.Frequency)
+		require.EqualValues(t, 3, manifest.ObjectCount)
+		require.Len(t, manifest.Files, 2)
+
+		var totalSize int64
+		keys := map[string]bool{}
+		for _, object := range objects {
+			totalSize += object.TotalEncryptedSize
+			keys[base64.StdEncoding.EncodeToString([]byte(object.ObjectKey))] = true
+		}
+		require.Equal(t, totalSize, manifest.TotalSize)
+
+		for _, file := range manifest.Files {
+			data, err := planet.Uplinks[0].Download(ctx, satellite, "inventories", file.Key)
+			require.NoError(t, err)
+
+			records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+			require.NoError(t, err)
+			require.Equal(t, inventory.Columns, records[0])
+			require.EqualValues(t, file.ObjectCount, len(records)-1)
+
+			for _, record := range records[1:] {
+				require.True(t, keys[record[0]], record[0])
+				delete(keys, record[0])
+			}
+		}
+		require.Empty(t, keys)
+
+		configured, err := satellite.DB.Buckets().GetBucketInventory(ctx, []byte("testbucket"), projectID)
+		require.NoError(t, err)
+		require.NotNil(t, configured.GeneratedFor)
+
+		// the inventory isn't uploaded again in the same period.
+		require.NoError(t, chore.RunOnce(ctx))
+		require.Equal(t, uploaded, listInventories())
+	})
+}
+
+func TestAccessGrantEncryption(t *testing.T) {
+	oldKey := orders.EncryptionKey{ID: orders.EncryptionKeyID{1}, Key: storx.Key{1}}
+	newKey := orders.EncryptionKey{ID: orders.EncryptionKeyID{2}, Key: storx.Key{2}}
+
+	oldKeys, err := orders.NewEncryptionKeys(oldKey)
+	require.NoError(t, err)
+	encrypted, err := inventory.EncryptAccessGrant(oldKeys, "access")
+	require.NoError(t, err)
+
+	// grants encrypted with a previous key can be decrypted after a new default key is added.
+	keys, err := orders.NewEncryptionKeys(newKey, oldKey)
+	require.NoError(t, err)
+	decrypted, err := inventory.DecryptAccessGrant(keys, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, "access", decrypted)
+
+	newKeys, err := orders.NewEncryptionKeys(newKey)
+	require.NoError(t, err)
+	_, err = inventory.DecryptAccessGrant(newKeys, encrypted)
+	require.Error(t, err)
+
+	_, err = inventory.EncryptAccessGrant(&orders.EncryptionKeys{}, "access")
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"crypto/rand"
+
+	"common/storx"
+	"storx/satellite/orders"
+)
+
+// EncryptAccessGrant encrypts the access grant of an inventory configuration
+// with the default key, so that the encryption keys in the grant aren't stored
+// in plaintext. The result contains the key identifier and the nonce.
+func EncryptAccessGrant(keys *orders.EncryptionKeys, accessGrant string) ([]byte, error) {
+	if keys.Default.IsZero() {
+		return nil, Error.New("no encryption key for access grants configured")
+	}
+
+	var nonce storx.SerialNumber
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	encrypted := make([]byte, 0, len(keys.Default.ID)+len(nonce))
+	encrypted = append(encrypted, keys.Default.ID[:]...)
+	encrypted = append(encrypted, nonce[:]...)
+	return append(encrypted, keys.Default.Encrypt([]byte(accessGrant), nonce)...), nil
+}
+
+// DecryptAccessGrant decrypts an access grant encrypted by EncryptAccessGrant
+// with any of the keys.
+func DecryptAccessGrant(keys *orders.EncryptionKeys, encrypted []byte) (string, error) {
+	var id orders.EncryptionKeyID
+	var nonce storx.SerialNumber
+	if len(encrypted) < len(id)+len(nonce) {
+		return "", Error.New("invalid encrypted access grant")
+	}
+	copy(id[:], encrypted)
+	copy(nonce[:], encrypted[len(id):])
+
+	key, ok := keys.KeyByID[id]
+	if !ok {
+		return "", Error.New("unknown access grant encryption key %x", id[:])
+	}
+
+	decrypted, err := (&orders.EncryptionKey{ID: id, Key: key}).Decrypt(encrypted[len(id)+len(nonce):], nonce)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return string(decrypted), nil
+}
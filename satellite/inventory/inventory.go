@@ -0,0 +1,49 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+// Package inventory generates scheduled inventory reports of buckets.
+//
+// An inventory lists the encrypted keys, sizes, creation and expiration times
+// of every committed object in a bucket. The inventory is uploaded as CSV
+// chunks followed by a manifest, which marks the inventory as complete. The
+// inventories are configured per bucket and uploaded into a bucket of the
+// same project with the access grant of the configuration, which is stored
+// encrypted with a key of the satellite.
+package inventory
+
+import (
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storx/satellite/buckets"
+	"storx/satellite/orders"
+)
+
+var (
+	// Error is the error class for bucket inventories.
+	Error = errs.Class("inventory")
+	mon   = monkit.Package()
+)
+
+// ManifestName is the name of the object that is uploaded after all chunks
+// of an inventory.
+const ManifestName = "manifest.json"
+
+// Config contains configurable values for bucket inventories.
+type Config struct {
+	Enabled   bool          `help:"whether the chore generating bucket inventories is enabled" default:"false"`
+	Interval  time.Duration `help:"how often the chore checks for inventories which are due" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	Timeout   time.Duration `help:"how long generating the inventory of a single bucket may take" default:"1h"`
+	ChunkSize int           `help:"how many objects are listed in a single inventory chunk" default:"100000" testDefault:"2"`
+	ExpireIn  time.Duration `help:"how long inventories remain in the bucket before they are automatically deleted, zero keeps them" default:"0s"`
+
+	EncryptionKeys orders.EncryptionKeys `help:"encryption keys for the access grants of inventory configurations, the first one encrypts new grants" default:""`
+}
+
+// Prefix returns the prefix the inventory of the period is uploaded to in the
+// destination bucket.
+func Prefix(inventory buckets.BucketInventory, periodStart time.Time) string {
+	return inventory.DestinationPrefix + inventory.BucketName + "/" + periodStart.Format("2006-01-02") + "/"
+}
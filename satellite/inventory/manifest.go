@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/csv"
+	"strconv"
+	"time"
+
+	"common/uuid"
+	"storx/satellite/buckets"
+	"storx/satellite/metabase"
+)
+
+// Columns are the columns of the inventory chunks.
+var Columns = []string{"encrypted_key", "version", "encrypted_size", "created_at", "expires_at"}
+
+// Manifest describes a complete inventory.
+type Manifest struct {
+	ProjectID  uuid.UUID                  `json:"projectId"`
+	BucketName string                     `json:"bucketName"`
+	Frequency  buckets.InventoryFrequency `json:"frequency"`
+	CreatedAt  time.Time                  `json:"createdAt"`
+
+	Format  string   `json:"format"`
+	Columns []string `json:"columns"`
+	Files   []File   `json:"files"`
+
+	ObjectCount int64 `json:"objectCount"`
+	TotalSize   int64 `json:"totalSize"`
+}
+
+// File is a chunk of an inventory.
+type File struct {
+	Key         string `json:"key"`
+	ObjectCount int64  `json:"objectCount"`
+}
+
+// chunk is a CSV chunk of an inventory that is being written.
+type chunk struct {
+	buf     bytes.Buffer
+	csv     *csv.Writer
+	objects int64
+}
+
+func newChunk() *chunk {
+	c := &chunk{}
+	c.reset()
+	return c
+}
+
+// reset empties the chunk and writes the header.
+func (c *chunk) reset() {
+	c.buf.Reset()
+	c.csv = csv.NewWriter(&c.buf)
+	c.objects = 0
+	_ = c.csv.Write(Columns)
+}
+
+// add writes the object to the chunk.
+func (c *chunk) add(entry *metabase.ObjectEntry) error {
+	expiresAt := ""
+	if entry.ExpiresAt != nil {
+		expiresAt = entry.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	c.objects++
+	return c.csv.Write([]string{
+		base64.StdEncoding.EncodeToString([]byte(entry.ObjectKey)),
+		strconv.FormatInt(int64(entry.Version), 10),
+		strconv.FormatInt(entry.TotalEncryptedSize, 10),
+		entry.CreatedAt.UTC().Format(time.RFC3339),
+		expiresAt,
+	})
+}
+
+// bytes flushes the chunk and returns its contents.
+func (c *chunk) bytes() ([]byte, error) {
+	c.csv.Flush()
+	return c.buf.Bytes(), c.csv.Error()
+}
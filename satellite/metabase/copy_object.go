@@ -41,6 +41,9 @@ func (db *DB) BeginCopyObject(ctx context.Context, opts BeginCopyObject) (_ Begi
 // FinishCopyObject holds all data needed to finish object copy.
 type FinishCopyObject struct {
 	ObjectStream
+	// NewProjectID is the project the object is copied into. The object is
+	// copied within the project of the source when it's zero.
+	NewProjectID          uuid.UUID
 	NewBucket             string
 	NewEncryptedObjectKey ObjectKey
 	NewStreamID           uuid.UUID
@@ -93,6 +96,14 @@ func (finishCopy FinishCopyObject) Verify() error {
 	return nil
 }
 
+// newProjectID returns the project of the copy.
+func (finishCopy FinishCopyObject) newProjectID() uuid.UUID {
+	if finishCopy.NewProjectID.IsZero() {
+		return finishCopy.ProjectID
+	}
+	return finishCopy.NewProjectID
+}
+
 // FinishCopyObject accepts new encryption keys for copied object and insert the corresponding new object ObjectKey and segments EncryptedKey.
 // It returns the object at the destination location.
 func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (object Object, err error) {
@@ -259,7 +270,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			)
 			RETURNING
 				created_at`,
-			opts.ProjectID, opts.NewBucket, opts.NewEncryptedObjectKey, nextAvailableVersion, opts.NewStreamID,
+			opts.newProjectID(), opts.NewBucket, opts.NewEncryptedObjectKey, nextAvailableVersion, opts.NewStreamID,
 			sourceObject.ExpiresAt, sourceObject.SegmentCount,
 			encryptionParameters{&sourceObject.Encryption},
 			copyMetadata, opts.NewEncryptedMetadataKeyNonce, opts.NewEncryptedMetadataKey,
@@ -323,6 +334,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 	}
 
 	newObject.StreamID = opts.NewStreamID
+	newObject.ProjectID = opts.newProjectID()
 	newObject.BucketName = opts.NewBucket
 	newObject.ObjectKey = opts.NewEncryptedObjectKey
 	newObject.EncryptedMetadata = copyMetadata
@@ -361,7 +373,7 @@ func getObjectAtCopySourceAndDestination(
 			SELECT status, max(version) AS version
 			FROM objects
 			WHERE
-				project_id  = $1 AND
+				project_id  = $7 AND
 				bucket_name = $5 AND
 				object_key  = $6
 			GROUP BY status
@@ -397,14 +409,15 @@ func getObjectAtCopySourceAndDestination(
 			(SELECT max(version) FROM destination_current_versions) AS highest_version
 		FROM objects
 		WHERE
-			project_id  = $1 AND
+			project_id  = $7 AND
 			bucket_name = $5 AND
 			object_key  = $6 AND
 			version     = (SELECT version FROM destination_current_versions
 							WHERE status = `+committedStatus+`)`,
 		sourceObject.ProjectID, sourceObject.Version,
 		[]byte(sourceObject.BucketName), sourceObject.ObjectKey,
-		opts.NewBucket, opts.NewEncryptedObjectKey)
+		opts.NewBucket, opts.NewEncryptedObjectKey,
+		opts.newProjectID())
 	if err != nil {
 		return Object{}, uuid.UUID{}, nil, 0, err
 	}
@@ -448,7 +461,7 @@ func getObjectAtCopySourceAndDestination(
 	if rows.Next() {
 		var _bogusBytes []byte
 		destinationObject = &Object{}
-		destinationObject.ProjectID = opts.ProjectID
+		destinationObject.ProjectID = opts.newProjectID()
 		destinationObject.BucketName = opts.NewBucket
 		destinationObject.ObjectKey = opts.NewEncryptedObjectKey
 		// There is an object at the destination.
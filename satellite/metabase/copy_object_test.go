@@ -751,6 +751,46 @@ func TestFinishCopyObject(t *testing.T) {
 			}.Check(ctx, t, db)
 		})
 
+		t.Run("finish copy object to another project", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			copyStream := metabasetest.RandObjectStream()
+
+			originalObj, _ := metabasetest.CreateTestObject{
+				CommitObject: &metabase.CommitObject{
+					ObjectStream:                  obj,
+					EncryptedMetadata:             testrand.Bytes(64),
+					EncryptedMetadataNonce:        testrand.Nonce().Bytes(),
+					EncryptedMetadataEncryptedKey: testrand.Bytes(265),
+				},
+			}.Run(ctx, t, db, obj, 3)
+
+			copyObj, expectedOriginalSegments, expectedCopySegments := metabasetest.CreateObjectCopy{
+				OriginalObject:   originalObj,
+				CopyObjectStream: &copyStream,
+				CrossProject:     true,
+			}.Run(ctx, t, db)
+
+			require.Equal(t, copyStream.ProjectID, copyObj.ProjectID)
+			require.NotEqual(t, originalObj.ProjectID, copyObj.ProjectID)
+
+			var expectedRawSegments []metabase.RawSegment
+			expectedRawSegments = append(expectedRawSegments, expectedOriginalSegments...)
+			expectedRawSegments = append(expectedRawSegments, expectedCopySegments...)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{
+					metabase.RawObject(originalObj),
+					metabase.RawObject(copyObj),
+				},
+				Segments: expectedRawSegments,
+				Copies: []metabase.RawCopy{{
+					StreamID:         copyObj.StreamID,
+					AncestorStreamID: originalObj.StreamID,
+				}},
+			}.Check(ctx, t, db)
+		})
+
 		// checks that a copy can be copied to it's ancestor location
 		t.Run("Copy child to ancestor", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
@@ -318,6 +318,9 @@ type CreateObjectCopy struct {
 	OriginalSegments []metabase.Segment
 	FinishObject     *metabase.FinishCopyObject
 	CopyObjectStream *metabase.ObjectStream
+	// CrossProject copies into the project of CopyObjectStream instead of
+	// the project of OriginalObject.
+	CrossProject bool
 }
 
 // Run creates the copy.
@@ -373,6 +376,9 @@ func (cc CreateObjectCopy) Run(ctx *testcontext.Context, t testing.TB, db *metab
 			NewEncryptedMetadataKeyNonce: testrand.Nonce(),
 			NewEncryptedMetadataKey:      testrand.Bytes(32),
 		}
+		if cc.CrossProject {
+			opts.NewProjectID = copyStream.ProjectID
+		}
 	}
 
 	copyObj, err := db.FinishCopyObject(ctx, *opts)
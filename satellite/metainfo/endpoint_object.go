@@ -73,7 +73,7 @@ func (endpoint *Endpoint) BeginObject(ctx context.Context, req *pb.ObjectBeginRe
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, fmt.Sprintf("key length is too big, got %v, maximum allowed is %v", objectKeyLength, endpoint.config.MaxEncryptedObjectKeyLength))
 	}
 
-	err = endpoint.checkUploadLimits(ctx, keyInfo.ProjectID)
+	err = endpoint.checkUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)})
 	if err != nil {
 		return nil, err
 	}
@@ -442,6 +442,15 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)}
+	if err := endpoint.checkBucketBandwidthLimit(ctx, bucket); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.checkAPIKeyBandwidthLimit(ctx, keyInfo); err != nil {
+		return nil, err
+	}
+
 	// get the object information
 	object, err := endpoint.metabase.GetObjectLastCommitted(ctx, metabase.GetObjectLastCommitted{
 		ObjectLocation: metabase.ObjectLocation{
@@ -505,6 +514,14 @@ func (endpoint *Endpoint) DownloadObject(ctx context.Context, req *pb.ObjectDown
 			)
 		}
 
+		if err := endpoint.addToBucketBandwidthUsage(ctx, bucket, downloadSizes.encryptedSize); err != nil {
+			return nil, err
+		}
+
+		if err := endpoint.addToAPIKeyBandwidthUsage(ctx, keyInfo, downloadSizes.encryptedSize); err != nil {
+			return nil, err
+		}
+
 		encryptedKeyNonce, err := storx.NonceFromBytes(segment.EncryptedKeyNonce)
 		if err != nil {
 			endpoint.log.Error("unable to get encryption key nonce from metadata", zap.Error(err))
@@ -1853,7 +1870,7 @@ func (endpoint *Endpoint) BeginCopyObject(ctx context.Context, req *pb.ObjectBeg
 			ObjectKey:  metabase.ObjectKey(req.EncryptedObjectKey),
 		},
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.checkUploadLimitsForNewObject(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments)
+			return endpoint.checkUploadLimitsForNewObject(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.Bucket)}, encryptedObjectSize, nSegments)
 		},
 	})
 	if err != nil {
@@ -1971,7 +1988,7 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		NewEncryptedMetadataKeyNonce: req.NewEncryptedMetadataKeyNonce,
 		NewEncryptedMetadataKey:      req.NewEncryptedMetadataKey,
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
-			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo.ProjectID, encryptedObjectSize, nSegments)
+			return endpoint.addStorageUsageUpToLimit(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(req.NewBucket)}, encryptedObjectSize, nSegments)
 		},
 	})
 	if err != nil {
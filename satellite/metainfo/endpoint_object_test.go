@@ -2444,3 +2444,36 @@ func TestListUploads(t *testing.T) {
 		require.Equal(t, 1000, items)
 	})
 }
+
+func TestEndpoint_BucketLimits(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		uplink := planet.Uplinks[0]
+		projectID := uplink.Projects[0].ID
+
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "limited"))
+		require.NoError(t, uplink.CreateBucket(ctx, sat, "unlimited"))
+
+		storageLimit := int64(100)
+		err := sat.DB.Buckets().UpdateBucketLimits(ctx, []byte("limited"), projectID, buckets.Limits{
+			Storage: &storageLimit,
+		})
+		require.NoError(t, err)
+
+		// the first upload fits as the bucket is empty
+		err = uplink.Upload(ctx, sat, "limited", "first", testrand.Bytes(200))
+		require.NoError(t, err)
+
+		err = uplink.Upload(ctx, sat, "limited", "second", testrand.Bytes(200))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Exceeded Bucket Storage Limit")
+
+		// other buckets of the project aren't affected
+		err = uplink.Upload(ctx, sat, "unlimited", "first", testrand.Bytes(200))
+		require.NoError(t, err)
+		err = uplink.Upload(ctx, sat, "unlimited", "second", testrand.Bytes(200))
+		require.NoError(t, err)
+	})
+}
@@ -55,7 +55,8 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "segment index must be greater then 0")
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo.ProjectID); err != nil {
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkUploadLimits(ctx, bucket); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +86,6 @@ func (endpoint *Endpoint) BeginSegment(ctx context.Context, req *pb.SegmentBegin
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 	rootPieceID, addressedLimits, piecePrivateKey, err := endpoint.orders.CreatePutOrderLimits(ctx, bucket, nodes, streamID.ExpirationDate, maxPieceSize)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -193,7 +193,7 @@ func (endpoint *Endpoint) RetryBeginSegmentPieces(ctx context.Context, req *pb.R
 		pieceNumberSet[pieceNumber] = struct{}{}
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo.ProjectID); err != nil {
+	if err := endpoint.checkUploadLimits(ctx, metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(segmentID.StreamId.Bucket)}); err != nil {
 		return nil, err
 	}
 
@@ -375,7 +375,8 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo.ProjectID); err != nil {
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkUploadLimits(ctx, bucket); err != nil {
 		return nil, err
 	}
 
@@ -401,7 +402,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
-	if err := endpoint.addSegmentToUploadLimits(ctx, keyInfo.ProjectID, segmentSize); err != nil {
+	if err := endpoint.addSegmentToUploadLimits(ctx, bucket, segmentSize); err != nil {
 		return nil, err
 	}
 
@@ -452,7 +453,8 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	if err := endpoint.checkUploadLimits(ctx, keyInfo.ProjectID); err != nil {
+	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
+	if err := endpoint.checkUploadLimits(ctx, bucket); err != nil {
 		return nil, err
 	}
 
@@ -487,14 +489,13 @@ func (endpoint *Endpoint) MakeInlineSegment(ctx context.Context, req *pb.Segment
 		return nil, endpoint.convertMetabaseErr(err)
 	}
 
-	bucket := metabase.BucketLocation{ProjectID: keyInfo.ProjectID, BucketName: string(streamID.Bucket)}
 	err = endpoint.orders.UpdatePutInlineOrder(ctx, bucket, inlineUsed)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
 		return nil, rpcstatus.Error(rpcstatus.Internal, err.Error())
 	}
 
-	if err := endpoint.addSegmentToUploadLimits(ctx, keyInfo.ProjectID, inlineUsed); err != nil {
+	if err := endpoint.addSegmentToUploadLimits(ctx, bucket, inlineUsed); err != nil {
 		return nil, err
 	}
 
@@ -635,6 +636,14 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		return nil, rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Usage Limit")
 	}
 
+	if err := endpoint.checkBucketBandwidthLimit(ctx, bucket); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.checkAPIKeyBandwidthLimit(ctx, keyInfo); err != nil {
+		return nil, err
+	}
+
 	id, err := uuid.FromBytes(streamID.StreamId)
 	if err != nil {
 		endpoint.log.Error("internal", zap.Error(err))
@@ -683,6 +692,14 @@ func (endpoint *Endpoint) DownloadSegment(ctx context.Context, req *pb.SegmentDo
 		)
 	}
 
+	if err := endpoint.addToBucketBandwidthUsage(ctx, bucket, int64(segment.EncryptedSize)); err != nil {
+		return nil, err
+	}
+
+	if err := endpoint.addToAPIKeyBandwidthUsage(ctx, keyInfo, int64(segment.EncryptedSize)); err != nil {
+		return nil, err
+	}
+
 	encryptedKeyNonce, err := storx.NonceFromBytes(segment.EncryptedKeyNonce)
 	if err != nil {
 		endpoint.log.Error("unable to get encryption key nonce from metadata", zap.Error(err))
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/subtle"
+	"fmt"
 	"regexp"
 	"strconv"
 	"time"
@@ -169,11 +170,21 @@ func (endpoint *Endpoint) validateBasic(ctx context.Context, header *pb.RequestH
 		eventkit.String("partner", string(keyInfo.UserAgent)),
 	)
 
+	if keyInfo.IsExpired(time.Now()) {
+		endpoint.log.Debug("expired api key", zap.Stringer("API Key ID", keyInfo.ID))
+		return nil, nil, rpcstatus.Error(rpcstatus.PermissionDenied, "API key expired")
+	}
+
 	if err = endpoint.checkRate(ctx, keyInfo.ProjectID); err != nil {
 		endpoint.log.Debug("rate check failed", zap.Error(err))
 		return nil, nil, err
 	}
 
+	if err = endpoint.checkAPIKeyRate(ctx, keyInfo); err != nil {
+		endpoint.log.Debug("api key rate check failed", zap.Error(err))
+		return nil, nil, err
+	}
+
 	return key, keyInfo, nil
 }
 
@@ -245,6 +256,39 @@ func (endpoint *Endpoint) checkRate(ctx context.Context, projectID uuid.UUID) (e
 	return nil
 }
 
+// checkAPIKeyRate enforces the requests per minute quota of the api key.
+// Keys without the quota are only limited by the project rate limit.
+func (endpoint *Endpoint) checkAPIKeyRate(ctx context.Context, keyInfo *console.APIKeyInfo) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if keyInfo.RequestsPerMinute == nil {
+		return nil
+	}
+	requestsPerMinute := *keyInfo.RequestsPerMinute
+
+	// the quota is part of the cache key, so that updated quotas are applied
+	// without waiting for the cached limiter to expire.
+	cacheKey := fmt.Sprintf("apikey:%s:%d", keyInfo.ID, requestsPerMinute)
+	limiter, err := endpoint.limiterCache.Get(cacheKey, func() (interface{}, error) {
+		return rate.NewLimiter(rate.Limit(float64(requestsPerMinute)/60), requestsPerMinute), nil
+	})
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	if !limiter.(*rate.Limiter).Allow() {
+		endpoint.log.Warn("too many requests for api key",
+			zap.Stringer("API Key ID", keyInfo.ID),
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+			zap.Int("requests per minute", requestsPerMinute))
+
+		mon.Event("metainfo_api_key_rate_limit_exceeded")
+
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+	}
+
+	return nil
+}
+
 func (endpoint *Endpoint) validateBucket(ctx context.Context, bucket []byte) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -376,28 +420,28 @@ func (endpoint *Endpoint) validateRemoteSegment(ctx context.Context, commitReque
 	return nil
 }
 
-func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, projectID uuid.UUID) error {
-	return endpoint.checkUploadLimitsForNewObject(ctx, projectID, 1, 1)
+func (endpoint *Endpoint) checkUploadLimits(ctx context.Context, bucket metabase.BucketLocation) error {
+	return endpoint.checkUploadLimitsForNewObject(ctx, bucket, 1, 1)
 }
 
 func (endpoint *Endpoint) checkUploadLimitsForNewObject(
-	ctx context.Context, projectID uuid.UUID, newObjectSize int64, newObjectSegmentCount int64,
+	ctx context.Context, bucket metabase.BucketLocation, newObjectSize int64, newObjectSegmentCount int64,
 ) error {
-	if limit, err := endpoint.projectUsage.ExceedsUploadLimits(ctx, projectID, newObjectSize, newObjectSegmentCount); err != nil {
+	if limit, err := endpoint.projectUsage.ExceedsUploadLimits(ctx, bucket.ProjectID, newObjectSize, newObjectSegmentCount); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
 		}
 
 		endpoint.log.Error(
 			"Retrieving project upload limit failed; limit won't be enforced",
-			zap.Stringer("Project ID", projectID),
+			zap.Stringer("Project ID", bucket.ProjectID),
 			zap.Error(err),
 		)
 	} else {
 		if limit.ExceedsSegments {
 			endpoint.log.Warn("Segment limit exceeded",
 				zap.String("Limit", strconv.Itoa(int(limit.SegmentsLimit))),
-				zap.Stringer("Project ID", projectID),
+				zap.Stringer("Project ID", bucket.ProjectID),
 			)
 			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Segments Limit")
 		}
@@ -405,20 +449,57 @@ func (endpoint *Endpoint) checkUploadLimitsForNewObject(
 		if limit.ExceedsStorage {
 			endpoint.log.Warn("Storage limit exceeded",
 				zap.String("Limit", strconv.Itoa(limit.StorageLimit.Int())),
-				zap.Stringer("Project ID", projectID),
+				zap.Stringer("Project ID", bucket.ProjectID),
 			)
 			return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Storage Limit")
 		}
 	}
 
+	return endpoint.checkBucketUploadLimits(ctx, bucket, newObjectSize, newObjectSegmentCount)
+}
+
+func (endpoint *Endpoint) checkBucketUploadLimits(
+	ctx context.Context, bucket metabase.BucketLocation, newObjectSize int64, newObjectSegmentCount int64,
+) error {
+	limit, err := endpoint.projectUsage.ExceedsBucketUploadLimits(ctx, bucket, newObjectSize, newObjectSegmentCount)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving bucket upload limit failed; limit won't be enforced",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if limit.ExceedsSegments {
+		endpoint.log.Warn("Bucket segment limit exceeded",
+			zap.String("Limit", strconv.Itoa(int(limit.SegmentsLimit))),
+			zap.Stringer("Project ID", bucket.ProjectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Segments Limit")
+	}
+
+	if limit.ExceedsStorage {
+		endpoint.log.Warn("Bucket storage limit exceeded",
+			zap.String("Limit", strconv.Itoa(limit.StorageLimit.Int())),
+			zap.Stringer("Project ID", bucket.ProjectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Storage Limit")
+	}
+
 	return nil
 }
 
-func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, projectID uuid.UUID, segmentSize int64) error {
-	return endpoint.addToUploadLimits(ctx, projectID, segmentSize, 1)
+func (endpoint *Endpoint) addSegmentToUploadLimits(ctx context.Context, bucket metabase.BucketLocation, segmentSize int64) error {
+	return endpoint.addToUploadLimits(ctx, bucket, segmentSize, 1)
 }
 
-func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, projectID uuid.UUID, size int64, segmentCount int64) error {
+func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, bucket metabase.BucketLocation, size int64, segmentCount int64) error {
+	projectID := bucket.ProjectID
 	if err := endpoint.projectUsage.AddProjectStorageUsage(ctx, projectID, size); err != nil {
 		if errs2.IsCanceled(err) {
 			return rpcstatus.Wrap(rpcstatus.Canceled, err)
@@ -449,16 +530,37 @@ func (endpoint *Endpoint) addToUploadLimits(ctx context.Context, projectID uuid.
 		)
 	}
 
+	return endpoint.addToBucketUploadLimits(ctx, bucket, size, segmentCount)
+}
+
+func (endpoint *Endpoint) addToBucketUploadLimits(ctx context.Context, bucket metabase.BucketLocation, size int64, segmentCount int64) error {
+	if err := endpoint.projectUsage.AddBucketUsage(ctx, bucket, size, segmentCount); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		// log it and continue. the only thing that will be affected is
+		// the per-bucket storage and segment limits.
+		endpoint.log.Error("Could not track the bucket's storage and segment usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+
 	return nil
 }
 
-func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectID uuid.UUID, storage int64, segments int64) (err error) {
-	err = endpoint.projectUsage.AddProjectUsageUpToLimit(ctx, projectID, storage, segments)
+func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, bucket metabase.BucketLocation, storage int64, segments int64) (err error) {
+	if err := endpoint.checkBucketUploadLimits(ctx, bucket, storage, segments); err != nil {
+		return err
+	}
+
+	err = endpoint.projectUsage.AddProjectUsageUpToLimit(ctx, bucket.ProjectID, storage, segments)
 
 	if err != nil {
 		if accounting.ErrProjectLimitExceeded.Has(err) {
 			endpoint.log.Warn("Upload limit exceeded",
-				zap.Stringer("Project ID", projectID),
+				zap.Stringer("Project ID", bucket.ProjectID),
 				zap.Error(err),
 			)
 			return rpcstatus.Error(rpcstatus.ResourceExhausted, err.Error())
@@ -470,7 +572,104 @@ func (endpoint *Endpoint) addStorageUsageUpToLimit(ctx context.Context, projectI
 
 		endpoint.log.Error(
 			"Updating project upload limits failed; limits won't be enforced",
-			zap.Stringer("Project ID", projectID),
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+
+	return endpoint.addToBucketUploadLimits(ctx, bucket, storage, segments)
+}
+
+func (endpoint *Endpoint) checkBucketBandwidthLimit(ctx context.Context, bucket metabase.BucketLocation) error {
+	exceeded, limit, err := endpoint.projectUsage.ExceedsBucketBandwidthUsage(ctx, bucket)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving bucket bandwidth total failed; bandwidth limit won't be enforced",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if exceeded {
+		endpoint.log.Warn("Monthly bucket bandwidth limit exceeded",
+			zap.Stringer("Limit", limit),
+			zap.Stringer("Project ID", bucket.ProjectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded Bucket Usage Limit")
+	}
+
+	return nil
+}
+
+func (endpoint *Endpoint) addToBucketBandwidthUsage(ctx context.Context, bucket metabase.BucketLocation, size int64) error {
+	if err := endpoint.projectUsage.UpdateBucketBandwidthUsage(ctx, bucket, size); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		// log it and continue. the only thing that will be affected is
+		// the per-bucket bandwidth limit.
+		endpoint.log.Error("Could not track the bucket's bandwidth usage",
+			zap.Stringer("Project ID", bucket.ProjectID),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
+
+// checkAPIKeyBandwidthLimit returns an error when the api key has used up
+// its daily egress quota.
+func (endpoint *Endpoint) checkAPIKeyBandwidthLimit(ctx context.Context, keyInfo *console.APIKeyInfo) error {
+	if keyInfo.DailyEgressLimit == nil {
+		return nil
+	}
+
+	exceeded, err := endpoint.projectUsage.ExceedsAPIKeyBandwidthUsage(ctx, keyInfo.ID, *keyInfo.DailyEgressLimit)
+	if err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		endpoint.log.Error(
+			"Retrieving api key bandwidth total failed; bandwidth limit won't be enforced",
+			zap.Stringer("API Key ID", keyInfo.ID),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if exceeded {
+		endpoint.log.Warn("Daily api key bandwidth limit exceeded",
+			zap.Int64("Limit", *keyInfo.DailyEgressLimit),
+			zap.Stringer("API Key ID", keyInfo.ID),
+			zap.Stringer("Project ID", keyInfo.ProjectID),
+		)
+		return rpcstatus.Error(rpcstatus.ResourceExhausted, "Exceeded API Key Daily Egress Limit")
+	}
+
+	return nil
+}
+
+func (endpoint *Endpoint) addToAPIKeyBandwidthUsage(ctx context.Context, keyInfo *console.APIKeyInfo, size int64) error {
+	if keyInfo.DailyEgressLimit == nil {
+		return nil
+	}
+
+	if err := endpoint.projectUsage.UpdateAPIKeyBandwidthUsage(ctx, keyInfo.ID, size); err != nil {
+		if errs2.IsCanceled(err) {
+			return rpcstatus.Wrap(rpcstatus.Canceled, err)
+		}
+
+		// log it and continue. the only thing that will be affected is
+		// the daily bandwidth limit of the api key.
+		endpoint.log.Error("Could not track the api key's bandwidth usage",
+			zap.Stringer("API Key ID", keyInfo.ID),
 			zap.Error(err),
 		)
 	}
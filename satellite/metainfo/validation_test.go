@@ -12,8 +12,11 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"common/errs2"
+	"common/lrucache"
 	"common/macaroon"
 	"common/pb"
+	"common/rpc/rpcstatus"
 	"common/testcontext"
 	"storx/satellite/console"
 	"storx/satellite/console/consoleauth"
@@ -21,10 +24,11 @@ import (
 
 type mockAPIKeys struct {
 	secret []byte
+	limits console.APIKeyLimits
 }
 
 func (m *mockAPIKeys) GetByHead(ctx context.Context, head []byte) (*console.APIKeyInfo, error) {
-	return &console.APIKeyInfo{Secret: m.secret}, nil
+	return &console.APIKeyInfo{Secret: m.secret, APIKeyLimits: m.limits}, nil
 }
 
 var _ APIKeys = (*mockAPIKeys)(nil)
@@ -231,3 +235,47 @@ func TestEndpoint_validateAuthN(t *testing.T) {
 		assert.Equal(t, tt.wantCanDelete, canDelete, i)
 	}
 }
+
+func TestEndpoint_validateBasicAPIKeyLimits(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	secret, err := macaroon.NewSecret()
+	require.NoError(t, err)
+
+	key, err := macaroon.NewAPIKey(secret)
+	require.NoError(t, err)
+
+	header := &pb.RequestHeader{ApiKey: key.SerializeRaw()}
+
+	newEndpoint := func(limits console.APIKeyLimits) *Endpoint {
+		return &Endpoint{
+			log:          zaptest.NewLogger(t),
+			apiKeys:      &mockAPIKeys{secret: secret, limits: limits},
+			limiterCache: lrucache.New(lrucache.Options{Capacity: 10, Expiration: time.Minute}),
+		}
+	}
+
+	t.Run("expiration", func(t *testing.T) {
+		expired := time.Now().Add(-time.Minute)
+		_, _, err := newEndpoint(console.APIKeyLimits{ExpiresAt: &expired}).validateBasic(ctx, header)
+		require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+		notExpired := time.Now().Add(time.Hour)
+		_, _, err = newEndpoint(console.APIKeyLimits{ExpiresAt: &notExpired}).validateBasic(ctx, header)
+		require.NoError(t, err)
+	})
+
+	t.Run("requests per minute", func(t *testing.T) {
+		requestsPerMinute := 3
+		endpoint := newEndpoint(console.APIKeyLimits{RequestsPerMinute: &requestsPerMinute})
+
+		for i := 0; i < requestsPerMinute; i++ {
+			_, _, err := endpoint.validateBasic(ctx, header)
+			require.NoError(t, err)
+		}
+
+		_, _, err := endpoint.validateBasic(ctx, header)
+		require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+	})
+}
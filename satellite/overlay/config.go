@@ -23,6 +23,7 @@ type Config struct {
 	Node                            NodeSelectionConfig
 	NodeSelectionCache              UploadSelectionCacheConfig
 	GeoIP                           GeoIPConfig
+	Maintenance                     MaintenanceConfig
 	UpdateStatsBatchSize            int           `help:"number of update requests to process per transaction" default:"100"`
 	NodeCheckInWaitPeriod           time.Duration `help:"the amount of time to wait before accepting a redundant check-in from a node (unmodified info since last check-in)" default:"2h" testDefault:"30s"`
 	NodeSoftwareUpdateEmailCooldown time.Duration `help:"the amount of time to wait between sending Node Software Update emails" default:"168h"`
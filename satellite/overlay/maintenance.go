@@ -0,0 +1,156 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/storx"
+)
+
+// ErrInvalidMaintenanceWindow is returned when announced maintenance window is out of allowed limits.
+var ErrInvalidMaintenanceWindow = errs.Class("invalid maintenance window")
+
+// ErrMaintenanceWindowNotFound is returned when the node has no maintenance window.
+var ErrMaintenanceWindowNotFound = errs.Class("maintenance window not found")
+
+// MaintenanceConfig defines limits of planned node maintenance.
+type MaintenanceConfig struct {
+	MaxDuration time.Duration `help:"the longest planned downtime which a node can announce" default:"24h"`
+	MaxLeadTime time.Duration `help:"how far in the future a planned downtime can start" default:"720h"`
+	Cooldown    time.Duration `help:"how long after a planned downtime ends the next one can start" default:"168h"`
+}
+
+// MaintenanceWindow is a planned downtime announced by the node.
+//
+// BlockedUntil is the earliest time the window could start, it's carried over
+// from the previous windows of the node.
+type MaintenanceWindow struct {
+	NodeID       storx.NodeID
+	Start        time.Time
+	End          time.Time
+	BlockedUntil time.Time
+	CreatedAt    time.Time
+}
+
+// Active returns true when the window covers specified time.
+func (window MaintenanceWindow) Active(now time.Time) bool {
+	return !now.Before(window.Start) && now.Before(window.End)
+}
+
+// started returns true when the window has started, windows without duration
+// only keep the cooldown of cancelled windows and never start.
+func (window MaintenanceWindow) started(now time.Time) bool {
+	return window.Start.Before(window.End) && !now.Before(window.Start)
+}
+
+// ScheduleMaintenance validates and stores maintenance window announced by the node.
+// It returns the accepted window.
+//
+// A window which is in progress can be changed, but keeps its start. The next
+// window can start only after the cooldown since the end of the previous one.
+func (service *Service) ScheduleMaintenance(ctx context.Context, nodeID storx.NodeID, start, end time.Time) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	config := service.config.Maintenance
+
+	previous, err := service.db.GetMaintenanceWindow(ctx, nodeID)
+	if err != nil && !ErrMaintenanceWindowNotFound.Has(err) {
+		return MaintenanceWindow{}, Error.Wrap(err)
+	}
+
+	blockedUntil := previous.BlockedUntil
+	if err == nil && previous.started(now) {
+		if previous.Active(now) {
+			start = previous.Start
+		} else if next := previous.End.Add(config.Cooldown); next.After(blockedUntil) {
+			blockedUntil = next
+		}
+	}
+
+	switch {
+	case !start.Before(end):
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("start %s should be before end %s", start, end)
+	case !end.After(now):
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("end %s is in the past", end)
+	case end.Sub(start) > config.MaxDuration:
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("duration %s exceeds maximum %s", end.Sub(start), config.MaxDuration)
+	case start.Sub(now) > config.MaxLeadTime:
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("start %s is more than %s in the future", start, config.MaxLeadTime)
+	case start.Before(blockedUntil):
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("start %s is before the end of the cooldown %s", start, blockedUntil)
+	}
+
+	// a window which already started can't be moved back to cover downtime which already happened.
+	if start.Before(now) && !previous.Active(now) {
+		start = now
+	}
+
+	window := MaintenanceWindow{
+		NodeID: nodeID,
+		Start:  start.UTC(),
+		End:    end.UTC(),
+	}
+	if !blockedUntil.IsZero() {
+		window.BlockedUntil = blockedUntil.UTC()
+	}
+	if err := service.db.SetMaintenanceWindow(ctx, window); err != nil {
+		return MaintenanceWindow{}, Error.Wrap(err)
+	}
+	return window, nil
+}
+
+// CancelMaintenance cancels maintenance window of the node. A window which is in
+// progress ends immediately and the cooldown starts. When a future window is
+// cancelled the cooldown of the previous windows is kept.
+func (service *Service) CancelMaintenance(ctx context.Context, nodeID storx.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+
+	window, err := service.db.GetMaintenanceWindow(ctx, nodeID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case !window.End.After(now) || !window.Start.Before(window.End):
+		return ErrMaintenanceWindowNotFound.New("%v", nodeID)
+	case window.started(now):
+		window.End = now.UTC()
+	case window.BlockedUntil.After(now):
+		window.Start, window.End = now.UTC(), now.UTC()
+	default:
+		return Error.Wrap(service.db.DeleteMaintenanceWindow(ctx, nodeID))
+	}
+	return Error.Wrap(service.db.SetMaintenanceWindow(ctx, window))
+}
+
+// GetMaintenanceWindow returns maintenance window of the node which is planned
+// or in progress.
+func (service *Service) GetMaintenanceWindow(ctx context.Context, nodeID storx.NodeID) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	window, err := service.db.GetMaintenanceWindow(ctx, nodeID)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+	if !window.End.After(time.Now()) || !window.Start.Before(window.End) {
+		return MaintenanceWindow{}, ErrMaintenanceWindowNotFound.New("%v", nodeID)
+	}
+	return window, nil
+}
+
+// InMaintenance filters a set of nodes to nodes which are currently in maintenance.
+func (service *Service) InMaintenance(ctx context.Context, nodeIDs storx.NodeIDList) (_ storx.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+	return service.db.InMaintenance(ctx, nodeIDs, time.Now())
+}
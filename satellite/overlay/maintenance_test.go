@@ -0,0 +1,116 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"common/storx"
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite"
+	"storx/satellite/overlay"
+)
+
+func TestScheduleMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Node.DistinctIP = false
+				config.Overlay.NodeCheckInWaitPeriod = 0
+				config.Overlay.Maintenance.MaxDuration = time.Hour
+				config.Overlay.Maintenance.MaxLeadTime = 24 * time.Hour
+				config.Overlay.Maintenance.Cooldown = 2 * time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		service := planet.Satellites[0].Overlay.Service
+		nodeID := planet.StorageNodes[0].ID()
+		now := time.Now()
+
+		for _, invalid := range []struct{ start, end time.Time }{
+			{now.Add(time.Hour), now},
+			{now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			{now, now.Add(2 * time.Hour)},
+			{now.Add(48 * time.Hour), now.Add(49 * time.Hour)},
+		} {
+			_, err := service.ScheduleMaintenance(ctx, nodeID, invalid.start, invalid.end)
+			require.True(t, overlay.ErrInvalidMaintenanceWindow.Has(err), err)
+		}
+
+		// window in the future doesn't affect the node.
+		_, err := service.ScheduleMaintenance(ctx, nodeID, now.Add(time.Hour), now.Add(90*time.Minute))
+		require.NoError(t, err)
+
+		inMaintenance, err := service.InMaintenance(ctx, storx.NodeIDList{nodeID})
+		require.NoError(t, err)
+		require.Empty(t, inMaintenance)
+
+		// already started window replaces the previous one and is clamped to now.
+		window, err := service.ScheduleMaintenance(ctx, nodeID, now.Add(-time.Minute), now.Add(30*time.Minute))
+		require.NoError(t, err)
+		require.False(t, window.Start.Before(now))
+
+		stored, err := service.GetMaintenanceWindow(ctx, nodeID)
+		require.NoError(t, err)
+		require.WithinDuration(t, window.End, stored.End, time.Second)
+
+		// window in progress can be extended, but keeps its start.
+		extended, err := service.ScheduleMaintenance(ctx, nodeID, now, window.Start.Add(50*time.Minute))
+		require.NoError(t, err)
+		require.WithinDuration(t, window.Start, extended.Start, time.Second)
+
+		_, err = service.ScheduleMaintenance(ctx, nodeID, now, window.Start.Add(90*time.Minute))
+		require.True(t, overlay.ErrInvalidMaintenanceWindow.Has(err), err)
+
+		inMaintenance, err = service.InMaintenance(ctx, storx.NodeIDList{nodeID, planet.StorageNodes[1].ID()})
+		require.NoError(t, err)
+		require.Equal(t, storx.NodeIDList{nodeID}, inMaintenance)
+
+		// node in maintenance isn't selected for uploads.
+		nodeConfig := planet.Satellites[0].Config.Overlay.Node
+		selected, err := service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4}, &nodeConfig)
+		require.Error(t, err)
+		require.Len(t, selected, 3)
+		for _, node := range selected {
+			require.NotEqual(t, nodeID, node.ID)
+		}
+
+		require.NoError(t, service.CancelMaintenance(ctx, nodeID))
+		err = service.CancelMaintenance(ctx, nodeID)
+		require.True(t, overlay.ErrMaintenanceWindowNotFound.Has(err), err)
+
+		_, err = service.GetMaintenanceWindow(ctx, nodeID)
+		require.True(t, overlay.ErrMaintenanceWindowNotFound.Has(err), err)
+
+		selected, err = service.FindStorageNodesWithPreferences(ctx, overlay.FindStorageNodesRequest{RequestedCount: 4}, &nodeConfig)
+		require.NoError(t, err)
+		require.Len(t, selected, 4)
+
+		// the next window can't start before the cooldown ends.
+		now = time.Now()
+		_, err = service.ScheduleMaintenance(ctx, nodeID, now.Add(time.Hour), now.Add(90*time.Minute))
+		require.True(t, overlay.ErrInvalidMaintenanceWindow.Has(err), err)
+
+		window, err = service.ScheduleMaintenance(ctx, nodeID, now.Add(3*time.Hour), now.Add(4*time.Hour))
+		require.NoError(t, err)
+		require.WithinDuration(t, now.Add(2*time.Hour), window.BlockedUntil, time.Minute)
+
+		// cancelling a future window keeps the cooldown.
+		require.NoError(t, service.CancelMaintenance(ctx, nodeID))
+		_, err = service.GetMaintenanceWindow(ctx, nodeID)
+		require.True(t, overlay.ErrMaintenanceWindowNotFound.Has(err), err)
+
+		_, err = service.ScheduleMaintenance(ctx, nodeID, now.Add(time.Hour), now.Add(90*time.Minute))
+		require.True(t, overlay.ErrInvalidMaintenanceWindow.Has(err), err)
+
+		_, err = service.ScheduleMaintenance(ctx, nodeID, now.Add(3*time.Hour), now.Add(4*time.Hour))
+		require.NoError(t, err)
+	})
+}
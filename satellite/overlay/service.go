@@ -137,6 +137,15 @@ type DB interface {
 	IterateAllContactedNodes(context.Context, func(context.Context, *SelectedNode) error) error
 	// IterateAllNodeDossiers will call cb on all known nodes (used for invoice generation).
 	IterateAllNodeDossiers(context.Context, func(context.Context, *NodeDossier) error) error
+
+	// SetMaintenanceWindow stores maintenance window of the node replacing previously announced one.
+	SetMaintenanceWindow(ctx context.Context, window MaintenanceWindow) error
+	// GetMaintenanceWindow returns maintenance window of the node.
+	GetMaintenanceWindow(ctx context.Context, nodeID storx.NodeID) (MaintenanceWindow, error)
+	// DeleteMaintenanceWindow removes maintenance window of the node.
+	DeleteMaintenanceWindow(ctx context.Context, nodeID storx.NodeID) error
+	// InMaintenance filters a set of nodes to nodes which are in maintenance at specified time.
+	InMaintenance(ctx context.Context, nodeIDs storx.NodeIDList, now time.Time) (storx.NodeIDList, error)
 }
 
 // DisqualificationReason is disqualification reason enum type.
@@ -0,0 +1,25 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"context"
+	"time"
+)
+
+// InvoiceGenerator generates the invoices for the usage of a billing period.
+// It is implemented by every billing backend, so that the invoicing pipeline
+// doesn't depend on a specific payment provider.
+//
+// architecture: Service
+type InvoiceGenerator interface {
+	// PrepareInvoiceProjectRecords creates the records of the project usage for the billing period.
+	PrepareInvoiceProjectRecords(ctx context.Context, period time.Time) error
+	// CreateInvoices creates the invoices for the billing period from the prepared records.
+	CreateInvoices(ctx context.Context, period time.Time) error
+	// GenerateInvoices performs all tasks necessary to create the invoices for the billing period.
+	GenerateInvoices(ctx context.Context, period time.Time) error
+	// FinalizeInvoices transitions all draft invoices to open invoices, which are ready to be paid.
+	FinalizeInvoices(ctx context.Context) error
+}
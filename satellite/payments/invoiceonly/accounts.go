@@ -0,0 +1,277 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/uuid"
+	"storx/satellite/accounting"
+	"storx/satellite/payments"
+)
+
+// ErrUnsupported is returned for the payment operations which aren't
+// available when invoices are paid outside of the satellite.
+var ErrUnsupported = errs.Class("not supported by invoice-only billing")
+
+// ensures that accounts implements payments.Accounts.
+var _ payments.Accounts = (*accounts)(nil)
+
+// accounts is an implementation of payments.Accounts for customers who pay
+// the invoices generated by the invoice-only backend outside of the satellite.
+//
+// architecture: Service
+type accounts struct {
+	service *Service
+}
+
+// Accounts exposes all needed functionality to manage payment accounts.
+func (service *Service) Accounts() payments.Accounts {
+	return &accounts{service: service}
+}
+
+// Setup does nothing, invoice-only customers don't have a payment account.
+func (accounts *accounts) Setup(ctx context.Context, userID uuid.UUID, email string, signupPromoCode string) (_ payments.CouponType, err error) {
+	defer mon.Task()(&ctx, userID, email)(&err)
+	return payments.NoCoupon, nil
+}
+
+// Balance returns an empty balance, invoice-only customers don't have credits or coins.
+func (accounts *accounts) Balance(ctx context.Context, userID uuid.UUID) (_ payments.Balance, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return payments.Balance{}, nil
+}
+
+// ProjectCharges returns how much money current user will be charged for each project.
+func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID, since, before time.Time) (charges []payments.ProjectCharge, err error) {
+	defer mon.Task()(&ctx, userID, since, before)(&err)
+
+	// to return empty slice instead of nil if there are no projects
+	charges = make([]payments.ProjectCharge, 0)
+
+	projects, err := accounts.service.projectsDB.GetOwn(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, project := range projects {
+		totalUsage := accounting.ProjectUsage{Since: since, Before: before}
+		charge := payments.ProjectCharge{ProjectID: project.PublicID}
+
+		usages, err := accounts.service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, accounts.service.partnerNames, since, before)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		for key, usage := range usages {
+			priceModel := accounts.GetProjectUsagePriceModel(key.Partner).ForPlacement(key.Placement)
+
+			charge.StorageGbHrs += priceModel.StorageCents(payments.StorageMBMonthDecimal(usage.Storage)).IntPart()
+			charge.Egress += priceModel.EgressCents(payments.EgressMBDecimal(usage.Egress)).IntPart()
+			charge.SegmentCount += priceModel.SegmentCents(payments.SegmentMonthDecimal(usage.SegmentCount)).IntPart()
+
+			totalUsage.Egress += usage.Egress
+			totalUsage.ObjectCount += usage.ObjectCount
+			totalUsage.SegmentCount += usage.SegmentCount
+			totalUsage.Storage += usage.Storage
+		}
+
+		charge.ProjectUsage = totalUsage
+		charges = append(charges, charge)
+	}
+
+	return charges, nil
+}
+
+// GetProjectUsagePriceModel returns the project usage price model for a partner name.
+func (accounts *accounts) GetProjectUsagePriceModel(partner string) payments.ProjectUsagePriceModel {
+	return accounts.service.getProjectUsagePriceModel(partner)
+}
+
+// CheckProjectInvoicingStatus returns error if the project was billed on an invoice of
+// the previous billing period which is still a draft.
+func (accounts *accounts) CheckProjectInvoicingStatus(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err := accounts.service.projectsDB.Get(ctx, projectID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	invoices, err := accounts.service.db.ListByUserID(ctx, project.OwnerID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, invoice := range invoices {
+		if invoice.Status != payments.InvoiceStatusDraft {
+			continue
+		}
+		for _, item := range invoice.Items {
+			if item.ProjectID == projectID {
+				return errs.New("draft invoice with the project usage exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckProjectUsageStatus returns error if for the given project there is some usage for current or previous month.
+func (accounts *accounts) CheckProjectUsageStatus(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := accounts.service.nowFn()
+	year, month, _ := now.UTC().Date()
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+
+	// check current month usage and do not allow deletion if usage exists
+	currentUsage, err := accounts.service.usageDB.GetProjectTotal(ctx, projectID, firstOfMonth, now)
+	if err != nil {
+		return err
+	}
+	if currentUsage.Storage > 0 || currentUsage.Egress > 0 || currentUsage.SegmentCount > 0 {
+		return errs.New("usage for current month exists")
+	}
+
+	// check usage for last month, if exists, ensure the owner was invoiced.
+	lastMonthUsage, err := accounts.service.usageDB.GetProjectTotal(ctx, projectID, firstOfMonth.AddDate(0, -1, 0), firstOfMonth.AddDate(0, 0, -1))
+	if err != nil {
+		return err
+	}
+	if lastMonthUsage.Storage > 0 || lastMonthUsage.Egress > 0 || lastMonthUsage.SegmentCount > 0 {
+		project, err := accounts.service.projectsDB.Get(ctx, projectID)
+		if err != nil {
+			return err
+		}
+		invoiced, err := accounts.service.db.Exists(ctx, project.OwnerID, firstOfMonth.AddDate(0, -1, 0))
+		if err != nil {
+			return err
+		}
+		if !invoiced {
+			return errs.New("usage for last month exist, but is not billed yet")
+		}
+	}
+
+	return nil
+}
+
+// Charges returns an empty list, invoice-only customers aren't charged by the satellite.
+func (accounts *accounts) Charges(ctx context.Context, userID uuid.UUID) (_ []payments.Charge, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// CreditCards exposes all needed functionality to manage account credit cards.
+func (accounts *accounts) CreditCards() payments.CreditCards {
+	return &creditCards{}
+}
+
+// StorxTokens exposes all storx token related functionality.
+func (accounts *accounts) StorxTokens() payments.StorxTokens {
+	return &storxTokens{}
+}
+
+// Invoices exposes all needed functionality to manage account invoices.
+func (accounts *accounts) Invoices() payments.Invoices {
+	return &invoices{service: accounts.service}
+}
+
+// Coupons exposes all needed functionality to manage coupons.
+func (accounts *accounts) Coupons() payments.Coupons {
+	return &coupons{}
+}
+
+// ensures that creditCards implements payments.CreditCards.
+var _ payments.CreditCards = (*creditCards)(nil)
+
+// creditCards is an implementation of payments.CreditCards without any cards.
+//
+// architecture: Service
+type creditCards struct{}
+
+// List returns an empty list of credit cards.
+func (creditCards *creditCards) List(ctx context.Context, userID uuid.UUID) (_ []payments.CreditCard, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// Add returns an error, credit cards can't be added.
+func (creditCards *creditCards) Add(ctx context.Context, userID uuid.UUID, cardToken string) (_ payments.CreditCard, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return payments.CreditCard{}, ErrUnsupported.New("credit cards")
+}
+
+// Remove returns an error, there are no credit cards to remove.
+func (creditCards *creditCards) Remove(ctx context.Context, userID uuid.UUID, cardID string) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return ErrUnsupported.New("credit cards")
+}
+
+// RemoveAll does nothing, there are no credit cards to remove.
+func (creditCards *creditCards) RemoveAll(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil
+}
+
+// MakeDefault returns an error, there are no credit cards.
+func (creditCards *creditCards) MakeDefault(ctx context.Context, userID uuid.UUID, cardID string) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return ErrUnsupported.New("credit cards")
+}
+
+// ensures that storxTokens implements payments.StorxTokens.
+var _ payments.StorxTokens = (*storxTokens)(nil)
+
+// storxTokens is an implementation of payments.StorxTokens without any deposits.
+//
+// architecture: Service
+type storxTokens struct{}
+
+// ListTransactionInfos returns an empty list of token transactions.
+func (tokens *storxTokens) ListTransactionInfos(ctx context.Context, userID uuid.UUID) (_ []payments.TransactionInfo, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// ListDepositBonuses returns an empty list of deposit bonuses.
+func (tokens *storxTokens) ListDepositBonuses(ctx context.Context, userID uuid.UUID) (_ []payments.DepositBonus, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// ensures that coupons implements payments.Coupons.
+var _ payments.Coupons = (*coupons)(nil)
+
+// coupons is an implementation of payments.Coupons without any coupons.
+//
+// architecture: Service
+type coupons struct{}
+
+// GetByUserID returns no coupon.
+func (coupons *coupons) GetByUserID(ctx context.Context, userID uuid.UUID) (_ *payments.Coupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, nil
+}
+
+// ApplyFreeTierCoupon returns an error, coupons can't be applied.
+func (coupons *coupons) ApplyFreeTierCoupon(ctx context.Context, userID uuid.UUID) (_ *payments.Coupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, ErrUnsupported.New("coupons")
+}
+
+// ApplyCoupon returns an error, coupons can't be applied.
+func (coupons *coupons) ApplyCoupon(ctx context.Context, userID uuid.UUID, couponID string) (_ *payments.Coupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, ErrUnsupported.New("coupons")
+}
+
+// ApplyCouponCode returns an error, coupons can't be applied.
+func (coupons *coupons) ApplyCouponCode(ctx context.Context, userID uuid.UUID, couponCode string) (_ *payments.Coupon, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, ErrUnsupported.New("coupons")
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/errs"
+
+	"common/uuid"
+)
+
+// ErrInvoiceNotFound is returned when an invoice doesn't exist.
+var ErrInvoiceNotFound = errs.Class("invoice not found")
+
+// DB contains the invoices generated by the invoice-only billing backend.
+//
+// architecture: Database
+type DB interface {
+	// Insert inserts a new invoice.
+	Insert(ctx context.Context, invoice Invoice) error
+	// Get returns the invoice with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (Invoice, error)
+	// Exists returns whether the user already has an invoice for the billing period.
+	Exists(ctx context.Context, userID uuid.UUID, periodStart time.Time) (bool, error)
+	// ListByUserID returns the invoices of the user, the newest billing period first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]Invoice, error)
+	// ListByPeriod returns the invoices of the billing period.
+	ListByPeriod(ctx context.Context, periodStart time.Time) ([]Invoice, error)
+	// ListByStatus returns the invoices with the given status, the oldest billing period first.
+	ListByStatus(ctx context.Context, status string) ([]Invoice, error)
+	// UpdateStatus updates the status and the payment information of the invoice.
+	UpdateStatus(ctx context.Context, id uuid.UUID, status, paymentReference string, paidAt *time.Time) error
+}
+
+// Invoice is an invoice generated by the invoice-only billing backend.
+type Invoice struct {
+	ID          uuid.UUID     `json:"id"`
+	UserID      uuid.UUID     `json:"userID"`
+	PeriodStart time.Time     `json:"periodStart"`
+	PeriodEnd   time.Time     `json:"periodEnd"`
+	Items       []InvoiceItem `json:"items"`
+	// Amount is the total of the invoice in cents.
+	Amount int64 `json:"amount"`
+	// Status is one of the payments.InvoiceStatus* constants.
+	Status           string     `json:"status"`
+	PaymentReference string     `json:"paymentReference"`
+	PaidAt           *time.Time `json:"paidAt"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
+// InvoiceItem is a line item of an invoice.
+type InvoiceItem struct {
+	ProjectID   uuid.UUID `json:"projectID"`
+	Description string    `json:"description"`
+	Quantity    int64     `json:"quantity"`
+	// UnitPrice is the price of a single unit in cents.
+	UnitPrice decimal.Decimal `json:"unitPrice"`
+	// Amount is the price of the item in cents.
+	Amount int64 `json:"amount"`
+}
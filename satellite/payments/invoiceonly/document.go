@@ -0,0 +1,232 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"common/uuid"
+)
+
+// Document is the content of an invoice document.
+type Document struct {
+	Number              string        `json:"number"`
+	Issuer              string        `json:"issuer"`
+	IssuedAt            time.Time     `json:"issuedAt"`
+	CustomerID          uuid.UUID     `json:"customerID"`
+	CustomerName        string        `json:"customerName"`
+	CustomerEmail       string        `json:"customerEmail"`
+	PeriodStart         time.Time     `json:"periodStart"`
+	PeriodEnd           time.Time     `json:"periodEnd"`
+	Items               []InvoiceItem `json:"items"`
+	Total               int64         `json:"total"`
+	Currency            string        `json:"currency"`
+	PaymentInstructions string        `json:"paymentInstructions,omitempty"`
+}
+
+// writeDocuments stores the invoice as JSON and PDF documents.
+func (service *Service) writeDocuments(ctx context.Context, invoice Invoice) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := service.usersDB.Get(ctx, invoice.UserID)
+	if err != nil {
+		return err
+	}
+
+	document := Document{
+		Number:              invoice.ID.String(),
+		Issuer:              service.config.Issuer,
+		IssuedAt:            invoice.CreatedAt,
+		CustomerID:          user.ID,
+		CustomerName:        user.FullName,
+		CustomerEmail:       user.Email,
+		PeriodStart:         invoice.PeriodStart,
+		PeriodEnd:           invoice.PeriodEnd,
+		Items:               invoice.Items,
+		Total:               invoice.Amount,
+		Currency:            "USD",
+		PaymentInstructions: service.config.PaymentInstructions,
+	}
+
+	jsonDocument, err := json.MarshalIndent(document, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(service.DocumentPath(invoice, "json")), 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(service.DocumentPath(invoice, "json"), jsonDocument, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(service.DocumentPath(invoice, "pdf"), renderPDF(document.lines()), 0600)
+}
+
+// lines returns the text lines of the document.
+func (document Document) lines() []string {
+	lines := []string{
+		"INVOICE",
+		"",
+		"Issuer:         " + document.Issuer,
+		"Invoice number: " + document.Number,
+		"Issued:         " + document.IssuedAt.UTC().Format("2006-01-02"),
+		"Billing period: " + document.PeriodStart.UTC().Format("2006-01-02") + " - " + document.PeriodEnd.UTC().AddDate(0, 0, -1).Format("2006-01-02"),
+		"Bill to:        " + document.CustomerName + " <" + document.CustomerEmail + ">",
+		"",
+		fmt.Sprintf("%-56s %12s %14s %12s", "Description", "Quantity", "Unit price", "Amount"),
+		strings.Repeat("-", 97),
+	}
+
+	for _, item := range document.Items {
+		// long descriptions continue on the following lines, so that they
+		// don't push the other columns out of the page.
+		description := wrapText(item.Description, descriptionWidth)
+		lines = append(lines, fmt.Sprintf("%-56s %12d %14s %12s",
+			description[0], item.Quantity, "$"+item.UnitPrice.Shift(-2).String(), formatCents(item.Amount)))
+		for _, continued := range description[1:] {
+			lines = append(lines, "  "+continued)
+		}
+	}
+
+	lines = append(lines,
+		strings.Repeat("-", 97),
+		fmt.Sprintf("%-84s %12s", "Total ("+document.Currency+")", formatCents(document.Total)),
+	)
+
+	if document.PaymentInstructions != "" {
+		lines = append(lines, "", "Payment instructions:")
+		lines = append(lines, strings.Split(document.PaymentInstructions, "\n")...)
+	}
+	lines = append(lines, "", "Please use the invoice number as the payment reference.")
+
+	return lines
+}
+
+// descriptionWidth is the width of the description column of the invoice items.
+const descriptionWidth = 56
+
+// wrapText splits the text into lines no longer than width. Lines are broken
+// at spaces and words longer than width are split. Continuation lines are
+// shorter by two characters to leave space for the indentation.
+func wrapText(text string, width int) []string {
+	var lines []string
+	line, limit := "", width
+	for _, word := range strings.Fields(text) {
+		for {
+			switch {
+			case line == "" && len(word) <= limit:
+				line = word
+			case line != "" && len(line)+1+len(word) <= limit:
+				line += " " + word
+			case line != "":
+				lines = append(lines, line)
+				line, limit = "", width-2
+				continue
+			default:
+				// the word doesn't fit on an empty line.
+				lines = append(lines, word[:limit])
+				word, limit = word[limit:], width-2
+				continue
+			}
+			break
+		}
+	}
+	return append(lines, line)
+}
+
+// formatCents formats the amount of cents as dollars.
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s$%d.%02d", sign, cents/100, cents%100)
+}
+
+const (
+	pdfLinesPerPage = 60
+	pdfFontSize     = 7
+	pdfLineHeight   = 11
+)
+
+// renderPDF renders the lines as a minimal PDF document using a monospaced font,
+// so that the columns of the invoice items stay aligned.
+func renderPDF(lines []string) []byte {
+	var pages [][]string
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	// objects 1, 2 and 3 are the catalog, the page tree and the font,
+	// followed by a page and a content stream object for every page.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // page tree, filled below
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+	}
+
+	var kids []string
+	for _, page := range pages {
+		pageObject := len(objects) + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObject))
+
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT /F1 %d Tf %d TL 40 800 Td\n", pdfFontSize, pdfLineHeight)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", escapePDFString(line))
+		}
+		content.WriteString("ET")
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pageObject+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var pdf bytes.Buffer
+	pdf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = pdf.Len()
+		fmt.Fprintf(&pdf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := pdf.Len()
+	fmt.Fprintf(&pdf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&pdf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&pdf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return pdf.Bytes()
+}
+
+// escapePDFString escapes the special characters of a PDF string literal and
+// replaces the characters which can't be represented with the font encoding.
+func escapePDFString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
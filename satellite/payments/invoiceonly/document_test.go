@@ -0,0 +1,29 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapText(t *testing.T) {
+	require.Equal(t, []string{""}, wrapText("", 20))
+	require.Equal(t, []string{"fits on a line"}, wrapText("fits on a line", 20))
+	require.Equal(t, []string{"Project name -", "Egress Bandwidth", "(MB)"}, wrapText("Project name - Egress Bandwidth (MB)", 20))
+	require.Equal(t, []string{strings.Repeat("x", 20), strings.Repeat("x", 18), "xx tail"}, wrapText(strings.Repeat("x", 40)+" tail", 20))
+
+	// the description continues on the following lines without moving the other columns.
+	document := Document{Items: []InvoiceItem{{Description: strings.Repeat("word ", 40)}}}
+	var continued int
+	for _, line := range document.lines() {
+		require.LessOrEqual(t, len(line), 97, line)
+		if strings.HasPrefix(line, "  word") {
+			continued++
+		}
+	}
+	require.Equal(t, 3, continued)
+}
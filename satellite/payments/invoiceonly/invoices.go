@@ -0,0 +1,100 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+
+	"common/uuid"
+	"storx/satellite/payments"
+)
+
+// ensures that invoices implements payments.Invoices.
+var _ payments.Invoices = (*invoices)(nil)
+
+// invoices is an implementation of payments.Invoices which lists the invoices
+// generated by the invoice-only backend. Invoices can only be paid outside of
+// the satellite and are marked as paid through the admin API.
+//
+// architecture: Service
+type invoices struct {
+	service *Service
+}
+
+// Create returns an error, invoices are only generated for the usage of a billing period.
+func (invoices *invoices) Create(ctx context.Context, userID uuid.UUID, price int64, desc string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return nil, ErrUnsupported.New("creating invoices")
+}
+
+// Pay returns an error, invoices are paid outside of the satellite.
+func (invoices *invoices) Pay(ctx context.Context, invoiceID, paymentMethodID string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return nil, ErrUnsupported.New("paying invoices")
+}
+
+// List returns the invoices of the user, the newest billing period first.
+func (invoices *invoices) List(ctx context.Context, userID uuid.UUID) (list []payments.Invoice, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	generated, err := invoices.service.db.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for _, invoice := range generated {
+		list = append(list, payments.Invoice{
+			ID:          invoice.ID.String(),
+			Description: "Invoice for " + invoice.PeriodStart.UTC().Format("January 2006"),
+			Amount:      invoice.Amount,
+			Status:      invoice.Status,
+			Start:       invoice.PeriodStart,
+			End:         invoice.PeriodEnd,
+		})
+	}
+	return list, nil
+}
+
+// ListFailed returns an empty list, there are no failed payment attempts.
+func (invoices *invoices) ListFailed(ctx context.Context) (_ []payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return nil, nil
+}
+
+// ListWithDiscounts returns the invoices of the user, there are no discounts.
+func (invoices *invoices) ListWithDiscounts(ctx context.Context, userID uuid.UUID) (_ []payments.Invoice, _ []payments.CouponUsage, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	list, err := invoices.List(ctx, userID)
+	return list, nil, err
+}
+
+// CheckPendingItems returns whether the user has a draft invoice.
+func (invoices *invoices) CheckPendingItems(ctx context.Context, userID uuid.UUID) (_ bool, err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+
+	generated, err := invoices.service.db.ListByUserID(ctx, userID)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+
+	for _, invoice := range generated {
+		if invoice.Status == payments.InvoiceStatusDraft {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// AttemptPayOverdueInvoices returns an error, invoices are paid outside of the satellite.
+func (invoices *invoices) AttemptPayOverdueInvoices(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx, userID)(&err)
+	return ErrUnsupported.New("paying invoices")
+}
+
+// Delete returns an error, generated invoices are kept.
+func (invoices *invoices) Delete(ctx context.Context, id string) (_ *payments.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return nil, ErrUnsupported.New("deleting invoices")
+}
@@ -0,0 +1,348 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/uuid"
+	"storx/satellite/accounting"
+	"storx/satellite/console"
+	"storx/satellite/payments"
+)
+
+var (
+	// Error defines invoice-only billing service error.
+	Error = errs.Class("invoice-only billing service")
+
+	// ErrInvalidStatus is returned when an invoice can't be transitioned to the requested status.
+	ErrInvalidStatus = errs.Class("invalid invoice status")
+
+	mon = monkit.Package()
+)
+
+// ensures that Service implements payments.InvoiceGenerator.
+var _ payments.InvoiceGenerator = (*Service)(nil)
+
+// Config stores needed information for the invoice-only billing backend.
+type Config struct {
+	Directory           string `help:"directory where the invoice documents are stored when the invoice-only payments provider is used" default:""`
+	Issuer              string `help:"name of the issuer printed on the invoice documents" default:"Storx"`
+	PaymentInstructions string `help:"payment instructions printed on the invoice documents, e.g. the bank account for wire transfers" default:""`
+	SkipEmptyInvoices   bool   `help:"if set, skips the creation of empty invoices for customers with zero usage for the billing period" default:"true"`
+}
+
+// Service generates invoices as JSON and PDF documents stored in a local
+// directory. Invoices are paid outside of the satellite, e.g. by wire
+// transfer, and marked as paid manually.
+//
+// architecture: Service
+type Service struct {
+	log    *zap.Logger
+	config Config
+
+	db         DB
+	projectsDB console.Projects
+	usersDB    console.Users
+	usageDB    accounting.ProjectAccounting
+
+	usagePrices         payments.ProjectUsagePriceModel
+	usagePriceOverrides map[string]payments.ProjectUsagePriceModel
+	partnerNames        []string
+
+	nowFn func() time.Time
+}
+
+// NewService creates a new invoice-only billing service.
+func NewService(log *zap.Logger, config Config, db DB, projectsDB console.Projects, usersDB console.Users, usageDB accounting.ProjectAccounting, usagePrices payments.ProjectUsagePriceModel, usagePriceOverrides map[string]payments.ProjectUsagePriceModel) (*Service, error) {
+	if config.Directory == "" {
+		return nil, Error.New("invoice document directory must be configured")
+	}
+
+	var partners []string
+	for partner := range usagePriceOverrides {
+		partners = append(partners, partner)
+	}
+
+	return &Service{
+		log:                 log,
+		config:              config,
+		db:                  db,
+		projectsDB:          projectsDB,
+		usersDB:             usersDB,
+		usageDB:             usageDB,
+		usagePrices:         usagePrices,
+		usagePriceOverrides: usagePriceOverrides,
+		partnerNames:        partners,
+		nowFn:               time.Now,
+	}, nil
+}
+
+// PrepareInvoiceProjectRecords creates a draft invoice with the project usage
+// of the billing period for every project owner who doesn't have one yet.
+func (service *Service) PrepareInvoiceProjectRecords(ctx context.Context, period time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start, end, err := service.periodBounds(period)
+	if err != nil {
+		return err
+	}
+
+	projects, err := service.projectsDB.GetCreatedBefore(ctx, end)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	byOwner := make(map[uuid.UUID][]console.Project)
+	var owners []uuid.UUID
+	for _, project := range projects {
+		if _, ok := byOwner[project.OwnerID]; !ok {
+			owners = append(owners, project.OwnerID)
+		}
+		byOwner[project.OwnerID] = append(byOwner[project.OwnerID], project)
+	}
+	sort.Slice(owners, func(i, k int) bool {
+		return owners[i].Less(owners[k])
+	})
+
+	var created, skipped int
+	for _, ownerID := range owners {
+		if err = ctx.Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		exists, err := service.db.Exists(ctx, ownerID, start)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if exists {
+			service.log.Warn("Invoice for this user already exists.", zap.Stringer("User ID", ownerID), zap.Time("Period", start))
+			continue
+		}
+
+		invoice := Invoice{
+			UserID:      ownerID,
+			PeriodStart: start,
+			PeriodEnd:   end,
+			Status:      payments.InvoiceStatusDraft,
+		}
+
+		var hasUsage bool
+		for _, project := range byOwner[ownerID] {
+			usages, err := service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, service.partnerNames, start, end)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			for _, item := range service.InvoiceItemsFromProjectUsage(project, usages) {
+				hasUsage = hasUsage || item.Quantity > 0
+				invoice.Amount += item.Amount
+				invoice.Items = append(invoice.Items, item)
+			}
+		}
+
+		if service.config.SkipEmptyInvoices && !hasUsage {
+			skipped++
+			continue
+		}
+
+		invoice.ID, err = uuid.New()
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		if err = service.db.Insert(ctx, invoice); err != nil {
+			return Error.Wrap(err)
+		}
+		created++
+	}
+
+	service.log.Info("Number of prepared invoices.", zap.Int("Created", created), zap.Int("Skipped", skipped))
+	return nil
+}
+
+// InvoiceItemsFromProjectUsage calculates the invoice items from the project usage.
+func (service *Service) InvoiceItemsFromProjectUsage(project console.Project, usages map[accounting.PartnerPlacement]accounting.ProjectUsage) (result []InvoiceItem) {
+	for _, item := range payments.UsageItems(project.Name, usages, service.getProjectUsagePriceModel) {
+		result = append(result, InvoiceItem{
+			ProjectID:   project.ID,
+			Description: item.Description,
+			Quantity:    item.Quantity.IntPart(),
+			UnitPrice:   item.UnitCents,
+			Amount:      item.Cents().IntPart(),
+		})
+	}
+	return result
+}
+
+// getProjectUsagePriceModel returns the project usage price model for a partner name.
+func (service *Service) getProjectUsagePriceModel(partner string) payments.ProjectUsagePriceModel {
+	if override, ok := service.usagePriceOverrides[partner]; ok {
+		return override
+	}
+	return service.usagePrices
+}
+
+// CreateInvoices stores the documents of the draft invoices of the billing period.
+func (service *Service) CreateInvoices(ctx context.Context, period time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	start, _, err := service.periodBounds(period)
+	if err != nil {
+		return err
+	}
+
+	invoices, err := service.db.ListByPeriod(ctx, start)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var draft int
+	for _, invoice := range invoices {
+		if err = ctx.Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		if invoice.Status != payments.InvoiceStatusDraft {
+			continue
+		}
+
+		if err := service.writeDocuments(ctx, invoice); err != nil {
+			return Error.Wrap(err)
+		}
+		draft++
+	}
+
+	service.log.Info("Number of created invoices", zap.Int("Draft", draft))
+	return nil
+}
+
+// GenerateInvoices performs all tasks necessary to generate the invoices.
+// This is equivalent to invoking PrepareInvoiceProjectRecords and CreateInvoices in order.
+func (service *Service) GenerateInvoices(ctx context.Context, period time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, subFn := range []struct {
+		Description string
+		Exec        func(context.Context, time.Time) error
+	}{
+		{"Preparing invoices", service.PrepareInvoiceProjectRecords},
+		{"Creating invoices", service.CreateInvoices},
+	} {
+		service.log.Info(subFn.Description)
+		if err := subFn.Exec(ctx, period); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FinalizeInvoices transitions all draft invoices to open invoices, which are
+// waiting for the manual payment.
+func (service *Service) FinalizeInvoices(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoices, err := service.db.ListByStatus(ctx, payments.InvoiceStatusDraft)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, invoice := range invoices {
+		if err = ctx.Err(); err != nil {
+			return Error.Wrap(err)
+		}
+
+		// documents are rewritten, in case the invoice was prepared but never created.
+		if err := service.writeDocuments(ctx, invoice); err != nil {
+			return Error.Wrap(err)
+		}
+
+		err := service.db.UpdateStatus(ctx, invoice.ID, payments.InvoiceStatusOpen, "", nil)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	service.log.Info("Number of finalized invoices", zap.Int("Open", len(invoices)))
+	return nil
+}
+
+// MarkPaid marks the open invoice as paid with the given payment reference, e.g. the wire transfer ID.
+func (service *Service) MarkPaid(ctx context.Context, id uuid.UUID, paymentReference string) (_ Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoice, err := service.db.Get(ctx, id)
+	if err != nil {
+		return Invoice{}, err
+	}
+
+	if invoice.Status != payments.InvoiceStatusOpen {
+		return Invoice{}, ErrInvalidStatus.New("only open invoices can be marked as paid, invoice is %s", invoice.Status)
+	}
+
+	paidAt := service.nowFn().UTC()
+	err = service.db.UpdateStatus(ctx, id, payments.InvoiceStatusPaid, paymentReference, &paidAt)
+	if err != nil {
+		return Invoice{}, Error.Wrap(err)
+	}
+
+	invoice.Status = payments.InvoiceStatusPaid
+	invoice.PaymentReference = paymentReference
+	invoice.PaidAt = &paidAt
+	return invoice, nil
+}
+
+// Get returns the invoice with the given ID.
+func (service *Service) Get(ctx context.Context, id uuid.UUID) (_ Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return service.db.Get(ctx, id)
+}
+
+// ListByPeriod returns the invoices of the billing period containing period.
+func (service *Service) ListByPeriod(ctx context.Context, period time.Time) (_ []Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	utc := period.UTC()
+	invoices, err := service.db.ListByPeriod(ctx, time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC))
+	return invoices, Error.Wrap(err)
+}
+
+// ListByStatus returns the invoices with the given status.
+func (service *Service) ListByStatus(ctx context.Context, status string) (_ []Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	invoices, err := service.db.ListByStatus(ctx, status)
+	return invoices, Error.Wrap(err)
+}
+
+// DocumentPath returns the path of the invoice document with the given extension, "json" or "pdf".
+func (service *Service) DocumentPath(invoice Invoice, extension string) string {
+	return filepath.Join(service.config.Directory, invoice.PeriodStart.UTC().Format("2006-01"), invoice.ID.String()+"."+extension)
+}
+
+// TestSetNow allows tests to have the Service act as if the current time is whatever they want.
+func (service *Service) TestSetNow(nowFn func() time.Time) {
+	service.nowFn = nowFn
+}
+
+// periodBounds returns the billing month containing period. Only past billing months are allowed.
+func (service *Service) periodBounds(period time.Time) (start, end time.Time, err error) {
+	utc := period.UTC()
+
+	start = time.Date(utc.Year(), utc.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end = time.Date(utc.Year(), utc.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+
+	if end.After(service.nowFn().UTC()) {
+		return time.Time{}, time.Time{}, Error.New("allowed for past periods only")
+	}
+	return start, end, nil
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package invoiceonly_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+	"common/pb"
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite/console"
+	"storx/satellite/payments"
+	"storx/satellite/payments/invoiceonly"
+)
+
+func TestService_InvoiceLifecycle(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		db := sat.DB
+
+		prices, err := sat.Config.Payments.UsagePrice.ToModel()
+		require.NoError(t, err)
+
+		service, err := invoiceonly.NewService(
+			sat.Log.Named("invoiceonly"),
+			invoiceonly.Config{
+				Directory:           ctx.Dir("invoices"),
+				Issuer:              "Storx Test",
+				PaymentInstructions: "IBAN XX00 0000 0000",
+				SkipEmptyInvoices:   true,
+			},
+			db.InvoiceOnly(),
+			db.Console().Projects(),
+			db.Console().Users(),
+			db.ProjectAccounting(),
+			prices,
+			nil)
+		require.NoError(t, err)
+
+		user, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "Wire Transfer",
+			Email:    "wire@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		project, err := sat.AddProject(ctx, user.ID, "billed")
+		require.NoError(t, err)
+
+		idle, err := sat.AddUser(ctx, console.CreateUser{
+			FullName: "No Usage",
+			Email:    "idle@mail.test",
+		}, 1)
+		require.NoError(t, err)
+		_, err = sat.AddProject(ctx, idle.ID, "idle")
+		require.NoError(t, err)
+
+		now := time.Now().UTC()
+		period := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC).AddDate(0, -1, 0)
+
+		// 10GB of egress is charged 45 cents with the test prices.
+		err = db.Orders().UpdateBucketBandwidthSettle(ctx, project.ID, []byte("bucket"), pb.PieceAction_GET, (10 * memory.GB).Int64(), 0, period.Add(24*time.Hour))
+		require.NoError(t, err)
+
+		require.Error(t, service.PrepareInvoiceProjectRecords(ctx, now))
+
+		require.NoError(t, service.PrepareInvoiceProjectRecords(ctx, period))
+		// preparing the period again doesn't create duplicate invoices.
+		require.NoError(t, service.PrepareInvoiceProjectRecords(ctx, period))
+
+		invoices, err := service.ListByPeriod(ctx, period)
+		require.NoError(t, err)
+		require.Len(t, invoices, 1)
+
+		invoice := invoices[0]
+		require.Equal(t, user.ID, invoice.UserID)
+		require.Equal(t, payments.InvoiceStatusDraft, invoice.Status)
+		require.EqualValues(t, 45, invoice.Amount)
+		require.Len(t, invoice.Items, 3)
+
+		require.NoError(t, service.CreateInvoices(ctx, period))
+
+		accounts := service.Accounts()
+
+		charges, err := accounts.ProjectCharges(ctx, user.ID, period, period.AddDate(0, 1, 0))
+		require.NoError(t, err)
+		require.Len(t, charges, 1)
+		require.Equal(t, project.PublicID, charges[0].ProjectID)
+		require.EqualValues(t, 45, charges[0].Egress)
+
+		// the draft invoice blocks the project deletion until it's finalized.
+		require.Error(t, accounts.CheckProjectInvoicingStatus(ctx, project.ID))
+
+		pending, err := accounts.Invoices().CheckPendingItems(ctx, user.ID)
+		require.NoError(t, err)
+		require.True(t, pending)
+
+		listed, err := accounts.Invoices().List(ctx, user.ID)
+		require.NoError(t, err)
+		require.Len(t, listed, 1)
+		require.Equal(t, invoice.ID.String(), listed[0].ID)
+		require.EqualValues(t, 45, listed[0].Amount)
+
+		_, err = accounts.CreditCards().Add(ctx, user.ID, "card")
+		require.True(t, invoiceonly.ErrUnsupported.Has(err))
+
+		jsonDocument, err := os.ReadFile(service.DocumentPath(invoice, "json"))
+		require.NoError(t, err)
+
+		var document invoiceonly.Document
+		require.NoError(t, json.Unmarshal(jsonDocument, &document))
+		require.Equal(t, invoice.ID.String(), document.Number)
+		require.Equal(t, "Storx Test", document.Issuer)
+		require.Equal(t, user.Email, document.CustomerEmail)
+		require.EqualValues(t, 45, document.Total)
+
+		pdfDocument, err := os.ReadFile(service.DocumentPath(invoice, "pdf"))
+		require.NoError(t, err)
+		require.Contains(t, string(pdfDocument), "%PDF-1.4")
+		require.Contains(t, string(pdfDocument), "IBAN XX00 0000 0000")
+
+		// only open invoices can be marked as paid.
+		_, err = service.MarkPaid(ctx, invoice.ID, "WIRE-1")
+		require.True(t, invoiceonly.ErrInvalidStatus.Has(err))
+
+		require.NoError(t, service.FinalizeInvoices(ctx))
+
+		invoice, err = service.Get(ctx, invoice.ID)
+		require.NoError(t, err)
+		require.Equal(t, payments.InvoiceStatusOpen, invoice.Status)
+		require.NoError(t, accounts.CheckProjectInvoicingStatus(ctx, project.ID))
+
+		paid, err := service.MarkPaid(ctx, invoice.ID, "WIRE-1")
+		require.NoError(t, err)
+		require.Equal(t, payments.InvoiceStatusPaid, paid.Status)
+
+		invoice, err = service.Get(ctx, invoice.ID)
+		require.NoError(t, err)
+		require.Equal(t, payments.InvoiceStatusPaid, invoice.Status)
+		require.Equal(t, "WIRE-1", invoice.PaymentReference)
+		require.NotNil(t, invoice.PaidAt)
+
+		open, err := service.ListByStatus(ctx, payments.InvoiceStatusOpen)
+		require.NoError(t, err)
+		require.Empty(t, open)
+	})
+}
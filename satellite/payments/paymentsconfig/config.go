@@ -12,9 +12,11 @@ import (
 	"github.com/spf13/pflag"
 	"github.com/zeebo/errs"
 
+	"common/storx"
 	"common/useragent"
 	"storx/satellite/payments"
 	"storx/satellite/payments/billing"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/storxscan"
 	"storx/satellite/payments/stripecoinpayments"
 )
@@ -29,6 +31,7 @@ type Config struct {
 
 	BillingConfig            billing.Config
 	StripeCoinPayments       stripecoinpayments.Config
+	InvoiceOnly              invoiceonly.Config
 	Storxscan                storxscan.Config
 	UsagePrice               ProjectUsagePrice
 	BonusRate                int64                      `help:"amount of percents that user will earn as bonus credits by depositing in STORX tokens" default:"10"`
@@ -36,7 +39,7 @@ type Config struct {
 	NodeRepairBandwidthPrice int64                      `help:"price node receive for storing TB of repair in cents" default:"1000"`
 	NodeAuditBandwidthPrice  int64                      `help:"price node receive for storing TB of audit in cents" default:"1000"`
 	NodeDiskSpacePrice       int64                      `help:"price node receive for storing disk space in cents/TB" default:"150"`
-	UsagePriceOverrides      ProjectUsagePriceOverrides `help:"semicolon-separated usage price overrides in the format partner:storage,egress,segment"`
+	UsagePriceOverrides      ProjectUsagePriceOverrides `help:"semicolon-separated usage price overrides in the format partner:storage,egress,segment. Overrides have flat prices and replace the volume tiers and placement prices"`
 	PackagePlans             PackagePlans               `help:"semicolon-separated partner package plans in the format partner:couponID,price. Price is in cents USD."`
 }
 
@@ -45,6 +48,10 @@ type ProjectUsagePrice struct {
 	StorageTB string `help:"price user should pay for storage per month in dollars/TB" default:"4" testDefault:"10"`
 	EgressTB  string `help:"price user should pay for egress in dollars/TB" default:"7" testDefault:"45"`
 	Segment   string `help:"price user should pay for segments stored on network per month in dollars/segment" default:"0.0000088" testDefault:"0.0000022"`
+
+	StorageTiers PriceTiers      `help:"semicolon-separated storage volume tiers in the format threshold-tb:dollars/TB. The tier price applies to the monthly storage of a project above the threshold, usage isn't combined across projects or placements"`
+	EgressTiers  PriceTiers      `help:"semicolon-separated egress volume tiers in the format threshold-tb:dollars/TB. The tier price applies to the monthly egress of a project above the threshold, usage isn't combined across projects or placements"`
+	Placements   PlacementPrices `help:"semicolon-separated prices for buckets with a placement constraint in the format region:storage,egress,segment, e.g. EU:6,7,0.0000088"`
 }
 
 // ToModel returns the payments.ProjectUsagePriceModel representation of the project usage price.
@@ -62,14 +69,232 @@ func (p ProjectUsagePrice) ToModel() (model payments.ProjectUsagePriceModel, err
 		return model, Error.Wrap(err)
 	}
 
+	storageTiers, err := p.StorageTiers.ToModels()
+	if err != nil {
+		return model, err
+	}
+	egressTiers, err := p.EgressTiers.ToModels()
+	if err != nil {
+		return model, err
+	}
+	placements, err := p.Placements.ToModels()
+	if err != nil {
+		return model, err
+	}
+
 	// Shift is to change the precision from TB dollars to MB cents
 	return payments.ProjectUsagePriceModel{
 		StorageMBMonthCents: storageTBMonthDollars.Shift(-6).Shift(2),
 		EgressMBCents:       egressTBDollars.Shift(-6).Shift(2),
 		SegmentMonthCents:   segmentMonthDollars.Shift(2),
+		StorageTiers:        storageTiers,
+		EgressTiers:         egressTiers,
+		Placements:          placements,
 	}, nil
 }
 
+// Ensure that PriceTiers implements pflag.Value.
+var _ pflag.Value = (*PriceTiers)(nil)
+
+// PriceTiers represents the volume tiers of a usage price.
+type PriceTiers struct {
+	tiers []PriceTier
+}
+
+// PriceTier holds the configuration of a single volume tier.
+type PriceTier struct {
+	ThresholdTB string
+	PriceTB     string
+}
+
+// Type returns the type of the pflag.Value.
+func (PriceTiers) Type() string { return "paymentsconfig.PriceTiers" }
+
+// String returns the string representation of the price tiers.
+func (p *PriceTiers) String() string {
+	if p == nil {
+		return ""
+	}
+	var s strings.Builder
+	for i, tier := range p.tiers {
+		if i > 0 {
+			s.WriteRune(';')
+		}
+		s.WriteString(fmt.Sprintf("%s:%s", tier.ThresholdTB, tier.PriceTB))
+	}
+	return s.String()
+}
+
+// Set sets the list of price tiers to the parsed string.
+func (p *PriceTiers) Set(s string) error {
+	var tiers []PriceTier
+	var prevThreshold decimal.Decimal
+	for _, tierStr := range strings.Split(s, ";") {
+		if tierStr == "" {
+			continue
+		}
+
+		info := strings.Split(tierStr, ":")
+		if len(info) != 2 {
+			return Error.New("Invalid price tier (expected format threshold:price, got %s)", tierStr)
+		}
+
+		threshold, err := decimal.NewFromString(strings.TrimSpace(info[0]))
+		if err != nil {
+			return Error.New("Invalid threshold (%s)", err)
+		}
+		if !threshold.IsPositive() {
+			return Error.New("Price tier threshold must be positive, got %s", info[0])
+		}
+		if threshold.LessThanOrEqual(prevThreshold) {
+			return Error.New("Price tier thresholds must be increasing, got %s after %s", threshold, prevThreshold)
+		}
+		prevThreshold = threshold
+
+		if _, err := decimal.NewFromString(strings.TrimSpace(info[1])); err != nil {
+			return Error.New("Invalid price (%s)", err)
+		}
+
+		tiers = append(tiers, PriceTier{
+			ThresholdTB: strings.TrimSpace(info[0]),
+			PriceTB:     strings.TrimSpace(info[1]),
+		})
+	}
+	p.tiers = tiers
+	return nil
+}
+
+// SetTiers sets the price tiers. The tiers must be sorted by threshold.
+func (p *PriceTiers) SetTiers(tiers []PriceTier) {
+	p.tiers = tiers
+}
+
+// ToModels returns the price tiers with the thresholds in MB and the prices in MB cents.
+func (p PriceTiers) ToModels() ([]payments.PriceTier, error) {
+	var models []payments.PriceTier
+	for _, tier := range p.tiers {
+		thresholdTB, err := decimal.NewFromString(tier.ThresholdTB)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		priceTB, err := decimal.NewFromString(tier.PriceTB)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		// Shift is to change the precision from TB to MB and from dollars to cents
+		models = append(models, payments.PriceTier{
+			Threshold: thresholdTB.Shift(6),
+			Cents:     priceTB.Shift(-6).Shift(2),
+		})
+	}
+	return models, nil
+}
+
+// Ensure that PlacementPrices implements pflag.Value.
+var _ pflag.Value = (*PlacementPrices)(nil)
+
+// PlacementPrices represents a mapping between placement constraints and project usage prices.
+type PlacementPrices struct {
+	priceMap map[storx.PlacementConstraint]ProjectUsagePrice
+}
+
+// Type returns the type of the pflag.Value.
+func (PlacementPrices) Type() string { return "paymentsconfig.PlacementPrices" }
+
+// String returns the string representation of the placement prices.
+func (p *PlacementPrices) String() string {
+	if p == nil {
+		return ""
+	}
+	var s strings.Builder
+	left := len(p.priceMap)
+	for placement, prices := range p.priceMap {
+		s.WriteString(fmt.Sprintf("%s:%s,%s,%s", payments.PlacementRegionCode(placement), prices.StorageTB, prices.EgressTB, prices.Segment))
+		left--
+		if left > 0 {
+			s.WriteRune(';')
+		}
+	}
+	return s.String()
+}
+
+// Set sets the placement prices to the parsed string.
+func (p *PlacementPrices) Set(s string) error {
+	priceMap := make(map[storx.PlacementConstraint]ProjectUsagePrice)
+	for _, priceStr := range strings.Split(s, ";") {
+		if priceStr == "" {
+			continue
+		}
+
+		info := strings.Split(priceStr, ":")
+		if len(info) != 2 {
+			return Error.New("Invalid placement price (expected format region:storage,egress,segment, got %s)", priceStr)
+		}
+
+		placement, err := parsePlacementRegionCode(strings.TrimSpace(info[0]))
+		if err != nil {
+			return err
+		}
+
+		prices := strings.Split(info[1], ",")
+		if len(prices) != 3 {
+			return Error.New("Invalid prices (expected format storage,egress,segment, got %s)", info[1])
+		}
+
+		for _, price := range prices {
+			if _, err := decimal.NewFromString(price); err != nil {
+				return Error.New("Invalid price (%s)", err)
+			}
+		}
+
+		priceMap[placement] = ProjectUsagePrice{
+			StorageTB: prices[0],
+			EgressTB:  prices[1],
+			Segment:   prices[2],
+		}
+	}
+	p.priceMap = priceMap
+	return nil
+}
+
+// SetMap sets the internal mapping between placement constraints and project usage prices.
+func (p *PlacementPrices) SetMap(prices map[storx.PlacementConstraint]ProjectUsagePrice) {
+	p.priceMap = prices
+}
+
+// ToModels returns the placement prices represented as a mapping between placement constraints and project usage price models.
+func (p PlacementPrices) ToModels() (map[storx.PlacementConstraint]payments.ProjectUsagePriceModel, error) {
+	if len(p.priceMap) == 0 {
+		return nil, nil
+	}
+	models := make(map[storx.PlacementConstraint]payments.ProjectUsagePriceModel)
+	for placement, prices := range p.priceMap {
+		model, err := prices.ToModel()
+		if err != nil {
+			return nil, err
+		}
+		models[placement] = model
+	}
+	return models, nil
+}
+
+// parsePlacementRegionCode returns the placement constraint of the region code.
+func parsePlacementRegionCode(regionCode string) (storx.PlacementConstraint, error) {
+	switch regionCode {
+	case "EU":
+		return storx.EU, nil
+	case "EEA":
+		return storx.EEA, nil
+	case "US":
+		return storx.US, nil
+	case "DE":
+		return storx.DE, nil
+	default:
+		return storx.EveryCountry, Error.New("Invalid placement region code (expected one of EU, EEA, US or DE, got %q)", regionCode)
+	}
+}
+
 // Ensure that ProjectUsagePriceOverrides implements pflag.Value.
 var _ pflag.Value = (*ProjectUsagePriceOverrides)(nil)
 
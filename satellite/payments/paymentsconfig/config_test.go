@@ -12,6 +12,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 
+	"common/storx"
 	"storx/satellite/payments"
 	"storx/satellite/payments/paymentsconfig"
 )
@@ -101,6 +102,84 @@ func TestProjectUsagePriceOverrides(t *testing.T) {
 	}
 }
 
+func TestPriceTiers(t *testing.T) {
+	cases := []struct {
+		testID        string
+		configValue   string
+		expectedTiers []payments.PriceTier
+		expectedError bool
+	}{
+		{
+			testID:      "empty",
+			configValue: "",
+		}, {
+			testID:        "missing price",
+			configValue:   "1",
+			expectedError: true,
+		}, {
+			testID:        "invalid threshold",
+			configValue:   "a:1",
+			expectedError: true,
+		}, {
+			testID:        "zero threshold",
+			configValue:   "0:1",
+			expectedError: true,
+		}, {
+			testID:        "decreasing thresholds",
+			configValue:   "10:2;5:1",
+			expectedError: true,
+		}, {
+			testID:      "multiple tiers",
+			configValue: "10:2;100:1",
+			expectedTiers: []payments.PriceTier{
+				// Shift is to change the precision from TB to MB and from dollars to cents
+				{Threshold: decimal.NewFromInt(10).Shift(6), Cents: decimal.NewFromInt(2).Shift(-4)},
+				{Threshold: decimal.NewFromInt(100).Shift(6), Cents: decimal.NewFromInt(1).Shift(-4)},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.testID, func(t *testing.T) {
+			tiers := &paymentsconfig.PriceTiers{}
+			err := tiers.Set(c.configValue)
+			if c.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.configValue, tiers.String())
+
+			models, err := tiers.ToModels()
+			require.NoError(t, err)
+			require.Len(t, models, len(c.expectedTiers))
+			for i, tier := range c.expectedTiers {
+				require.True(t, tier.Threshold.Equal(models[i].Threshold))
+				require.True(t, tier.Cents.Equal(models[i].Cents))
+			}
+		})
+	}
+}
+
+func TestPlacementPrices(t *testing.T) {
+	prices := &paymentsconfig.PlacementPrices{}
+	require.Error(t, prices.Set("XX:1,2,3"))
+	require.Error(t, prices.Set("EU:1,2"))
+	require.NoError(t, prices.Set("EU:1,2,3"))
+	require.Equal(t, "EU:1,2,3", prices.String())
+
+	price := paymentsconfig.ProjectUsagePrice{StorageTB: "4", EgressTB: "5", Segment: "6", Placements: *prices}
+	model, err := price.ToModel()
+	require.NoError(t, err)
+
+	require.Equal(t, decimal.NewFromInt(4).Shift(-4), model.ForPlacement(storx.EveryCountry).StorageMBMonthCents)
+	require.Equal(t, decimal.NewFromInt(1).Shift(-4), model.ForPlacement(storx.EU).StorageMBMonthCents)
+	require.Equal(t, decimal.NewFromInt(2).Shift(-4), model.ForPlacement(storx.EU).EgressMBCents)
+	require.Equal(t, decimal.NewFromInt(3).Shift(2), model.ForPlacement(storx.EU).SegmentMonthCents)
+	require.Equal(t, decimal.NewFromInt(4).Shift(-4), model.ForPlacement(storx.US).StorageMBMonthCents)
+}
+
 func TestPackagePlans(t *testing.T) {
 	type packages map[string]payments.PackagePlan
 
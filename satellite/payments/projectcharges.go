@@ -4,8 +4,11 @@
 package payments
 
 import (
+	"strconv"
+
 	"github.com/shopspring/decimal"
 
+	"common/storx"
 	"common/uuid"
 	"storx/satellite/accounting"
 )
@@ -28,4 +31,123 @@ type ProjectUsagePriceModel struct {
 	StorageMBMonthCents decimal.Decimal `json:"storageMBMonthCents"`
 	EgressMBCents       decimal.Decimal `json:"egressMBCents"`
 	SegmentMonthCents   decimal.Decimal `json:"segmentMonthCents"`
+
+	// StorageTiers and EgressTiers are the volume tiers, sorted by threshold,
+	// which replace the flat price for the usage above their thresholds.
+	// The thresholds are in MB-Months and MB respectively.
+	//
+	// Tiers are applied to the usage of a single project in a single
+	// placement, the usage of the projects of a customer isn't combined.
+	StorageTiers []PriceTier `json:"storageTiers,omitempty"`
+	EgressTiers  []PriceTier `json:"egressTiers,omitempty"`
+
+	// Placements contains the price models which replace this one for the usage
+	// of the buckets with the given placement constraint.
+	Placements map[storx.PlacementConstraint]ProjectUsagePriceModel `json:"placements,omitempty"`
+}
+
+// PriceTier is a volume tier of a usage price.
+type PriceTier struct {
+	// Threshold is the amount of usage above which the tier price applies.
+	Threshold decimal.Decimal `json:"threshold"`
+	// Cents is the price per unit of the usage above the threshold.
+	Cents decimal.Decimal `json:"cents"`
+}
+
+// PricedUsage is a part of the usage which is charged at a single unit price.
+type PricedUsage struct {
+	// Threshold is the amount of usage from which this part starts.
+	Threshold decimal.Decimal
+	Quantity  decimal.Decimal
+	UnitCents decimal.Decimal
+}
+
+// IsTier returns whether the usage part is charged at a volume tier price.
+func (usage PricedUsage) IsTier() bool {
+	return usage.Threshold.IsPositive()
+}
+
+// Cents returns the price of the usage part rounded to cents.
+func (usage PricedUsage) Cents() decimal.Decimal {
+	return usage.Quantity.Mul(usage.UnitCents).Round(0)
+}
+
+// ForPlacement returns the price model to apply to the usage of the placement.
+func (model ProjectUsagePriceModel) ForPlacement(placement storx.PlacementConstraint) ProjectUsagePriceModel {
+	if placementModel, ok := model.Placements[placement]; ok {
+		return placementModel
+	}
+	return model
+}
+
+// SplitStorage splits the storage usage in MB-Months into the parts charged at the same price.
+func (model ProjectUsagePriceModel) SplitStorage(mbMonths decimal.Decimal) []PricedUsage {
+	return splitByTiers(mbMonths, model.StorageMBMonthCents, model.StorageTiers)
+}
+
+// SplitEgress splits the egress usage in MB into the parts charged at the same price.
+func (model ProjectUsagePriceModel) SplitEgress(mb decimal.Decimal) []PricedUsage {
+	return splitByTiers(mb, model.EgressMBCents, model.EgressTiers)
+}
+
+// StorageCents returns the price of the storage usage in MB-Months.
+func (model ProjectUsagePriceModel) StorageCents(mbMonths decimal.Decimal) decimal.Decimal {
+	return sumCents(model.SplitStorage(mbMonths))
+}
+
+// EgressCents returns the price of the egress usage in MB.
+func (model ProjectUsagePriceModel) EgressCents(mb decimal.Decimal) decimal.Decimal {
+	return sumCents(model.SplitEgress(mb))
+}
+
+// SegmentCents returns the price of the segment usage in Segment-Months.
+func (model ProjectUsagePriceModel) SegmentCents(segmentMonths decimal.Decimal) decimal.Decimal {
+	return segmentMonths.Mul(model.SegmentMonthCents).Round(0)
+}
+
+// PlacementRegionCode returns the region code of the placement constraint
+// used in the price configuration and the invoice item descriptions.
+func PlacementRegionCode(placement storx.PlacementConstraint) string {
+	switch placement {
+	case storx.EU:
+		return "EU"
+	case storx.EEA:
+		return "EEA"
+	case storx.US:
+		return "US"
+	case storx.DE:
+		return "DE"
+	default:
+		return strconv.Itoa(int(placement))
+	}
+}
+
+// splitByTiers splits the quantity into the part below the first tier, which
+// is charged at the flat price, and the parts falling into each tier. The
+// quantity is the usage of a single project in a single placement.
+// The first part is always returned, even when the quantity is zero.
+func splitByTiers(quantity, flatCents decimal.Decimal, tiers []PriceTier) []PricedUsage {
+	parts := []PricedUsage{{Quantity: quantity, UnitCents: flatCents}}
+	for _, tier := range tiers {
+		if quantity.LessThanOrEqual(tier.Threshold) {
+			break
+		}
+		last := &parts[len(parts)-1]
+		last.Quantity = tier.Threshold.Sub(last.Threshold)
+		parts = append(parts, PricedUsage{
+			Threshold: tier.Threshold,
+			Quantity:  quantity.Sub(tier.Threshold),
+			UnitCents: tier.Cents,
+		})
+	}
+	return parts
+}
+
+// sumCents returns the total price of the usage parts.
+func sumCents(parts []PricedUsage) decimal.Decimal {
+	total := decimal.Zero
+	for _, part := range parts {
+		total = total.Add(part.Cents())
+	}
+	return total
 }
@@ -140,15 +140,15 @@ func (accounts *accounts) ProjectCharges(ctx context.Context, userID uuid.UUID,
 	for _, project := range projects {
 		totalUsage := accounting.ProjectUsage{Since: since, Before: before}
 
-		usages, err := accounts.service.usageDB.GetProjectTotalByPartner(ctx, project.ID, accounts.service.partnerNames, since, before)
+		usages, err := accounts.service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, project.ID, accounts.service.partnerNames, since, before)
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
 
 		var totalPrice projectUsagePrice
 
-		for partner, usage := range usages {
-			priceModel := accounts.GetProjectUsagePriceModel(partner)
+		for key, usage := range usages {
+			priceModel := accounts.GetProjectUsagePriceModel(key.Partner).ForPlacement(key.Placement)
 			price := accounts.service.calculateProjectUsagePrice(usage.Egress, usage.Storage, usage.SegmentCount, priceModel)
 
 			totalPrice.Egress = totalPrice.Egress.Add(price.Egress)
@@ -8,7 +8,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -35,8 +34,8 @@ var (
 	mon = monkit.Package()
 )
 
-// hoursPerMonth is the number of months in a billing month. For the purpose of billing, the billing month is always 30 days.
-const hoursPerMonth = 24 * 30
+// ensures that Service implements payments.InvoiceGenerator.
+var _ payments.InvoiceGenerator = (*Service)(nil)
 
 // Config stores needed information for payment service initialization.
 type Config struct {
@@ -489,7 +488,7 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 		return true, nil
 	}
 
-	usages, err := service.usageDB.GetProjectTotalByPartner(ctx, record.ProjectID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
+	usages, err := service.usageDB.GetProjectTotalByPartnerAndPlacement(ctx, record.ProjectID, service.partnerNames, record.PeriodStart, record.PeriodEnd)
 	if err != nil {
 		return false, err
 	}
@@ -511,47 +510,14 @@ func (service *Service) createInvoiceItems(ctx context.Context, cusID, projName
 }
 
 // InvoiceItemsFromProjectUsage calculates Stripe invoice item from project usage.
-func (service *Service) InvoiceItemsFromProjectUsage(projName string, partnerUsages map[string]accounting.ProjectUsage) (result []*stripe.InvoiceItemParams) {
-	var partners []string
-	if len(partnerUsages) == 0 {
-		partners = []string{""}
-		partnerUsages = map[string]accounting.ProjectUsage{"": {}}
-	} else {
-		for partner := range partnerUsages {
-			partners = append(partners, partner)
-		}
-		sort.Strings(partners)
-	}
-
-	for _, partner := range partners {
-		usage := partnerUsages[partner]
-		priceModel := service.Accounts().GetProjectUsagePriceModel(partner)
-
-		prefix := "Project " + projName
-		if partner != "" {
-			prefix += " (" + partner + ")"
-		}
-
-		projectItem := &stripe.InvoiceItemParams{}
-		projectItem.Description = stripe.String(prefix + " - Segment Storage (MB-Month)")
-		projectItem.Quantity = stripe.Int64(storageMBMonthDecimal(usage.Storage).IntPart())
-		storagePrice, _ := priceModel.StorageMBMonthCents.Float64()
-		projectItem.UnitAmountDecimal = stripe.Float64(storagePrice)
-		result = append(result, projectItem)
-
-		projectItem = &stripe.InvoiceItemParams{}
-		projectItem.Description = stripe.String(prefix + " - Egress Bandwidth (MB)")
-		projectItem.Quantity = stripe.Int64(egressMBDecimal(usage.Egress).IntPart())
-		egressPrice, _ := priceModel.EgressMBCents.Float64()
-		projectItem.UnitAmountDecimal = stripe.Float64(egressPrice)
-		result = append(result, projectItem)
-
-		projectItem = &stripe.InvoiceItemParams{}
-		projectItem.Description = stripe.String(prefix + " - Segment Fee (Segment-Month)")
-		projectItem.Quantity = stripe.Int64(segmentMonthDecimal(usage.SegmentCount).IntPart())
-		segmentPrice, _ := priceModel.SegmentMonthCents.Float64()
-		projectItem.UnitAmountDecimal = stripe.Float64(segmentPrice)
-		result = append(result, projectItem)
+func (service *Service) InvoiceItemsFromProjectUsage(projName string, usages map[accounting.PartnerPlacement]accounting.ProjectUsage) (result []*stripe.InvoiceItemParams) {
+	for _, item := range payments.UsageItems(projName, usages, service.Accounts().GetProjectUsagePriceModel) {
+		unitPrice, _ := item.UnitCents.Float64()
+		result = append(result, &stripe.InvoiceItemParams{
+			Description:       stripe.String(item.Description),
+			Quantity:          stripe.Int64(item.Quantity.IntPart()),
+			UnitAmountDecimal: stripe.Float64(unitPrice),
+		})
 	}
 
 	service.log.Info("invoice items", zap.Any("result", result))
@@ -822,9 +788,9 @@ func (price projectUsagePrice) TotalInt64() int64 {
 // calculateProjectUsagePrice calculate project usage price.
 func (service *Service) calculateProjectUsagePrice(egress int64, storage, segments float64, pricing payments.ProjectUsagePriceModel) projectUsagePrice {
 	return projectUsagePrice{
-		Storage:  pricing.StorageMBMonthCents.Mul(storageMBMonthDecimal(storage)).Round(0),
-		Egress:   pricing.EgressMBCents.Mul(egressMBDecimal(egress)).Round(0),
-		Segments: pricing.SegmentMonthCents.Mul(segmentMonthDecimal(segments)).Round(0),
+		Storage:  pricing.StorageCents(payments.StorageMBMonthDecimal(storage)),
+		Egress:   pricing.EgressCents(payments.EgressMBDecimal(egress)),
+		Segments: pricing.SegmentCents(payments.SegmentMonthDecimal(segments)),
 	}
 }
 
@@ -834,24 +800,6 @@ func (service *Service) SetNow(now func() time.Time) {
 	service.nowFn = now
 }
 
-// storageMBMonthDecimal converts storage usage from Byte-Hours to Megabyte-Months.
-// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
-func storageMBMonthDecimal(storage float64) decimal.Decimal {
-	return decimal.NewFromFloat(storage).Shift(-6).Div(decimal.NewFromInt(hoursPerMonth)).Round(0)
-}
-
-// egressMBDecimal converts egress usage from bytes to Megabytes
-// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
-func egressMBDecimal(egress int64) decimal.Decimal {
-	return decimal.NewFromInt(egress).Shift(-6).Round(0)
-}
-
-// segmentMonthDecimal converts segments usage from Segment-Hours to Segment-Months.
-// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
-func segmentMonthDecimal(segments float64) decimal.Decimal {
-	return decimal.NewFromFloat(segments).Div(decimal.NewFromInt(hoursPerMonth)).Round(0)
-}
-
 // doesProjectRecordHaveNoUsage returns true if the given project record
 // represents a billing cycle where there was no usage.
 func doesProjectRecordHaveNoUsage(record ProjectRecord) bool {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/stripe/stripe-go/v72"
 	"go.uber.org/zap"
@@ -266,18 +267,18 @@ func TestService_InvoiceItemsFromProjectUsage(t *testing.T) {
 			},
 		},
 	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
-		usage := map[string]accounting.ProjectUsage{
-			"": {
+		usage := map[accounting.PartnerPlacement]accounting.ProjectUsage{
+			{}: {
 				Storage:      10000000000,             // Byte-hours
 				Egress:       123 * memory.GB.Int64(), // Bytes
 				SegmentCount: 200000,                  // Segment-Hours
 			},
-			partnerName: {
+			{Partner: partnerName}: {
 				Storage:      20000000000,
 				Egress:       456 * memory.GB.Int64(),
 				SegmentCount: 400000,
 			},
-			noOverridePartnerName: {
+			{Partner: noOverridePartnerName}: {
 				Storage:      30000000000,
 				Egress:       789 * memory.GB.Int64(),
 				SegmentCount: 600000,
@@ -302,7 +303,7 @@ func TestService_InvoiceItemsFromProjectUsage(t *testing.T) {
 					prefix += " (" + tt.partner + ")"
 				}
 
-				usage := usage[tt.partner]
+				usage := usage[accounting.PartnerPlacement{Partner: tt.partner}]
 				expectedStorageQuantity := int64(math.Round(usage.Storage / float64(byteHoursPerMBMonth)))
 				expectedEgressQuantity := int64(math.Round(float64(usage.Egress) / float64(bytesPerMegabyte)))
 				expectedSegmentQuantity := int64(math.Round(usage.SegmentCount / hoursPerMonth))
@@ -331,6 +332,73 @@ func TestService_InvoiceItemsFromProjectUsage(t *testing.T) {
 	})
 }
 
+func TestService_InvoiceItemsFromProjectUsageTiersAndPlacements(t *testing.T) {
+	const (
+		projectName = "my-project"
+
+		hoursPerMonth       = 24 * 30
+		byteHoursPerTBMonth = hoursPerMonth * int64(memory.TB/memory.B)
+	)
+
+	defaultPrice := paymentsconfig.ProjectUsagePrice{
+		StorageTB: "1",
+		EgressTB:  "2",
+		Segment:   "3",
+	}
+	defaultPrice.StorageTiers.SetTiers([]paymentsconfig.PriceTier{{ThresholdTB: "1", PriceTB: "0.5"}})
+	defaultPrice.Placements.SetMap(map[storx.PlacementConstraint]paymentsconfig.ProjectUsagePrice{
+		storx.EU: {StorageTB: "4", EgressTB: "5", Segment: "6"},
+	})
+	defaultModel, err := defaultPrice.ToModel()
+	require.NoError(t, err)
+	euModel := defaultModel.Placements[storx.EU]
+
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Payments.UsagePrice = defaultPrice
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		usage := map[accounting.PartnerPlacement]accounting.ProjectUsage{
+			{}: {
+				Storage:      float64(3 * byteHoursPerTBMonth),
+				Egress:       memory.TB.Int64(),
+				SegmentCount: hoursPerMonth,
+			},
+			{Placement: storx.EU}: {
+				Storage:      float64(3 * byteHoursPerTBMonth),
+				Egress:       memory.TB.Int64(),
+				SegmentCount: hoursPerMonth,
+			},
+		}
+
+		items := planet.Satellites[0].API.Payments.StripeService.InvoiceItemsFromProjectUsage(projectName, usage)
+		require.Len(t, items, 7)
+
+		prefix := "Project " + projectName
+		for i, tt := range []struct {
+			description string
+			quantity    int64
+			unitPrice   decimal.Decimal
+		}{
+			{prefix + " - Segment Storage (MB-Month)", 1000000, defaultModel.StorageMBMonthCents},
+			{prefix + " - Segment Storage (MB-Month, above 1 TB)", 2000000, defaultModel.StorageTiers[0].Cents},
+			{prefix + " - Egress Bandwidth (MB)", 1000000, defaultModel.EgressMBCents},
+			{prefix + " - Segment Fee (Segment-Month)", 1, defaultModel.SegmentMonthCents},
+			{prefix + " [EU] - Segment Storage (MB-Month)", 3000000, euModel.StorageMBMonthCents},
+			{prefix + " [EU] - Egress Bandwidth (MB)", 1000000, euModel.EgressMBCents},
+			{prefix + " [EU] - Segment Fee (Segment-Month)", 1, euModel.SegmentMonthCents},
+		} {
+			require.Equal(t, tt.description, *items[i].Description)
+			require.Equal(t, tt.quantity, *items[i].Quantity)
+			unitPrice, _ := tt.unitPrice.Float64()
+			require.Equal(t, unitPrice, *items[i].UnitAmountDecimal)
+		}
+	})
+}
+
 func TestService_InvoiceItemsFromZeroTokenBalance(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 0,
@@ -0,0 +1,107 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package payments
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+
+	"common/storx"
+	"storx/satellite/accounting"
+)
+
+// HoursPerMonth is the number of hours in a billing month. For the purpose of billing, the billing month is always 30 days.
+const HoursPerMonth = 24 * 30
+
+// UsageItem is a part of the usage of a project which is billed as a single invoice item.
+type UsageItem struct {
+	Description string
+	PricedUsage
+}
+
+// UsageItems splits the usage of a project into the items billed by every payments provider.
+// The usage of every partner and placement is priced with its own price model, storage and
+// egress are split further by the volume tiers. The items are sorted by partner and placement.
+// A project without usage gets the items of the default price model with zero quantities.
+func UsageItems(projectName string, usages map[accounting.PartnerPlacement]accounting.ProjectUsage, priceModel func(partner string) ProjectUsagePriceModel) (items []UsageItem) {
+	var keys []accounting.PartnerPlacement
+	if len(usages) == 0 {
+		keys = []accounting.PartnerPlacement{{}}
+		usages = map[accounting.PartnerPlacement]accounting.ProjectUsage{{}: {}}
+	} else {
+		for key := range usages {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, k int) bool {
+			if keys[i].Partner != keys[k].Partner {
+				return keys[i].Partner < keys[k].Partner
+			}
+			return keys[i].Placement < keys[k].Placement
+		})
+	}
+
+	for _, key := range keys {
+		usage := usages[key]
+		model := priceModel(key.Partner).ForPlacement(key.Placement)
+
+		prefix := "Project " + projectName
+		if key.Partner != "" {
+			prefix += " (" + key.Partner + ")"
+		}
+		if key.Placement != storx.EveryCountry {
+			prefix += " [" + PlacementRegionCode(key.Placement) + "]"
+		}
+
+		for _, part := range model.SplitStorage(StorageMBMonthDecimal(usage.Storage)) {
+			items = append(items, UsageItem{
+				Description: prefix + " - Segment Storage (MB-Month" + tierSuffix(part) + ")",
+				PricedUsage: part,
+			})
+		}
+		for _, part := range model.SplitEgress(EgressMBDecimal(usage.Egress)) {
+			items = append(items, UsageItem{
+				Description: prefix + " - Egress Bandwidth (MB" + tierSuffix(part) + ")",
+				PricedUsage: part,
+			})
+		}
+		items = append(items, UsageItem{
+			Description: prefix + " - Segment Fee (Segment-Month)",
+			PricedUsage: PricedUsage{
+				Quantity:  SegmentMonthDecimal(usage.SegmentCount),
+				UnitCents: model.SegmentMonthCents,
+			},
+		})
+	}
+
+	return items
+}
+
+// tierSuffix returns the invoice item description suffix of the usage part
+// charged at a volume tier price.
+func tierSuffix(part PricedUsage) string {
+	if !part.IsTier() {
+		return ""
+	}
+	// Shift is to change the precision from MB to TB
+	return ", above " + part.Threshold.Shift(-6).String() + " TB"
+}
+
+// StorageMBMonthDecimal converts storage usage from Byte-Hours to Megabyte-Months.
+// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
+func StorageMBMonthDecimal(storage float64) decimal.Decimal {
+	return decimal.NewFromFloat(storage).Shift(-6).Div(decimal.NewFromInt(HoursPerMonth)).Round(0)
+}
+
+// EgressMBDecimal converts egress usage from bytes to Megabytes
+// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
+func EgressMBDecimal(egress int64) decimal.Decimal {
+	return decimal.NewFromInt(egress).Shift(-6).Round(0)
+}
+
+// SegmentMonthDecimal converts segments usage from Segment-Hours to Segment-Months.
+// The result is rounded to the nearest whole number, but returned as Decimal for convenience.
+func SegmentMonthDecimal(segments float64) decimal.Decimal {
+	return decimal.NewFromFloat(segments).Div(decimal.NewFromInt(HoursPerMonth)).Round(0)
+}
@@ -35,6 +35,9 @@ import (
 	"storx/satellite/buckets"
 	"storx/satellite/compensation"
 	"storx/satellite/console"
+	"storx/satellite/console/accountdeletion"
+	"storx/satellite/console/accountexport"
+	"storx/satellite/console/budgetalerts"
 	"storx/satellite/console/consoleauth"
 	"storx/satellite/console/consoleweb"
 	"storx/satellite/console/emailreminders"
@@ -44,6 +47,7 @@ import (
 	"storx/satellite/gc/bloomfilter"
 	"storx/satellite/gc/sender"
 	"storx/satellite/gracefulexit"
+	"storx/satellite/inventory"
 	"storx/satellite/mailservice"
 	"storx/satellite/mailservice/simulate"
 	"storx/satellite/metabase/rangedloop"
@@ -60,6 +64,7 @@ import (
 	"storx/satellite/overlay/straynodes"
 	"storx/satellite/payments/accountfreeze"
 	"storx/satellite/payments/billing"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/paymentsconfig"
 	"storx/satellite/payments/storxscan"
 	"storx/satellite/payments/stripecoinpayments"
@@ -126,6 +131,8 @@ type DB interface {
 	Billing() billing.TransactionsDB
 	// Wallets returns storxscan wallets database.
 	Wallets() storxscan.WalletsDB
+	// InvoiceOnly returns the database of the invoice-only billing backend.
+	InvoiceOnly() invoiceonly.DB
 	// SNOPayouts returns database for payouts.
 	SNOPayouts() snopayouts.DB
 	// Compensation tracks storage node compensation
@@ -198,10 +205,13 @@ type Config struct {
 
 	Payments paymentsconfig.Config
 
-	RESTKeys       restkeys.Config
-	Console        consoleweb.Config
-	ConsoleAuth    consoleauth.Config
-	EmailReminders emailreminders.Config
+	RESTKeys        restkeys.Config
+	Console         consoleweb.Config
+	ConsoleAuth     consoleauth.Config
+	EmailReminders  emailreminders.Config
+	BudgetAlerts    budgetalerts.Config
+	AccountExport   accountexport.Config
+	AccountDeletion accountdeletion.Config
 
 	AccountFreeze accountfreeze.Config
 
@@ -209,6 +219,8 @@ type Config struct {
 
 	GracefulExit gracefulexit.Config
 
+	Inventory inventory.Config
+
 	Metrics metrics.Config
 
 	Compensation compensation.Config
@@ -0,0 +1,154 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"common/uuid"
+	"storx/satellite/console"
+	"storx/satellite/satellitedb/dbx"
+)
+
+// Ensure that accountDeletions implements console.AccountDeletions.
+var _ console.AccountDeletions = (*accountDeletions)(nil)
+
+// accountDeletions is an implementation of console.AccountDeletions.
+type accountDeletions struct {
+	db dbx.Methods
+}
+
+// Create schedules the deletion of the user account.
+func (deletions *accountDeletions) Create(ctx context.Context, userID uuid.UUID, requestedBy console.AccountDeletionRequester, deleteAfter time.Time) (_ *console.AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxDeletion, err := deletions.db.Create_AccountDeletion(ctx,
+		dbx.AccountDeletion_UserId(userID.Bytes()),
+		dbx.AccountDeletion_RequestedBy(string(requestedBy)),
+		dbx.AccountDeletion_DeleteAfter(deleteAfter),
+		dbx.AccountDeletion_Create_Fields{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXAccountDeletion(dbxDeletion)
+}
+
+// Get returns the scheduled deletion of the user account.
+func (deletions *accountDeletions) Get(ctx context.Context, userID uuid.UUID) (_ *console.AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxDeletion, err := deletions.db.Get_AccountDeletion_By_UserId(ctx, dbx.AccountDeletion_UserId(userID.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXAccountDeletion(dbxDeletion)
+}
+
+// GetAll returns all the scheduled account deletions, the earliest due first.
+func (deletions *accountDeletions) GetAll(ctx context.Context) (_ []console.AccountDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxDeletions, err := deletions.db.All_AccountDeletion_OrderBy_Asc_DeleteAfter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	all := make([]console.AccountDeletion, 0, len(dbxDeletions))
+	for _, dbxDeletion := range dbxDeletions {
+		deletion, err := fromDBXAccountDeletion(dbxDeletion)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, *deletion)
+	}
+
+	return all, nil
+}
+
+// UpdateNotifiedAt records when the user was notified about the scheduled deletion.
+func (deletions *accountDeletions) UpdateNotifiedAt(ctx context.Context, userID uuid.UUID, notifiedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return deletions.db.UpdateNoReturn_AccountDeletion_By_UserId(ctx,
+		dbx.AccountDeletion_UserId(userID.Bytes()),
+		dbx.AccountDeletion_Update_Fields{
+			NotifiedAt: dbx.AccountDeletion_NotifiedAt(notifiedAt),
+		},
+	)
+}
+
+// Delete removes the scheduled deletion of the user account.
+func (deletions *accountDeletions) Delete(ctx context.Context, userID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = deletions.db.Delete_AccountDeletion_By_UserId(ctx, dbx.AccountDeletion_UserId(userID.Bytes()))
+	return err
+}
+
+// InsertEvent records a step of the deletion of the user account.
+func (deletions *accountDeletions) InsertEvent(ctx context.Context, userID uuid.UUID, step console.AccountDeletionStep, details string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return err
+	}
+
+	createFields := dbx.AccountDeletionEvent_Create_Fields{}
+	if details != "" {
+		createFields.Details = dbx.AccountDeletionEvent_Details(details)
+	}
+
+	return deletions.db.CreateNoReturn_AccountDeletionEvent(ctx,
+		dbx.AccountDeletionEvent_Id(id.Bytes()),
+		dbx.AccountDeletionEvent_UserId(userID.Bytes()),
+		dbx.AccountDeletionEvent_Step(string(step)),
+		createFields,
+	)
+}
+
+// GetEvents returns the recorded steps of the deletion of the user account, oldest first.
+func (deletions *accountDeletions) GetEvents(ctx context.Context, userID uuid.UUID) (_ []console.AccountDeletionEvent, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxEvents, err := deletions.db.All_AccountDeletionEvent_By_UserId_OrderBy_Asc_CreatedAt(ctx, dbx.AccountDeletionEvent_UserId(userID.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]console.AccountDeletionEvent, 0, len(dbxEvents))
+	for _, dbxEvent := range dbxEvents {
+		event := console.AccountDeletionEvent{
+			UserID:    userID,
+			Step:      console.AccountDeletionStep(dbxEvent.Step),
+			CreatedAt: dbxEvent.CreatedAt,
+		}
+		if dbxEvent.Details != nil {
+			event.Details = *dbxEvent.Details
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// fromDBXAccountDeletion converts *dbx.AccountDeletion to *console.AccountDeletion.
+func fromDBXAccountDeletion(dbxDeletion *dbx.AccountDeletion) (_ *console.AccountDeletion, err error) {
+	userID, err := uuid.FromBytes(dbxDeletion.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.AccountDeletion{
+		UserID:      userID,
+		RequestedBy: console.AccountDeletionRequester(dbxDeletion.RequestedBy),
+		DeleteAfter: dbxDeletion.DeleteAfter,
+		NotifiedAt:  dbxDeletion.NotifiedAt,
+		CreatedAt:   dbxDeletion.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/testcontext"
+	"common/testrand"
+	"storx/satellite"
+	"storx/satellite/console"
+	"storx/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAccountDeletions(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		deletionsDB := db.Console().AccountDeletions()
+		userID := testrand.UUID()
+		otherID := testrand.UUID()
+		now := time.Now()
+
+		_, err := deletionsDB.Get(ctx, userID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		deletion, err := deletionsDB.Create(ctx, userID, console.AccountDeletionByUser, now.Add(2*time.Hour))
+		require.NoError(t, err)
+		require.Equal(t, userID, deletion.UserID)
+		require.Equal(t, console.AccountDeletionByUser, deletion.RequestedBy)
+		require.Nil(t, deletion.NotifiedAt)
+		require.WithinDuration(t, now, deletion.CreatedAt, time.Minute)
+
+		_, err = deletionsDB.Create(ctx, otherID, console.AccountDeletionByAdmin, now.Add(time.Hour))
+		require.NoError(t, err)
+
+		deletions, err := deletionsDB.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, deletions, 2)
+		require.Equal(t, otherID, deletions[0].UserID)
+		require.Equal(t, console.AccountDeletionByAdmin, deletions[0].RequestedBy)
+
+		require.NoError(t, deletionsDB.UpdateNotifiedAt(ctx, userID, now))
+
+		deletion, err = deletionsDB.Get(ctx, userID)
+		require.NoError(t, err)
+		require.NotNil(t, deletion.NotifiedAt)
+		require.WithinDuration(t, now, *deletion.NotifiedAt, time.Second)
+
+		require.NoError(t, deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionScheduled, "requested by user"))
+		require.NoError(t, deletionsDB.InsertEvent(ctx, userID, console.AccountDeletionFrozen, ""))
+		require.NoError(t, deletionsDB.InsertEvent(ctx, otherID, console.AccountDeletionScheduled, ""))
+
+		require.NoError(t, deletionsDB.Delete(ctx, userID))
+
+		_, err = deletionsDB.Get(ctx, userID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+
+		// the events are kept after the deletion row is removed.
+		events, err := deletionsDB.GetEvents(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, console.AccountDeletionScheduled, events[0].Step)
+		require.Equal(t, "requested by user", events[0].Details)
+		require.Equal(t, console.AccountDeletionFrozen, events[1].Step)
+		require.Empty(t, events[1].Details)
+	})
+}
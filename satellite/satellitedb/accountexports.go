@@ -0,0 +1,156 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"common/uuid"
+	"storx/satellite/console"
+	"storx/satellite/satellitedb/dbx"
+)
+
+// Ensure that accountExports implements console.AccountExports.
+var _ console.AccountExports = (*accountExports)(nil)
+
+// accountExports is an implementation of console.AccountExports.
+type accountExports struct {
+	db dbx.Methods
+}
+
+// Create inserts a new pending account data export of the user.
+func (exports *accountExports) Create(ctx context.Context, userID uuid.UUID) (_ *console.AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := uuid.New()
+	if err != nil {
+		return nil, err
+	}
+
+	dbxExport, err := exports.db.Create_AccountExport(ctx,
+		dbx.AccountExport_Id(id.Bytes()),
+		dbx.AccountExport_UserId(userID.Bytes()),
+		dbx.AccountExport_Status(int(console.AccountExportPending)),
+		dbx.AccountExport_Create_Fields{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXAccountExport(dbxExport)
+}
+
+// Get returns the account data export with the given ID.
+func (exports *accountExports) Get(ctx context.Context, id uuid.UUID) (_ *console.AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxExport, err := exports.db.Get_AccountExport_By_Id(ctx, dbx.AccountExport_Id(id.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXAccountExport(dbxExport)
+}
+
+// ListByUserID returns the account data exports of the user, newest first.
+// The archives aren't included.
+func (exports *accountExports) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []console.AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxExports, err := exports.db.All_AccountExport_By_UserId_OrderBy_Desc_CreatedAt(ctx, dbx.AccountExport_UserId(userID.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXAccountExports(dbxExports)
+}
+
+// ListPending returns the account data exports which haven't been processed yet, oldest first.
+func (exports *accountExports) ListPending(ctx context.Context) (_ []console.AccountExport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxExports, err := exports.db.All_AccountExport_By_Status_OrderBy_Asc_CreatedAt(ctx, dbx.AccountExport_Status(int(console.AccountExportPending)))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXAccountExports(dbxExports)
+}
+
+// Complete stores the archive of the account data export and marks it as ready.
+func (exports *accountExports) Complete(ctx context.Context, id uuid.UUID, archive []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return exports.db.UpdateNoReturn_AccountExport_By_Id(ctx,
+		dbx.AccountExport_Id(id.Bytes()),
+		dbx.AccountExport_Update_Fields{
+			Status:      dbx.AccountExport_Status(int(console.AccountExportReady)),
+			Archive:     dbx.AccountExport_Archive(archive),
+			CompletedAt: dbx.AccountExport_CompletedAt(time.Now()),
+		},
+	)
+}
+
+// Fail marks the account data export as failed.
+func (exports *accountExports) Fail(ctx context.Context, id uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return exports.db.UpdateNoReturn_AccountExport_By_Id(ctx,
+		dbx.AccountExport_Id(id.Bytes()),
+		dbx.AccountExport_Update_Fields{
+			Status:      dbx.AccountExport_Status(int(console.AccountExportFailed)),
+			CompletedAt: dbx.AccountExport_CompletedAt(time.Now()),
+		},
+	)
+}
+
+// DeleteCompletedBefore removes the account data exports completed before the given time.
+func (exports *accountExports) DeleteCompletedBefore(ctx context.Context, before time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return exports.db.Delete_AccountExport_By_CompletedAt_Less(ctx, dbx.AccountExport_CompletedAt(before))
+}
+
+// DeleteByUserID removes all the account data exports of the user.
+func (exports *accountExports) DeleteByUserID(ctx context.Context, userID uuid.UUID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return exports.db.Delete_AccountExport_By_UserId(ctx, dbx.AccountExport_UserId(userID.Bytes()))
+}
+
+// fromDBXAccountExports converts []*dbx.AccountExport to []console.AccountExport without the archives.
+func fromDBXAccountExports(dbxExports []*dbx.AccountExport) (_ []console.AccountExport, err error) {
+	exports := make([]console.AccountExport, 0, len(dbxExports))
+	for _, dbxExport := range dbxExports {
+		export, err := fromDBXAccountExport(dbxExport)
+		if err != nil {
+			return nil, err
+		}
+		export.Archive = nil
+		exports = append(exports, *export)
+	}
+	return exports, nil
+}
+
+// fromDBXAccountExport converts *dbx.AccountExport to *console.AccountExport.
+func fromDBXAccountExport(dbxExport *dbx.AccountExport) (_ *console.AccountExport, err error) {
+	id, err := uuid.FromBytes(dbxExport.Id)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuid.FromBytes(dbxExport.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.AccountExport{
+		ID:          id,
+		UserID:      userID,
+		Status:      console.AccountExportStatus(dbxExport.Status),
+		Archive:     dbxExport.Archive,
+		CreatedAt:   dbxExport.CreatedAt,
+		CompletedAt: dbxExport.CompletedAt,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"common/testcontext"
+	"common/testrand"
+	"storx/satellite"
+	"storx/satellite/console"
+	"storx/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAccountExports(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		exportsDB := db.Console().AccountExports()
+		userID := testrand.UUID()
+
+		first, err := exportsDB.Create(ctx, userID)
+		require.NoError(t, err)
+		require.Equal(t, userID, first.UserID)
+		require.Equal(t, console.AccountExportPending, first.Status)
+		require.Nil(t, first.CompletedAt)
+		require.WithinDuration(t, time.Now(), first.CreatedAt, time.Minute)
+
+		second, err := exportsDB.Create(ctx, userID)
+		require.NoError(t, err)
+
+		_, err = exportsDB.Create(ctx, testrand.UUID())
+		require.NoError(t, err)
+
+		pending, err := exportsDB.ListPending(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 3)
+		require.Equal(t, first.ID, pending[0].ID)
+
+		archive := testrand.BytesInt(256)
+		require.NoError(t, exportsDB.Complete(ctx, first.ID, archive))
+		require.NoError(t, exportsDB.Fail(ctx, second.ID))
+
+		export, err := exportsDB.Get(ctx, first.ID)
+		require.NoError(t, err)
+		require.Equal(t, console.AccountExportReady, export.Status)
+		require.Equal(t, archive, export.Archive)
+		require.NotNil(t, export.CompletedAt)
+
+		exports, err := exportsDB.ListByUserID(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, exports, 2)
+		require.Equal(t, second.ID, exports[0].ID)
+		require.Equal(t, console.AccountExportFailed, exports[0].Status)
+		require.Nil(t, exports[1].Archive)
+
+		pending, err = exportsDB.ListPending(ctx)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+
+		deleted, err := exportsDB.DeleteCompletedBefore(ctx, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		require.EqualValues(t, 2, deleted)
+
+		_, err = exportsDB.Get(ctx, first.ID)
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
+}
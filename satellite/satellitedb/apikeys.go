@@ -74,7 +74,8 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	}
 
 	repoundQuery := keys.db.Rebind(`
-		SELECT ak.id, ak.project_id, ak.name, ak.user_agent, ak.created_at
+		SELECT ak.id, ak.project_id, ak.name, ak.user_agent, ak.created_at,
+			ak.expires_at, ak.daily_egress_limit, ak.requests_per_minute
 		FROM api_keys ak
 		WHERE ak.project_id = ?
 		AND lower(ak.name) LIKE ?
@@ -98,7 +99,8 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	for rows.Next() {
 		ak := console.APIKeyInfo{}
 
-		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &ak.UserAgent, &ak.CreatedAt)
+		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &ak.UserAgent, &ak.CreatedAt,
+			&ak.ExpiresAt, &ak.DailyEgressLimit, &ak.RequestsPerMinute)
 		if err != nil {
 			return nil, err
 		}
@@ -177,6 +179,15 @@ func (keys *apikeys) Create(ctx context.Context, head []byte, info console.APIKe
 	if info.UserAgent != nil {
 		optional.UserAgent = dbx.ApiKey_UserAgent(info.UserAgent)
 	}
+	if info.ExpiresAt != nil {
+		optional.ExpiresAt = dbx.ApiKey_ExpiresAt(*info.ExpiresAt)
+	}
+	if info.DailyEgressLimit != nil {
+		optional.DailyEgressLimit = dbx.ApiKey_DailyEgressLimit(*info.DailyEgressLimit)
+	}
+	if info.RequestsPerMinute != nil {
+		optional.RequestsPerMinute = dbx.ApiKey_RequestsPerMinute(*info.RequestsPerMinute)
+	}
 
 	dbKey, err := keys.methods.Create_ApiKey(
 		ctx,
@@ -202,7 +213,10 @@ func (keys *apikeys) Update(ctx context.Context, key console.APIKeyInfo) (err er
 		ctx,
 		dbx.ApiKey_Id(key.ID[:]),
 		dbx.ApiKey_Update_Fields{
-			Name: dbx.ApiKey_Name(key.Name),
+			Name:              dbx.ApiKey_Name(key.Name),
+			ExpiresAt:         dbx.ApiKey_ExpiresAt_Raw(key.ExpiresAt),
+			DailyEgressLimit:  dbx.ApiKey_DailyEgressLimit_Raw(key.DailyEgressLimit),
+			RequestsPerMinute: dbx.ApiKey_RequestsPerMinute_Raw(key.RequestsPerMinute),
 		},
 	)
 }
@@ -234,6 +248,11 @@ func fromDBXAPIKey(ctx context.Context, key *dbx.ApiKey) (_ *console.APIKeyInfo,
 		CreatedAt: key.CreatedAt,
 		Head:      key.Head,
 		Secret:    key.Secret,
+		APIKeyLimits: console.APIKeyLimits{
+			ExpiresAt:         key.ExpiresAt,
+			DailyEgressLimit:  key.DailyEgressLimit,
+			RequestsPerMinute: key.RequestsPerMinute,
+		},
 	}
 
 	if key.UserAgent != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/zeebo/errs"
 
@@ -175,19 +176,189 @@ func (db *bucketsDB) UpdateBucket(ctx context.Context, bucket storx.Bucket) (_ s
 // DeleteBucket deletes a bucket.
 func (db *bucketsDB) DeleteBucket(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
 	defer mon.Task()(&ctx)(&err)
-	deleted, err := db.db.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
-		dbx.BucketMetainfo_ProjectId(projectID[:]),
-		dbx.BucketMetainfo_Name(bucketName),
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		deleted, err := tx.Delete_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name(bucketName),
+		)
+		if err != nil {
+			return storx.ErrBucket.Wrap(err)
+		}
+		if !deleted {
+			return storx.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		_, err = tx.Delete_BucketLimit_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketLimit_ProjectId(projectID[:]),
+			dbx.BucketLimit_BucketName(bucketName),
+		)
+		if err != nil {
+			return storx.ErrBucket.Wrap(err)
+		}
+
+		_, err = tx.Delete_BucketInventory_By_ProjectId_And_BucketName(ctx,
+			dbx.BucketInventory_ProjectId(projectID[:]),
+			dbx.BucketInventory_BucketName(bucketName),
+		)
+		return storx.ErrBucket.Wrap(err)
+	})
+}
+
+// GetBucketLimits returns usage limits of an existing bucket.
+func (db *bucketsDB) GetBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.Limits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxLimits, err := db.db.Get_BucketLimit_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketLimit_ProjectId(projectID[:]),
+		dbx.BucketLimit_BucketName(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.Limits{}, nil
+		}
+		return buckets.Limits{}, storx.ErrBucket.Wrap(err)
+	}
+
+	return buckets.Limits{
+		Storage:   dbxLimits.StorageLimit,
+		Bandwidth: dbxLimits.BandwidthLimit,
+		Segments:  dbxLimits.SegmentLimit,
+	}, nil
+}
+
+// UpdateBucketLimits replaces usage limits of an existing bucket.
+func (db *bucketsDB) UpdateBucketLimits(ctx context.Context, bucketName []byte, projectID uuid.UUID, limits buckets.Limits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		exists, err := tx.Has_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name(bucketName),
+		)
+		if err != nil {
+			return storx.ErrBucket.Wrap(err)
+		}
+		if !exists {
+			return storx.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		if limits.IsZero() {
+			_, err = tx.Delete_BucketLimit_By_ProjectId_And_BucketName(ctx,
+				dbx.BucketLimit_ProjectId(projectID[:]),
+				dbx.BucketLimit_BucketName(bucketName),
+			)
+			return storx.ErrBucket.Wrap(err)
+		}
+
+		return storx.ErrBucket.Wrap(tx.ReplaceNoReturn_BucketLimit(ctx,
+			dbx.BucketLimit_ProjectId(projectID[:]),
+			dbx.BucketLimit_BucketName(bucketName),
+			dbx.BucketLimit_Create_Fields{
+				StorageLimit:   dbx.BucketLimit_StorageLimit_Raw(limits.Storage),
+				BandwidthLimit: dbx.BucketLimit_BandwidthLimit_Raw(limits.Bandwidth),
+				SegmentLimit:   dbx.BucketLimit_SegmentLimit_Raw(limits.Segments),
+			},
+		))
+	})
+}
+
+// GetBucketInventory returns the inventory configuration of an existing bucket.
+func (db *bucketsDB) GetBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (_ buckets.BucketInventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInventory, err := db.db.Get_BucketInventory_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketInventory_ProjectId(projectID[:]),
+		dbx.BucketInventory_BucketName(bucketName),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return buckets.BucketInventory{}, buckets.ErrInventoryNotFound.New("%s", bucketName)
+		}
+		return buckets.BucketInventory{}, storx.ErrBucket.Wrap(err)
+	}
+
+	return convertDBXtoBucketInventory(dbxInventory)
+}
+
+// UpdateBucketInventory replaces the inventory configuration of an existing bucket.
+// The inventory is generated again for the current period.
+func (db *bucketsDB) UpdateBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID, inventory buckets.Inventory) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return db.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		exists, err := tx.Has_BucketMetainfo_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name(bucketName),
+		)
+		if err != nil {
+			return storx.ErrBucket.Wrap(err)
+		}
+		if !exists {
+			return storx.ErrBucketNotFound.New("%s", bucketName)
+		}
+
+		return storx.ErrBucket.Wrap(tx.ReplaceNoReturn_BucketInventory(ctx,
+			dbx.BucketInventory_ProjectId(projectID[:]),
+			dbx.BucketInventory_BucketName(bucketName),
+			dbx.BucketInventory_Frequency(string(inventory.Frequency)),
+			dbx.BucketInventory_DestinationBucket([]byte(inventory.DestinationBucket)),
+			dbx.BucketInventory_DestinationPrefix(inventory.DestinationPrefix),
+			dbx.BucketInventory_EncryptedAccessGrant(inventory.EncryptedAccessGrant),
+			dbx.BucketInventory_Create_Fields{},
+		))
+	})
+}
+
+// DeleteBucketInventory removes the inventory configuration of a bucket.
+func (db *bucketsDB) DeleteBucketInventory(ctx context.Context, bucketName []byte, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := db.db.Delete_BucketInventory_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketInventory_ProjectId(projectID[:]),
+		dbx.BucketInventory_BucketName(bucketName),
 	)
 	if err != nil {
 		return storx.ErrBucket.Wrap(err)
 	}
 	if !deleted {
-		return storx.ErrBucketNotFound.New("%s", bucketName)
+		return buckets.ErrInventoryNotFound.New("%s", bucketName)
 	}
 	return nil
 }
 
+// ListBucketInventories returns the inventory configurations of all buckets.
+func (db *bucketsDB) ListBucketInventories(ctx context.Context) (_ []buckets.BucketInventory, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInventories, err := db.db.All_BucketInventory(ctx)
+	if err != nil {
+		return nil, storx.ErrBucket.Wrap(err)
+	}
+
+	inventories := make([]buckets.BucketInventory, 0, len(dbxInventories))
+	for _, dbxInventory := range dbxInventories {
+		inventory, err := convertDBXtoBucketInventory(dbxInventory)
+		if err != nil {
+			return nil, err
+		}
+		inventories = append(inventories, inventory)
+	}
+	return inventories, nil
+}
+
+// SetBucketInventoryGenerated records the start of the period an inventory was uploaded for.
+func (db *bucketsDB) SetBucketInventoryGenerated(ctx context.Context, bucket metabase.BucketLocation, periodStart time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return storx.ErrBucket.Wrap(db.db.UpdateNoReturn_BucketInventory_By_ProjectId_And_BucketName(ctx,
+		dbx.BucketInventory_ProjectId(bucket.ProjectID[:]),
+		dbx.BucketInventory_BucketName([]byte(bucket.BucketName)),
+		dbx.BucketInventory_Update_Fields{
+			GeneratedFor: dbx.BucketInventory_GeneratedFor(periodStart),
+		},
+	))
+}
+
 // ListBuckets returns a list of buckets for a project.
 func (db *bucketsDB) ListBuckets(ctx context.Context, projectID uuid.UUID, listOpts storx.BucketListOptions, allowedBuckets macaroon.AllowedBuckets) (bucketList storx.BucketList, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -369,3 +540,24 @@ func (db *bucketsDB) IterateBucketLocations(ctx context.Context, projectID uuid.
 
 	return false, Error.Wrap(fn(result))
 }
+
+func convertDBXtoBucketInventory(dbxInventory *dbx.BucketInventory) (inventory buckets.BucketInventory, err error) {
+	projectID, err := uuid.FromBytes(dbxInventory.ProjectId)
+	if err != nil {
+		return buckets.BucketInventory{}, storx.ErrBucket.Wrap(err)
+	}
+
+	return buckets.BucketInventory{
+		BucketLocation: metabase.BucketLocation{
+			ProjectID:  projectID,
+			BucketName: string(dbxInventory.BucketName),
+		},
+		Inventory: buckets.Inventory{
+			Frequency:            buckets.InventoryFrequency(dbxInventory.Frequency),
+			DestinationBucket:    string(dbxInventory.DestinationBucket),
+			DestinationPrefix:    dbxInventory.DestinationPrefix,
+			EncryptedAccessGrant: dbxInventory.EncryptedAccessGrant,
+		},
+		GeneratedFor: dbxInventory.GeneratedFor,
+	}, nil
+}
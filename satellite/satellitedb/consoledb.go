@@ -79,6 +79,21 @@ func (db *ConsoleDB) AccountFreezeEvents() console.AccountFreezeEvents {
 	return &accountFreezeEvents{db.methods}
 }
 
+// ProjectBudgets is a getter for ProjectBudgets repository.
+func (db *ConsoleDB) ProjectBudgets() console.ProjectBudgets {
+	return &projectBudgets{db.methods}
+}
+
+// AccountExports is a getter for AccountExports repository.
+func (db *ConsoleDB) AccountExports() console.AccountExports {
+	return &accountExports{db.methods}
+}
+
+// AccountDeletions is a getter for AccountDeletions repository.
+func (db *ConsoleDB) AccountDeletions() console.AccountDeletions {
+	return &accountDeletions{db.methods}
+}
+
 // WithTx is a method for executing and retrying transaction.
 func (db *ConsoleDB) WithTx(ctx context.Context, fn func(context.Context, console.DBTx) error) error {
 	if db.db == nil {
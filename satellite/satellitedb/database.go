@@ -29,6 +29,7 @@ import (
 	"storx/satellite/orders"
 	"storx/satellite/overlay"
 	"storx/satellite/payments/billing"
+	"storx/satellite/payments/invoiceonly"
 	"storx/satellite/payments/storxscan"
 	"storx/satellite/payments/stripecoinpayments"
 	"storx/satellite/repair/queue"
@@ -282,6 +283,11 @@ func (dbc *satelliteDBCollection) Wallets() storxscan.WalletsDB {
 	return &storxscanWalletsDB{db: dbc.getByName("storxscan")}
 }
 
+// InvoiceOnly returns database for the invoice-only billing backend.
+func (dbc *satelliteDBCollection) InvoiceOnly() invoiceonly.DB {
+	return &invoiceOnlyInvoices{db: dbc.getByName("invoiceonly")}
+}
+
 // SNOPayouts returns database for storagenode payStubs and payments info.
 func (dbc *satelliteDBCollection) SNOPayouts() snopayouts.DB {
 	return &snopayoutsDB{db: dbc.getByName("snopayouts")}
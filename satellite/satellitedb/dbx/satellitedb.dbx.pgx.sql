@@ -1,5 +1,30 @@
 -- AUTOGENERATED BY storx/dbx
 -- DO NOT EDIT
+CREATE TABLE account_deletion_events (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	step text NOT NULL,
+	details text,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE account_deletions (
+	user_id bytea NOT NULL,
+	requested_by text NOT NULL,
+	delete_after timestamp with time zone NOT NULL,
+	notified_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( user_id )
+);
+CREATE TABLE account_exports (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	status integer NOT NULL,
+	archive bytea,
+	created_at timestamp with time zone NOT NULL,
+	completed_at timestamp with time zone,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE account_freeze_events (
 	user_id bytea NOT NULL,
 	event integer NOT NULL,
@@ -66,6 +91,26 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 );
+CREATE TABLE bucket_inventories (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	frequency text NOT NULL,
+	destination_bucket bytea NOT NULL,
+	destination_prefix text NOT NULL,
+	encrypted_access_grant bytea NOT NULL,
+	generated_for timestamp with time zone,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
+CREATE TABLE bucket_limits (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	storage_limit bigint,
+	bandwidth_limit bigint,
+	segment_limit bigint,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -116,6 +161,28 @@ CREATE TABLE graceful_exit_segment_transfer_queue (
 	order_limit_send_count integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( node_id, stream_id, position, piece_num )
 );
+CREATE TABLE invoiceonly_invoices (
+	id bytea NOT NULL,
+	user_id bytea NOT NULL,
+	period_start timestamp with time zone NOT NULL,
+	period_end timestamp with time zone NOT NULL,
+	items jsonb NOT NULL,
+	amount bigint NOT NULL,
+	status text NOT NULL,
+	payment_reference text NOT NULL,
+	paid_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( user_id, period_start )
+);
+CREATE TABLE node_maintenance_windows (
+	node_id bytea NOT NULL,
+	start_at timestamp with time zone NOT NULL,
+	end_at timestamp with time zone NOT NULL,
+	blocked_until timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE nodes (
 	id bytea NOT NULL,
 	address text NOT NULL DEFAULT '',
@@ -214,6 +281,24 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 );
+CREATE TABLE project_budget_alerts (
+	project_id bytea NOT NULL,
+	period timestamp with time zone NOT NULL,
+	threshold integer NOT NULL,
+	spent bigint NOT NULL,
+	budget bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, period, threshold )
+);
+CREATE TABLE project_budgets (
+	project_id bytea NOT NULL,
+	amount bigint NOT NULL,
+	hard_cap boolean NOT NULL,
+	capped_limits jsonb,
+	capped_at timestamp with time zone,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -500,6 +585,9 @@ CREATE TABLE api_keys (
 	partner_id bytea,
 	user_agent bytea,
 	created_at timestamp with time zone NOT NULL,
+	expires_at timestamp with time zone,
+	daily_egress_limit bigint,
+	requests_per_minute integer,
 	PRIMARY KEY ( id ),
 	UNIQUE ( head ),
 	UNIQUE ( name, project_id )
@@ -537,6 +625,9 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( tx_id )
 );
+CREATE INDEX account_deletion_events_user_id_index ON account_deletion_events ( user_id ) ;
+CREATE INDEX account_exports_status_index ON account_exports ( status ) ;
+CREATE INDEX account_exports_user_id_index ON account_exports ( user_id ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
 CREATE INDEX billing_transactions_timestamp_index ON billing_transactions ( timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
@@ -569,3 +660,5 @@ CREATE INDEX storxscan_payments_block_number_log_index_index ON storxscan_paymen
 CREATE INDEX storxscan_wallets_wallet_address_index ON storxscan_wallets ( wallet_address ) ;
 CREATE INDEX users_email_status_index ON users ( normalized_email, status ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
+CREATE INDEX invoiceonly_invoices_period_start_index ON invoiceonly_invoices ( period_start ) ;
+CREATE INDEX invoiceonly_invoices_status_index ON invoiceonly_invoices ( status ) ;
@@ -0,0 +1,164 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"common/uuid"
+	"storx/satellite/payments/invoiceonly"
+	"storx/satellite/satellitedb/dbx"
+)
+
+// Ensure that invoiceOnlyInvoices implements invoiceonly.DB.
+var _ invoiceonly.DB = (*invoiceOnlyInvoices)(nil)
+
+// invoiceOnlyInvoices is an implementation of invoiceonly.DB.
+type invoiceOnlyInvoices struct {
+	db *satelliteDB
+}
+
+// Insert inserts a new invoice.
+func (invoices *invoiceOnlyInvoices) Insert(ctx context.Context, invoice invoiceonly.Invoice) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	items, err := json.Marshal(invoice.Items)
+	if err != nil {
+		return err
+	}
+
+	return invoices.db.CreateNoReturn_InvoiceonlyInvoice(ctx,
+		dbx.InvoiceonlyInvoice_Id(invoice.ID.Bytes()),
+		dbx.InvoiceonlyInvoice_UserId(invoice.UserID.Bytes()),
+		dbx.InvoiceonlyInvoice_PeriodStart(invoice.PeriodStart),
+		dbx.InvoiceonlyInvoice_PeriodEnd(invoice.PeriodEnd),
+		dbx.InvoiceonlyInvoice_Items(items),
+		dbx.InvoiceonlyInvoice_Amount(invoice.Amount),
+		dbx.InvoiceonlyInvoice_Status(invoice.Status),
+		dbx.InvoiceonlyInvoice_PaymentReference(invoice.PaymentReference),
+		dbx.InvoiceonlyInvoice_Create_Fields{
+			PaidAt: dbx.InvoiceonlyInvoice_PaidAt_Raw(invoice.PaidAt),
+		},
+	)
+}
+
+// Get returns the invoice with the given ID.
+func (invoices *invoiceOnlyInvoices) Get(ctx context.Context, id uuid.UUID) (_ invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoice, err := invoices.db.Get_InvoiceonlyInvoice_By_Id(ctx, dbx.InvoiceonlyInvoice_Id(id.Bytes()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return invoiceonly.Invoice{}, invoiceonly.ErrInvoiceNotFound.New("%s", id)
+		}
+		return invoiceonly.Invoice{}, err
+	}
+
+	return fromDBXInvoiceOnlyInvoice(dbxInvoice)
+}
+
+// Exists returns whether the user already has an invoice for the billing period.
+func (invoices *invoiceOnlyInvoices) Exists(ctx context.Context, userID uuid.UUID, periodStart time.Time) (_ bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return invoices.db.Has_InvoiceonlyInvoice_By_UserId_And_PeriodStart(ctx,
+		dbx.InvoiceonlyInvoice_UserId(userID.Bytes()),
+		dbx.InvoiceonlyInvoice_PeriodStart(periodStart),
+	)
+}
+
+// ListByUserID returns the invoices of the user, the newest billing period first.
+func (invoices *invoiceOnlyInvoices) ListByUserID(ctx context.Context, userID uuid.UUID) (_ []invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoices, err := invoices.db.All_InvoiceonlyInvoice_By_UserId_OrderBy_Desc_PeriodStart(ctx,
+		dbx.InvoiceonlyInvoice_UserId(userID.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXInvoiceOnlyInvoices(dbxInvoices)
+}
+
+// ListByPeriod returns the invoices of the billing period.
+func (invoices *invoiceOnlyInvoices) ListByPeriod(ctx context.Context, periodStart time.Time) (_ []invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoices, err := invoices.db.All_InvoiceonlyInvoice_By_PeriodStart_OrderBy_Asc_Id(ctx,
+		dbx.InvoiceonlyInvoice_PeriodStart(periodStart))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXInvoiceOnlyInvoices(dbxInvoices)
+}
+
+// ListByStatus returns the invoices with the given status, the oldest billing period first.
+func (invoices *invoiceOnlyInvoices) ListByStatus(ctx context.Context, status string) (_ []invoiceonly.Invoice, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxInvoices, err := invoices.db.All_InvoiceonlyInvoice_By_Status_OrderBy_Asc_PeriodStart_Asc_Id(ctx,
+		dbx.InvoiceonlyInvoice_Status(status))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXInvoiceOnlyInvoices(dbxInvoices)
+}
+
+// UpdateStatus updates the status and the payment information of the invoice.
+func (invoices *invoiceOnlyInvoices) UpdateStatus(ctx context.Context, id uuid.UUID, status, paymentReference string, paidAt *time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return invoices.db.UpdateNoReturn_InvoiceonlyInvoice_By_Id(ctx,
+		dbx.InvoiceonlyInvoice_Id(id.Bytes()),
+		dbx.InvoiceonlyInvoice_Update_Fields{
+			Status:           dbx.InvoiceonlyInvoice_Status(status),
+			PaymentReference: dbx.InvoiceonlyInvoice_PaymentReference(paymentReference),
+			PaidAt:           dbx.InvoiceonlyInvoice_PaidAt_Raw(paidAt),
+		},
+	)
+}
+
+// fromDBXInvoiceOnlyInvoices converts dbx invoices to invoiceonly.Invoice slice.
+func fromDBXInvoiceOnlyInvoices(dbxInvoices []*dbx.InvoiceonlyInvoice) ([]invoiceonly.Invoice, error) {
+	all := make([]invoiceonly.Invoice, 0, len(dbxInvoices))
+	for _, dbxInvoice := range dbxInvoices {
+		invoice, err := fromDBXInvoiceOnlyInvoice(dbxInvoice)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, invoice)
+	}
+	return all, nil
+}
+
+// fromDBXInvoiceOnlyInvoice converts dbx invoice to invoiceonly.Invoice.
+func fromDBXInvoiceOnlyInvoice(dbxInvoice *dbx.InvoiceonlyInvoice) (invoice invoiceonly.Invoice, err error) {
+	invoice.ID, err = uuid.FromBytes(dbxInvoice.Id)
+	if err != nil {
+		return invoiceonly.Invoice{}, err
+	}
+	invoice.UserID, err = uuid.FromBytes(dbxInvoice.UserId)
+	if err != nil {
+		return invoiceonly.Invoice{}, err
+	}
+	if err := json.Unmarshal(dbxInvoice.Items, &invoice.Items); err != nil {
+		return invoiceonly.Invoice{}, err
+	}
+
+	invoice.PeriodStart = dbxInvoice.PeriodStart
+	invoice.PeriodEnd = dbxInvoice.PeriodEnd
+	invoice.Amount = dbxInvoice.Amount
+	invoice.Status = dbxInvoice.Status
+	invoice.PaymentReference = dbxInvoice.PaymentReference
+	invoice.PaidAt = dbxInvoice.PaidAt
+	invoice.CreatedAt = dbxInvoice.CreatedAt
+
+	return invoice, nil
+}
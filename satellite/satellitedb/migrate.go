@@ -2302,6 +2302,162 @@ func (db *satelliteDB) ProductionMigration() *migrate.Migration {
 					`ALTER TABLE nodes ADD COLUMN debounce_limit integer NOT NULL DEFAULT 0;`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add node_maintenance_windows table",
+				Version:     230,
+				Action: migrate.SQL{
+					`CREATE TABLE node_maintenance_windows (
+						node_id bytea NOT NULL,
+						start_at timestamp with time zone NOT NULL,
+						end_at timestamp with time zone NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( node_id )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_limits table",
+				Version:     231,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_limits (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						storage_limit bigint,
+						bandwidth_limit bigint,
+						segment_limit bigint,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add project_budgets and project_budget_alerts tables",
+				Version:     232,
+				Action: migrate.SQL{
+					`CREATE TABLE project_budgets (
+						project_id bytea NOT NULL,
+						amount bigint NOT NULL,
+						hard_cap boolean NOT NULL,
+						capped_limits jsonb,
+						capped_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id )
+					);`,
+					`CREATE TABLE project_budget_alerts (
+						project_id bytea NOT NULL,
+						period timestamp with time zone NOT NULL,
+						threshold integer NOT NULL,
+						spent bigint NOT NULL,
+						budget bigint NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, period, threshold )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add invoiceonly_invoices table",
+				Version:     233,
+				Action: migrate.SQL{
+					`CREATE TABLE invoiceonly_invoices (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						period_start timestamp with time zone NOT NULL,
+						period_end timestamp with time zone NOT NULL,
+						items jsonb NOT NULL,
+						amount bigint NOT NULL,
+						status text NOT NULL,
+						payment_reference text NOT NULL,
+						paid_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id ),
+						UNIQUE ( user_id, period_start )
+					);`,
+					`CREATE INDEX invoiceonly_invoices_period_start_index ON invoiceonly_invoices ( period_start );`,
+					`CREATE INDEX invoiceonly_invoices_status_index ON invoiceonly_invoices ( status );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add expiration and usage limits to api_keys",
+				Version:     234,
+				Action: migrate.SQL{
+					`ALTER TABLE api_keys ADD COLUMN expires_at timestamp with time zone;`,
+					`ALTER TABLE api_keys ADD COLUMN daily_egress_limit bigint;`,
+					`ALTER TABLE api_keys ADD COLUMN requests_per_minute integer;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add account_exports table",
+				Version:     235,
+				Action: migrate.SQL{
+					`CREATE TABLE account_exports (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						status integer NOT NULL,
+						archive bytea,
+						created_at timestamp with time zone NOT NULL,
+						completed_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX account_exports_status_index ON account_exports ( status ) ;`,
+					`CREATE INDEX account_exports_user_id_index ON account_exports ( user_id ) ;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add account_deletions and account_deletion_events tables",
+				Version:     236,
+				Action: migrate.SQL{
+					`CREATE TABLE account_deletions (
+						user_id bytea NOT NULL,
+						requested_by text NOT NULL,
+						delete_after timestamp with time zone NOT NULL,
+						notified_at timestamp with time zone,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( user_id )
+					);`,
+					`CREATE TABLE account_deletion_events (
+						id bytea NOT NULL,
+						user_id bytea NOT NULL,
+						step text NOT NULL,
+						details text,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE INDEX account_deletion_events_user_id_index ON account_deletion_events ( user_id ) ;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add blocked_until to node_maintenance_windows",
+				Version:     237,
+				Action: migrate.SQL{
+					`ALTER TABLE node_maintenance_windows ADD COLUMN blocked_until timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add bucket_inventories table",
+				Version:     238,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_inventories (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						frequency text NOT NULL,
+						destination_bucket bytea NOT NULL,
+						destination_prefix text NOT NULL,
+						encrypted_access_grant bytea NOT NULL,
+						generated_for timestamp with time zone,
+						updated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
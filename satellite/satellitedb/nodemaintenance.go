@@ -0,0 +1,102 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"common/storx"
+	"private/dbutil/pgutil"
+	"storx/satellite/overlay"
+	"storx/satellite/satellitedb/dbx"
+)
+
+// SetMaintenanceWindow stores maintenance window of the node replacing previously announced one.
+func (cache *overlaycache) SetMaintenanceWindow(ctx context.Context, window overlay.MaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var blockedUntil *time.Time
+	if !window.BlockedUntil.IsZero() {
+		blockedUntil = &window.BlockedUntil
+	}
+
+	return Error.Wrap(cache.db.ReplaceNoReturn_NodeMaintenanceWindow(ctx,
+		dbx.NodeMaintenanceWindow_NodeId(window.NodeID.Bytes()),
+		dbx.NodeMaintenanceWindow_StartAt(window.Start),
+		dbx.NodeMaintenanceWindow_EndAt(window.End),
+		dbx.NodeMaintenanceWindow_Create_Fields{
+			BlockedUntil: dbx.NodeMaintenanceWindow_BlockedUntil_Raw(blockedUntil),
+		},
+	))
+}
+
+// GetMaintenanceWindow returns maintenance window of the node.
+func (cache *overlaycache) GetMaintenanceWindow(ctx context.Context, nodeID storx.NodeID) (_ overlay.MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxWindow, err := cache.db.Get_NodeMaintenanceWindow_By_NodeId(ctx, dbx.NodeMaintenanceWindow_NodeId(nodeID.Bytes()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return overlay.MaintenanceWindow{}, overlay.ErrMaintenanceWindowNotFound.New("%v", nodeID)
+		}
+		return overlay.MaintenanceWindow{}, Error.Wrap(err)
+	}
+
+	window := overlay.MaintenanceWindow{
+		NodeID:    nodeID,
+		Start:     dbxWindow.StartAt,
+		End:       dbxWindow.EndAt,
+		CreatedAt: dbxWindow.CreatedAt,
+	}
+	if dbxWindow.BlockedUntil != nil {
+		window.BlockedUntil = *dbxWindow.BlockedUntil
+	}
+	return window, nil
+}
+
+// DeleteMaintenanceWindow removes maintenance window of the node.
+func (cache *overlaycache) DeleteMaintenanceWindow(ctx context.Context, nodeID storx.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deleted, err := cache.db.Delete_NodeMaintenanceWindow_By_NodeId(ctx, dbx.NodeMaintenanceWindow_NodeId(nodeID.Bytes()))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if !deleted {
+		return overlay.ErrMaintenanceWindowNotFound.New("%v", nodeID)
+	}
+	return nil
+}
+
+// InMaintenance filters a set of nodes to nodes which are in maintenance at specified time.
+func (cache *overlaycache) InMaintenance(ctx context.Context, nodeIDs storx.NodeIDList, now time.Time) (_ storx.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.Query(ctx, cache.db.Rebind(`
+		SELECT node_id FROM node_maintenance_windows
+			WHERE node_id = any($1::bytea[])
+			AND start_at <= $2
+			AND end_at > $2
+		`), pgutil.NodeIDArray(nodeIDs), now,
+	)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	var inMaintenance storx.NodeIDList
+	for rows.Next() {
+		var id storx.NodeID
+		if err := rows.Scan(&id); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		inMaintenance = append(inMaintenance, id)
+	}
+	return inMaintenance, Error.Wrap(rows.Err())
+}
@@ -166,7 +166,15 @@ func nodeSelectionCondition(ctx context.Context, criteria *overlay.NodeCriteria,
 
 	conds.add(`type = ?`, int(pb.NodeType_STORAGE))
 	conds.add(`free_disk >= ?`, criteria.FreeDisk)
-	conds.add(`last_contact_success > ?`, time.Now().UTC().Add(-criteria.OnlineWindow))
+	now := time.Now().UTC()
+	conds.add(`last_contact_success > ?`, now.Add(-criteria.OnlineWindow))
+	// nodes in planned maintenance are not selected for uploads.
+	conds.add(`NOT EXISTS (
+		SELECT 1 FROM node_maintenance_windows
+			WHERE node_maintenance_windows.node_id = nodes.id
+			AND node_maintenance_windows.start_at <= ?
+			AND node_maintenance_windows.end_at > ?
+	)`, now, now)
 
 	if isNewNodeQuery {
 		conds.add(
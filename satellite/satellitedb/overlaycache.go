@@ -67,23 +67,32 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			AND type = $1
 			AND free_disk >= $2
 			AND last_contact_success > $3
+			AND NOT EXISTS (
+				SELECT 1 FROM node_maintenance_windows
+					WHERE node_maintenance_windows.node_id = nodes.id
+					AND node_maintenance_windows.start_at <= $4
+					AND node_maintenance_windows.end_at > $4
+			)
 	`
+	now := time.Now()
 	args := []interface{}{
 		// $1
 		int(pb.NodeType_STORAGE),
 		// $2
 		selectionCfg.MinimumDiskSpace.Int64(),
 		// $3
-		time.Now().Add(-selectionCfg.OnlineWindow),
+		now.Add(-selectionCfg.OnlineWindow),
+		// $4
+		now,
 	}
 	if selectionCfg.MinimumVersion != "" {
 		version, err := version.NewSemVer(selectionCfg.MinimumVersion)
 		if err != nil {
 			return nil, nil, err
 		}
-		query += `AND (major > $4 OR (major = $5 AND (minor > $6 OR (minor = $7 AND patch >= $8)))) AND release`
+		query += `AND (major > $5 OR (major = $6 AND (minor > $7 OR (minor = $8 AND patch >= $9)))) AND release`
 		args = append(args,
-			// $4 - $8
+			// $5 - $9
 			version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 		)
 	}
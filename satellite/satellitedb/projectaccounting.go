@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	pgxerrcode "github.com/jackc/pgerrcode"
@@ -16,6 +17,7 @@ import (
 
 	"common/memory"
 	"common/pb"
+	"common/storx"
 	"common/useragent"
 	"common/uuid"
 	"private/dbutil"
@@ -157,6 +159,19 @@ func (db *ProjectAccounting) GetProjectSettledBandwidthTotal(ctx context.Context
 	return *sum, err
 }
 
+// GetBucketBandwidth returns the sum of GET bandwidth allocated for a bucket since from.
+func (db *ProjectAccounting) GetBucketBandwidth(ctx context.Context, projectID uuid.UUID, bucketName []byte, from time.Time) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+	var sum *int64
+	query := `SELECT SUM(allocated) + SUM(inline) FROM bucket_bandwidth_rollups WHERE project_id = $1 AND bucket_name = $2 AND action = $3 AND interval_start >= $4;`
+	err = db.db.QueryRow(ctx, db.db.Rebind(query), projectID[:], bucketName, pb.PieceAction_GET, from.UTC()).Scan(&sum)
+	if errors.Is(err, sql.ErrNoRows) || sum == nil {
+		return 0, nil
+	}
+
+	return *sum, err
+}
+
 // GetProjectBandwidth returns the used bandwidth (settled or allocated) for the specified year, month and day.
 func (db *ProjectAccounting) GetProjectBandwidth(ctx context.Context, projectID uuid.UUID, year int, month time.Month, day int, asOfSystemInterval time.Duration) (_ int64, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -522,6 +537,32 @@ func (db *ProjectAccounting) GetProjectTotal(ctx context.Context, projectID uuid
 // Unpartnered usage or usage for a partner not present in partnerNames is mapped to the empty string.
 func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[string]accounting.ProjectUsage, err error) {
 	defer mon.Task()(&ctx)(&err)
+
+	placementUsages, err := db.GetProjectTotalByPartnerAndPlacement(ctx, projectID, partnerNames, since, before)
+	if err != nil {
+		return nil, err
+	}
+
+	usages = make(map[string]accounting.ProjectUsage)
+	for key, placementUsage := range placementUsages {
+		usage, ok := usages[key.Partner]
+		if !ok {
+			usage = accounting.ProjectUsage{Since: placementUsage.Since, Before: placementUsage.Before}
+		}
+		usage.Storage += placementUsage.Storage
+		usage.Egress += placementUsage.Egress
+		usage.SegmentCount += placementUsage.SegmentCount
+		usage.ObjectCount += placementUsage.ObjectCount
+		usages[key.Partner] = usage
+	}
+
+	return usages, nil
+}
+
+// GetProjectTotalByPartnerAndPlacement retrieves project usage for a given period categorized by partner name and bucket placement.
+// Unpartnered usage or usage for a partner not present in partnerNames is mapped to the empty string.
+func (db *ProjectAccounting) GetProjectTotalByPartnerAndPlacement(ctx context.Context, projectID uuid.UUID, partnerNames []string, since, before time.Time) (usages map[accounting.PartnerPlacement]accounting.ProjectUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
 	since = timeTruncateDown(since)
 	bucketNames, err := db.getBucketsSinceAndBefore(ctx, projectID, since, before)
 	if err != nil {
@@ -559,7 +600,7 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 			action = ?;
 	`)
 
-	usages = make(map[string]accounting.ProjectUsage)
+	usages = make(map[accounting.PartnerPlacement]accounting.ProjectUsage)
 
 	for _, bucket := range bucketNames {
 		userAgentRow, err := db.db.Get_BucketMetainfo_UserAgent_By_ProjectId_And_Name(ctx,
@@ -583,10 +624,24 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 				}
 			}
 		}
-		if _, ok := usages[partner]; !ok {
-			usages[partner] = accounting.ProjectUsage{Since: since, Before: before}
+
+		placementRow, err := db.db.Get_BucketMetainfo_Placement_By_ProjectId_And_Name(ctx,
+			dbx.BucketMetainfo_ProjectId(projectID[:]),
+			dbx.BucketMetainfo_Name([]byte(bucket)))
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, err
+		}
+
+		placement := storx.EveryCountry
+		if placementRow != nil && placementRow.Placement != nil {
+			placement = storx.PlacementConstraint(*placementRow.Placement)
+		}
+
+		key := accounting.PartnerPlacement{Partner: partner, Placement: placement}
+		if _, ok := usages[key]; !ok {
+			usages[key] = accounting.ProjectUsage{Since: since, Before: before}
 		}
-		usage := usages[partner]
+		usage := usages[key]
 
 		storageTalliesRows, err := db.db.QueryContext(ctx, storageQuery, projectID[:], []byte(bucket), since, before)
 		if err != nil {
@@ -635,7 +690,7 @@ func (db *ProjectAccounting) GetProjectTotalByPartner(ctx context.Context, proje
 		}
 		usage.Egress += egress
 
-		usages[partner] = usage
+		usages[key] = usage
 	}
 
 	return usages, nil
@@ -762,6 +817,180 @@ func (db *ProjectAccounting) getSingleBucketRollup(ctx context.Context, projectI
 	return bucketRollup, nil
 }
 
+// GetBucketDailyUsages retrieves usage per bucket per UTC day of particular project for a given period.
+// Storage is integrated between consecutive tallies, including the last tally before since, and
+// every interval is split at the day boundaries, so the daily values add up to the usage of the period.
+func (db *ProjectAccounting) GetBucketDailyUsages(ctx context.Context, projectID uuid.UUID, since, before time.Time) (_ []accounting.BucketDailyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	since = since.UTC()
+	before = before.UTC()
+
+	buckets, err := db.getBucketsSinceAndBefore(ctx, projectID, since, before)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []accounting.BucketDailyUsage
+	for _, bucket := range buckets {
+		bucketUsages, err := db.getSingleBucketDailyUsages(ctx, projectID, bucket, since, before)
+		if err != nil {
+			return nil, err
+		}
+
+		usages = append(usages, bucketUsages...)
+	}
+
+	sort.Slice(usages, func(i, k int) bool {
+		if !usages[i].Date.Equal(usages[k].Date) {
+			return usages[i].Date.Before(usages[k].Date)
+		}
+		return usages[i].BucketName < usages[k].BucketName
+	})
+
+	return usages, nil
+}
+
+func (db *ProjectAccounting) getSingleBucketDailyUsages(ctx context.Context, projectID uuid.UUID, bucket string, since, before time.Time) (_ []accounting.BucketDailyUsage, err error) {
+	days := make(map[time.Time]*accounting.BucketDailyUsage)
+	usageOf := func(t time.Time) *accounting.BucketDailyUsage {
+		day := timeTruncateDay(t)
+		usage, ok := days[day]
+		if !ok {
+			usage = &accounting.BucketDailyUsage{Date: day, BucketName: bucket}
+			days[day] = usage
+		}
+		return usage
+	}
+
+	rollupRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT interval_start, settled, inline, action
+		FROM bucket_bandwidth_rollups
+		WHERE project_id = ? AND bucket_name = ? AND interval_start >= ? AND interval_start < ?
+	`), projectID[:], []byte(bucket), since, before)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rollupRows.Close()) }()
+
+	for rollupRows.Next() {
+		var intervalStart time.Time
+		var settled, inline int64
+		var action pb.PieceAction
+
+		err = rollupRows.Scan(&intervalStart, &settled, &inline, &action)
+		if err != nil {
+			return nil, err
+		}
+
+		switch action {
+		case pb.PieceAction_GET:
+			usageOf(intervalStart).GetEgress += settled + inline
+		case pb.PieceAction_GET_AUDIT:
+			usageOf(intervalStart).AuditEgress += settled + inline
+		case pb.PieceAction_GET_REPAIR:
+			usageOf(intervalStart).RepairEgress += settled + inline
+		default:
+			continue
+		}
+	}
+	if err := rollupRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// the tally before since covers the beginning of the period
+	// and the first tally at or after before ends the last interval.
+	tallyRows, err := db.db.QueryContext(ctx, db.db.Rebind(`
+		SELECT interval_start, total_bytes, inline, remote,
+			total_segments_count, remote_segments_count, inline_segments_count, object_count
+		FROM bucket_storage_tallies
+		WHERE project_id = ? AND bucket_name = ?
+			AND interval_start >= COALESCE((
+				SELECT MAX(interval_start) FROM bucket_storage_tallies
+				WHERE project_id = ? AND bucket_name = ? AND interval_start < ?
+			), ?)
+			AND interval_start <= COALESCE((
+				SELECT MIN(interval_start) FROM bucket_storage_tallies
+				WHERE project_id = ? AND bucket_name = ? AND interval_start >= ?
+			), ?)
+		ORDER BY interval_start ASC
+	`), projectID[:], []byte(bucket),
+		projectID[:], []byte(bucket), since, since,
+		projectID[:], []byte(bucket), before, before)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, tallyRows.Close()) }()
+
+	type tally struct {
+		intervalStart time.Time
+		bytes         int64
+		segments      int64
+		objects       int64
+	}
+
+	var tallies []tally
+	for tallyRows.Next() {
+		var t tally
+		var totalBytes, inline, remote int64
+		var totalSegments, remoteSegments, inlineSegments int64
+
+		err = tallyRows.Scan(&t.intervalStart, &totalBytes, &inline, &remote,
+			&totalSegments, &remoteSegments, &inlineSegments, &t.objects)
+		if err != nil {
+			return nil, err
+		}
+
+		t.intervalStart = t.intervalStart.UTC()
+		t.bytes = totalBytes
+		if totalBytes <= 0 {
+			t.bytes = remote + inline
+		}
+		t.segments = totalSegments
+		if totalSegments <= 0 {
+			t.segments = remoteSegments + inlineSegments
+		}
+
+		tallies = append(tallies, t)
+	}
+	if err := tallyRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// the value of a tally lasts until the next tally,
+	// so the most recent one is skipped.
+	for i := 0; i+1 < len(tallies); i++ {
+		start, end := tallies[i].intervalStart, tallies[i+1].intervalStart
+		if start.Before(since) {
+			start = since
+		}
+		if end.After(before) {
+			end = before
+		}
+
+		for start.Before(end) {
+			dayEnd := timeTruncateDay(start).AddDate(0, 0, 1)
+			if dayEnd.After(end) {
+				dayEnd = end
+			}
+			hours := dayEnd.Sub(start).Hours()
+
+			usage := usageOf(start)
+			usage.StorageByteHours += float64(tallies[i].bytes) * hours
+			usage.SegmentHours += float64(tallies[i].segments) * hours
+			usage.ObjectHours += float64(tallies[i].objects) * hours
+
+			start = dayEnd
+		}
+	}
+
+	usages := make([]accounting.BucketDailyUsage, 0, len(days))
+	for _, usage := range days {
+		usages = append(usages, *usage)
+	}
+
+	return usages, nil
+}
+
 // prefixIncrement returns the lexicographically lowest byte string which is
 // greater than origPrefix and does not have origPrefix as a prefix. If no such
 // byte string exists (origPrefix is empty, or origPrefix contains only 0xff
@@ -1086,6 +1315,12 @@ func timeTruncateDown(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
 }
 
+// timeTruncateDay truncates down to the beginning of the UTC day.
+func timeTruncateDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
 // GetProjectLimits returns current project limit for both storage and bandwidth.
 func (db *ProjectAccounting) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (_ accounting.ProjectLimits, err error) {
 	defer mon.Task()(&ctx)(&err)
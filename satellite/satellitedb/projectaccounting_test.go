@@ -176,6 +176,65 @@ func Test_GetSingleBucketRollup(t *testing.T) {
 	)
 }
 
+func Test_GetBucketDailyUsages(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			const (
+				bucketName = "testbucket"
+				bytes      = int64(1000)
+				segments   = int64(10)
+				objects    = int64(5)
+			)
+
+			db := planet.Satellites[0].DB
+			projectID := testrand.UUID()
+
+			today := time.Now().UTC().Truncate(24 * time.Hour)
+			since := today.AddDate(0, 0, -3)
+			before := today.AddDate(0, 0, -1)
+
+			// tallies every 6 hours, none of them at midnight,
+			// starting before since and ending after before.
+			for intervalStart := since.Add(-3 * time.Hour); !intervalStart.After(before.Add(3 * time.Hour)); intervalStart = intervalStart.Add(6 * time.Hour) {
+				err := db.ProjectAccounting().CreateStorageTally(ctx, accounting.BucketStorageTally{
+					BucketName:        bucketName,
+					ProjectID:         projectID,
+					IntervalStart:     intervalStart,
+					TotalBytes:        bytes,
+					TotalSegmentCount: segments,
+					ObjectCount:       objects,
+				})
+				require.NoError(t, err)
+			}
+
+			err := db.Orders().UpdateBucketBandwidthSettle(ctx, projectID, []byte(bucketName), pb.PieceAction_GET, memory.MB.Int64(), 0, since.Add(time.Hour))
+			require.NoError(t, err)
+
+			usages, err := db.ProjectAccounting().GetBucketDailyUsages(ctx, projectID, since, before)
+			require.NoError(t, err)
+			require.Len(t, usages, 2)
+
+			var storageByteHours, segmentHours, objectHours float64
+			for i, usage := range usages {
+				require.Equal(t, bucketName, usage.BucketName)
+				require.True(t, since.AddDate(0, 0, i).Equal(usage.Date))
+				require.InDelta(t, float64(bytes*24), usage.StorageByteHours, 1e-6)
+
+				storageByteHours += usage.StorageByteHours
+				segmentHours += usage.SegmentHours
+				objectHours += usage.ObjectHours
+			}
+			require.Equal(t, memory.MB.Int64(), usages[0].GetEgress)
+			require.Zero(t, usages[1].GetEgress)
+
+			hours := before.Sub(since).Hours()
+			require.InDelta(t, float64(bytes)*hours, storageByteHours, 1e-6)
+			require.InDelta(t, float64(segments)*hours, segmentHours, 1e-6)
+			require.InDelta(t, float64(objects)*hours, objectHours, 1e-6)
+		},
+	)
+}
+
 func Test_GetProjectTotal(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{SatelliteCount: 1, StorageNodeCount: 1},
 		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
@@ -391,6 +450,72 @@ func Test_GetProjectTotalByPartner(t *testing.T) {
 	)
 }
 
+func Test_GetProjectTotalByPartnerAndPlacement(t *testing.T) {
+	const epsilon = 1e-8
+	since := time.Time{}
+	before := since.Add(time.Hour)
+
+	testplanet.Run(t, testplanet.Config{SatelliteCount: 1},
+		func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+			sat := planet.Satellites[0]
+
+			user, err := sat.AddUser(ctx, console.CreateUser{
+				FullName: "Test User",
+				Email:    "user@mail.test",
+			}, 1)
+			require.NoError(t, err)
+
+			project, err := sat.AddProject(ctx, user.ID, "testproject")
+			require.NoError(t, err)
+
+			expectedUsages := make(map[accounting.PartnerPlacement]accounting.ProjectUsage)
+			for _, key := range []accounting.PartnerPlacement{
+				{},
+				{Placement: storx.EU},
+				{Partner: "partner", Placement: storx.EU},
+			} {
+				bucket := storx.Bucket{
+					ID:        testrand.UUID(),
+					Name:      testrand.BucketName(),
+					ProjectID: project.ID,
+					Placement: key.Placement,
+				}
+				if key.Partner != "" {
+					bucket.UserAgent = []byte(key.Partner)
+				}
+				_, err := sat.DB.Buckets().CreateBucket(ctx, bucket)
+				require.NoError(t, err)
+
+				tally := randTally(bucket.Name, project.ID, since)
+				require.NoError(t, sat.DB.ProjectAccounting().CreateStorageTally(ctx, tally))
+				require.NoError(t, sat.DB.ProjectAccounting().CreateStorageTally(ctx, randTally(bucket.Name, project.ID, before)))
+
+				rollup := randRollup(bucket.Name, project.ID, since)
+				require.NoError(t, sat.DB.Orders().UpdateBandwidthBatch(ctx, []orders.BucketBandwidthRollup{rollup}))
+
+				expectedUsages[key] = accounting.ProjectUsage{
+					Storage:      float64(tally.Bytes()),
+					SegmentCount: float64(tally.TotalSegmentCount),
+					ObjectCount:  float64(tally.ObjectCount),
+					Egress:       rollup.Inline + rollup.Settled,
+				}
+			}
+
+			usages, err := sat.DB.ProjectAccounting().GetProjectTotalByPartnerAndPlacement(ctx, project.ID, []string{"partner"}, since, before.Add(time.Nanosecond))
+			require.NoError(t, err)
+			require.Len(t, usages, len(expectedUsages))
+			for key, expected := range expectedUsages {
+				require.Contains(t, usages, key)
+				actual := usages[key]
+				require.InDelta(t, expected.Storage, actual.Storage, epsilon)
+				require.InDelta(t, expected.SegmentCount, actual.SegmentCount, epsilon)
+				require.InDelta(t, expected.ObjectCount, actual.ObjectCount, epsilon)
+				require.Equal(t, expected.Egress, actual.Egress)
+			}
+		},
+	)
+}
+
 func randTally(bucketName string, projectID uuid.UUID, intervalStart time.Time) accounting.BucketStorageTally {
 	return accounting.BucketStorageTally{
 		BucketName:        bucketName,
@@ -0,0 +1,183 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"common/uuid"
+	"storx/satellite/console"
+	"storx/satellite/satellitedb/dbx"
+)
+
+// Ensure that projectBudgets implements console.ProjectBudgets.
+var _ console.ProjectBudgets = (*projectBudgets)(nil)
+
+// projectBudgets is an implementation of console.ProjectBudgets.
+type projectBudgets struct {
+	db dbx.Methods
+}
+
+// Get returns the budget of the project.
+func (budgets *projectBudgets) Get(ctx context.Context, projectID uuid.UUID) (_ *console.ProjectBudget, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBudget, err := budgets.db.Get_ProjectBudget_By_ProjectId(ctx, dbx.ProjectBudget_ProjectId(projectID.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDBXProjectBudget(dbxBudget)
+}
+
+// GetAll returns the budgets of all projects.
+func (budgets *projectBudgets) GetAll(ctx context.Context) (_ []console.ProjectBudget, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxBudgets, err := budgets.db.All_ProjectBudget_OrderBy_Asc_ProjectId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	all := make([]console.ProjectBudget, 0, len(dbxBudgets))
+	for _, dbxBudget := range dbxBudgets {
+		budget, err := fromDBXProjectBudget(dbxBudget)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, *budget)
+	}
+
+	return all, nil
+}
+
+// Upsert creates the budget of the project or updates the amount and hard cap of an existing one.
+func (budgets *projectBudgets) Upsert(ctx context.Context, projectID uuid.UUID, amount int64, hardCap bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = budgets.db.Get_ProjectBudget_By_ProjectId(ctx, dbx.ProjectBudget_ProjectId(projectID.Bytes()))
+	if errors.Is(err, sql.ErrNoRows) {
+		return budgets.db.CreateNoReturn_ProjectBudget(ctx,
+			dbx.ProjectBudget_ProjectId(projectID.Bytes()),
+			dbx.ProjectBudget_Amount(amount),
+			dbx.ProjectBudget_HardCap(hardCap),
+			dbx.ProjectBudget_Create_Fields{},
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	return budgets.db.UpdateNoReturn_ProjectBudget_By_ProjectId(ctx,
+		dbx.ProjectBudget_ProjectId(projectID.Bytes()),
+		dbx.ProjectBudget_Update_Fields{
+			Amount:  dbx.ProjectBudget_Amount(amount),
+			HardCap: dbx.ProjectBudget_HardCap(hardCap),
+		},
+	)
+}
+
+// UpdateCap sets the project usage limits which were in place before the hard cap was applied.
+// Passing nil limits removes the hard cap.
+func (budgets *projectBudgets) UpdateCap(ctx context.Context, projectID uuid.UUID, cappedLimits *console.NullableUsageLimits, cappedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	update := dbx.ProjectBudget_Update_Fields{
+		CappedLimits: dbx.ProjectBudget_CappedLimits_Null(),
+		CappedAt:     dbx.ProjectBudget_CappedAt_Null(),
+	}
+	if cappedLimits != nil {
+		limitBytes, err := json.Marshal(cappedLimits)
+		if err != nil {
+			return err
+		}
+		update.CappedLimits = dbx.ProjectBudget_CappedLimits(limitBytes)
+		update.CappedAt = dbx.ProjectBudget_CappedAt(cappedAt)
+	}
+
+	return budgets.db.UpdateNoReturn_ProjectBudget_By_ProjectId(ctx, dbx.ProjectBudget_ProjectId(projectID.Bytes()), update)
+}
+
+// Delete removes the budget of the project.
+func (budgets *projectBudgets) Delete(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = budgets.db.Delete_ProjectBudget_By_ProjectId(ctx, dbx.ProjectBudget_ProjectId(projectID.Bytes()))
+	return err
+}
+
+// InsertAlert records that a budget alert was sent.
+func (budgets *projectBudgets) InsertAlert(ctx context.Context, alert console.ProjectBudgetAlert) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return budgets.db.CreateNoReturn_ProjectBudgetAlert(ctx,
+		dbx.ProjectBudgetAlert_ProjectId(alert.ProjectID.Bytes()),
+		dbx.ProjectBudgetAlert_Period(alert.Period),
+		dbx.ProjectBudgetAlert_Threshold(alert.Threshold),
+		dbx.ProjectBudgetAlert_Spent(alert.Spent),
+		dbx.ProjectBudgetAlert_Budget(alert.Budget),
+	)
+}
+
+// DeleteAlerts removes all the budget alerts of the project.
+func (budgets *projectBudgets) DeleteAlerts(ctx context.Context, projectID uuid.UUID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = budgets.db.Delete_ProjectBudgetAlert_By_ProjectId(ctx, dbx.ProjectBudgetAlert_ProjectId(projectID.Bytes()))
+	return err
+}
+
+// GetAlerts returns the budget alerts of the project, newest first.
+func (budgets *projectBudgets) GetAlerts(ctx context.Context, projectID uuid.UUID) (_ []console.ProjectBudgetAlert, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbxAlerts, err := budgets.db.All_ProjectBudgetAlert_By_ProjectId_OrderBy_Desc_CreatedAt(ctx,
+		dbx.ProjectBudgetAlert_ProjectId(projectID.Bytes()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	alerts := make([]console.ProjectBudgetAlert, 0, len(dbxAlerts))
+	for _, dbxAlert := range dbxAlerts {
+		alerts = append(alerts, console.ProjectBudgetAlert{
+			ProjectID: projectID,
+			Period:    dbxAlert.Period,
+			Threshold: dbxAlert.Threshold,
+			Spent:     dbxAlert.Spent,
+			Budget:    dbxAlert.Budget,
+			CreatedAt: dbxAlert.CreatedAt,
+		})
+	}
+
+	return alerts, nil
+}
+
+// fromDBXProjectBudget converts *dbx.ProjectBudget to *console.ProjectBudget.
+func fromDBXProjectBudget(dbxBudget *dbx.ProjectBudget) (_ *console.ProjectBudget, err error) {
+	projectID, err := uuid.FromBytes(dbxBudget.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := &console.ProjectBudget{
+		ProjectID: projectID,
+		Amount:    dbxBudget.Amount,
+		HardCap:   dbxBudget.HardCap,
+		CappedAt:  dbxBudget.CappedAt,
+		CreatedAt: dbxBudget.CreatedAt,
+	}
+	if dbxBudget.CappedLimits != nil {
+		err := json.Unmarshal(dbxBudget.CappedLimits, &budget.CappedLimits)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return budget, nil
+}
@@ -458,3 +458,18 @@ func (projects *projects) UpdateUsageLimits(ctx context.Context, id uuid.UUID, l
 	)
 	return err
 }
+
+// UpdateNullableUsageLimits is a method for updating project's usage limits, nil limits are unset.
+func (projects *projects) UpdateNullableUsageLimits(ctx context.Context, id uuid.UUID, limits console.NullableUsageLimits) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = projects.db.Update_Project_By_Id(ctx,
+		dbx.Project_Id(id[:]),
+		dbx.Project_Update_Fields{
+			BandwidthLimit: dbx.Project_BandwidthLimit_Raw(limits.Bandwidth),
+			UsageLimit:     dbx.Project_UsageLimit_Raw(limits.Storage),
+			SegmentLimit:   dbx.Project_SegmentLimit_Raw(limits.Segment),
+		},
+	)
+	return err
+}
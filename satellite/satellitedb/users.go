@@ -220,6 +220,26 @@ func (users *users) Update(ctx context.Context, userID uuid.UUID, updateRequest
 	return err
 }
 
+// Anonymize erases the personal data and the credentials of the user, replaces its email
+// and marks it as deleted.
+func (users *users) Anonymize(ctx context.Context, id uuid.UUID, email string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = users.db.ExecContext(ctx, `
+		UPDATE users
+		SET email = $2, normalized_email = $3, full_name = '', short_name = NULL,
+			password_hash = $4, status = $5,
+			partner_id = NULL, user_agent = NULL,
+			position = NULL, company_name = NULL, company_size = NULL, working_on = NULL,
+			is_professional = false, employee_count = NULL, have_sales_contact = false,
+			mfa_enabled = false, mfa_secret_key = NULL, mfa_recovery_codes = NULL,
+			signup_promo_code = NULL, signup_captcha = NULL,
+			failed_login_count = NULL, login_lockout_expiration = NULL
+		WHERE id = $1
+	`, id.Bytes(), email, normalizeEmail(email), []byte{}, int(console.Deleted))
+	return err
+}
+
 // UpdatePaidTier sets whether the user is in the paid tier.
 func (users *users) UpdatePaidTier(ctx context.Context, id uuid.UUID, paidTier bool, projectBandwidthLimit, projectStorageLimit memory.Size, projectSegmentLimit int64, projectLimit int) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -14,6 +14,7 @@ import (
 
 	"common/storx"
 	"storx/storagenode/console"
+	"storx/storagenode/contact"
 )
 
 // ErrStorageNodeAPI - console storagenode api error type.
@@ -155,6 +156,60 @@ func (dashboard *StorageNode) EstimatedPayout(w http.ResponseWriter, r *http.Req
 	}
 }
 
+// AnnounceMaintenance handles announcing planned downtime to satellites.
+func (dashboard *StorageNode) AnnounceMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	var request struct {
+		Start time.Time `json:"start"`
+		End   time.Time `json:"end"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&request); err != nil {
+		dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	window, err := dashboard.service.AnnounceMaintenance(ctx, request.Start, request.End)
+	if err != nil {
+		if contact.ErrInvalidMaintenanceWindow.Has(err) {
+			dashboard.serveJSONError(w, http.StatusBadRequest, ErrStorageNodeAPI.Wrap(err))
+			return
+		}
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(window); err != nil {
+		dashboard.log.Error("failed to encode json response", zap.Error(ErrStorageNodeAPI.Wrap(err)))
+		return
+	}
+}
+
+// CancelMaintenance handles cancelling announced planned downtime.
+func (dashboard *StorageNode) CancelMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	w.Header().Set(contentType, applicationJSON)
+
+	err = dashboard.service.CancelMaintenance(ctx)
+	if err != nil {
+		if contact.ErrNoMaintenanceWindow.Has(err) {
+			dashboard.serveJSONError(w, http.StatusNotFound, ErrStorageNodeAPI.Wrap(err))
+			return
+		}
+		dashboard.serveJSONError(w, http.StatusInternalServerError, ErrStorageNodeAPI.Wrap(err))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // serveJSONError writes JSON error to response output stream.
 func (dashboard *StorageNode) serveJSONError(w http.ResponseWriter, status int, err error) {
 	w.WriteHeader(status)
@@ -64,6 +64,8 @@ type Service struct {
 
 	quicStats      *contact.QUICStats
 	configuredPort string
+
+	maintenance *contact.MaintenanceService
 }
 
 // NewService returns new instance of Service.
@@ -71,7 +73,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 	allocatedDiskSpace memory.Size, walletAddress string, versionInfo version.Info, trust *trust.Pool,
 	reputationDB reputation.DB, storageUsageDB storageusage.DB, pricingDB pricing.DB, satelliteDB satellites.DB,
 	pingStats *contact.PingStats, contact *contact.Service, estimation *estimatedpayouts.Service, usageCache *pieces.BlobsUsageCache,
-	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats) (*Service, error) {
+	walletFeatures operator.WalletFeatures, port string, quicStats *contact.QUICStats, maintenance *contact.MaintenanceService) (*Service, error) {
 	if log == nil {
 		return nil, errs.New("log can't be nil")
 	}
@@ -125,6 +127,7 @@ func NewService(log *zap.Logger, bandwidth bandwidth.DB, pieceStore *pieces.Stor
 		walletFeatures:     walletFeatures,
 		quicStats:          quicStats,
 		configuredPort:     port,
+		maintenance:        maintenance,
 	}, nil
 }
 
@@ -159,6 +162,8 @@ type Dashboard struct {
 	ConfiguredPort   string    `json:"configuredPort"`
 	QUICStatus       string    `json:"quicStatus"`
 	LastQUICPingedAt time.Time `json:"lastQuicPingedAt"`
+
+	Maintenance *contact.MaintenanceWindow `json:"maintenance"`
 }
 
 // GetDashboardData returns stale dashboard data.
@@ -179,6 +184,12 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	data.LastQUICPingedAt = s.quicStats.WhenLastPinged()
 	data.ConfiguredPort = s.configuredPort
 
+	if s.maintenance != nil {
+		if window, ok := s.maintenance.Window(); ok {
+			data.Maintenance = &window
+		}
+	}
+
 	stats, err := s.reputationDB.All(ctx)
 	if err != nil {
 		return nil, SNOServiceErr.Wrap(err)
@@ -242,6 +253,18 @@ func (s *Service) GetDashboardData(ctx context.Context) (_ *Dashboard, err error
 	return data, nil
 }
 
+// AnnounceMaintenance announces planned downtime to trusted satellites.
+func (s *Service) AnnounceMaintenance(ctx context.Context, start, end time.Time) (_ contact.MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.maintenance.Announce(ctx, start, end)
+}
+
+// CancelMaintenance cancels announced planned downtime.
+func (s *Service) CancelMaintenance(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.maintenance.Cancel(ctx)
+}
+
 // PriceModel is a satellite prices for storagenode usage TB/H.
 type PriceModel struct {
 	EgressBandwidth int64
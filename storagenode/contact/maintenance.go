@@ -0,0 +1,244 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"common/fpath"
+	"common/rpc"
+	"common/rpc/rpcstatus"
+	"common/storx"
+	"storx/private/maintenancepb"
+	"storx/storagenode/trust"
+)
+
+var (
+	// ErrMaintenance is the error class for planned maintenance errors.
+	ErrMaintenance = errs.Class("maintenance")
+	// ErrInvalidMaintenanceWindow is the error class for maintenance window which can't be announced.
+	ErrInvalidMaintenanceWindow = errs.Class("invalid maintenance window")
+	// ErrNoMaintenanceWindow is returned when there is no announced maintenance window.
+	ErrNoMaintenanceWindow = errs.Class("no maintenance window")
+)
+
+// MaintenanceWindow is a planned downtime announced to satellites.
+type MaintenanceWindow struct {
+	Start      time.Time              `json:"start"`
+	End        time.Time              `json:"end"`
+	Satellites []SatelliteMaintenance `json:"satellites"`
+}
+
+// SatelliteMaintenance is a maintenance window as accepted by a single satellite.
+type SatelliteMaintenance struct {
+	SatelliteID storx.NodeID `json:"satelliteID"`
+	Start       time.Time    `json:"start"`
+	End         time.Time    `json:"end"`
+	Error       string       `json:"error,omitempty"`
+}
+
+// MaintenanceService announces planned downtime to trusted satellites and keeps the announced window.
+//
+// architecture: Service
+type MaintenanceService struct {
+	log    *zap.Logger
+	dialer rpc.Dialer
+	trust  *trust.Pool
+	path   string
+
+	mu     sync.Mutex
+	window *MaintenanceWindow
+}
+
+// NewMaintenanceService creates a new maintenance service, announced window is persisted at path.
+func NewMaintenanceService(log *zap.Logger, dialer rpc.Dialer, trust *trust.Pool, path string) *MaintenanceService {
+	service := &MaintenanceService{
+		log:    log,
+		dialer: dialer,
+		trust:  trust,
+		path:   path,
+	}
+
+	window, err := service.load()
+	if err != nil {
+		log.Warn("unable to load maintenance window", zap.String("path", path), zap.Error(err))
+	}
+	service.window = window
+
+	return service
+}
+
+// Announce announces planned downtime in [start, end) range to all trusted satellites.
+// Satellites which rejected the window are listed with an error.
+func (service *MaintenanceService) Announce(ctx context.Context, start, end time.Time) (_ MaintenanceWindow, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !start.Before(end) {
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("start should be before end")
+	}
+	if !end.After(time.Now()) {
+		return MaintenanceWindow{}, ErrInvalidMaintenanceWindow.New("end is in the past")
+	}
+
+	window := MaintenanceWindow{
+		Start: start.UTC(),
+		End:   end.UTC(),
+	}
+
+	var accepted int
+	for _, satelliteID := range service.trust.GetSatellites(ctx) {
+		satellite := SatelliteMaintenance{SatelliteID: satelliteID}
+
+		resp, err := service.announce(ctx, satelliteID, window.Start, window.End)
+		if err != nil {
+			service.log.Warn("satellite rejected maintenance window", zap.Stringer("Satellite ID", satelliteID), zap.Error(err))
+			satellite.Error = err.Error()
+		} else {
+			satellite.Start, satellite.End = resp.Start, resp.End
+			accepted++
+		}
+
+		window.Satellites = append(window.Satellites, satellite)
+	}
+
+	if accepted == 0 {
+		return window, ErrMaintenance.New("no satellite accepted maintenance window")
+	}
+
+	return window, service.save(&window)
+}
+
+// Cancel cancels announced maintenance on all trusted satellites.
+func (service *MaintenanceService) Cancel(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, ok := service.Window(); !ok {
+		return ErrNoMaintenanceWindow.New("nothing to cancel")
+	}
+
+	var group errs.Group
+	for _, satelliteID := range service.trust.GetSatellites(ctx) {
+		err := service.cancel(ctx, satelliteID)
+		if err != nil && !errs.Is(err, errNotAnnounced) {
+			group.Add(ErrMaintenance.New("%s: %v", satelliteID, err))
+		}
+	}
+	if err := group.Err(); err != nil {
+		return err
+	}
+
+	return service.save(nil)
+}
+
+// Window returns announced maintenance window, ok is false when there is no window
+// or it has already ended.
+func (service *MaintenanceService) Window() (_ MaintenanceWindow, ok bool) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.window == nil || !service.window.End.After(time.Now()) {
+		return MaintenanceWindow{}, false
+	}
+	return *service.window, true
+}
+
+// errNotAnnounced is returned when the satellite doesn't know about the maintenance window.
+var errNotAnnounced = errs.New("maintenance window not announced")
+
+// announce announces maintenance window to a single satellite.
+func (service *MaintenanceService) announce(ctx context.Context, satelliteID storx.NodeID, start, end time.Time) (_ *maintenancepb.AnnounceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dial(ctx, satelliteID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	return maintenancepb.NewDRPCNodeMaintenanceClient(conn).Announce(ctx, &maintenancepb.AnnounceRequest{
+		Start: start,
+		End:   end,
+	})
+}
+
+// cancel cancels maintenance window on a single satellite.
+func (service *MaintenanceService) cancel(ctx context.Context, satelliteID storx.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := service.dial(ctx, satelliteID)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	_, err = maintenancepb.NewDRPCNodeMaintenanceClient(conn).Cancel(ctx, &maintenancepb.CancelRequest{})
+	if err != nil && rpcstatus.Code(err) == rpcstatus.NotFound {
+		return errNotAnnounced
+	}
+	return err
+}
+
+// dial dials the satellite.
+func (service *MaintenanceService) dial(ctx context.Context, satelliteID storx.NodeID) (*rpc.Conn, error) {
+	nodeurl, err := service.trust.GetNodeURL(ctx, satelliteID)
+	if err != nil {
+		return nil, err
+	}
+	return service.dialer.DialNodeURL(ctx, nodeurl)
+}
+
+// save persists announced window, nil window removes it.
+func (service *MaintenanceService) save(window *MaintenanceWindow) error {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+
+	if service.path != "" {
+		if window == nil {
+			if err := os.Remove(service.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return ErrMaintenance.Wrap(err)
+			}
+		} else {
+			data, err := json.Marshal(window)
+			if err != nil {
+				return ErrMaintenance.Wrap(err)
+			}
+			if err := fpath.AtomicWriteFile(service.path, data, 0644); err != nil {
+				return ErrMaintenance.Wrap(err)
+			}
+		}
+	}
+
+	service.window = window
+	return nil
+}
+
+// load reads persisted window, nil is returned when there is none.
+func (service *MaintenanceService) load() (*MaintenanceWindow, error) {
+	if service.path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(service.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, ErrMaintenance.Wrap(err)
+	}
+
+	var window MaintenanceWindow
+	if err := json.Unmarshal(data, &window); err != nil {
+		return nil, ErrMaintenance.Wrap(err)
+	}
+	return &window, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2023 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package contact_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
+
+	"common/testcontext"
+	"storx/private/testplanet"
+	"storx/satellite"
+	"storx/satellite/overlay"
+	"storx/storagenode/contact"
+)
+
+func TestMaintenanceService(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Overlay.Maintenance.MaxDuration = time.Hour
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		satelliteOverlay := planet.Satellites[0].Overlay.Service
+		service := node.Contact.Maintenance
+		now := time.Now()
+
+		_, ok := service.Window()
+		require.False(t, ok)
+
+		err := service.Cancel(ctx)
+		require.True(t, contact.ErrNoMaintenanceWindow.Has(err), err)
+
+		_, err = service.Announce(ctx, now.Add(time.Hour), now)
+		require.True(t, contact.ErrInvalidMaintenanceWindow.Has(err), err)
+
+		_, err = service.Announce(ctx, now.Add(-2*time.Hour), now.Add(-time.Hour))
+		require.True(t, contact.ErrInvalidMaintenanceWindow.Has(err), err)
+
+		// the satellite rejects windows exceeding its limits.
+		window, err := service.Announce(ctx, now.Add(time.Hour), now.Add(3*time.Hour))
+		require.True(t, contact.ErrMaintenance.Has(err), err)
+		require.Len(t, window.Satellites, 1)
+		require.NotEmpty(t, window.Satellites[0].Error)
+
+		_, ok = service.Window()
+		require.False(t, ok)
+
+		window, err = service.Announce(ctx, now.Add(time.Hour), now.Add(90*time.Minute))
+		require.NoError(t, err)
+		require.Len(t, window.Satellites, 1)
+		require.Equal(t, planet.Satellites[0].ID(), window.Satellites[0].SatelliteID)
+		require.Empty(t, window.Satellites[0].Error)
+
+		announced, ok := service.Window()
+		require.True(t, ok)
+		require.Equal(t, window, announced)
+
+		stored, err := satelliteOverlay.GetMaintenanceWindow(ctx, node.ID())
+		require.NoError(t, err)
+		require.WithinDuration(t, window.Start, stored.Start, time.Second)
+		require.WithinDuration(t, window.End, stored.End, time.Second)
+
+		// the window is loaded after restart.
+		reloaded := contact.NewMaintenanceService(zaptest.NewLogger(t), node.Dialer, node.Storage2.Trust, node.Config.Contact.MaintenancePath)
+		announced, ok = reloaded.Window()
+		require.True(t, ok)
+		require.True(t, window.Start.Equal(announced.Start))
+		require.True(t, window.End.Equal(announced.End))
+
+		require.NoError(t, reloaded.Cancel(ctx))
+
+		_, ok = reloaded.Window()
+		require.False(t, ok)
+
+		_, err = satelliteOverlay.GetMaintenanceWindow(ctx, node.ID())
+		require.True(t, overlay.ErrMaintenanceWindowNotFound.Has(err), err)
+
+		// cancelling a window which the satellite doesn't know about succeeds.
+		_, err = service.Announce(ctx, now.Add(time.Hour), now.Add(90*time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, satelliteOverlay.CancelMaintenance(ctx, node.ID()))
+		require.NoError(t, service.Cancel(ctx))
+
+		_, ok = service.Window()
+		require.False(t, ok)
+	})
+}
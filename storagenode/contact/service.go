@@ -38,6 +38,8 @@ type Config struct {
 
 	// Chore config values
 	Interval time.Duration `help:"how frequently the node contact chore should run" releaseDefault:"1h" devDefault:"30s"`
+
+	MaintenancePath string `help:"path to the file which keeps announced maintenance window" default:"${CONFDIR}/maintenance.json"`
 }
 
 // NodeInfo contains information necessary for introducing storagenode to satellite.
@@ -59,6 +61,8 @@ type Service struct {
 
 	mu   sync.Mutex
 	self NodeInfo
+	// satelliteCapacity overrides capacity reported to satellites with allocation quota.
+	satelliteCapacity map[storx.NodeID]pb.NodeCapacity
 
 	trust     *trust.Pool
 	quicStats *QUICStats
@@ -130,10 +134,11 @@ func (service *Service) pingSatelliteOnce(ctx context.Context, id storx.NodeID)
 	defer func() { err = errs.Combine(err, conn.Close()) }()
 
 	self := service.Local()
+	capacity := service.capacityFor(id)
 	resp, err := pb.NewDRPCNodeClient(conn).CheckIn(ctx, &pb.CheckInRequest{
 		Address:             self.Address,
 		Version:             &self.Version,
-		Capacity:            &self.Capacity,
+		Capacity:            &capacity,
 		Operator:            &self.Operator,
 		NoiseKeyAttestation: self.NoiseKeyAttestation,
 		DebounceLimit:       int32(self.DebounceLimit),
@@ -239,3 +244,29 @@ func (service *Service) UpdateSelf(capacity *pb.NodeCapacity) {
 	}
 	service.initialized.Release()
 }
+
+// UpdateSatelliteCapacity updates capacity reported to the satellite,
+// nil capacity makes the satellite to receive capacity of the local node.
+func (service *Service) UpdateSatelliteCapacity(satelliteID storx.NodeID, capacity *pb.NodeCapacity) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	if capacity == nil {
+		delete(service.satelliteCapacity, satelliteID)
+		return
+	}
+	if service.satelliteCapacity == nil {
+		service.satelliteCapacity = make(map[storx.NodeID]pb.NodeCapacity)
+	}
+	service.satelliteCapacity[satelliteID] = *capacity
+}
+
+// capacityFor returns capacity which should be reported to the satellite.
+func (service *Service) capacityFor(satelliteID storx.NodeID) pb.NodeCapacity {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	capacity := service.self.Capacity
+	if override, ok := service.satelliteCapacity[satelliteID]; ok && override.FreeDisk < capacity.FreeDisk {
+		capacity.FreeDisk = override.FreeDisk
+	}
+	return capacity
+}
@@ -7,6 +7,11 @@ package monitor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -14,8 +19,10 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"common/fpath"
 	"common/memory"
 	"common/pb"
+	"common/storx"
 	"common/sync2"
 	"storx/storagenode/bandwidth"
 	"storx/storagenode/contact"
@@ -27,6 +34,9 @@ var (
 
 	// Error is the default error class for piecestore monitor errors.
 	Error = errs.Class("piecestore monitor")
+
+	// ErrInvalidAllocation is the error class for allocated disk space which can't be applied.
+	ErrInvalidAllocation = errs.Class("invalid allocated disk space")
 )
 
 // DiskSpace consolidates monitored disk space statistics.
@@ -49,6 +59,13 @@ type Config struct {
 	MinimumDiskSpace          memory.Size   `help:"how much disk space a node at minimum has to advertise" default:"500GB"`
 	MinimumBandwidth          memory.Size   `help:"how much bandwidth a node at minimum has to advertise (deprecated)" default:"0TB"`
 	NotifyLowDiskCooldown     time.Duration `help:"minimum length of time between capacity reports" default:"10m" hidden:"true"`
+	AllocationOverridePath    string        `help:"path to the file which keeps allocated disk space changed at runtime" default:"${CONFDIR}/allocated-disk-space.json"`
+	SatelliteQuotas           Quotas        `help:"comma separated list of disk space limits per satellite, either absolute or percent of allocated disk space, e.g. <satellite-id>=2TB,<satellite-id>=25%" default:""`
+}
+
+// allocationOverride is allocated disk space changed at runtime, persisted to survive restarts.
+type allocationOverride struct {
+	AllocatedDiskSpace int64 `json:"allocatedDiskSpace"`
 }
 
 // Service which monitors disk usage.
@@ -59,6 +76,7 @@ type Service struct {
 	store                 *pieces.Store
 	contact               *contact.Service
 	usageDB               bandwidth.DB
+	mu                    sync.Mutex
 	allocatedDiskSpace    int64
 	cooldown              *sync2.Cooldown
 	Loop                  *sync2.Cycle
@@ -69,6 +87,15 @@ type Service struct {
 
 // NewService creates a new storage node monitoring service.
 func NewService(log *zap.Logger, store *pieces.Store, contact *contact.Service, usageDB bandwidth.DB, allocatedDiskSpace int64, interval time.Duration, reportCapacity func(context.Context), config Config) *Service {
+	override, err := loadAllocationOverride(config.AllocationOverridePath)
+	switch {
+	case err != nil:
+		log.Warn("unable to load allocated disk space override", zap.String("path", config.AllocationOverridePath), zap.Error(err))
+	case override > 0:
+		log.Info("using allocated disk space changed at runtime", zap.Int64("bytes", override))
+		allocatedDiskSpace = override
+	}
+
 	return &Service{
 		log:                   log,
 		store:                 store,
@@ -100,6 +127,7 @@ func (service *Service) Run(ctx context.Context) (err error) {
 		return Error.Wrap(err)
 	}
 
+	service.mu.Lock()
 	// check your hard drive is big enough
 	// first time setup as a piece node server
 	if totalUsed == 0 && freeDiskSpace < service.allocatedDiskSpace {
@@ -122,7 +150,10 @@ func (service *Service) Run(ctx context.Context) (err error) {
 	}
 
 	// Ensure the disk is at least 500GB in size, which is our current minimum required to be an operator
-	if service.allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
+	allocatedDiskSpace := service.allocatedDiskSpace
+	service.mu.Unlock()
+
+	if allocatedDiskSpace < service.Config.MinimumDiskSpace.Int64() {
 		service.log.Error("Total disk space is less than required minimum", zap.Int64("bytes", service.Config.MinimumDiskSpace.Int64()))
 		return Error.New("disk space requirement not met")
 	}
@@ -185,6 +216,74 @@ func (service *Service) NotifyLowDisk() {
 	service.cooldown.Trigger()
 }
 
+// SetAllocatedDiskSpace changes allocated disk space at runtime and persists the change.
+func (service *Service) SetAllocatedDiskSpace(ctx context.Context, allocated int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if allocated < service.Config.MinimumDiskSpace.Int64() {
+		return ErrInvalidAllocation.New("%d is less than required minimum %d", allocated, service.Config.MinimumDiskSpace.Int64())
+	}
+
+	storageStatus, err := service.store.StorageStatus(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	totalUsed, err := service.store.SpaceUsedForPiecesAndTrash(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if allocated > storageStatus.DiskFree+totalUsed {
+		return ErrInvalidAllocation.New("%d exceeds available disk space %d", allocated, storageStatus.DiskFree+totalUsed)
+	}
+
+	if service.Config.AllocationOverridePath != "" {
+		data, err := json.Marshal(allocationOverride{AllocatedDiskSpace: allocated})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		if err := fpath.AtomicWriteFile(service.Config.AllocationOverridePath, data, 0644); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	service.mu.Lock()
+	service.allocatedDiskSpace = allocated
+	service.mu.Unlock()
+
+	service.log.Info("Allocated disk space changed", zap.Int64("bytes", allocated))
+
+	return Error.Wrap(service.updateNodeInformation(ctx))
+}
+
+// allocated returns currently allocated disk space.
+func (service *Service) allocated() int64 {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return service.allocatedDiskSpace
+}
+
+// loadAllocationOverride reads allocated disk space changed at runtime, zero is returned when there is none.
+func loadAllocationOverride(path string) (int64, error) {
+	if path == "" {
+		return 0, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, Error.Wrap(err)
+	}
+
+	var override allocationOverride
+	if err := json.Unmarshal(data, &override); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	return override.AllocatedDiskSpace, nil
+}
+
 // Close stops the monitor service.
 func (service *Service) Close() (err error) {
 	service.Loop.Close()
@@ -203,6 +302,16 @@ func (service *Service) updateNodeInformation(ctx context.Context) (err error) {
 		FreeDisk: freeSpace,
 	})
 
+	for _, quota := range service.Config.SatelliteQuotas {
+		satelliteFreeSpace, err := service.SatelliteAvailableSpace(ctx, quota.SatelliteID)
+		if err != nil {
+			return err
+		}
+		service.contact.UpdateSatelliteCapacity(quota.SatelliteID, &pb.NodeCapacity{
+			FreeDisk: satelliteFreeSpace,
+		})
+	}
+
 	return nil
 }
 
@@ -215,7 +324,8 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		return 0, err
 	}
 
-	freeSpaceForStorx := service.allocatedDiskSpace - usedSpace
+	allocatedDiskSpace := service.allocated()
+	freeSpaceForStorx := allocatedDiskSpace - usedSpace
 
 	diskStatus, err := service.store.StorageStatus(ctx)
 	if err != nil {
@@ -225,13 +335,44 @@ func (service *Service) AvailableSpace(ctx context.Context) (_ int64, err error)
 		freeSpaceForStorx = diskStatus.DiskFree
 	}
 
-	mon.IntVal("allocated_space").Observe(service.allocatedDiskSpace)
+	mon.IntVal("allocated_space").Observe(allocatedDiskSpace)
 	mon.IntVal("used_space").Observe(usedSpace)
 	mon.IntVal("available_space").Observe(freeSpaceForStorx)
 
 	return freeSpaceForStorx, nil
 }
 
+// SatelliteAvailableSpace returns available disk space for upload from the satellite,
+// it is limited by the satellite quota when there is one.
+func (service *Service) SatelliteAvailableSpace(ctx context.Context, satelliteID storx.NodeID) (_ int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	available, err := service.AvailableSpace(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	quota, ok := service.Config.SatelliteQuotas.Find(satelliteID)
+	if !ok {
+		return available, nil
+	}
+
+	usedBySatellite, _, err := service.store.SpaceUsedBySatellite(ctx, satelliteID)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	quotaAvailable := quota.Limit(service.allocated()) - usedBySatellite
+	if quotaAvailable < 0 {
+		quotaAvailable = 0
+	}
+	if quotaAvailable < available {
+		available = quotaAvailable
+	}
+
+	return available, nil
+}
+
 // DiskSpace returns consolidated disk space state info.
 func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -252,7 +393,8 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 
 	overused := int64(0)
 
-	available := service.allocatedDiskSpace - (usedForPieces + usedForTrash)
+	allocatedDiskSpace := service.allocated()
+	available := allocatedDiskSpace - (usedForPieces + usedForTrash)
 	if available < 0 {
 		overused = -available
 	}
@@ -261,7 +403,7 @@ func (service *Service) DiskSpace(ctx context.Context) (_ DiskSpace, err error)
 	}
 
 	return DiskSpace{
-		Allocated:     service.allocatedDiskSpace,
+		Allocated:     allocatedDiskSpace,
 		UsedForPieces: usedForPieces,
 		UsedForTrash:  usedForTrash,
 		Free:          storageStatus.DiskFree,
@@ -0,0 +1,127 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/zeebo/errs"
+
+	"common/memory"
+	"common/storx"
+)
+
+// ErrInvalidQuota is the error class for satellite quotas which can't be parsed.
+var ErrInvalidQuota = errs.Class("invalid satellite quota")
+
+// Quota limits disk space which a single satellite is allowed to use.
+// Either Size or Percent of allocated disk space is set.
+type Quota struct {
+	SatelliteID storx.NodeID
+	Size        memory.Size
+	Percent     float64
+}
+
+// Limit returns disk space limit of the satellite for the allocated disk space.
+func (quota Quota) Limit(allocated int64) int64 {
+	if quota.Percent > 0 {
+		return int64(float64(allocated) * quota.Percent / 100)
+	}
+	return quota.Size.Int64()
+}
+
+// String returns quota in <satellite-id>=<size or percent> format.
+func (quota Quota) String() string {
+	if quota.Percent > 0 {
+		return quota.SatelliteID.String() + "=" + strconv.FormatFloat(quota.Percent, 'f', -1, 64) + "%"
+	}
+	return quota.SatelliteID.String() + "=" + quota.Size.String()
+}
+
+// ParseQuota parses quota in <satellite-id>=<size or percent> format, e.g. 12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S=2TB or 12EayRS2V1kEsWESU9QMRseFhdxYxKicsiFmxrsLZHeLUtdps3S=25%.
+func ParseQuota(value string) (Quota, error) {
+	satellite, limit, ok := strings.Cut(strings.TrimSpace(value), "=")
+	if !ok {
+		return Quota{}, ErrInvalidQuota.New("%q should be in <satellite-id>=<size or percent> format", value)
+	}
+
+	satelliteID, err := storx.NodeIDFromString(strings.TrimSpace(satellite))
+	if err != nil {
+		return Quota{}, ErrInvalidQuota.Wrap(err)
+	}
+
+	quota := Quota{SatelliteID: satelliteID}
+
+	limit = strings.TrimSpace(limit)
+	if strings.HasSuffix(limit, "%") {
+		quota.Percent, err = strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(limit, "%")), 64)
+		if err != nil {
+			return Quota{}, ErrInvalidQuota.Wrap(err)
+		}
+		if quota.Percent <= 0 || quota.Percent > 100 {
+			return Quota{}, ErrInvalidQuota.New("percent should be in (0, 100] range, got %v", quota.Percent)
+		}
+		return quota, nil
+	}
+
+	if err := quota.Size.Set(limit); err != nil {
+		return Quota{}, ErrInvalidQuota.Wrap(err)
+	}
+	if quota.Size <= 0 {
+		return Quota{}, ErrInvalidQuota.New("size should be positive, got %v", quota.Size)
+	}
+	return quota, nil
+}
+
+// ensure Quotas implements pflag.Value.
+var _ pflag.Value = (*Quotas)(nil)
+
+// Quotas is a list of per satellite disk space quotas.
+type Quotas []Quota
+
+// Find returns quota of the satellite, ok is false when the satellite has no quota.
+func (quotas Quotas) Find(satelliteID storx.NodeID) (_ Quota, ok bool) {
+	for _, quota := range quotas {
+		if quota.SatelliteID == satelliteID {
+			return quota, true
+		}
+	}
+	return Quota{}, false
+}
+
+// String returns the comma separated list of quotas.
+func (quotas Quotas) String() string {
+	values := make([]string, 0, len(quotas))
+	for _, quota := range quotas {
+		values = append(values, quota.String())
+	}
+	return strings.Join(values, ",")
+}
+
+// Set implements pflag.Value by parsing a comma separated list of quotas.
+func (quotas *Quotas) Set(value string) error {
+	var parsed Quotas
+	for _, entry := range strings.Split(value, ",") {
+		if strings.TrimSpace(entry) == "" {
+			continue
+		}
+		quota, err := ParseQuota(entry)
+		if err != nil {
+			return err
+		}
+		if _, ok := parsed.Find(quota.SatelliteID); ok {
+			return ErrInvalidQuota.New("duplicate quota for satellite %s", quota.SatelliteID)
+		}
+		parsed = append(parsed, quota)
+	}
+	*quotas = parsed
+	return nil
+}
+
+// Type returns the type of the pflag.Value.
+func (quotas Quotas) Type() string {
+	return "satellite-quotas"
+}
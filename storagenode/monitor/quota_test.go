@@ -0,0 +1,82 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package monitor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+	"common/testcontext"
+	"common/testrand"
+	"storx/private/testplanet"
+	"storx/storagenode/monitor"
+)
+
+func TestQuotas(t *testing.T) {
+	first, second := testrand.NodeID(), testrand.NodeID()
+
+	var quotas monitor.Quotas
+	require.NoError(t, quotas.Set(first.String()+"=2TB, "+second.String()+"=25%"))
+	require.Len(t, quotas, 2)
+
+	quota, ok := quotas.Find(first)
+	require.True(t, ok)
+	assert.Equal(t, 2*memory.TB.Int64(), quota.Limit(10*memory.TB.Int64()))
+
+	quota, ok = quotas.Find(second)
+	require.True(t, ok)
+	assert.Equal(t, memory.TB.Int64(), quota.Limit(4*memory.TB.Int64()))
+
+	_, ok = quotas.Find(testrand.NodeID())
+	assert.False(t, ok)
+
+	var parsed monitor.Quotas
+	require.NoError(t, parsed.Set(quotas.String()))
+	assert.Equal(t, quotas, parsed)
+
+	for _, invalid := range []string{
+		"2TB",
+		"invalid=2TB",
+		first.String() + "=",
+		first.String() + "=0%",
+		first.String() + "=101%",
+		first.String() + "=-1GB",
+		first.String() + "=1TB," + first.String() + "=2TB",
+	} {
+		var quotas monitor.Quotas
+		assert.True(t, monitor.ErrInvalidQuota.Has(quotas.Set(invalid)), invalid)
+	}
+}
+
+func TestSatelliteAvailableSpace(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		satelliteID := planet.Satellites[0].ID()
+
+		available, err := node.Storage2.Monitor.AvailableSpace(ctx)
+		require.NoError(t, err)
+
+		satelliteAvailable, err := node.Storage2.Monitor.SatelliteAvailableSpace(ctx, satelliteID)
+		require.NoError(t, err)
+		assert.Equal(t, available, satelliteAvailable)
+
+		node.Storage2.Monitor.Config.SatelliteQuotas = monitor.Quotas{
+			{SatelliteID: satelliteID, Size: memory.MiB},
+		}
+
+		satelliteAvailable, err = node.Storage2.Monitor.SatelliteAvailableSpace(ctx, satelliteID)
+		require.NoError(t, err)
+		assert.Equal(t, memory.MiB.Int64(), satelliteAvailable)
+
+		// other satellites are not limited by the quota.
+		otherAvailable, err := node.Storage2.Monitor.SatelliteAvailableSpace(ctx, testrand.NodeID())
+		require.NoError(t, err)
+		assert.Equal(t, available, otherAvailable)
+	})
+}
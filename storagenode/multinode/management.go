@@ -0,0 +1,223 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"common/rpc/rpcstatus"
+	"storx/private/multinodepb"
+	"storx/storagenode/apikeys"
+	"storx/storagenode/contact"
+	"storx/storagenode/gracefulexit"
+	"storx/storagenode/internalpb"
+	"storx/storagenode/monitor"
+	"storx/storagenode/pieces"
+	"storx/storagenode/trust"
+)
+
+// ensures that ManagementEndpoint implements multinodepb.DRPCManagementServer.
+var _ multinodepb.DRPCManagementServer = (*ManagementEndpoint)(nil)
+
+// ManagementEndpoint implements multinode node management endpoint,
+// it allows operator to perform actions on the node remotely.
+//
+// architecture: Endpoint
+type ManagementEndpoint struct {
+	multinodepb.DRPCManagementUnimplementedServer
+
+	log          *zap.Logger
+	apiKeys      *apikeys.Service
+	gracefulExit *gracefulexit.Endpoint
+	monitor      *monitor.Service
+	trust        *trust.Pool
+	cache        *pieces.CacheService
+	maintenance  *contact.MaintenanceService
+}
+
+// NewManagementEndpoint creates new multinode node management endpoint.
+func NewManagementEndpoint(log *zap.Logger, apiKeys *apikeys.Service, gracefulExit *gracefulexit.Endpoint, monitor *monitor.Service, trust *trust.Pool, cache *pieces.CacheService, maintenance *contact.MaintenanceService) *ManagementEndpoint {
+	return &ManagementEndpoint{
+		log:          log,
+		apiKeys:      apiKeys,
+		gracefulExit: gracefulExit,
+		monitor:      monitor,
+		trust:        trust,
+		cache:        cache,
+		maintenance:  maintenance,
+	}
+}
+
+// InitiateGracefulExit starts graceful exit from specific satellite.
+func (management *ManagementEndpoint) InitiateGracefulExit(ctx context.Context, req *multinodepb.InitiateGracefulExitRequest) (_ *multinodepb.InitiateGracefulExitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	progress, err := management.gracefulExit.InitiateGracefulExit(ctx, &internalpb.InitiateGracefulExitRequest{
+		NodeId: req.SatelliteId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	management.log.Info("graceful exit initiated remotely", zap.Stringer("Satellite ID", req.SatelliteId))
+
+	return &multinodepb.InitiateGracefulExitResponse{
+		DomainName:      progress.DomainName,
+		NodeId:          progress.NodeId,
+		PercentComplete: progress.PercentComplete,
+		Successful:      progress.Successful,
+	}, nil
+}
+
+// SetAllocatedDiskSpace changes disk space allocated for the node.
+func (management *ManagementEndpoint) SetAllocatedDiskSpace(ctx context.Context, req *multinodepb.SetAllocatedDiskSpaceRequest) (_ *multinodepb.SetAllocatedDiskSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = management.monitor.SetAllocatedDiskSpace(ctx, req.Allocated); err != nil {
+		if monitor.ErrInvalidAllocation.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	diskSpace, err := management.monitor.DiskSpace(ctx)
+	if err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	return &multinodepb.SetAllocatedDiskSpaceResponse{
+		Allocated: diskSpace.Allocated,
+	}, nil
+}
+
+// TrustExclusions returns trust exclusions added remotely.
+func (management *ManagementEndpoint) TrustExclusions(ctx context.Context, req *multinodepb.TrustExclusionsRequest) (_ *multinodepb.TrustExclusionsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	return &multinodepb.TrustExclusionsResponse{
+		Exclusions: management.trust.Exclusions(),
+	}, nil
+}
+
+// AddTrustExclusion excludes satellites matching the rule from the trusted ones.
+func (management *ManagementEndpoint) AddTrustExclusion(ctx context.Context, req *multinodepb.AddTrustExclusionRequest) (_ *multinodepb.AddTrustExclusionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = management.trust.AddExclusion(ctx, req.Exclusion); err != nil {
+		if trust.ErrExclusion.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	management.log.Info("trust exclusion added remotely", zap.String("Exclusion", req.Exclusion))
+
+	return &multinodepb.AddTrustExclusionResponse{}, nil
+}
+
+// RemoveTrustExclusion removes trust exclusion added remotely.
+func (management *ManagementEndpoint) RemoveTrustExclusion(ctx context.Context, req *multinodepb.RemoveTrustExclusionRequest) (_ *multinodepb.RemoveTrustExclusionResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = management.trust.RemoveExclusion(ctx, req.Exclusion); err != nil {
+		switch {
+		case trust.ErrExclusion.Has(err):
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		case trust.ErrNoExclusion.Has(err):
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		default:
+			return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+		}
+	}
+
+	management.log.Info("trust exclusion removed remotely", zap.String("Exclusion", req.Exclusion))
+
+	return &multinodepb.RemoveTrustExclusionResponse{}, nil
+}
+
+// RescanUsedSpace schedules recalculation of used space by walking all pieces on disk.
+func (management *ManagementEndpoint) RescanUsedSpace(ctx context.Context, req *multinodepb.RescanUsedSpaceRequest) (_ *multinodepb.RescanUsedSpaceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	management.cache.TriggerRescan()
+
+	return &multinodepb.RescanUsedSpaceResponse{}, nil
+}
+
+// AnnounceMaintenance announces planned downtime of the node to trusted satellites.
+func (management *ManagementEndpoint) AnnounceMaintenance(ctx context.Context, req *multinodepb.AnnounceMaintenanceRequest) (_ *multinodepb.AnnounceMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	window, err := management.maintenance.Announce(ctx, req.Start, req.End)
+	if err != nil {
+		if contact.ErrInvalidMaintenanceWindow.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	management.log.Info("maintenance announced remotely", zap.Time("start", window.Start), zap.Time("end", window.End))
+
+	resp := new(multinodepb.AnnounceMaintenanceResponse)
+	for _, satellite := range window.Satellites {
+		resp.Satellites = append(resp.Satellites, &multinodepb.AnnounceMaintenanceResponse_Satellite{
+			SatelliteId: satellite.SatelliteID,
+			Start:       satellite.Start,
+			End:         satellite.End,
+			Error:       satellite.Error,
+		})
+	}
+
+	return resp, nil
+}
+
+// CancelMaintenance cancels announced planned downtime of the node.
+func (management *ManagementEndpoint) CancelMaintenance(ctx context.Context, req *multinodepb.CancelMaintenanceRequest) (_ *multinodepb.CancelMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = authenticate(ctx, management.apiKeys, req.GetHeader()); err != nil {
+		return nil, rpcstatus.Wrap(rpcstatus.Unauthenticated, err)
+	}
+
+	if err = management.maintenance.Cancel(ctx); err != nil {
+		if contact.ErrNoMaintenanceWindow.Has(err) {
+			return nil, rpcstatus.Wrap(rpcstatus.NotFound, err)
+		}
+		return nil, rpcstatus.Wrap(rpcstatus.Internal, err)
+	}
+
+	management.log.Info("maintenance cancelled remotely")
+
+	return &multinodepb.CancelMaintenanceResponse{}, nil
+}
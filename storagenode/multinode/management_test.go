@@ -0,0 +1,101 @@
+// Copyright (C) 2022 Storx Labs, Inc.
+// See LICENSE for copying information.
+
+package multinode_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"common/memory"
+	"common/rpc/rpcstatus"
+	"common/testcontext"
+	"storx/private/multinodepb"
+	"storx/private/testplanet"
+	"storx/storagenode/apikeys"
+)
+
+func TestManagementEndpoint(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		node := planet.StorageNodes[0]
+		satellite := planet.Satellites[0]
+		endpoint := node.Multinode.Management
+
+		apiKey, err := apikeys.NewService(node.DB.APIKeys()).Issue(ctx)
+		require.NoError(t, err)
+		header := &multinodepb.RequestHeader{ApiKey: apiKey.Secret[:]}
+
+		t.Run("unauthenticated", func(t *testing.T) {
+			_, err := endpoint.RescanUsedSpace(ctx, &multinodepb.RescanUsedSpaceRequest{
+				Header: &multinodepb.RequestHeader{ApiKey: make([]byte, len(apiKey.Secret))},
+			})
+			require.Error(t, err)
+			require.Equal(t, rpcstatus.Unauthenticated, rpcstatus.Code(err))
+		})
+
+		t.Run("allocated disk space", func(t *testing.T) {
+			_, err := endpoint.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+				Header:    header,
+				Allocated: (10 * memory.MB).Int64(),
+			})
+			require.Error(t, err)
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+			resp, err := endpoint.SetAllocatedDiskSpace(ctx, &multinodepb.SetAllocatedDiskSpaceRequest{
+				Header:    header,
+				Allocated: (500 * memory.MB).Int64(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, (500 * memory.MB).Int64(), resp.Allocated)
+
+			diskSpace, err := node.Storage2.Monitor.DiskSpace(ctx)
+			require.NoError(t, err)
+			require.Equal(t, (500 * memory.MB).Int64(), diskSpace.Allocated)
+			require.FileExists(t, node.Config.Storage2.Monitor.AllocationOverridePath)
+		})
+
+		t.Run("trust exclusions", func(t *testing.T) {
+			exclusion := satellite.ID().String() + "@"
+
+			_, err := endpoint.AddTrustExclusion(ctx, &multinodepb.AddTrustExclusionRequest{
+				Header:    header,
+				Exclusion: "invalid@",
+			})
+			require.Error(t, err)
+			require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+			_, err = endpoint.AddTrustExclusion(ctx, &multinodepb.AddTrustExclusionRequest{
+				Header:    header,
+				Exclusion: exclusion,
+			})
+			require.NoError(t, err)
+			require.NotContains(t, node.Storage2.Trust.GetSatellites(ctx), satellite.ID())
+
+			list, err := endpoint.TrustExclusions(ctx, &multinodepb.TrustExclusionsRequest{Header: header})
+			require.NoError(t, err)
+			require.Equal(t, []string{exclusion}, list.Exclusions)
+
+			_, err = endpoint.RemoveTrustExclusion(ctx, &multinodepb.RemoveTrustExclusionRequest{
+				Header:    header,
+				Exclusion: exclusion,
+			})
+			require.NoError(t, err)
+			require.Contains(t, node.Storage2.Trust.GetSatellites(ctx), satellite.ID())
+
+			_, err = endpoint.RemoveTrustExclusion(ctx, &multinodepb.RemoveTrustExclusionRequest{
+				Header:    header,
+				Exclusion: exclusion,
+			})
+			require.Error(t, err)
+			require.Equal(t, rpcstatus.NotFound, rpcstatus.Code(err))
+		})
+
+		t.Run("rescan used space", func(t *testing.T) {
+			_, err := endpoint.RescanUsedSpace(ctx, &multinodepb.RescanUsedSpaceRequest{Header: header})
+			require.NoError(t, err)
+		})
+	})
+}
@@ -229,11 +229,12 @@ type Peer struct {
 	}
 
 	Contact struct {
-		Service   *contact.Service
-		Chore     *contact.Chore
-		Endpoint  *contact.Endpoint
-		PingStats *contact.PingStats
-		QUICStats *contact.QUICStats
+		Service     *contact.Service
+		Chore       *contact.Chore
+		Endpoint    *contact.Endpoint
+		PingStats   *contact.PingStats
+		QUICStats   *contact.QUICStats
+		Maintenance *contact.MaintenanceService
 	}
 
 	Estimation struct {
@@ -294,10 +295,11 @@ type Peer struct {
 	Reputation *reputation.Service
 
 	Multinode struct {
-		Storage   *multinode.StorageEndpoint
-		Bandwidth *multinode.BandwidthEndpoint
-		Node      *multinode.NodeEndpoint
-		Payout    *multinode.PayoutEndpoint
+		Storage    *multinode.StorageEndpoint
+		Bandwidth  *multinode.BandwidthEndpoint
+		Node       *multinode.NodeEndpoint
+		Payout     *multinode.PayoutEndpoint
+		Management *multinode.ManagementEndpoint
 	}
 }
 
@@ -450,6 +452,8 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err := pb.DRPCRegisterContact(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+
+		peer.Contact.Maintenance = contact.NewMaintenanceService(peer.Log.Named("contact:maintenance"), peer.Dialer, peer.Storage2.Trust, config.Contact.MaintenancePath)
 	}
 
 	{ // setup storage
@@ -688,6 +692,7 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			config.Operator.WalletFeatures,
 			port,
 			peer.Contact.QUICStats,
+			peer.Contact.Maintenance,
 		)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -853,6 +858,16 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 			peer.Payout.Service,
 		)
 
+		peer.Multinode.Management = multinode.NewManagementEndpoint(
+			peer.Log.Named("multinode:management-endpoint"),
+			apiKeys,
+			peer.GracefulExit.Endpoint,
+			peer.Storage2.Monitor,
+			peer.Storage2.Trust,
+			peer.Storage2.CacheService,
+			peer.Contact.Maintenance,
+		)
+
 		if err = multinodepb.DRPCRegisterStorage(peer.Server.DRPC(), peer.Multinode.Storage); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
@@ -868,6 +883,9 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, revocationDB exten
 		if err = multinodepb.DRPCRegisterPayouts(peer.Server.DRPC(), peer.Multinode.Payout); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err = multinodepb.DRPCRegisterManagement(peer.Server.DRPC(), peer.Multinode.Management); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	return peer, nil
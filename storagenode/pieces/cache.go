@@ -11,7 +11,9 @@ import (
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
+	"common/errs2"
 	"common/storx"
 	"common/sync2"
 	"storx/storage"
@@ -25,6 +27,7 @@ type CacheService struct {
 	usageCache         *BlobsUsageCache
 	store              *Store
 	pieceScanOnStartup bool
+	rescan             chan struct{}
 	Loop               *sync2.Cycle
 
 	// InitFence is released once the cache's Run method returns or when it has
@@ -40,6 +43,7 @@ func NewService(log *zap.Logger, usageCache *BlobsUsageCache, pieces *Store, int
 		usageCache:         usageCache,
 		store:              pieces,
 		pieceScanOnStartup: pieceScanOnStartup,
+		rescan:             make(chan struct{}, 1),
 		Loop:               sync2.NewCycle(interval),
 	}
 }
@@ -50,30 +54,11 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	defer service.InitFence.Release()
 
-	totalsAtStart := service.usageCache.copyCacheTotals()
-
 	// recalculate the cache once
 	if service.pieceScanOnStartup {
-		piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.SpaceUsedTotalAndBySatellite(ctx)
-		if err != nil {
-			service.log.Error("error getting current used space: ", zap.Error(err))
-			return err
-		}
-		trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
-		if err != nil {
-			service.log.Error("error getting current used space for trash: ", zap.Error(err))
+		if err := service.recalculate(ctx); err != nil {
 			return err
 		}
-		service.usageCache.Recalculate(
-			piecesTotal,
-			totalsAtStart.piecesTotal,
-			piecesContentSize,
-			totalsAtStart.piecesContentSize,
-			trashTotal,
-			totalsAtStart.trashTotal,
-			totalsBySatellite,
-			totalsAtStart.spaceUsedBySatellite,
-		)
 	} else {
 		service.log.Info("Startup piece scan omitted by configuration")
 	}
@@ -83,7 +68,25 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		return err
 	}
 
-	return service.Loop.Run(ctx, func(ctx context.Context) (err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var group errgroup.Group
+	group.Go(func() error {
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-service.rescan:
+				service.log.Info("Rescanning used space")
+				if err := service.recalculate(ctx); err != nil && !errs2.IsCanceled(err) {
+					service.log.Error("error rescanning used space: ", zap.Error(err))
+				}
+			}
+		}
+	})
+
+	err = service.Loop.Run(ctx, func(ctx context.Context) (err error) {
 		defer mon.Task()(&ctx)(&err)
 
 		// on a loop sync the cache values to the db so that we have the them saved
@@ -94,6 +97,48 @@ func (service *CacheService) Run(ctx context.Context) (err error) {
 		service.InitFence.Release()
 		return err
 	})
+
+	cancel()
+	return errs.Combine(err, group.Wait())
+}
+
+// TriggerRescan schedules recalculation of the space used cache by iterating over all the pieces
+// on disk. The rescan runs in the background, repeated requests are coalesced.
+func (service *CacheService) TriggerRescan() {
+	select {
+	case service.rescan <- struct{}{}:
+	default:
+	}
+}
+
+// recalculate iterates over all the pieces on disk and updates the space used cache.
+func (service *CacheService) recalculate(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	totalsAtStart := service.usageCache.copyCacheTotals()
+
+	piecesTotal, piecesContentSize, totalsBySatellite, err := service.store.SpaceUsedTotalAndBySatellite(ctx)
+	if err != nil {
+		service.log.Error("error getting current used space: ", zap.Error(err))
+		return err
+	}
+	trashTotal, err := service.usageCache.Blobs.SpaceUsedForTrash(ctx)
+	if err != nil {
+		service.log.Error("error getting current used space for trash: ", zap.Error(err))
+		return err
+	}
+	service.usageCache.Recalculate(
+		piecesTotal,
+		totalsAtStart.piecesTotal,
+		piecesContentSize,
+		totalsAtStart.piecesContentSize,
+		trashTotal,
+		totalsAtStart.trashTotal,
+		totalsBySatellite,
+		totalsAtStart.spaceUsedBySatellite,
+	)
+
+	return nil
 }
 
 // PersistCacheTotals saves the current totals of the space used cache to the database
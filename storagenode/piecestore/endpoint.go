@@ -310,7 +310,7 @@ func (endpoint *Endpoint) Upload(stream pb.DRPCPiecestore_UploadStream) (err err
 		return err
 	}
 
-	availableSpace, err := endpoint.monitor.AvailableSpace(ctx)
+	availableSpace, err := endpoint.monitor.SatelliteAvailableSpace(ctx, limit.SatelliteId)
 	if err != nil {
 		return rpcstatus.Wrap(rpcstatus.Internal, err)
 	}
@@ -65,6 +65,16 @@ func (cache *Cache) Set(key string, entries []Entry) {
 	cache.data.Entries[key] = entries
 }
 
+// Exclusions returns exclusions added at runtime.
+func (cache *Cache) Exclusions() []string {
+	return cache.data.Exclusions
+}
+
+// SetExclusions sets exclusions added at runtime.
+func (cache *Cache) SetExclusions(exclusions []string) {
+	cache.data.Exclusions = exclusions
+}
+
 // Save persists the cache to disk.
 func (cache *Cache) Save(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -73,7 +83,8 @@ func (cache *Cache) Save(ctx context.Context) (err error) {
 
 // CacheData represents the data stored in the cache.
 type CacheData struct {
-	Entries map[string][]Entry `json:"entries"`
+	Entries    map[string][]Entry `json:"entries"`
+	Exclusions []string           `json:"exclusions,omitempty"`
 }
 
 // NewCacheData returns an new CacheData.
@@ -6,17 +6,24 @@ package trust
 import (
 	"context"
 
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"common/storx"
 )
 
+// ErrNoExclusion is an error class for missing runtime exclusion.
+var ErrNoExclusion = errs.Class("no such exclusion")
+
 // List represents a dynamic trust list.
 type List struct {
 	log     *zap.Logger
 	sources Sources
 	rules   Rules
 	cache   *Cache
+
+	// exclusions are rules added at runtime, persisted in the cache.
+	exclusions Rules
 }
 
 // NewList takes one or more sources, optional rules, and a cache and returns a new List.
@@ -31,11 +38,23 @@ func NewList(log *zap.Logger, sources []Source, rules Rules, cache *Cache) (*Lis
 	case cache == nil:
 		return nil, Error.New("cache cannot be nil")
 	}
+
+	var exclusions Rules
+	for _, config := range cache.Exclusions() {
+		rule, err := NewExcluder(config)
+		if err != nil {
+			log.Warn("Ignoring malformed cached exclusion", zap.String("exclusion", config), zap.Error(err))
+			continue
+		}
+		exclusions = append(exclusions, rule)
+	}
+
 	return &List{
-		log:     log,
-		sources: sources,
-		rules:   rules,
-		cache:   cache,
+		log:        log,
+		sources:    sources,
+		rules:      rules,
+		cache:      cache,
+		exclusions: exclusions,
 	}, nil
 }
 
@@ -54,7 +73,7 @@ func (list *List) FetchURLs(ctx context.Context) ([]storx.NodeURL, error) {
 	byAddress := make(map[string]int)
 	entries := make([]Entry, 0, len(candidates))
 	for _, entry := range candidates {
-		if !list.rules.IsTrusted(entry.SatelliteURL) {
+		if !list.rules.IsTrusted(entry.SatelliteURL) || !list.exclusions.IsTrusted(entry.SatelliteURL) {
 			continue
 		}
 		previousIdx, ok := byAddress[entry.SatelliteURL.Address()]
@@ -80,6 +99,58 @@ func (list *List) FetchURLs(ctx context.Context) ([]storx.NodeURL, error) {
 	return urls, nil
 }
 
+// Exclusions returns exclusions added at runtime.
+func (list *List) Exclusions() []string {
+	exclusions := make([]string, 0, len(list.exclusions))
+	for _, rule := range list.exclusions {
+		exclusions = append(exclusions, rule.String())
+	}
+	return exclusions
+}
+
+// AddExclusion adds an excluding rule at runtime and persists it in the cache.
+// Accepted forms are the same as for NewExcluder.
+func (list *List) AddExclusion(ctx context.Context, config string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rule, err := NewExcluder(config)
+	if err != nil {
+		return err
+	}
+
+	for _, existing := range list.exclusions {
+		if existing.String() == rule.String() {
+			return nil
+		}
+	}
+
+	list.exclusions = append(list.exclusions, rule)
+	list.cache.SetExclusions(list.Exclusions())
+	return list.saveCache(ctx)
+}
+
+// RemoveExclusion removes an excluding rule added at runtime.
+func (list *List) RemoveExclusion(ctx context.Context, config string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rule, err := NewExcluder(config)
+	if err != nil {
+		return err
+	}
+
+	for i, existing := range list.exclusions {
+		if existing.String() != rule.String() {
+			continue
+		}
+
+		list.exclusions = append(list.exclusions[:i:i], list.exclusions[i+1:]...)
+		list.cache.SetExclusions(list.Exclusions())
+		return list.saveCache(ctx)
+	}
+
+	return ErrNoExclusion.New("%q", rule.String())
+}
+
 func (list *List) fetchEntries(ctx context.Context) (_ []Entry, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -324,6 +324,58 @@ func TestListCacheInteraction(t *testing.T) {
 	}
 }
 
+func TestListExclusions(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	foo := makeSatelliteURL("foo.test")
+	bar := makeSatelliteURL("bar.test")
+
+	source := &fakeSource{
+		name:   "fixed",
+		static: true,
+		entries: []trust.Entry{
+			{SatelliteURL: foo, Authoritative: true},
+			{SatelliteURL: bar, Authoritative: true},
+		},
+	}
+
+	cache := newTestCache(t, ctx.Dir(), nil)
+
+	list, err := trust.NewList(zaptest.NewLogger(t), []trust.Source{source}, nil, cache)
+	require.NoError(t, err)
+	require.Empty(t, list.Exclusions())
+
+	require.Error(t, list.AddExclusion(ctx, "not a valid exclusion@"))
+
+	require.NoError(t, list.AddExclusion(ctx, "bar.test"))
+	// adding the same exclusion twice is a no-op.
+	require.NoError(t, list.AddExclusion(ctx, "bar.test"))
+	require.Equal(t, []string{"bar.test"}, list.Exclusions())
+
+	urls, err := list.FetchURLs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []storx.NodeURL{foo.NodeURL()}, urls)
+
+	// exclusions survive reloading the cache.
+	reloaded, err := trust.LoadCache(cache.Path())
+	require.NoError(t, err)
+	list, err = trust.NewList(zaptest.NewLogger(t), []trust.Source{source}, nil, reloaded)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bar.test"}, list.Exclusions())
+
+	err = list.RemoveExclusion(ctx, "foo.test")
+	require.Error(t, err)
+	require.True(t, trust.ErrNoExclusion.Has(err))
+
+	require.NoError(t, list.RemoveExclusion(ctx, "bar.test"))
+	require.Empty(t, list.Exclusions())
+
+	urls, err = list.FetchURLs(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []storx.NodeURL{foo.NodeURL(), bar.NodeURL()}, urls)
+}
+
 func newTestCache(t *testing.T, dir string, entries map[string][]trust.Entry) *trust.Cache {
 	cachePath := filepath.Join(dir, "cache.json")
 
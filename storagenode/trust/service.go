@@ -230,6 +230,41 @@ func (pool *Pool) Refresh(ctx context.Context) error {
 	return nil
 }
 
+// Exclusions returns trust exclusions added at runtime.
+func (pool *Pool) Exclusions() []string {
+	pool.listMu.Lock()
+	defer pool.listMu.Unlock()
+	return pool.list.Exclusions()
+}
+
+// AddExclusion adds a trust exclusion at runtime and refreshes the pool.
+func (pool *Pool) AddExclusion(ctx context.Context, config string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pool.listMu.Lock()
+	err = pool.list.AddExclusion(ctx, config)
+	pool.listMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return pool.Refresh(ctx)
+}
+
+// RemoveExclusion removes a trust exclusion added at runtime and refreshes the pool.
+func (pool *Pool) RemoveExclusion(ctx context.Context, config string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pool.listMu.Lock()
+	err = pool.list.RemoveExclusion(ctx, config)
+	pool.listMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return pool.Refresh(ctx)
+}
+
 func (pool *Pool) getInfo(id storx.NodeID) (*satelliteInfoCache, error) {
 	pool.satellitesMu.RLock()
 	defer pool.satellitesMu.RUnlock()
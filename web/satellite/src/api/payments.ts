@@ -10,6 +10,7 @@ import {
     CreditCard,
     PaymentsApi,
     PaymentsHistoryItem,
+    PriceTier,
     ProjectUsageAndCharges,
     ProjectUsagePriceModel,
     TokenAmount,
@@ -112,12 +113,35 @@ export class PaymentsHttpApi implements PaymentsApi {
 
         const model = await response.json();
         if (model) {
-            return new ProjectUsagePriceModel(model.storageMBMonthCents, model.egressMBCents, model.segmentMonthCents);
+            return this.priceModelFromJSON(model);
         }
 
         return new ProjectUsagePriceModel();
     }
 
+    /**
+     * priceModelFromJSON converts the price model returned by the server, including its placement price models.
+     */
+    private priceModelFromJSON(model: any): ProjectUsagePriceModel { // eslint-disable-line @typescript-eslint/no-explicit-any
+        const tiers = (tiers: any[] | null | undefined): PriceTier[] => { // eslint-disable-line @typescript-eslint/no-explicit-any
+            return (tiers || []).map(tier => new PriceTier(tier.threshold, tier.cents));
+        };
+
+        const placements: Record<number, ProjectUsagePriceModel> = {};
+        Object.keys(model.placements || {}).forEach(placement => {
+            placements[Number(placement)] = this.priceModelFromJSON(model.placements[placement]);
+        });
+
+        return new ProjectUsagePriceModel(
+            model.storageMBMonthCents,
+            model.egressMBCents,
+            model.segmentMonthCents,
+            tiers(model.storageTiers),
+            tiers(model.egressTiers),
+            placements,
+        );
+    }
+
     /**
      * Add credit card.
      *
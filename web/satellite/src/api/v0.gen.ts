@@ -10,6 +10,10 @@ export class APIKeyInfo {
     userAgent: string;
     name: string;
     createdAt: Time;
+    expiresAt: Time;
+    dailyEgressLimit: number;
+    requestsPerMinute: number;
+    expiringSoon: boolean;
 }
 
 export class APIKeyPage {
@@ -41,6 +45,9 @@ export class BucketUsageRollup {
 export class CreateAPIKeyRequest {
     projectID: string;
     name: string;
+    expiresAt: Time;
+    dailyEgressLimit: number;
+    requestsPerMinute: number;
 }
 
 export class CreateAPIKeyResponse {
@@ -439,5 +439,26 @@ export class ProjectUsagePriceModel {
         public readonly storageMBMonthCents: string = '',
         public readonly egressMBCents: string = '',
         public readonly segmentMonthCents: string = '',
+        public readonly storageTiers: PriceTier[] = [],
+        public readonly egressTiers: PriceTier[] = [],
+        public readonly placements: Record<number, ProjectUsagePriceModel> = {},
+    ) { }
+
+    /**
+     * isFlat indicates whether all usage is charged at the base prices of the model,
+     * i.e. there are neither volume tiers nor placement specific prices.
+     */
+    public isFlat(): boolean {
+        return !this.storageTiers.length && !this.egressTiers.length && !Object.keys(this.placements).length;
+    }
+}
+
+/**
+ * PriceTier represents the unit price of the usage above a threshold.
+ */
+export class PriceTier {
+    public constructor(
+        public readonly threshold: string = '',
+        public readonly cents: string = '',
     ) { }
 }
@@ -43,6 +43,7 @@ export class FrontendConfig {
     passwordMaximumLength: number;
     abTestingEnabled: boolean;
     pricingPackagesEnabled: boolean;
+    ssoEnabled: boolean;
 }
 
 export class MultiCaptchaConfig {
@@ -70,6 +70,7 @@ export function newNodeModule(service: StorageNodeService): StoreModule<StorageN
                     nodeInfo.quicStatus,
                     nodeInfo.configuredPort,
                     nodeInfo.lastQuicPingedAt,
+                    nodeInfo.maintenance,
                 );
 
                 state.utilization = new Utilization(
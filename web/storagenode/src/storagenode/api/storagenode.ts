@@ -3,6 +3,7 @@
 
 import {
     Dashboard,
+    MaintenanceWindow,
     Satellite,
     SatelliteByDayInfo,
     SatelliteInfo,
@@ -44,8 +45,11 @@ export class StorageNodeApi {
         const diskSpace: Traffic = new Traffic(data.diskSpace.used, data.diskSpace.available, data.diskSpace.trash, data.diskSpace.overused);
         const bandwidth: Traffic = new Traffic(data.bandwidth.used);
 
+        const maintenance: MaintenanceWindow | null = data.maintenance ?
+            new MaintenanceWindow(new Date(data.maintenance.start), new Date(data.maintenance.end)) : null;
+
         return new Dashboard(data.nodeID, data.wallet, data.walletFeatures || [], satellites, diskSpace, bandwidth,
-            new Date(data.lastPinged), new Date(data.startedAt), data.version, data.allowedVersion, data.upToDate, data.quicStatus, data.configuredPort, new Date(data.lastQuicPingedAt));
+            new Date(data.lastPinged), new Date(data.startedAt), data.version, data.allowedVersion, data.upToDate, data.quicStatus, data.configuredPort, new Date(data.lastQuicPingedAt), maintenance);
     }
 
     /**
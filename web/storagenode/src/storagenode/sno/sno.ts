@@ -20,6 +20,17 @@ export class Node {
         public quicStatus: string = '',
         public configuredPort: string = '',
         public lastQuicPingedAt: Date = new Date(),
+        public maintenance: MaintenanceWindow | null = null,
+    ) {}
+}
+
+/**
+ * MaintenanceWindow holds planned downtime announced to satellites.
+ */
+export class MaintenanceWindow {
+    public constructor(
+        public start: Date = new Date(),
+        public end: Date = new Date(),
     ) {}
 }
 
@@ -79,6 +90,7 @@ export class Dashboard {
         public quicStatus: string,
         public configuredPort: string,
         public lastQuicPingedAt: Date,
+        public maintenance: MaintenanceWindow | null = null,
     ) { }
 }
 